@@ -607,9 +607,9 @@ func (x *AccessDefinition) GetIsChecker() bool {
 var File_vvtxchain_acl_access_definition_proto protoreflect.FileDescriptor
 
 var file_vvtxchain_acl_access_definition_proto_rawDesc = []byte{
-	0x0a, 0x25, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x63, 0x6c, 0x2f,
+	0x0a, 0x25, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x63, 0x6c, 0x2f,
 	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61,
 	0x69, 0x6e, 0x2e, 0x61, 0x63, 0x6c, 0x22, 0x64, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
 	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x6f,
 	0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x6f, 0x64, 0x75,
@@ -617,17 +617,17 @@ var file_vvtxchain_acl_access_definition_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x1d, 0x0a,
 	0x0a, 0x69, 0x73, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x08, 0x52, 0x09, 0x69, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x42, 0xb0, 0x01, 0x0a,
-	0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x61,
+	0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x61,
 	0x63, 0x6c, 0x42, 0x15, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
 	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x47, 0x45, 0x5a, 0x4c, 0x61, 0x62, 0x73,
-	0x2f, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67,
-	0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x63, 0x6c, 0xa2, 0x02, 0x03, 0x47,
-	0x41, 0x58, 0xaa, 0x02, 0x0d, 0x47, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x41,
-	0x63, 0x6c, 0xca, 0x02, 0x0d, 0x47, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x41,
-	0x63, 0x6c, 0xe2, 0x02, 0x19, 0x47, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x41,
+	0x2f, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x63, 0x6c, 0xa2, 0x02, 0x03, 0x56,
+	0x41, 0x58, 0xaa, 0x02, 0x0d, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x41,
+	0x63, 0x6c, 0xca, 0x02, 0x0d, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x41,
+	0x63, 0x6c, 0xe2, 0x02, 0x19, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x41,
 	0x63, 0x6c, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x0e, 0x47, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x41, 0x63, 0x6c, 0x62,
+	0x0e, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x41, 0x63, 0x6c, 0x62,
 	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
@@ -0,0 +1,6052 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package trade
+
+import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_EventTradeCreated                    protoreflect.MessageDescriptor
+	fd_EventTradeCreated_trade_index        protoreflect.FieldDescriptor
+	fd_EventTradeCreated_status             protoreflect.FieldDescriptor
+	fd_EventTradeCreated_maker              protoreflect.FieldDescriptor
+	fd_EventTradeCreated_trade_type         protoreflect.FieldDescriptor
+	fd_EventTradeCreated_amount             protoreflect.FieldDescriptor
+	fd_EventTradeCreated_receiver_address   protoreflect.FieldDescriptor
+	fd_EventTradeCreated_external_reference protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_vvtxchain_trade_events_proto_init()
+	md_EventTradeCreated = File_vvtxchain_trade_events_proto.Messages().ByName("EventTradeCreated")
+	fd_EventTradeCreated_trade_index = md_EventTradeCreated.Fields().ByName("trade_index")
+	fd_EventTradeCreated_status = md_EventTradeCreated.Fields().ByName("status")
+	fd_EventTradeCreated_maker = md_EventTradeCreated.Fields().ByName("maker")
+	fd_EventTradeCreated_trade_type = md_EventTradeCreated.Fields().ByName("trade_type")
+	fd_EventTradeCreated_amount = md_EventTradeCreated.Fields().ByName("amount")
+	fd_EventTradeCreated_receiver_address = md_EventTradeCreated.Fields().ByName("receiver_address")
+	fd_EventTradeCreated_external_reference = md_EventTradeCreated.Fields().ByName("external_reference")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventTradeCreated)(nil)
+
+type fastReflection_EventTradeCreated EventTradeCreated
+
+func (x *EventTradeCreated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTradeCreated)(x)
+}
+
+func (x *EventTradeCreated) slowProtoReflect() protoreflect.Message {
+	mi := &file_vvtxchain_trade_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventTradeCreated_messageType fastReflection_EventTradeCreated_messageType
+var _ protoreflect.MessageType = fastReflection_EventTradeCreated_messageType{}
+
+type fastReflection_EventTradeCreated_messageType struct{}
+
+func (x fastReflection_EventTradeCreated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTradeCreated)(nil)
+}
+func (x fastReflection_EventTradeCreated_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTradeCreated)
+}
+func (x fastReflection_EventTradeCreated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeCreated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventTradeCreated) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeCreated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventTradeCreated) Type() protoreflect.MessageType {
+	return _fastReflection_EventTradeCreated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventTradeCreated) New() protoreflect.Message {
+	return new(fastReflection_EventTradeCreated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventTradeCreated) Interface() protoreflect.ProtoMessage {
+	return (*EventTradeCreated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventTradeCreated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TradeIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TradeIndex)
+		if !f(fd_EventTradeCreated_trade_index, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_EventTradeCreated_status, value) {
+			return
+		}
+	}
+	if x.Maker != "" {
+		value := protoreflect.ValueOfString(x.Maker)
+		if !f(fd_EventTradeCreated_maker, value) {
+			return
+		}
+	}
+	if x.TradeType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TradeType))
+		if !f(fd_EventTradeCreated_trade_type, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_EventTradeCreated_amount, value) {
+			return
+		}
+	}
+	if x.ReceiverAddress != "" {
+		value := protoreflect.ValueOfString(x.ReceiverAddress)
+		if !f(fd_EventTradeCreated_receiver_address, value) {
+			return
+		}
+	}
+	if x.ExternalReference != "" {
+		value := protoreflect.ValueOfString(x.ExternalReference)
+		if !f(fd_EventTradeCreated_external_reference, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventTradeCreated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeCreated.trade_index":
+		return x.TradeIndex != uint64(0)
+	case "vvtxchain.trade.EventTradeCreated.status":
+		return x.Status != 0
+	case "vvtxchain.trade.EventTradeCreated.maker":
+		return x.Maker != ""
+	case "vvtxchain.trade.EventTradeCreated.trade_type":
+		return x.TradeType != 0
+	case "vvtxchain.trade.EventTradeCreated.amount":
+		return x.Amount != nil
+	case "vvtxchain.trade.EventTradeCreated.receiver_address":
+		return x.ReceiverAddress != ""
+	case "vvtxchain.trade.EventTradeCreated.external_reference":
+		return x.ExternalReference != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeCreated"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeCreated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeCreated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeCreated.trade_index":
+		x.TradeIndex = uint64(0)
+	case "vvtxchain.trade.EventTradeCreated.status":
+		x.Status = 0
+	case "vvtxchain.trade.EventTradeCreated.maker":
+		x.Maker = ""
+	case "vvtxchain.trade.EventTradeCreated.trade_type":
+		x.TradeType = 0
+	case "vvtxchain.trade.EventTradeCreated.amount":
+		x.Amount = nil
+	case "vvtxchain.trade.EventTradeCreated.receiver_address":
+		x.ReceiverAddress = ""
+	case "vvtxchain.trade.EventTradeCreated.external_reference":
+		x.ExternalReference = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeCreated"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeCreated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventTradeCreated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "vvtxchain.trade.EventTradeCreated.trade_index":
+		value := x.TradeIndex
+		return protoreflect.ValueOfUint64(value)
+	case "vvtxchain.trade.EventTradeCreated.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "vvtxchain.trade.EventTradeCreated.maker":
+		value := x.Maker
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.EventTradeCreated.trade_type":
+		value := x.TradeType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "vvtxchain.trade.EventTradeCreated.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "vvtxchain.trade.EventTradeCreated.receiver_address":
+		value := x.ReceiverAddress
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.EventTradeCreated.external_reference":
+		value := x.ExternalReference
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeCreated"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeCreated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeCreated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeCreated.trade_index":
+		x.TradeIndex = value.Uint()
+	case "vvtxchain.trade.EventTradeCreated.status":
+		x.Status = (TradeStatus)(value.Enum())
+	case "vvtxchain.trade.EventTradeCreated.maker":
+		x.Maker = value.Interface().(string)
+	case "vvtxchain.trade.EventTradeCreated.trade_type":
+		x.TradeType = (TradeType)(value.Enum())
+	case "vvtxchain.trade.EventTradeCreated.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	case "vvtxchain.trade.EventTradeCreated.receiver_address":
+		x.ReceiverAddress = value.Interface().(string)
+	case "vvtxchain.trade.EventTradeCreated.external_reference":
+		x.ExternalReference = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeCreated"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeCreated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeCreated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeCreated.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "vvtxchain.trade.EventTradeCreated.trade_index":
+		panic(fmt.Errorf("field trade_index of message vvtxchain.trade.EventTradeCreated is not mutable"))
+	case "vvtxchain.trade.EventTradeCreated.status":
+		panic(fmt.Errorf("field status of message vvtxchain.trade.EventTradeCreated is not mutable"))
+	case "vvtxchain.trade.EventTradeCreated.maker":
+		panic(fmt.Errorf("field maker of message vvtxchain.trade.EventTradeCreated is not mutable"))
+	case "vvtxchain.trade.EventTradeCreated.trade_type":
+		panic(fmt.Errorf("field trade_type of message vvtxchain.trade.EventTradeCreated is not mutable"))
+	case "vvtxchain.trade.EventTradeCreated.receiver_address":
+		panic(fmt.Errorf("field receiver_address of message vvtxchain.trade.EventTradeCreated is not mutable"))
+	case "vvtxchain.trade.EventTradeCreated.external_reference":
+		panic(fmt.Errorf("field external_reference of message vvtxchain.trade.EventTradeCreated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeCreated"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeCreated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventTradeCreated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeCreated.trade_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "vvtxchain.trade.EventTradeCreated.status":
+		return protoreflect.ValueOfEnum(0)
+	case "vvtxchain.trade.EventTradeCreated.maker":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.EventTradeCreated.trade_type":
+		return protoreflect.ValueOfEnum(0)
+	case "vvtxchain.trade.EventTradeCreated.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "vvtxchain.trade.EventTradeCreated.receiver_address":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.EventTradeCreated.external_reference":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeCreated"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeCreated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventTradeCreated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in vvtxchain.trade.EventTradeCreated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventTradeCreated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeCreated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventTradeCreated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventTradeCreated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventTradeCreated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TradeIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.TradeIndex))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Maker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TradeType != 0 {
+			n += 1 + runtime.Sov(uint64(x.TradeType))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReceiverAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ExternalReference)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventTradeCreated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ExternalReference) > 0 {
+			i -= len(x.ExternalReference)
+			copy(dAtA[i:], x.ExternalReference)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExternalReference)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.ReceiverAddress) > 0 {
+			i -= len(x.ReceiverAddress)
+			copy(dAtA[i:], x.ReceiverAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReceiverAddress)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.TradeType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TradeType))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Maker) > 0 {
+			i -= len(x.Maker)
+			copy(dAtA[i:], x.Maker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Maker)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TradeIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TradeIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventTradeCreated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeCreated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+				}
+				x.TradeIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TradeIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= TradeStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Maker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeType", wireType)
+				}
+				x.TradeType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TradeType |= TradeType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReceiverAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalReference", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExternalReference = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventTradeProcessed                    protoreflect.MessageDescriptor
+	fd_EventTradeProcessed_trade_index        protoreflect.FieldDescriptor
+	fd_EventTradeProcessed_status             protoreflect.FieldDescriptor
+	fd_EventTradeProcessed_checker            protoreflect.FieldDescriptor
+	fd_EventTradeProcessed_maker              protoreflect.FieldDescriptor
+	fd_EventTradeProcessed_process_date       protoreflect.FieldDescriptor
+	fd_EventTradeProcessed_result             protoreflect.FieldDescriptor
+	fd_EventTradeProcessed_revision           protoreflect.FieldDescriptor
+	fd_EventTradeProcessed_approvals          protoreflect.FieldDescriptor
+	fd_EventTradeProcessed_required_approvals protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_vvtxchain_trade_events_proto_init()
+	md_EventTradeProcessed = File_vvtxchain_trade_events_proto.Messages().ByName("EventTradeProcessed")
+	fd_EventTradeProcessed_trade_index = md_EventTradeProcessed.Fields().ByName("trade_index")
+	fd_EventTradeProcessed_status = md_EventTradeProcessed.Fields().ByName("status")
+	fd_EventTradeProcessed_checker = md_EventTradeProcessed.Fields().ByName("checker")
+	fd_EventTradeProcessed_maker = md_EventTradeProcessed.Fields().ByName("maker")
+	fd_EventTradeProcessed_process_date = md_EventTradeProcessed.Fields().ByName("process_date")
+	fd_EventTradeProcessed_result = md_EventTradeProcessed.Fields().ByName("result")
+	fd_EventTradeProcessed_revision = md_EventTradeProcessed.Fields().ByName("revision")
+	fd_EventTradeProcessed_approvals = md_EventTradeProcessed.Fields().ByName("approvals")
+	fd_EventTradeProcessed_required_approvals = md_EventTradeProcessed.Fields().ByName("required_approvals")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventTradeProcessed)(nil)
+
+type fastReflection_EventTradeProcessed EventTradeProcessed
+
+func (x *EventTradeProcessed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTradeProcessed)(x)
+}
+
+func (x *EventTradeProcessed) slowProtoReflect() protoreflect.Message {
+	mi := &file_vvtxchain_trade_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventTradeProcessed_messageType fastReflection_EventTradeProcessed_messageType
+var _ protoreflect.MessageType = fastReflection_EventTradeProcessed_messageType{}
+
+type fastReflection_EventTradeProcessed_messageType struct{}
+
+func (x fastReflection_EventTradeProcessed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTradeProcessed)(nil)
+}
+func (x fastReflection_EventTradeProcessed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTradeProcessed)
+}
+func (x fastReflection_EventTradeProcessed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeProcessed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventTradeProcessed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeProcessed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventTradeProcessed) Type() protoreflect.MessageType {
+	return _fastReflection_EventTradeProcessed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventTradeProcessed) New() protoreflect.Message {
+	return new(fastReflection_EventTradeProcessed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventTradeProcessed) Interface() protoreflect.ProtoMessage {
+	return (*EventTradeProcessed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventTradeProcessed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TradeIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TradeIndex)
+		if !f(fd_EventTradeProcessed_trade_index, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_EventTradeProcessed_status, value) {
+			return
+		}
+	}
+	if x.Checker != "" {
+		value := protoreflect.ValueOfString(x.Checker)
+		if !f(fd_EventTradeProcessed_checker, value) {
+			return
+		}
+	}
+	if x.Maker != "" {
+		value := protoreflect.ValueOfString(x.Maker)
+		if !f(fd_EventTradeProcessed_maker, value) {
+			return
+		}
+	}
+	if x.ProcessDate != "" {
+		value := protoreflect.ValueOfString(x.ProcessDate)
+		if !f(fd_EventTradeProcessed_process_date, value) {
+			return
+		}
+	}
+	if x.Result != "" {
+		value := protoreflect.ValueOfString(x.Result)
+		if !f(fd_EventTradeProcessed_result, value) {
+			return
+		}
+	}
+	if x.Revision != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Revision)
+		if !f(fd_EventTradeProcessed_revision, value) {
+			return
+		}
+	}
+	if x.Approvals != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Approvals)
+		if !f(fd_EventTradeProcessed_approvals, value) {
+			return
+		}
+	}
+	if x.RequiredApprovals != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RequiredApprovals)
+		if !f(fd_EventTradeProcessed_required_approvals, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventTradeProcessed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeProcessed.trade_index":
+		return x.TradeIndex != uint64(0)
+	case "vvtxchain.trade.EventTradeProcessed.status":
+		return x.Status != 0
+	case "vvtxchain.trade.EventTradeProcessed.checker":
+		return x.Checker != ""
+	case "vvtxchain.trade.EventTradeProcessed.maker":
+		return x.Maker != ""
+	case "vvtxchain.trade.EventTradeProcessed.process_date":
+		return x.ProcessDate != ""
+	case "vvtxchain.trade.EventTradeProcessed.result":
+		return x.Result != ""
+	case "vvtxchain.trade.EventTradeProcessed.revision":
+		return x.Revision != uint64(0)
+	case "vvtxchain.trade.EventTradeProcessed.approvals":
+		return x.Approvals != uint32(0)
+	case "vvtxchain.trade.EventTradeProcessed.required_approvals":
+		return x.RequiredApprovals != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeProcessed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeProcessed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeProcessed.trade_index":
+		x.TradeIndex = uint64(0)
+	case "vvtxchain.trade.EventTradeProcessed.status":
+		x.Status = 0
+	case "vvtxchain.trade.EventTradeProcessed.checker":
+		x.Checker = ""
+	case "vvtxchain.trade.EventTradeProcessed.maker":
+		x.Maker = ""
+	case "vvtxchain.trade.EventTradeProcessed.process_date":
+		x.ProcessDate = ""
+	case "vvtxchain.trade.EventTradeProcessed.result":
+		x.Result = ""
+	case "vvtxchain.trade.EventTradeProcessed.revision":
+		x.Revision = uint64(0)
+	case "vvtxchain.trade.EventTradeProcessed.approvals":
+		x.Approvals = uint32(0)
+	case "vvtxchain.trade.EventTradeProcessed.required_approvals":
+		x.RequiredApprovals = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeProcessed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventTradeProcessed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "vvtxchain.trade.EventTradeProcessed.trade_index":
+		value := x.TradeIndex
+		return protoreflect.ValueOfUint64(value)
+	case "vvtxchain.trade.EventTradeProcessed.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "vvtxchain.trade.EventTradeProcessed.checker":
+		value := x.Checker
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.EventTradeProcessed.maker":
+		value := x.Maker
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.EventTradeProcessed.process_date":
+		value := x.ProcessDate
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.EventTradeProcessed.result":
+		value := x.Result
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.EventTradeProcessed.revision":
+		value := x.Revision
+		return protoreflect.ValueOfUint64(value)
+	case "vvtxchain.trade.EventTradeProcessed.approvals":
+		value := x.Approvals
+		return protoreflect.ValueOfUint32(value)
+	case "vvtxchain.trade.EventTradeProcessed.required_approvals":
+		value := x.RequiredApprovals
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeProcessed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeProcessed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeProcessed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeProcessed.trade_index":
+		x.TradeIndex = value.Uint()
+	case "vvtxchain.trade.EventTradeProcessed.status":
+		x.Status = (TradeStatus)(value.Enum())
+	case "vvtxchain.trade.EventTradeProcessed.checker":
+		x.Checker = value.Interface().(string)
+	case "vvtxchain.trade.EventTradeProcessed.maker":
+		x.Maker = value.Interface().(string)
+	case "vvtxchain.trade.EventTradeProcessed.process_date":
+		x.ProcessDate = value.Interface().(string)
+	case "vvtxchain.trade.EventTradeProcessed.result":
+		x.Result = value.Interface().(string)
+	case "vvtxchain.trade.EventTradeProcessed.revision":
+		x.Revision = value.Uint()
+	case "vvtxchain.trade.EventTradeProcessed.approvals":
+		x.Approvals = uint32(value.Uint())
+	case "vvtxchain.trade.EventTradeProcessed.required_approvals":
+		x.RequiredApprovals = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeProcessed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeProcessed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeProcessed.trade_index":
+		panic(fmt.Errorf("field trade_index of message vvtxchain.trade.EventTradeProcessed is not mutable"))
+	case "vvtxchain.trade.EventTradeProcessed.status":
+		panic(fmt.Errorf("field status of message vvtxchain.trade.EventTradeProcessed is not mutable"))
+	case "vvtxchain.trade.EventTradeProcessed.checker":
+		panic(fmt.Errorf("field checker of message vvtxchain.trade.EventTradeProcessed is not mutable"))
+	case "vvtxchain.trade.EventTradeProcessed.maker":
+		panic(fmt.Errorf("field maker of message vvtxchain.trade.EventTradeProcessed is not mutable"))
+	case "vvtxchain.trade.EventTradeProcessed.process_date":
+		panic(fmt.Errorf("field process_date of message vvtxchain.trade.EventTradeProcessed is not mutable"))
+	case "vvtxchain.trade.EventTradeProcessed.result":
+		panic(fmt.Errorf("field result of message vvtxchain.trade.EventTradeProcessed is not mutable"))
+	case "vvtxchain.trade.EventTradeProcessed.revision":
+		panic(fmt.Errorf("field revision of message vvtxchain.trade.EventTradeProcessed is not mutable"))
+	case "vvtxchain.trade.EventTradeProcessed.approvals":
+		panic(fmt.Errorf("field approvals of message vvtxchain.trade.EventTradeProcessed is not mutable"))
+	case "vvtxchain.trade.EventTradeProcessed.required_approvals":
+		panic(fmt.Errorf("field required_approvals of message vvtxchain.trade.EventTradeProcessed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeProcessed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventTradeProcessed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeProcessed.trade_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "vvtxchain.trade.EventTradeProcessed.status":
+		return protoreflect.ValueOfEnum(0)
+	case "vvtxchain.trade.EventTradeProcessed.checker":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.EventTradeProcessed.maker":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.EventTradeProcessed.process_date":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.EventTradeProcessed.result":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.EventTradeProcessed.revision":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "vvtxchain.trade.EventTradeProcessed.approvals":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "vvtxchain.trade.EventTradeProcessed.required_approvals":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeProcessed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeProcessed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventTradeProcessed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in vvtxchain.trade.EventTradeProcessed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventTradeProcessed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeProcessed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventTradeProcessed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventTradeProcessed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventTradeProcessed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TradeIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.TradeIndex))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Checker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Maker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProcessDate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Result)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Revision != 0 {
+			n += 1 + runtime.Sov(uint64(x.Revision))
+		}
+		if x.Approvals != 0 {
+			n += 1 + runtime.Sov(uint64(x.Approvals))
+		}
+		if x.RequiredApprovals != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequiredApprovals))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventTradeProcessed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RequiredApprovals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequiredApprovals))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Approvals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Approvals))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Revision != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Revision))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.ProcessDate) > 0 {
+			i -= len(x.ProcessDate)
+			copy(dAtA[i:], x.ProcessDate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProcessDate)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Maker) > 0 {
+			i -= len(x.Maker)
+			copy(dAtA[i:], x.Maker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Maker)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Checker) > 0 {
+			i -= len(x.Checker)
+			copy(dAtA[i:], x.Checker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Checker)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TradeIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TradeIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventTradeProcessed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeProcessed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeProcessed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+				}
+				x.TradeIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TradeIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= TradeStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Checker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Checker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Maker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProcessDate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProcessDate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Result = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				}
+				x.Revision = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Revision |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+				}
+				x.Approvals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Approvals |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequiredApprovals", wireType)
+				}
+				x.RequiredApprovals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequiredApprovals |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventTradeCanceled             protoreflect.MessageDescriptor
+	fd_EventTradeCanceled_trade_index protoreflect.FieldDescriptor
+	fd_EventTradeCanceled_status      protoreflect.FieldDescriptor
+	fd_EventTradeCanceled_canceled_by protoreflect.FieldDescriptor
+	fd_EventTradeCanceled_maker       protoreflect.FieldDescriptor
+	fd_EventTradeCanceled_reason      protoreflect.FieldDescriptor
+	fd_EventTradeCanceled_result      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_vvtxchain_trade_events_proto_init()
+	md_EventTradeCanceled = File_vvtxchain_trade_events_proto.Messages().ByName("EventTradeCanceled")
+	fd_EventTradeCanceled_trade_index = md_EventTradeCanceled.Fields().ByName("trade_index")
+	fd_EventTradeCanceled_status = md_EventTradeCanceled.Fields().ByName("status")
+	fd_EventTradeCanceled_canceled_by = md_EventTradeCanceled.Fields().ByName("canceled_by")
+	fd_EventTradeCanceled_maker = md_EventTradeCanceled.Fields().ByName("maker")
+	fd_EventTradeCanceled_reason = md_EventTradeCanceled.Fields().ByName("reason")
+	fd_EventTradeCanceled_result = md_EventTradeCanceled.Fields().ByName("result")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventTradeCanceled)(nil)
+
+type fastReflection_EventTradeCanceled EventTradeCanceled
+
+func (x *EventTradeCanceled) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTradeCanceled)(x)
+}
+
+func (x *EventTradeCanceled) slowProtoReflect() protoreflect.Message {
+	mi := &file_vvtxchain_trade_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventTradeCanceled_messageType fastReflection_EventTradeCanceled_messageType
+var _ protoreflect.MessageType = fastReflection_EventTradeCanceled_messageType{}
+
+type fastReflection_EventTradeCanceled_messageType struct{}
+
+func (x fastReflection_EventTradeCanceled_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTradeCanceled)(nil)
+}
+func (x fastReflection_EventTradeCanceled_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTradeCanceled)
+}
+func (x fastReflection_EventTradeCanceled_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeCanceled
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventTradeCanceled) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeCanceled
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventTradeCanceled) Type() protoreflect.MessageType {
+	return _fastReflection_EventTradeCanceled_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventTradeCanceled) New() protoreflect.Message {
+	return new(fastReflection_EventTradeCanceled)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventTradeCanceled) Interface() protoreflect.ProtoMessage {
+	return (*EventTradeCanceled)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventTradeCanceled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TradeIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TradeIndex)
+		if !f(fd_EventTradeCanceled_trade_index, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_EventTradeCanceled_status, value) {
+			return
+		}
+	}
+	if x.CanceledBy != "" {
+		value := protoreflect.ValueOfString(x.CanceledBy)
+		if !f(fd_EventTradeCanceled_canceled_by, value) {
+			return
+		}
+	}
+	if x.Maker != "" {
+		value := protoreflect.ValueOfString(x.Maker)
+		if !f(fd_EventTradeCanceled_maker, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventTradeCanceled_reason, value) {
+			return
+		}
+	}
+	if x.Result != "" {
+		value := protoreflect.ValueOfString(x.Result)
+		if !f(fd_EventTradeCanceled_result, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventTradeCanceled) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeCanceled.trade_index":
+		return x.TradeIndex != uint64(0)
+	case "vvtxchain.trade.EventTradeCanceled.status":
+		return x.Status != 0
+	case "vvtxchain.trade.EventTradeCanceled.canceled_by":
+		return x.CanceledBy != ""
+	case "vvtxchain.trade.EventTradeCanceled.maker":
+		return x.Maker != ""
+	case "vvtxchain.trade.EventTradeCanceled.reason":
+		return x.Reason != ""
+	case "vvtxchain.trade.EventTradeCanceled.result":
+		return x.Result != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeCanceled"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeCanceled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeCanceled) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeCanceled.trade_index":
+		x.TradeIndex = uint64(0)
+	case "vvtxchain.trade.EventTradeCanceled.status":
+		x.Status = 0
+	case "vvtxchain.trade.EventTradeCanceled.canceled_by":
+		x.CanceledBy = ""
+	case "vvtxchain.trade.EventTradeCanceled.maker":
+		x.Maker = ""
+	case "vvtxchain.trade.EventTradeCanceled.reason":
+		x.Reason = ""
+	case "vvtxchain.trade.EventTradeCanceled.result":
+		x.Result = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeCanceled"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeCanceled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventTradeCanceled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "vvtxchain.trade.EventTradeCanceled.trade_index":
+		value := x.TradeIndex
+		return protoreflect.ValueOfUint64(value)
+	case "vvtxchain.trade.EventTradeCanceled.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "vvtxchain.trade.EventTradeCanceled.canceled_by":
+		value := x.CanceledBy
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.EventTradeCanceled.maker":
+		value := x.Maker
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.EventTradeCanceled.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.EventTradeCanceled.result":
+		value := x.Result
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeCanceled"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeCanceled does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeCanceled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeCanceled.trade_index":
+		x.TradeIndex = value.Uint()
+	case "vvtxchain.trade.EventTradeCanceled.status":
+		x.Status = (TradeStatus)(value.Enum())
+	case "vvtxchain.trade.EventTradeCanceled.canceled_by":
+		x.CanceledBy = value.Interface().(string)
+	case "vvtxchain.trade.EventTradeCanceled.maker":
+		x.Maker = value.Interface().(string)
+	case "vvtxchain.trade.EventTradeCanceled.reason":
+		x.Reason = value.Interface().(string)
+	case "vvtxchain.trade.EventTradeCanceled.result":
+		x.Result = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeCanceled"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeCanceled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeCanceled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeCanceled.trade_index":
+		panic(fmt.Errorf("field trade_index of message vvtxchain.trade.EventTradeCanceled is not mutable"))
+	case "vvtxchain.trade.EventTradeCanceled.status":
+		panic(fmt.Errorf("field status of message vvtxchain.trade.EventTradeCanceled is not mutable"))
+	case "vvtxchain.trade.EventTradeCanceled.canceled_by":
+		panic(fmt.Errorf("field canceled_by of message vvtxchain.trade.EventTradeCanceled is not mutable"))
+	case "vvtxchain.trade.EventTradeCanceled.maker":
+		panic(fmt.Errorf("field maker of message vvtxchain.trade.EventTradeCanceled is not mutable"))
+	case "vvtxchain.trade.EventTradeCanceled.reason":
+		panic(fmt.Errorf("field reason of message vvtxchain.trade.EventTradeCanceled is not mutable"))
+	case "vvtxchain.trade.EventTradeCanceled.result":
+		panic(fmt.Errorf("field result of message vvtxchain.trade.EventTradeCanceled is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeCanceled"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeCanceled does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventTradeCanceled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeCanceled.trade_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "vvtxchain.trade.EventTradeCanceled.status":
+		return protoreflect.ValueOfEnum(0)
+	case "vvtxchain.trade.EventTradeCanceled.canceled_by":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.EventTradeCanceled.maker":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.EventTradeCanceled.reason":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.EventTradeCanceled.result":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeCanceled"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeCanceled does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventTradeCanceled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in vvtxchain.trade.EventTradeCanceled", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventTradeCanceled) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeCanceled) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventTradeCanceled) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventTradeCanceled) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventTradeCanceled)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TradeIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.TradeIndex))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.CanceledBy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Maker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Result)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventTradeCanceled)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Maker) > 0 {
+			i -= len(x.Maker)
+			copy(dAtA[i:], x.Maker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Maker)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.CanceledBy) > 0 {
+			i -= len(x.CanceledBy)
+			copy(dAtA[i:], x.CanceledBy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CanceledBy)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TradeIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TradeIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventTradeCanceled)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeCanceled: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+				}
+				x.TradeIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TradeIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= TradeStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CanceledBy", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CanceledBy = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Maker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Result = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventTradeAmended                       protoreflect.MessageDescriptor
+	fd_EventTradeAmended_trade_index           protoreflect.FieldDescriptor
+	fd_EventTradeAmended_revision              protoreflect.FieldDescriptor
+	fd_EventTradeAmended_maker                 protoreflect.FieldDescriptor
+	fd_EventTradeAmended_payload_hash          protoreflect.FieldDescriptor
+	fd_EventTradeAmended_previous_payload_hash protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_vvtxchain_trade_events_proto_init()
+	md_EventTradeAmended = File_vvtxchain_trade_events_proto.Messages().ByName("EventTradeAmended")
+	fd_EventTradeAmended_trade_index = md_EventTradeAmended.Fields().ByName("trade_index")
+	fd_EventTradeAmended_revision = md_EventTradeAmended.Fields().ByName("revision")
+	fd_EventTradeAmended_maker = md_EventTradeAmended.Fields().ByName("maker")
+	fd_EventTradeAmended_payload_hash = md_EventTradeAmended.Fields().ByName("payload_hash")
+	fd_EventTradeAmended_previous_payload_hash = md_EventTradeAmended.Fields().ByName("previous_payload_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventTradeAmended)(nil)
+
+type fastReflection_EventTradeAmended EventTradeAmended
+
+func (x *EventTradeAmended) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTradeAmended)(x)
+}
+
+func (x *EventTradeAmended) slowProtoReflect() protoreflect.Message {
+	mi := &file_vvtxchain_trade_events_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventTradeAmended_messageType fastReflection_EventTradeAmended_messageType
+var _ protoreflect.MessageType = fastReflection_EventTradeAmended_messageType{}
+
+type fastReflection_EventTradeAmended_messageType struct{}
+
+func (x fastReflection_EventTradeAmended_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTradeAmended)(nil)
+}
+func (x fastReflection_EventTradeAmended_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTradeAmended)
+}
+func (x fastReflection_EventTradeAmended_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeAmended
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventTradeAmended) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeAmended
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventTradeAmended) Type() protoreflect.MessageType {
+	return _fastReflection_EventTradeAmended_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventTradeAmended) New() protoreflect.Message {
+	return new(fastReflection_EventTradeAmended)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventTradeAmended) Interface() protoreflect.ProtoMessage {
+	return (*EventTradeAmended)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventTradeAmended) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TradeIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TradeIndex)
+		if !f(fd_EventTradeAmended_trade_index, value) {
+			return
+		}
+	}
+	if x.Revision != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Revision)
+		if !f(fd_EventTradeAmended_revision, value) {
+			return
+		}
+	}
+	if x.Maker != "" {
+		value := protoreflect.ValueOfString(x.Maker)
+		if !f(fd_EventTradeAmended_maker, value) {
+			return
+		}
+	}
+	if x.PayloadHash != "" {
+		value := protoreflect.ValueOfString(x.PayloadHash)
+		if !f(fd_EventTradeAmended_payload_hash, value) {
+			return
+		}
+	}
+	if x.PreviousPayloadHash != "" {
+		value := protoreflect.ValueOfString(x.PreviousPayloadHash)
+		if !f(fd_EventTradeAmended_previous_payload_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventTradeAmended) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeAmended.trade_index":
+		return x.TradeIndex != uint64(0)
+	case "vvtxchain.trade.EventTradeAmended.revision":
+		return x.Revision != uint64(0)
+	case "vvtxchain.trade.EventTradeAmended.maker":
+		return x.Maker != ""
+	case "vvtxchain.trade.EventTradeAmended.payload_hash":
+		return x.PayloadHash != ""
+	case "vvtxchain.trade.EventTradeAmended.previous_payload_hash":
+		return x.PreviousPayloadHash != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeAmended"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeAmended does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeAmended) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeAmended.trade_index":
+		x.TradeIndex = uint64(0)
+	case "vvtxchain.trade.EventTradeAmended.revision":
+		x.Revision = uint64(0)
+	case "vvtxchain.trade.EventTradeAmended.maker":
+		x.Maker = ""
+	case "vvtxchain.trade.EventTradeAmended.payload_hash":
+		x.PayloadHash = ""
+	case "vvtxchain.trade.EventTradeAmended.previous_payload_hash":
+		x.PreviousPayloadHash = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeAmended"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeAmended does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventTradeAmended) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "vvtxchain.trade.EventTradeAmended.trade_index":
+		value := x.TradeIndex
+		return protoreflect.ValueOfUint64(value)
+	case "vvtxchain.trade.EventTradeAmended.revision":
+		value := x.Revision
+		return protoreflect.ValueOfUint64(value)
+	case "vvtxchain.trade.EventTradeAmended.maker":
+		value := x.Maker
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.EventTradeAmended.payload_hash":
+		value := x.PayloadHash
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.EventTradeAmended.previous_payload_hash":
+		value := x.PreviousPayloadHash
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeAmended"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeAmended does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeAmended) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeAmended.trade_index":
+		x.TradeIndex = value.Uint()
+	case "vvtxchain.trade.EventTradeAmended.revision":
+		x.Revision = value.Uint()
+	case "vvtxchain.trade.EventTradeAmended.maker":
+		x.Maker = value.Interface().(string)
+	case "vvtxchain.trade.EventTradeAmended.payload_hash":
+		x.PayloadHash = value.Interface().(string)
+	case "vvtxchain.trade.EventTradeAmended.previous_payload_hash":
+		x.PreviousPayloadHash = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeAmended"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeAmended does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeAmended) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeAmended.trade_index":
+		panic(fmt.Errorf("field trade_index of message vvtxchain.trade.EventTradeAmended is not mutable"))
+	case "vvtxchain.trade.EventTradeAmended.revision":
+		panic(fmt.Errorf("field revision of message vvtxchain.trade.EventTradeAmended is not mutable"))
+	case "vvtxchain.trade.EventTradeAmended.maker":
+		panic(fmt.Errorf("field maker of message vvtxchain.trade.EventTradeAmended is not mutable"))
+	case "vvtxchain.trade.EventTradeAmended.payload_hash":
+		panic(fmt.Errorf("field payload_hash of message vvtxchain.trade.EventTradeAmended is not mutable"))
+	case "vvtxchain.trade.EventTradeAmended.previous_payload_hash":
+		panic(fmt.Errorf("field previous_payload_hash of message vvtxchain.trade.EventTradeAmended is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeAmended"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeAmended does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventTradeAmended) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeAmended.trade_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "vvtxchain.trade.EventTradeAmended.revision":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "vvtxchain.trade.EventTradeAmended.maker":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.EventTradeAmended.payload_hash":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.EventTradeAmended.previous_payload_hash":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeAmended"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeAmended does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventTradeAmended) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in vvtxchain.trade.EventTradeAmended", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventTradeAmended) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeAmended) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventTradeAmended) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventTradeAmended) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventTradeAmended)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TradeIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.TradeIndex))
+		}
+		if x.Revision != 0 {
+			n += 1 + runtime.Sov(uint64(x.Revision))
+		}
+		l = len(x.Maker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PayloadHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PreviousPayloadHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventTradeAmended)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PreviousPayloadHash) > 0 {
+			i -= len(x.PreviousPayloadHash)
+			copy(dAtA[i:], x.PreviousPayloadHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousPayloadHash)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.PayloadHash) > 0 {
+			i -= len(x.PayloadHash)
+			copy(dAtA[i:], x.PayloadHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PayloadHash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Maker) > 0 {
+			i -= len(x.Maker)
+			copy(dAtA[i:], x.Maker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Maker)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Revision != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Revision))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TradeIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TradeIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventTradeAmended)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeAmended: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeAmended: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+				}
+				x.TradeIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TradeIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				}
+				x.Revision = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Revision |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Maker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PayloadHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousPayloadHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousPayloadHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventTradeRetried                  protoreflect.MessageDescriptor
+	fd_EventTradeRetried_trade_index      protoreflect.FieldDescriptor
+	fd_EventTradeRetried_status           protoreflect.FieldDescriptor
+	fd_EventTradeRetried_checker          protoreflect.FieldDescriptor
+	fd_EventTradeRetried_maker            protoreflect.FieldDescriptor
+	fd_EventTradeRetried_receiver_address protoreflect.FieldDescriptor
+	fd_EventTradeRetried_attempts         protoreflect.FieldDescriptor
+	fd_EventTradeRetried_result           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_vvtxchain_trade_events_proto_init()
+	md_EventTradeRetried = File_vvtxchain_trade_events_proto.Messages().ByName("EventTradeRetried")
+	fd_EventTradeRetried_trade_index = md_EventTradeRetried.Fields().ByName("trade_index")
+	fd_EventTradeRetried_status = md_EventTradeRetried.Fields().ByName("status")
+	fd_EventTradeRetried_checker = md_EventTradeRetried.Fields().ByName("checker")
+	fd_EventTradeRetried_maker = md_EventTradeRetried.Fields().ByName("maker")
+	fd_EventTradeRetried_receiver_address = md_EventTradeRetried.Fields().ByName("receiver_address")
+	fd_EventTradeRetried_attempts = md_EventTradeRetried.Fields().ByName("attempts")
+	fd_EventTradeRetried_result = md_EventTradeRetried.Fields().ByName("result")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventTradeRetried)(nil)
+
+type fastReflection_EventTradeRetried EventTradeRetried
+
+func (x *EventTradeRetried) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTradeRetried)(x)
+}
+
+func (x *EventTradeRetried) slowProtoReflect() protoreflect.Message {
+	mi := &file_vvtxchain_trade_events_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventTradeRetried_messageType fastReflection_EventTradeRetried_messageType
+var _ protoreflect.MessageType = fastReflection_EventTradeRetried_messageType{}
+
+type fastReflection_EventTradeRetried_messageType struct{}
+
+func (x fastReflection_EventTradeRetried_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTradeRetried)(nil)
+}
+func (x fastReflection_EventTradeRetried_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTradeRetried)
+}
+func (x fastReflection_EventTradeRetried_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeRetried
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventTradeRetried) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradeRetried
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventTradeRetried) Type() protoreflect.MessageType {
+	return _fastReflection_EventTradeRetried_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventTradeRetried) New() protoreflect.Message {
+	return new(fastReflection_EventTradeRetried)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventTradeRetried) Interface() protoreflect.ProtoMessage {
+	return (*EventTradeRetried)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventTradeRetried) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TradeIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TradeIndex)
+		if !f(fd_EventTradeRetried_trade_index, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_EventTradeRetried_status, value) {
+			return
+		}
+	}
+	if x.Checker != "" {
+		value := protoreflect.ValueOfString(x.Checker)
+		if !f(fd_EventTradeRetried_checker, value) {
+			return
+		}
+	}
+	if x.Maker != "" {
+		value := protoreflect.ValueOfString(x.Maker)
+		if !f(fd_EventTradeRetried_maker, value) {
+			return
+		}
+	}
+	if x.ReceiverAddress != "" {
+		value := protoreflect.ValueOfString(x.ReceiverAddress)
+		if !f(fd_EventTradeRetried_receiver_address, value) {
+			return
+		}
+	}
+	if x.Attempts != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Attempts)
+		if !f(fd_EventTradeRetried_attempts, value) {
+			return
+		}
+	}
+	if x.Result != "" {
+		value := protoreflect.ValueOfString(x.Result)
+		if !f(fd_EventTradeRetried_result, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventTradeRetried) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeRetried.trade_index":
+		return x.TradeIndex != uint64(0)
+	case "vvtxchain.trade.EventTradeRetried.status":
+		return x.Status != 0
+	case "vvtxchain.trade.EventTradeRetried.checker":
+		return x.Checker != ""
+	case "vvtxchain.trade.EventTradeRetried.maker":
+		return x.Maker != ""
+	case "vvtxchain.trade.EventTradeRetried.receiver_address":
+		return x.ReceiverAddress != ""
+	case "vvtxchain.trade.EventTradeRetried.attempts":
+		return x.Attempts != uint32(0)
+	case "vvtxchain.trade.EventTradeRetried.result":
+		return x.Result != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeRetried"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeRetried does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeRetried) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeRetried.trade_index":
+		x.TradeIndex = uint64(0)
+	case "vvtxchain.trade.EventTradeRetried.status":
+		x.Status = 0
+	case "vvtxchain.trade.EventTradeRetried.checker":
+		x.Checker = ""
+	case "vvtxchain.trade.EventTradeRetried.maker":
+		x.Maker = ""
+	case "vvtxchain.trade.EventTradeRetried.receiver_address":
+		x.ReceiverAddress = ""
+	case "vvtxchain.trade.EventTradeRetried.attempts":
+		x.Attempts = uint32(0)
+	case "vvtxchain.trade.EventTradeRetried.result":
+		x.Result = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeRetried"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeRetried does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventTradeRetried) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "vvtxchain.trade.EventTradeRetried.trade_index":
+		value := x.TradeIndex
+		return protoreflect.ValueOfUint64(value)
+	case "vvtxchain.trade.EventTradeRetried.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "vvtxchain.trade.EventTradeRetried.checker":
+		value := x.Checker
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.EventTradeRetried.maker":
+		value := x.Maker
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.EventTradeRetried.receiver_address":
+		value := x.ReceiverAddress
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.EventTradeRetried.attempts":
+		value := x.Attempts
+		return protoreflect.ValueOfUint32(value)
+	case "vvtxchain.trade.EventTradeRetried.result":
+		value := x.Result
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeRetried"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeRetried does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeRetried) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeRetried.trade_index":
+		x.TradeIndex = value.Uint()
+	case "vvtxchain.trade.EventTradeRetried.status":
+		x.Status = (TradeStatus)(value.Enum())
+	case "vvtxchain.trade.EventTradeRetried.checker":
+		x.Checker = value.Interface().(string)
+	case "vvtxchain.trade.EventTradeRetried.maker":
+		x.Maker = value.Interface().(string)
+	case "vvtxchain.trade.EventTradeRetried.receiver_address":
+		x.ReceiverAddress = value.Interface().(string)
+	case "vvtxchain.trade.EventTradeRetried.attempts":
+		x.Attempts = uint32(value.Uint())
+	case "vvtxchain.trade.EventTradeRetried.result":
+		x.Result = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeRetried"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeRetried does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeRetried) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeRetried.trade_index":
+		panic(fmt.Errorf("field trade_index of message vvtxchain.trade.EventTradeRetried is not mutable"))
+	case "vvtxchain.trade.EventTradeRetried.status":
+		panic(fmt.Errorf("field status of message vvtxchain.trade.EventTradeRetried is not mutable"))
+	case "vvtxchain.trade.EventTradeRetried.checker":
+		panic(fmt.Errorf("field checker of message vvtxchain.trade.EventTradeRetried is not mutable"))
+	case "vvtxchain.trade.EventTradeRetried.maker":
+		panic(fmt.Errorf("field maker of message vvtxchain.trade.EventTradeRetried is not mutable"))
+	case "vvtxchain.trade.EventTradeRetried.receiver_address":
+		panic(fmt.Errorf("field receiver_address of message vvtxchain.trade.EventTradeRetried is not mutable"))
+	case "vvtxchain.trade.EventTradeRetried.attempts":
+		panic(fmt.Errorf("field attempts of message vvtxchain.trade.EventTradeRetried is not mutable"))
+	case "vvtxchain.trade.EventTradeRetried.result":
+		panic(fmt.Errorf("field result of message vvtxchain.trade.EventTradeRetried is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeRetried"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeRetried does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventTradeRetried) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradeRetried.trade_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "vvtxchain.trade.EventTradeRetried.status":
+		return protoreflect.ValueOfEnum(0)
+	case "vvtxchain.trade.EventTradeRetried.checker":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.EventTradeRetried.maker":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.EventTradeRetried.receiver_address":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.EventTradeRetried.attempts":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "vvtxchain.trade.EventTradeRetried.result":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradeRetried"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradeRetried does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventTradeRetried) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in vvtxchain.trade.EventTradeRetried", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventTradeRetried) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradeRetried) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventTradeRetried) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventTradeRetried) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventTradeRetried)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TradeIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.TradeIndex))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Checker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Maker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReceiverAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Attempts != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attempts))
+		}
+		l = len(x.Result)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventTradeRetried)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Result) > 0 {
+			i -= len(x.Result)
+			copy(dAtA[i:], x.Result)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Result)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Attempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempts))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.ReceiverAddress) > 0 {
+			i -= len(x.ReceiverAddress)
+			copy(dAtA[i:], x.ReceiverAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReceiverAddress)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Maker) > 0 {
+			i -= len(x.Maker)
+			copy(dAtA[i:], x.Maker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Maker)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Checker) > 0 {
+			i -= len(x.Checker)
+			copy(dAtA[i:], x.Checker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Checker)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TradeIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TradeIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventTradeRetried)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeRetried: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradeRetried: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+				}
+				x.TradeIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TradeIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= TradeStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Checker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Checker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Maker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReceiverAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+				}
+				x.Attempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attempts |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Result = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventTradesExpired_1_list)(nil)
+
+type _EventTradesExpired_1_list struct {
+	list *[]uint64
+}
+
+func (x *_EventTradesExpired_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventTradesExpired_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_EventTradesExpired_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventTradesExpired_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventTradesExpired_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventTradesExpired at list field TradeIndexes as it is not of Message kind"))
+}
+
+func (x *_EventTradesExpired_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventTradesExpired_1_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_EventTradesExpired_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventTradesExpired               protoreflect.MessageDescriptor
+	fd_EventTradesExpired_trade_indexes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_vvtxchain_trade_events_proto_init()
+	md_EventTradesExpired = File_vvtxchain_trade_events_proto.Messages().ByName("EventTradesExpired")
+	fd_EventTradesExpired_trade_indexes = md_EventTradesExpired.Fields().ByName("trade_indexes")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventTradesExpired)(nil)
+
+type fastReflection_EventTradesExpired EventTradesExpired
+
+func (x *EventTradesExpired) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventTradesExpired)(x)
+}
+
+func (x *EventTradesExpired) slowProtoReflect() protoreflect.Message {
+	mi := &file_vvtxchain_trade_events_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventTradesExpired_messageType fastReflection_EventTradesExpired_messageType
+var _ protoreflect.MessageType = fastReflection_EventTradesExpired_messageType{}
+
+type fastReflection_EventTradesExpired_messageType struct{}
+
+func (x fastReflection_EventTradesExpired_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventTradesExpired)(nil)
+}
+func (x fastReflection_EventTradesExpired_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventTradesExpired)
+}
+func (x fastReflection_EventTradesExpired_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradesExpired
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventTradesExpired) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventTradesExpired
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventTradesExpired) Type() protoreflect.MessageType {
+	return _fastReflection_EventTradesExpired_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventTradesExpired) New() protoreflect.Message {
+	return new(fastReflection_EventTradesExpired)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventTradesExpired) Interface() protoreflect.ProtoMessage {
+	return (*EventTradesExpired)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventTradesExpired) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TradeIndexes) != 0 {
+		value := protoreflect.ValueOfList(&_EventTradesExpired_1_list{list: &x.TradeIndexes})
+		if !f(fd_EventTradesExpired_trade_indexes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventTradesExpired) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradesExpired.trade_indexes":
+		return len(x.TradeIndexes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradesExpired"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradesExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradesExpired) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradesExpired.trade_indexes":
+		x.TradeIndexes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradesExpired"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradesExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventTradesExpired) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "vvtxchain.trade.EventTradesExpired.trade_indexes":
+		if len(x.TradeIndexes) == 0 {
+			return protoreflect.ValueOfList(&_EventTradesExpired_1_list{})
+		}
+		listValue := &_EventTradesExpired_1_list{list: &x.TradeIndexes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradesExpired"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradesExpired does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradesExpired) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradesExpired.trade_indexes":
+		lv := value.List()
+		clv := lv.(*_EventTradesExpired_1_list)
+		x.TradeIndexes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradesExpired"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradesExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradesExpired) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradesExpired.trade_indexes":
+		if x.TradeIndexes == nil {
+			x.TradeIndexes = []uint64{}
+		}
+		value := &_EventTradesExpired_1_list{list: &x.TradeIndexes}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradesExpired"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradesExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventTradesExpired) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventTradesExpired.trade_indexes":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_EventTradesExpired_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventTradesExpired"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventTradesExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventTradesExpired) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in vvtxchain.trade.EventTradesExpired", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventTradesExpired) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventTradesExpired) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventTradesExpired) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventTradesExpired) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventTradesExpired)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.TradeIndexes) > 0 {
+			l = 0
+			for _, e := range x.TradeIndexes {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventTradesExpired)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TradeIndexes) > 0 {
+			var pksize2 int
+			for _, num := range x.TradeIndexes {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.TradeIndexes {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventTradesExpired)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradesExpired: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventTradesExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.TradeIndexes = append(x.TradeIndexes, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.TradeIndexes) == 0 {
+						x.TradeIndexes = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.TradeIndexes = append(x.TradeIndexes, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeIndexes", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventCreateTradeEntryFailed       protoreflect.MessageDescriptor
+	fd_EventCreateTradeEntryFailed_entry protoreflect.FieldDescriptor
+	fd_EventCreateTradeEntryFailed_error protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_vvtxchain_trade_events_proto_init()
+	md_EventCreateTradeEntryFailed = File_vvtxchain_trade_events_proto.Messages().ByName("EventCreateTradeEntryFailed")
+	fd_EventCreateTradeEntryFailed_entry = md_EventCreateTradeEntryFailed.Fields().ByName("entry")
+	fd_EventCreateTradeEntryFailed_error = md_EventCreateTradeEntryFailed.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventCreateTradeEntryFailed)(nil)
+
+type fastReflection_EventCreateTradeEntryFailed EventCreateTradeEntryFailed
+
+func (x *EventCreateTradeEntryFailed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventCreateTradeEntryFailed)(x)
+}
+
+func (x *EventCreateTradeEntryFailed) slowProtoReflect() protoreflect.Message {
+	mi := &file_vvtxchain_trade_events_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventCreateTradeEntryFailed_messageType fastReflection_EventCreateTradeEntryFailed_messageType
+var _ protoreflect.MessageType = fastReflection_EventCreateTradeEntryFailed_messageType{}
+
+type fastReflection_EventCreateTradeEntryFailed_messageType struct{}
+
+func (x fastReflection_EventCreateTradeEntryFailed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventCreateTradeEntryFailed)(nil)
+}
+func (x fastReflection_EventCreateTradeEntryFailed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventCreateTradeEntryFailed)
+}
+func (x fastReflection_EventCreateTradeEntryFailed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCreateTradeEntryFailed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventCreateTradeEntryFailed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCreateTradeEntryFailed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventCreateTradeEntryFailed) Type() protoreflect.MessageType {
+	return _fastReflection_EventCreateTradeEntryFailed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventCreateTradeEntryFailed) New() protoreflect.Message {
+	return new(fastReflection_EventCreateTradeEntryFailed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventCreateTradeEntryFailed) Interface() protoreflect.ProtoMessage {
+	return (*EventCreateTradeEntryFailed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventCreateTradeEntryFailed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Entry != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Entry)
+		if !f(fd_EventCreateTradeEntryFailed_entry, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_EventCreateTradeEntryFailed_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventCreateTradeEntryFailed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventCreateTradeEntryFailed.entry":
+		return x.Entry != uint32(0)
+	case "vvtxchain.trade.EventCreateTradeEntryFailed.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventCreateTradeEntryFailed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventCreateTradeEntryFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCreateTradeEntryFailed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventCreateTradeEntryFailed.entry":
+		x.Entry = uint32(0)
+	case "vvtxchain.trade.EventCreateTradeEntryFailed.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventCreateTradeEntryFailed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventCreateTradeEntryFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventCreateTradeEntryFailed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "vvtxchain.trade.EventCreateTradeEntryFailed.entry":
+		value := x.Entry
+		return protoreflect.ValueOfUint32(value)
+	case "vvtxchain.trade.EventCreateTradeEntryFailed.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventCreateTradeEntryFailed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventCreateTradeEntryFailed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCreateTradeEntryFailed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventCreateTradeEntryFailed.entry":
+		x.Entry = uint32(value.Uint())
+	case "vvtxchain.trade.EventCreateTradeEntryFailed.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventCreateTradeEntryFailed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventCreateTradeEntryFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCreateTradeEntryFailed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventCreateTradeEntryFailed.entry":
+		panic(fmt.Errorf("field entry of message vvtxchain.trade.EventCreateTradeEntryFailed is not mutable"))
+	case "vvtxchain.trade.EventCreateTradeEntryFailed.error":
+		panic(fmt.Errorf("field error of message vvtxchain.trade.EventCreateTradeEntryFailed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventCreateTradeEntryFailed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventCreateTradeEntryFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventCreateTradeEntryFailed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventCreateTradeEntryFailed.entry":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "vvtxchain.trade.EventCreateTradeEntryFailed.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventCreateTradeEntryFailed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventCreateTradeEntryFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventCreateTradeEntryFailed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in vvtxchain.trade.EventCreateTradeEntryFailed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventCreateTradeEntryFailed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCreateTradeEntryFailed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventCreateTradeEntryFailed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventCreateTradeEntryFailed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventCreateTradeEntryFailed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Entry != 0 {
+			n += 1 + runtime.Sov(uint64(x.Entry))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventCreateTradeEntryFailed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Entry != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Entry))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventCreateTradeEntryFailed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCreateTradeEntryFailed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCreateTradeEntryFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+				}
+				x.Entry = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Entry |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventProcessTradeEntryFailed             protoreflect.MessageDescriptor
+	fd_EventProcessTradeEntryFailed_trade_index protoreflect.FieldDescriptor
+	fd_EventProcessTradeEntryFailed_error       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_vvtxchain_trade_events_proto_init()
+	md_EventProcessTradeEntryFailed = File_vvtxchain_trade_events_proto.Messages().ByName("EventProcessTradeEntryFailed")
+	fd_EventProcessTradeEntryFailed_trade_index = md_EventProcessTradeEntryFailed.Fields().ByName("trade_index")
+	fd_EventProcessTradeEntryFailed_error = md_EventProcessTradeEntryFailed.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventProcessTradeEntryFailed)(nil)
+
+type fastReflection_EventProcessTradeEntryFailed EventProcessTradeEntryFailed
+
+func (x *EventProcessTradeEntryFailed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventProcessTradeEntryFailed)(x)
+}
+
+func (x *EventProcessTradeEntryFailed) slowProtoReflect() protoreflect.Message {
+	mi := &file_vvtxchain_trade_events_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventProcessTradeEntryFailed_messageType fastReflection_EventProcessTradeEntryFailed_messageType
+var _ protoreflect.MessageType = fastReflection_EventProcessTradeEntryFailed_messageType{}
+
+type fastReflection_EventProcessTradeEntryFailed_messageType struct{}
+
+func (x fastReflection_EventProcessTradeEntryFailed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventProcessTradeEntryFailed)(nil)
+}
+func (x fastReflection_EventProcessTradeEntryFailed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventProcessTradeEntryFailed)
+}
+func (x fastReflection_EventProcessTradeEntryFailed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventProcessTradeEntryFailed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventProcessTradeEntryFailed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventProcessTradeEntryFailed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventProcessTradeEntryFailed) Type() protoreflect.MessageType {
+	return _fastReflection_EventProcessTradeEntryFailed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventProcessTradeEntryFailed) New() protoreflect.Message {
+	return new(fastReflection_EventProcessTradeEntryFailed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventProcessTradeEntryFailed) Interface() protoreflect.ProtoMessage {
+	return (*EventProcessTradeEntryFailed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventProcessTradeEntryFailed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TradeIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TradeIndex)
+		if !f(fd_EventProcessTradeEntryFailed_trade_index, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_EventProcessTradeEntryFailed_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventProcessTradeEntryFailed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventProcessTradeEntryFailed.trade_index":
+		return x.TradeIndex != uint64(0)
+	case "vvtxchain.trade.EventProcessTradeEntryFailed.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventProcessTradeEntryFailed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventProcessTradeEntryFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProcessTradeEntryFailed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventProcessTradeEntryFailed.trade_index":
+		x.TradeIndex = uint64(0)
+	case "vvtxchain.trade.EventProcessTradeEntryFailed.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventProcessTradeEntryFailed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventProcessTradeEntryFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventProcessTradeEntryFailed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "vvtxchain.trade.EventProcessTradeEntryFailed.trade_index":
+		value := x.TradeIndex
+		return protoreflect.ValueOfUint64(value)
+	case "vvtxchain.trade.EventProcessTradeEntryFailed.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventProcessTradeEntryFailed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventProcessTradeEntryFailed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProcessTradeEntryFailed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventProcessTradeEntryFailed.trade_index":
+		x.TradeIndex = value.Uint()
+	case "vvtxchain.trade.EventProcessTradeEntryFailed.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventProcessTradeEntryFailed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventProcessTradeEntryFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProcessTradeEntryFailed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventProcessTradeEntryFailed.trade_index":
+		panic(fmt.Errorf("field trade_index of message vvtxchain.trade.EventProcessTradeEntryFailed is not mutable"))
+	case "vvtxchain.trade.EventProcessTradeEntryFailed.error":
+		panic(fmt.Errorf("field error of message vvtxchain.trade.EventProcessTradeEntryFailed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventProcessTradeEntryFailed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventProcessTradeEntryFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventProcessTradeEntryFailed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.EventProcessTradeEntryFailed.trade_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "vvtxchain.trade.EventProcessTradeEntryFailed.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.EventProcessTradeEntryFailed"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.EventProcessTradeEntryFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventProcessTradeEntryFailed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in vvtxchain.trade.EventProcessTradeEntryFailed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventProcessTradeEntryFailed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProcessTradeEntryFailed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventProcessTradeEntryFailed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventProcessTradeEntryFailed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventProcessTradeEntryFailed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TradeIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.TradeIndex))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventProcessTradeEntryFailed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TradeIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TradeIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventProcessTradeEntryFailed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProcessTradeEntryFailed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProcessTradeEntryFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+				}
+				x.TradeIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TradeIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: vvtxchain/trade/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventTradeCreated is emitted when a trade is created.
+type EventTradeCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeIndex        uint64        `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Status            TradeStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
+	Maker             string        `protobuf:"bytes,3,opt,name=maker,proto3" json:"maker,omitempty"`
+	TradeType         TradeType     `protobuf:"varint,4,opt,name=trade_type,json=tradeType,proto3,enum=vvtxchain.trade.TradeType" json:"trade_type,omitempty"`
+	Amount            *v1beta1.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	ReceiverAddress   string        `protobuf:"bytes,6,opt,name=receiver_address,json=receiverAddress,proto3" json:"receiver_address,omitempty"`
+	ExternalReference string        `protobuf:"bytes,7,opt,name=external_reference,json=externalReference,proto3" json:"external_reference,omitempty"`
+}
+
+func (x *EventTradeCreated) Reset() {
+	*x = EventTradeCreated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vvtxchain_trade_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTradeCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTradeCreated) ProtoMessage() {}
+
+// Deprecated: Use EventTradeCreated.ProtoReflect.Descriptor instead.
+func (*EventTradeCreated) Descriptor() ([]byte, []int) {
+	return file_vvtxchain_trade_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventTradeCreated) GetTradeIndex() uint64 {
+	if x != nil {
+		return x.TradeIndex
+	}
+	return 0
+}
+
+func (x *EventTradeCreated) GetStatus() TradeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
+}
+
+func (x *EventTradeCreated) GetMaker() string {
+	if x != nil {
+		return x.Maker
+	}
+	return ""
+}
+
+func (x *EventTradeCreated) GetTradeType() TradeType {
+	if x != nil {
+		return x.TradeType
+	}
+	return TradeType_TRADE_TYPE_UNSPECIFIED
+}
+
+func (x *EventTradeCreated) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *EventTradeCreated) GetReceiverAddress() string {
+	if x != nil {
+		return x.ReceiverAddress
+	}
+	return ""
+}
+
+func (x *EventTradeCreated) GetExternalReference() string {
+	if x != nil {
+		return x.ExternalReference
+	}
+	return ""
+}
+
+// EventTradeProcessed is emitted when a trade is confirmed or rejected by a checker.
+type EventTradeProcessed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeIndex        uint64      `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Status            TradeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
+	Checker           string      `protobuf:"bytes,3,opt,name=checker,proto3" json:"checker,omitempty"`
+	Maker             string      `protobuf:"bytes,4,opt,name=maker,proto3" json:"maker,omitempty"`
+	ProcessDate       string      `protobuf:"bytes,5,opt,name=process_date,json=processDate,proto3" json:"process_date,omitempty"`
+	Result            string      `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+	Revision          uint64      `protobuf:"varint,7,opt,name=revision,proto3" json:"revision,omitempty"`
+	Approvals         uint32      `protobuf:"varint,8,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	RequiredApprovals uint32      `protobuf:"varint,9,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+}
+
+func (x *EventTradeProcessed) Reset() {
+	*x = EventTradeProcessed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vvtxchain_trade_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTradeProcessed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTradeProcessed) ProtoMessage() {}
+
+// Deprecated: Use EventTradeProcessed.ProtoReflect.Descriptor instead.
+func (*EventTradeProcessed) Descriptor() ([]byte, []int) {
+	return file_vvtxchain_trade_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventTradeProcessed) GetTradeIndex() uint64 {
+	if x != nil {
+		return x.TradeIndex
+	}
+	return 0
+}
+
+func (x *EventTradeProcessed) GetStatus() TradeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
+}
+
+func (x *EventTradeProcessed) GetChecker() string {
+	if x != nil {
+		return x.Checker
+	}
+	return ""
+}
+
+func (x *EventTradeProcessed) GetMaker() string {
+	if x != nil {
+		return x.Maker
+	}
+	return ""
+}
+
+func (x *EventTradeProcessed) GetProcessDate() string {
+	if x != nil {
+		return x.ProcessDate
+	}
+	return ""
+}
+
+func (x *EventTradeProcessed) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *EventTradeProcessed) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *EventTradeProcessed) GetApprovals() uint32 {
+	if x != nil {
+		return x.Approvals
+	}
+	return 0
+}
+
+func (x *EventTradeProcessed) GetRequiredApprovals() uint32 {
+	if x != nil {
+		return x.RequiredApprovals
+	}
+	return 0
+}
+
+// EventTradeCanceled is emitted when a pending trade is canceled by MsgCancelTrade.
+type EventTradeCanceled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeIndex uint64      `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Status     TradeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
+	CanceledBy string      `protobuf:"bytes,3,opt,name=canceled_by,json=canceledBy,proto3" json:"canceled_by,omitempty"`
+	Maker      string      `protobuf:"bytes,4,opt,name=maker,proto3" json:"maker,omitempty"`
+	Reason     string      `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Result     string      `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *EventTradeCanceled) Reset() {
+	*x = EventTradeCanceled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vvtxchain_trade_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTradeCanceled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTradeCanceled) ProtoMessage() {}
+
+// Deprecated: Use EventTradeCanceled.ProtoReflect.Descriptor instead.
+func (*EventTradeCanceled) Descriptor() ([]byte, []int) {
+	return file_vvtxchain_trade_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventTradeCanceled) GetTradeIndex() uint64 {
+	if x != nil {
+		return x.TradeIndex
+	}
+	return 0
+}
+
+func (x *EventTradeCanceled) GetStatus() TradeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
+}
+
+func (x *EventTradeCanceled) GetCanceledBy() string {
+	if x != nil {
+		return x.CanceledBy
+	}
+	return ""
+}
+
+func (x *EventTradeCanceled) GetMaker() string {
+	if x != nil {
+		return x.Maker
+	}
+	return ""
+}
+
+func (x *EventTradeCanceled) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EventTradeCanceled) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+// EventTradeAmended is emitted when the payload of a pending trade is amended.
+type EventTradeAmended struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeIndex          uint64 `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Revision            uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Maker               string `protobuf:"bytes,3,opt,name=maker,proto3" json:"maker,omitempty"`
+	PayloadHash         string `protobuf:"bytes,4,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
+	PreviousPayloadHash string `protobuf:"bytes,5,opt,name=previous_payload_hash,json=previousPayloadHash,proto3" json:"previous_payload_hash,omitempty"`
+}
+
+func (x *EventTradeAmended) Reset() {
+	*x = EventTradeAmended{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vvtxchain_trade_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTradeAmended) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTradeAmended) ProtoMessage() {}
+
+// Deprecated: Use EventTradeAmended.ProtoReflect.Descriptor instead.
+func (*EventTradeAmended) Descriptor() ([]byte, []int) {
+	return file_vvtxchain_trade_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventTradeAmended) GetTradeIndex() uint64 {
+	if x != nil {
+		return x.TradeIndex
+	}
+	return 0
+}
+
+func (x *EventTradeAmended) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *EventTradeAmended) GetMaker() string {
+	if x != nil {
+		return x.Maker
+	}
+	return ""
+}
+
+func (x *EventTradeAmended) GetPayloadHash() string {
+	if x != nil {
+		return x.PayloadHash
+	}
+	return ""
+}
+
+func (x *EventTradeAmended) GetPreviousPayloadHash() string {
+	if x != nil {
+		return x.PreviousPayloadHash
+	}
+	return ""
+}
+
+// EventTradeRetried is emitted when the mint or burn of a failed trade is retried.
+type EventTradeRetried struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeIndex      uint64      `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Status          TradeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
+	Checker         string      `protobuf:"bytes,3,opt,name=checker,proto3" json:"checker,omitempty"`
+	Maker           string      `protobuf:"bytes,4,opt,name=maker,proto3" json:"maker,omitempty"`
+	ReceiverAddress string      `protobuf:"bytes,5,opt,name=receiver_address,json=receiverAddress,proto3" json:"receiver_address,omitempty"`
+	Attempts        uint32      `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Result          string      `protobuf:"bytes,7,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *EventTradeRetried) Reset() {
+	*x = EventTradeRetried{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vvtxchain_trade_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTradeRetried) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTradeRetried) ProtoMessage() {}
+
+// Deprecated: Use EventTradeRetried.ProtoReflect.Descriptor instead.
+func (*EventTradeRetried) Descriptor() ([]byte, []int) {
+	return file_vvtxchain_trade_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventTradeRetried) GetTradeIndex() uint64 {
+	if x != nil {
+		return x.TradeIndex
+	}
+	return 0
+}
+
+func (x *EventTradeRetried) GetStatus() TradeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
+}
+
+func (x *EventTradeRetried) GetChecker() string {
+	if x != nil {
+		return x.Checker
+	}
+	return ""
+}
+
+func (x *EventTradeRetried) GetMaker() string {
+	if x != nil {
+		return x.Maker
+	}
+	return ""
+}
+
+func (x *EventTradeRetried) GetReceiverAddress() string {
+	if x != nil {
+		return x.ReceiverAddress
+	}
+	return ""
+}
+
+func (x *EventTradeRetried) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *EventTradeRetried) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+// EventTradesExpired is emitted at the end of a block in which pending trades expired.
+type EventTradesExpired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeIndexes []uint64 `protobuf:"varint,1,rep,packed,name=trade_indexes,json=tradeIndexes,proto3" json:"trade_indexes,omitempty"`
+}
+
+func (x *EventTradesExpired) Reset() {
+	*x = EventTradesExpired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vvtxchain_trade_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTradesExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTradesExpired) ProtoMessage() {}
+
+// Deprecated: Use EventTradesExpired.ProtoReflect.Descriptor instead.
+func (*EventTradesExpired) Descriptor() ([]byte, []int) {
+	return file_vvtxchain_trade_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventTradesExpired) GetTradeIndexes() []uint64 {
+	if x != nil {
+		return x.TradeIndexes
+	}
+	return nil
+}
+
+// EventCreateTradeEntryFailed is emitted for each entry of a MsgCreateTrades that failed in
+// the best effort mode.
+type EventCreateTradeEntryFailed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry uint32 `protobuf:"varint,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EventCreateTradeEntryFailed) Reset() {
+	*x = EventCreateTradeEntryFailed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vvtxchain_trade_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCreateTradeEntryFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCreateTradeEntryFailed) ProtoMessage() {}
+
+// Deprecated: Use EventCreateTradeEntryFailed.ProtoReflect.Descriptor instead.
+func (*EventCreateTradeEntryFailed) Descriptor() ([]byte, []int) {
+	return file_vvtxchain_trade_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventCreateTradeEntryFailed) GetEntry() uint32 {
+	if x != nil {
+		return x.Entry
+	}
+	return 0
+}
+
+func (x *EventCreateTradeEntryFailed) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// EventProcessTradeEntryFailed is emitted for each trade of a MsgProcessTrades that failed in
+// the best effort mode.
+type EventProcessTradeEntryFailed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeIndex uint64 `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *EventProcessTradeEntryFailed) Reset() {
+	*x = EventProcessTradeEntryFailed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vvtxchain_trade_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventProcessTradeEntryFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventProcessTradeEntryFailed) ProtoMessage() {}
+
+// Deprecated: Use EventProcessTradeEntryFailed.ProtoReflect.Descriptor instead.
+func (*EventProcessTradeEntryFailed) Descriptor() ([]byte, []int) {
+	return file_vvtxchain_trade_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EventProcessTradeEntryFailed) GetTradeIndex() uint64 {
+	if x != nil {
+		return x.TradeIndex
+	}
+	return 0
+}
+
+func (x *EventProcessTradeEntryFailed) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_vvtxchain_trade_events_proto protoreflect.FileDescriptor
+
+var file_vvtxchain_trade_events_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
+	0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x1a,
+	0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1b, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc8, 0x02, 0x0a,
+	0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12,
+	0x39, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xc0, 0x02, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x12, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0xbd, 0x01, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x41, 0x6d,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x32, 0x0a, 0x15, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x61, 0x73, 0x68, 0x22,
+	0xf9, 0x01, 0x0a, 0x11, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x39, 0x0a, 0x12, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x1b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x61, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x46,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x55, 0x0a, 0x1c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x54, 0x72, 0x61, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0xb2, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d,
+	0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x47, 0x45, 0x5a,
+	0x4c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0xa2, 0x02, 0x03, 0x56, 0x54, 0x58, 0xaa, 0x02, 0x0f, 0x56, 0x76, 0x74, 0x78, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0xca, 0x02, 0x0f, 0x56, 0x76, 0x74,
+	0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0xe2, 0x02, 0x1b, 0x56,
+	0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x56, 0x76, 0x74,
+	0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_vvtxchain_trade_events_proto_rawDescOnce sync.Once
+	file_vvtxchain_trade_events_proto_rawDescData = file_vvtxchain_trade_events_proto_rawDesc
+)
+
+func file_vvtxchain_trade_events_proto_rawDescGZIP() []byte {
+	file_vvtxchain_trade_events_proto_rawDescOnce.Do(func() {
+		file_vvtxchain_trade_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_vvtxchain_trade_events_proto_rawDescData)
+	})
+	return file_vvtxchain_trade_events_proto_rawDescData
+}
+
+var file_vvtxchain_trade_events_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_vvtxchain_trade_events_proto_goTypes = []interface{}{
+	(*EventTradeCreated)(nil),            // 0: vvtxchain.trade.EventTradeCreated
+	(*EventTradeProcessed)(nil),          // 1: vvtxchain.trade.EventTradeProcessed
+	(*EventTradeCanceled)(nil),           // 2: vvtxchain.trade.EventTradeCanceled
+	(*EventTradeAmended)(nil),            // 3: vvtxchain.trade.EventTradeAmended
+	(*EventTradeRetried)(nil),            // 4: vvtxchain.trade.EventTradeRetried
+	(*EventTradesExpired)(nil),           // 5: vvtxchain.trade.EventTradesExpired
+	(*EventCreateTradeEntryFailed)(nil),  // 6: vvtxchain.trade.EventCreateTradeEntryFailed
+	(*EventProcessTradeEntryFailed)(nil), // 7: vvtxchain.trade.EventProcessTradeEntryFailed
+	(TradeStatus)(0),                     // 8: vvtxchain.trade.TradeStatus
+	(TradeType)(0),                       // 9: vvtxchain.trade.TradeType
+	(*v1beta1.Coin)(nil),                 // 10: cosmos.base.v1beta1.Coin
+}
+var file_vvtxchain_trade_events_proto_depIdxs = []int32{
+	8,  // 0: vvtxchain.trade.EventTradeCreated.status:type_name -> vvtxchain.trade.TradeStatus
+	9,  // 1: vvtxchain.trade.EventTradeCreated.trade_type:type_name -> vvtxchain.trade.TradeType
+	10, // 2: vvtxchain.trade.EventTradeCreated.amount:type_name -> cosmos.base.v1beta1.Coin
+	8,  // 3: vvtxchain.trade.EventTradeProcessed.status:type_name -> vvtxchain.trade.TradeStatus
+	8,  // 4: vvtxchain.trade.EventTradeCanceled.status:type_name -> vvtxchain.trade.TradeStatus
+	8,  // 5: vvtxchain.trade.EventTradeRetried.status:type_name -> vvtxchain.trade.TradeStatus
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_vvtxchain_trade_events_proto_init() }
+func file_vvtxchain_trade_events_proto_init() {
+	if File_vvtxchain_trade_events_proto != nil {
+		return
+	}
+	file_vvtxchain_trade_trade_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_vvtxchain_trade_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTradeCreated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vvtxchain_trade_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTradeProcessed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vvtxchain_trade_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTradeCanceled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vvtxchain_trade_events_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTradeAmended); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vvtxchain_trade_events_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTradeRetried); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vvtxchain_trade_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventTradesExpired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vvtxchain_trade_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventCreateTradeEntryFailed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_vvtxchain_trade_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventProcessTradeEntryFailed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_vvtxchain_trade_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_vvtxchain_trade_events_proto_goTypes,
+		DependencyIndexes: file_vvtxchain_trade_events_proto_depIdxs,
+		MessageInfos:      file_vvtxchain_trade_events_proto_msgTypes,
+	}.Build()
+	File_vvtxchain_trade_events_proto = out.File
+	file_vvtxchain_trade_events_proto_rawDesc = nil
+	file_vvtxchain_trade_events_proto_goTypes = nil
+	file_vvtxchain_trade_events_proto_depIdxs = nil
+}
@@ -116,12 +116,168 @@ func (x *_GenesisState_4_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*MintBurnRecord
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintBurnRecord)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MintBurnRecord)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(MintBurnRecord)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(MintBurnRecord)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*TradeHistoryEntry
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TradeHistoryEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TradeHistoryEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(TradeHistoryEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(TradeHistoryEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*TradeEscrow
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TradeEscrow)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TradeEscrow)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(TradeEscrow)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(TradeEscrow)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                    protoreflect.MessageDescriptor
 	fd_GenesisState_params             protoreflect.FieldDescriptor
 	fd_GenesisState_trade_index        protoreflect.FieldDescriptor
 	fd_GenesisState_stored_trades      protoreflect.FieldDescriptor
 	fd_GenesisState_stored_temp_trades protoreflect.FieldDescriptor
+	fd_GenesisState_mint_burn_records  protoreflect.FieldDescriptor
+	fd_GenesisState_trade_history      protoreflect.FieldDescriptor
+	fd_GenesisState_trade_escrows      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -131,6 +287,9 @@ func init() {
 	fd_GenesisState_trade_index = md_GenesisState.Fields().ByName("trade_index")
 	fd_GenesisState_stored_trades = md_GenesisState.Fields().ByName("stored_trades")
 	fd_GenesisState_stored_temp_trades = md_GenesisState.Fields().ByName("stored_temp_trades")
+	fd_GenesisState_mint_burn_records = md_GenesisState.Fields().ByName("mint_burn_records")
+	fd_GenesisState_trade_history = md_GenesisState.Fields().ByName("trade_history")
+	fd_GenesisState_trade_escrows = md_GenesisState.Fields().ByName("trade_escrows")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -222,6 +381,24 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.MintBurnRecords) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.MintBurnRecords})
+		if !f(fd_GenesisState_mint_burn_records, value) {
+			return
+		}
+	}
+	if len(x.TradeHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.TradeHistory})
+		if !f(fd_GenesisState_trade_history, value) {
+			return
+		}
+	}
+	if len(x.TradeEscrows) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.TradeEscrows})
+		if !f(fd_GenesisState_trade_escrows, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -245,6 +422,12 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.StoredTrades) != 0
 	case "vvtxchain.trade.GenesisState.stored_temp_trades":
 		return len(x.StoredTempTrades) != 0
+	case "vvtxchain.trade.GenesisState.mint_burn_records":
+		return len(x.MintBurnRecords) != 0
+	case "vvtxchain.trade.GenesisState.trade_history":
+		return len(x.TradeHistory) != 0
+	case "vvtxchain.trade.GenesisState.trade_escrows":
+		return len(x.TradeEscrows) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.GenesisState"))
@@ -269,6 +452,12 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.StoredTrades = nil
 	case "vvtxchain.trade.GenesisState.stored_temp_trades":
 		x.StoredTempTrades = nil
+	case "vvtxchain.trade.GenesisState.mint_burn_records":
+		x.MintBurnRecords = nil
+	case "vvtxchain.trade.GenesisState.trade_history":
+		x.TradeHistory = nil
+	case "vvtxchain.trade.GenesisState.trade_escrows":
+		x.TradeEscrows = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.GenesisState"))
@@ -303,6 +492,24 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_4_list{list: &x.StoredTempTrades}
 		return protoreflect.ValueOfList(listValue)
+	case "vvtxchain.trade.GenesisState.mint_burn_records":
+		if len(x.MintBurnRecords) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.MintBurnRecords}
+		return protoreflect.ValueOfList(listValue)
+	case "vvtxchain.trade.GenesisState.trade_history":
+		if len(x.TradeHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.TradeHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "vvtxchain.trade.GenesisState.trade_escrows":
+		if len(x.TradeEscrows) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.TradeEscrows}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.GenesisState"))
@@ -335,6 +542,18 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_4_list)
 		x.StoredTempTrades = *clv.list
+	case "vvtxchain.trade.GenesisState.mint_burn_records":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.MintBurnRecords = *clv.list
+	case "vvtxchain.trade.GenesisState.trade_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.TradeHistory = *clv.list
+	case "vvtxchain.trade.GenesisState.trade_escrows":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.TradeEscrows = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.GenesisState"))
@@ -377,6 +596,24 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_4_list{list: &x.StoredTempTrades}
 		return protoreflect.ValueOfList(value)
+	case "vvtxchain.trade.GenesisState.mint_burn_records":
+		if x.MintBurnRecords == nil {
+			x.MintBurnRecords = []*MintBurnRecord{}
+		}
+		value := &_GenesisState_5_list{list: &x.MintBurnRecords}
+		return protoreflect.ValueOfList(value)
+	case "vvtxchain.trade.GenesisState.trade_history":
+		if x.TradeHistory == nil {
+			x.TradeHistory = []*TradeHistoryEntry{}
+		}
+		value := &_GenesisState_6_list{list: &x.TradeHistory}
+		return protoreflect.ValueOfList(value)
+	case "vvtxchain.trade.GenesisState.trade_escrows":
+		if x.TradeEscrows == nil {
+			x.TradeEscrows = []*TradeEscrow{}
+		}
+		value := &_GenesisState_7_list{list: &x.TradeEscrows}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.GenesisState"))
@@ -402,6 +639,15 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "vvtxchain.trade.GenesisState.stored_temp_trades":
 		list := []*StoredTempTrade{}
 		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "vvtxchain.trade.GenesisState.mint_burn_records":
+		list := []*MintBurnRecord{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "vvtxchain.trade.GenesisState.trade_history":
+		list := []*TradeHistoryEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "vvtxchain.trade.GenesisState.trade_escrows":
+		list := []*TradeEscrow{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.GenesisState"))
@@ -491,6 +737,24 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.MintBurnRecords) > 0 {
+			for _, e := range x.MintBurnRecords {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TradeHistory) > 0 {
+			for _, e := range x.TradeHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TradeEscrows) > 0 {
+			for _, e := range x.TradeEscrows {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -520,6 +784,54 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.TradeEscrows) > 0 {
+			for iNdEx := len(x.TradeEscrows) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TradeEscrows[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.TradeHistory) > 0 {
+			for iNdEx := len(x.TradeHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TradeHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.MintBurnRecords) > 0 {
+			for iNdEx := len(x.MintBurnRecords) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MintBurnRecords[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
 		if len(x.StoredTempTrades) > 0 {
 			for iNdEx := len(x.StoredTempTrades) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.StoredTempTrades[iNdEx])
@@ -769,6 +1081,108 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintBurnRecords", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintBurnRecords = append(x.MintBurnRecords, &MintBurnRecord{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MintBurnRecords[len(x.MintBurnRecords)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TradeHistory = append(x.TradeHistory, &TradeHistoryEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TradeHistory[len(x.TradeHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeEscrows", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TradeEscrows = append(x.TradeEscrows, &TradeEscrow{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TradeEscrows[len(x.TradeEscrows)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -824,10 +1238,13 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	// params defines all the parameters of the module.
-	Params           *Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	TradeIndex       *TradeIndex        `protobuf:"bytes,2,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
-	StoredTrades     []*StoredTrade     `protobuf:"bytes,3,rep,name=stored_trades,json=storedTrades,proto3" json:"stored_trades,omitempty"`
-	StoredTempTrades []*StoredTempTrade `protobuf:"bytes,4,rep,name=stored_temp_trades,json=storedTempTrades,proto3" json:"stored_temp_trades,omitempty"`
+	Params           *Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	TradeIndex       *TradeIndex          `protobuf:"bytes,2,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	StoredTrades     []*StoredTrade       `protobuf:"bytes,3,rep,name=stored_trades,json=storedTrades,proto3" json:"stored_trades,omitempty"`
+	StoredTempTrades []*StoredTempTrade   `protobuf:"bytes,4,rep,name=stored_temp_trades,json=storedTempTrades,proto3" json:"stored_temp_trades,omitempty"`
+	MintBurnRecords  []*MintBurnRecord    `protobuf:"bytes,5,rep,name=mint_burn_records,json=mintBurnRecords,proto3" json:"mint_burn_records,omitempty"`
+	TradeHistory     []*TradeHistoryEntry `protobuf:"bytes,6,rep,name=trade_history,json=tradeHistory,proto3" json:"trade_history,omitempty"`
+	TradeEscrows     []*TradeEscrow       `protobuf:"bytes,7,rep,name=trade_escrows,json=tradeEscrows,proto3" json:"trade_escrows,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -878,56 +1295,99 @@ func (x *GenesisState) GetStoredTempTrades() []*StoredTempTrade {
 	return nil
 }
 
+func (x *GenesisState) GetMintBurnRecords() []*MintBurnRecord {
+	if x != nil {
+		return x.MintBurnRecords
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTradeHistory() []*TradeHistoryEntry {
+	if x != nil {
+		return x.TradeHistory
+	}
+	return nil
+}
+
+func (x *GenesisState) GetTradeEscrows() []*TradeEscrow {
+	if x != nil {
+		return x.TradeEscrows
+	}
+	return nil
+}
+
 var File_vvtxchain_trade_genesis_proto protoreflect.FileDescriptor
 
 var file_vvtxchain_trade_genesis_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64,
+	0x0a, 0x1d, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64,
 	0x65, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x0f, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x0f, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
 	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
-	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x67, 0x65, 0x7a, 0x63,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x76, 0x76, 0x74, 0x78, 0x63,
 	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61,
 	0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x67, 0x67, 0x65, 0x7a,
+	0x6e, 0x64, 0x65, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x76, 0x76, 0x74, 0x78,
 	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x73, 0x74, 0x6f, 0x72,
 	0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27,
-	0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f,
+	0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x75,
+	0x72, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x23, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x65, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x76, 0x74, 0x78,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x76, 0x74,
+	0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4c,
+	0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x12,
 	0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
-	0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x67, 0x65, 0x7a, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x67, 0x65, 0x7a,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64,
-	0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4c, 0x0a,
-	0x0d, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x12, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64,
-	0x54, 0x65, 0x6d, 0x70, 0x54, 0x72, 0x61, 0x64, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x65, 0x6d, 0x70,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x42, 0xb3, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
-	0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x42, 0x0c,
-	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x47, 0x45, 0x5a, 0x4c,
-	0x61, 0x62, 0x73, 0x2f, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0xa2, 0x02, 0x03, 0x47, 0x54, 0x58, 0xaa, 0x02, 0x0f, 0x47, 0x67, 0x65, 0x7a, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0xca, 0x02, 0x0f, 0x47, 0x67, 0x65, 0x7a,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0xe2, 0x02, 0x1b, 0x47, 0x67,
-	0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x47, 0x67, 0x65, 0x7a,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x64, 0x54, 0x65, 0x6d, 0x70, 0x54, 0x72, 0x61, 0x64, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x65, 0x6d,
+	0x70, 0x54, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x74, 0x5f,
+	0x62, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f,
+	0x6d, 0x69, 0x6e, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12,
+	0x52, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x12, 0x4c, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x65, 0x73, 0x63,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x76, 0x76, 0x74,
+	0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x64, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77,
+	0x73, 0x42, 0xb3, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x47, 0x45, 0x5a, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x76,
+	0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x76, 0x74,
+	0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0xa2, 0x02, 0x03, 0x56,
+	0x54, 0x58, 0xaa, 0x02, 0x0f, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0xca, 0x02, 0x0f, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0xe2, 0x02, 0x1b, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -944,22 +1404,28 @@ func file_vvtxchain_trade_genesis_proto_rawDescGZIP() []byte {
 
 var file_vvtxchain_trade_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_vvtxchain_trade_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),    // 0: vvtxchain.trade.GenesisState
-	(*Params)(nil),          // 1: vvtxchain.trade.Params
-	(*TradeIndex)(nil),      // 2: vvtxchain.trade.TradeIndex
-	(*StoredTrade)(nil),     // 3: vvtxchain.trade.StoredTrade
-	(*StoredTempTrade)(nil), // 4: vvtxchain.trade.StoredTempTrade
+	(*GenesisState)(nil),      // 0: vvtxchain.trade.GenesisState
+	(*Params)(nil),            // 1: vvtxchain.trade.Params
+	(*TradeIndex)(nil),        // 2: vvtxchain.trade.TradeIndex
+	(*StoredTrade)(nil),       // 3: vvtxchain.trade.StoredTrade
+	(*StoredTempTrade)(nil),   // 4: vvtxchain.trade.StoredTempTrade
+	(*MintBurnRecord)(nil),    // 5: vvtxchain.trade.MintBurnRecord
+	(*TradeHistoryEntry)(nil), // 6: vvtxchain.trade.TradeHistoryEntry
+	(*TradeEscrow)(nil),       // 7: vvtxchain.trade.TradeEscrow
 }
 var file_vvtxchain_trade_genesis_proto_depIdxs = []int32{
 	1, // 0: vvtxchain.trade.GenesisState.params:type_name -> vvtxchain.trade.Params
 	2, // 1: vvtxchain.trade.GenesisState.trade_index:type_name -> vvtxchain.trade.TradeIndex
 	3, // 2: vvtxchain.trade.GenesisState.stored_trades:type_name -> vvtxchain.trade.StoredTrade
 	4, // 3: vvtxchain.trade.GenesisState.stored_temp_trades:type_name -> vvtxchain.trade.StoredTempTrade
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5, // 4: vvtxchain.trade.GenesisState.mint_burn_records:type_name -> vvtxchain.trade.MintBurnRecord
+	6, // 5: vvtxchain.trade.GenesisState.trade_history:type_name -> vvtxchain.trade.TradeHistoryEntry
+	7, // 6: vvtxchain.trade.GenesisState.trade_escrows:type_name -> vvtxchain.trade.TradeEscrow
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_vvtxchain_trade_genesis_proto_init() }
@@ -971,6 +1437,9 @@ func file_vvtxchain_trade_genesis_proto_init() {
 	file_vvtxchain_trade_trade_index_proto_init()
 	file_vvtxchain_trade_stored_trade_proto_init()
 	file_vvtxchain_trade_stored_temp_trade_proto_init()
+	file_vvtxchain_trade_mint_burn_record_proto_init()
+	file_vvtxchain_trade_trade_history_proto_init()
+	file_vvtxchain_trade_trade_escrow_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_vvtxchain_trade_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -19,12 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName             = "/vvtxchain.trade.Query/Params"
-	Query_TradeIndex_FullMethodName         = "/vvtxchain.trade.Query/TradeIndex"
-	Query_StoredTrade_FullMethodName        = "/vvtxchain.trade.Query/StoredTrade"
-	Query_StoredTradeAll_FullMethodName     = "/vvtxchain.trade.Query/StoredTradeAll"
-	Query_StoredTempTrade_FullMethodName    = "/vvtxchain.trade.Query/StoredTempTrade"
-	Query_StoredTempTradeAll_FullMethodName = "/vvtxchain.trade.Query/StoredTempTradeAll"
+	Query_Params_FullMethodName                         = "/vvtxchain.trade.Query/Params"
+	Query_TradeIndex_FullMethodName                     = "/vvtxchain.trade.Query/TradeIndex"
+	Query_StoredTrade_FullMethodName                    = "/vvtxchain.trade.Query/StoredTrade"
+	Query_StoredTradeAll_FullMethodName                 = "/vvtxchain.trade.Query/StoredTradeAll"
+	Query_StoredTempTrade_FullMethodName                = "/vvtxchain.trade.Query/StoredTempTrade"
+	Query_StoredTempTradeAll_FullMethodName             = "/vvtxchain.trade.Query/StoredTempTradeAll"
+	Query_StoredTradesByStatus_FullMethodName           = "/vvtxchain.trade.Query/StoredTradesByStatus"
+	Query_StoredTradesByMaker_FullMethodName            = "/vvtxchain.trade.Query/StoredTradesByMaker"
+	Query_StoredTradesByChecker_FullMethodName          = "/vvtxchain.trade.Query/StoredTradesByChecker"
+	Query_StoredTradesByReceiver_FullMethodName         = "/vvtxchain.trade.Query/StoredTradesByReceiver"
+	Query_StoredTradesByDateRange_FullMethodName        = "/vvtxchain.trade.Query/StoredTradesByDateRange"
+	Query_StoredTradeByExternalReference_FullMethodName = "/vvtxchain.trade.Query/StoredTradeByExternalReference"
+	Query_MintBurnCapacity_FullMethodName               = "/vvtxchain.trade.Query/MintBurnCapacity"
+	Query_TradeHistory_FullMethodName                   = "/vvtxchain.trade.Query/TradeHistory"
+	Query_TradeEscrow_FullMethodName                    = "/vvtxchain.trade.Query/TradeEscrow"
+	Query_TradeEscrowAll_FullMethodName                 = "/vvtxchain.trade.Query/TradeEscrowAll"
+	Query_SupplyReconciliation_FullMethodName           = "/vvtxchain.trade.Query/SupplyReconciliation"
 )
 
 // QueryClient is the client API for Query service.
@@ -41,6 +52,28 @@ type QueryClient interface {
 	// Queries a list of StoredTempTrade items.
 	StoredTempTrade(ctx context.Context, in *QueryGetStoredTempTradeRequest, opts ...grpc.CallOption) (*QueryGetStoredTempTradeResponse, error)
 	StoredTempTradeAll(ctx context.Context, in *QueryAllStoredTempTradeRequest, opts ...grpc.CallOption) (*QueryAllStoredTempTradeResponse, error)
+	// Queries a list of StoredTrade items by status.
+	StoredTradesByStatus(ctx context.Context, in *QueryStoredTradesByStatusRequest, opts ...grpc.CallOption) (*QueryStoredTradesByStatusResponse, error)
+	// Queries a list of StoredTrade items by maker.
+	StoredTradesByMaker(ctx context.Context, in *QueryStoredTradesByMakerRequest, opts ...grpc.CallOption) (*QueryStoredTradesByMakerResponse, error)
+	// Queries a list of StoredTrade items by checker.
+	StoredTradesByChecker(ctx context.Context, in *QueryStoredTradesByCheckerRequest, opts ...grpc.CallOption) (*QueryStoredTradesByCheckerResponse, error)
+	// Queries a list of StoredTrade items by receiver address.
+	StoredTradesByReceiver(ctx context.Context, in *QueryStoredTradesByReceiverRequest, opts ...grpc.CallOption) (*QueryStoredTradesByReceiverResponse, error)
+	// Queries a list of StoredTrade items with the selected date between from and to (inclusive).
+	StoredTradesByDateRange(ctx context.Context, in *QueryStoredTradesByDateRangeRequest, opts ...grpc.CallOption) (*QueryStoredTradesByDateRangeResponse, error)
+	// Queries the pending or processed StoredTrade with the given external reference.
+	StoredTradeByExternalReference(ctx context.Context, in *QueryStoredTradeByExternalReferenceRequest, opts ...grpc.CallOption) (*QueryStoredTradeByExternalReferenceResponse, error)
+	// Queries the remaining mint and burn capacity of a denom for a maker and for all makers.
+	MintBurnCapacity(ctx context.Context, in *QueryMintBurnCapacityRequest, opts ...grpc.CallOption) (*QueryMintBurnCapacityResponse, error)
+	// Queries the transitions of a trade, ordered by sequence.
+	TradeHistory(ctx context.Context, in *QueryTradeHistoryRequest, opts ...grpc.CallOption) (*QueryTradeHistoryResponse, error)
+	// Queries the escrow of a pending withdrawal.
+	TradeEscrow(ctx context.Context, in *QueryGetTradeEscrowRequest, opts ...grpc.CallOption) (*QueryGetTradeEscrowResponse, error)
+	// Queries the escrows of all pending withdrawals and the escrow balance of the module account.
+	TradeEscrowAll(ctx context.Context, in *QueryAllTradeEscrowRequest, opts ...grpc.CallOption) (*QueryAllTradeEscrowResponse, error)
+	// Queries the supply of a denom reconciled with the processed trades.
+	SupplyReconciliation(ctx context.Context, in *QuerySupplyReconciliationRequest, opts ...grpc.CallOption) (*QuerySupplyReconciliationResponse, error)
 }
 
 type queryClient struct {
@@ -105,6 +138,105 @@ func (c *queryClient) StoredTempTradeAll(ctx context.Context, in *QueryAllStored
 	return out, nil
 }
 
+func (c *queryClient) StoredTradesByStatus(ctx context.Context, in *QueryStoredTradesByStatusRequest, opts ...grpc.CallOption) (*QueryStoredTradesByStatusResponse, error) {
+	out := new(QueryStoredTradesByStatusResponse)
+	err := c.cc.Invoke(ctx, Query_StoredTradesByStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTradesByMaker(ctx context.Context, in *QueryStoredTradesByMakerRequest, opts ...grpc.CallOption) (*QueryStoredTradesByMakerResponse, error) {
+	out := new(QueryStoredTradesByMakerResponse)
+	err := c.cc.Invoke(ctx, Query_StoredTradesByMaker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTradesByChecker(ctx context.Context, in *QueryStoredTradesByCheckerRequest, opts ...grpc.CallOption) (*QueryStoredTradesByCheckerResponse, error) {
+	out := new(QueryStoredTradesByCheckerResponse)
+	err := c.cc.Invoke(ctx, Query_StoredTradesByChecker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTradesByReceiver(ctx context.Context, in *QueryStoredTradesByReceiverRequest, opts ...grpc.CallOption) (*QueryStoredTradesByReceiverResponse, error) {
+	out := new(QueryStoredTradesByReceiverResponse)
+	err := c.cc.Invoke(ctx, Query_StoredTradesByReceiver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTradesByDateRange(ctx context.Context, in *QueryStoredTradesByDateRangeRequest, opts ...grpc.CallOption) (*QueryStoredTradesByDateRangeResponse, error) {
+	out := new(QueryStoredTradesByDateRangeResponse)
+	err := c.cc.Invoke(ctx, Query_StoredTradesByDateRange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTradeByExternalReference(ctx context.Context, in *QueryStoredTradeByExternalReferenceRequest, opts ...grpc.CallOption) (*QueryStoredTradeByExternalReferenceResponse, error) {
+	out := new(QueryStoredTradeByExternalReferenceResponse)
+	err := c.cc.Invoke(ctx, Query_StoredTradeByExternalReference_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintBurnCapacity(ctx context.Context, in *QueryMintBurnCapacityRequest, opts ...grpc.CallOption) (*QueryMintBurnCapacityResponse, error) {
+	out := new(QueryMintBurnCapacityResponse)
+	err := c.cc.Invoke(ctx, Query_MintBurnCapacity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradeHistory(ctx context.Context, in *QueryTradeHistoryRequest, opts ...grpc.CallOption) (*QueryTradeHistoryResponse, error) {
+	out := new(QueryTradeHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_TradeHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradeEscrow(ctx context.Context, in *QueryGetTradeEscrowRequest, opts ...grpc.CallOption) (*QueryGetTradeEscrowResponse, error) {
+	out := new(QueryGetTradeEscrowResponse)
+	err := c.cc.Invoke(ctx, Query_TradeEscrow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradeEscrowAll(ctx context.Context, in *QueryAllTradeEscrowRequest, opts ...grpc.CallOption) (*QueryAllTradeEscrowResponse, error) {
+	out := new(QueryAllTradeEscrowResponse)
+	err := c.cc.Invoke(ctx, Query_TradeEscrowAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyReconciliation(ctx context.Context, in *QuerySupplyReconciliationRequest, opts ...grpc.CallOption) (*QuerySupplyReconciliationResponse, error) {
+	out := new(QuerySupplyReconciliationResponse)
+	err := c.cc.Invoke(ctx, Query_SupplyReconciliation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -119,6 +251,28 @@ type QueryServer interface {
 	// Queries a list of StoredTempTrade items.
 	StoredTempTrade(context.Context, *QueryGetStoredTempTradeRequest) (*QueryGetStoredTempTradeResponse, error)
 	StoredTempTradeAll(context.Context, *QueryAllStoredTempTradeRequest) (*QueryAllStoredTempTradeResponse, error)
+	// Queries a list of StoredTrade items by status.
+	StoredTradesByStatus(context.Context, *QueryStoredTradesByStatusRequest) (*QueryStoredTradesByStatusResponse, error)
+	// Queries a list of StoredTrade items by maker.
+	StoredTradesByMaker(context.Context, *QueryStoredTradesByMakerRequest) (*QueryStoredTradesByMakerResponse, error)
+	// Queries a list of StoredTrade items by checker.
+	StoredTradesByChecker(context.Context, *QueryStoredTradesByCheckerRequest) (*QueryStoredTradesByCheckerResponse, error)
+	// Queries a list of StoredTrade items by receiver address.
+	StoredTradesByReceiver(context.Context, *QueryStoredTradesByReceiverRequest) (*QueryStoredTradesByReceiverResponse, error)
+	// Queries a list of StoredTrade items with the selected date between from and to (inclusive).
+	StoredTradesByDateRange(context.Context, *QueryStoredTradesByDateRangeRequest) (*QueryStoredTradesByDateRangeResponse, error)
+	// Queries the pending or processed StoredTrade with the given external reference.
+	StoredTradeByExternalReference(context.Context, *QueryStoredTradeByExternalReferenceRequest) (*QueryStoredTradeByExternalReferenceResponse, error)
+	// Queries the remaining mint and burn capacity of a denom for a maker and for all makers.
+	MintBurnCapacity(context.Context, *QueryMintBurnCapacityRequest) (*QueryMintBurnCapacityResponse, error)
+	// Queries the transitions of a trade, ordered by sequence.
+	TradeHistory(context.Context, *QueryTradeHistoryRequest) (*QueryTradeHistoryResponse, error)
+	// Queries the escrow of a pending withdrawal.
+	TradeEscrow(context.Context, *QueryGetTradeEscrowRequest) (*QueryGetTradeEscrowResponse, error)
+	// Queries the escrows of all pending withdrawals and the escrow balance of the module account.
+	TradeEscrowAll(context.Context, *QueryAllTradeEscrowRequest) (*QueryAllTradeEscrowResponse, error)
+	// Queries the supply of a denom reconciled with the processed trades.
+	SupplyReconciliation(context.Context, *QuerySupplyReconciliationRequest) (*QuerySupplyReconciliationResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -144,6 +298,39 @@ func (UnimplementedQueryServer) StoredTempTrade(context.Context, *QueryGetStored
 func (UnimplementedQueryServer) StoredTempTradeAll(context.Context, *QueryAllStoredTempTradeRequest) (*QueryAllStoredTempTradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StoredTempTradeAll not implemented")
 }
+func (UnimplementedQueryServer) StoredTradesByStatus(context.Context, *QueryStoredTradesByStatusRequest) (*QueryStoredTradesByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTradesByStatus not implemented")
+}
+func (UnimplementedQueryServer) StoredTradesByMaker(context.Context, *QueryStoredTradesByMakerRequest) (*QueryStoredTradesByMakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTradesByMaker not implemented")
+}
+func (UnimplementedQueryServer) StoredTradesByChecker(context.Context, *QueryStoredTradesByCheckerRequest) (*QueryStoredTradesByCheckerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTradesByChecker not implemented")
+}
+func (UnimplementedQueryServer) StoredTradesByReceiver(context.Context, *QueryStoredTradesByReceiverRequest) (*QueryStoredTradesByReceiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTradesByReceiver not implemented")
+}
+func (UnimplementedQueryServer) StoredTradesByDateRange(context.Context, *QueryStoredTradesByDateRangeRequest) (*QueryStoredTradesByDateRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTradesByDateRange not implemented")
+}
+func (UnimplementedQueryServer) StoredTradeByExternalReference(context.Context, *QueryStoredTradeByExternalReferenceRequest) (*QueryStoredTradeByExternalReferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTradeByExternalReference not implemented")
+}
+func (UnimplementedQueryServer) MintBurnCapacity(context.Context, *QueryMintBurnCapacityRequest) (*QueryMintBurnCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintBurnCapacity not implemented")
+}
+func (UnimplementedQueryServer) TradeHistory(context.Context, *QueryTradeHistoryRequest) (*QueryTradeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradeHistory not implemented")
+}
+func (UnimplementedQueryServer) TradeEscrow(context.Context, *QueryGetTradeEscrowRequest) (*QueryGetTradeEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradeEscrow not implemented")
+}
+func (UnimplementedQueryServer) TradeEscrowAll(context.Context, *QueryAllTradeEscrowRequest) (*QueryAllTradeEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradeEscrowAll not implemented")
+}
+func (UnimplementedQueryServer) SupplyReconciliation(context.Context, *QuerySupplyReconciliationRequest) (*QuerySupplyReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyReconciliation not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -265,6 +452,204 @@ func _Query_StoredTempTradeAll_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StoredTradesByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoredTradesByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTradesByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StoredTradesByStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTradesByStatus(ctx, req.(*QueryStoredTradesByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTradesByMaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoredTradesByMakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTradesByMaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StoredTradesByMaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTradesByMaker(ctx, req.(*QueryStoredTradesByMakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTradesByChecker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoredTradesByCheckerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTradesByChecker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StoredTradesByChecker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTradesByChecker(ctx, req.(*QueryStoredTradesByCheckerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTradesByReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoredTradesByReceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTradesByReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StoredTradesByReceiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTradesByReceiver(ctx, req.(*QueryStoredTradesByReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTradesByDateRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoredTradesByDateRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTradesByDateRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StoredTradesByDateRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTradesByDateRange(ctx, req.(*QueryStoredTradesByDateRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTradeByExternalReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoredTradeByExternalReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTradeByExternalReference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StoredTradeByExternalReference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTradeByExternalReference(ctx, req.(*QueryStoredTradeByExternalReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintBurnCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintBurnCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintBurnCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MintBurnCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintBurnCapacity(ctx, req.(*QueryMintBurnCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TradeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradeHistory(ctx, req.(*QueryTradeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradeEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTradeEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradeEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TradeEscrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradeEscrow(ctx, req.(*QueryGetTradeEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradeEscrowAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTradeEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradeEscrowAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TradeEscrowAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradeEscrowAll(ctx, req.(*QueryAllTradeEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SupplyReconciliation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyReconciliation(ctx, req.(*QuerySupplyReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -296,6 +681,50 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StoredTempTradeAll",
 			Handler:    _Query_StoredTempTradeAll_Handler,
 		},
+		{
+			MethodName: "StoredTradesByStatus",
+			Handler:    _Query_StoredTradesByStatus_Handler,
+		},
+		{
+			MethodName: "StoredTradesByMaker",
+			Handler:    _Query_StoredTradesByMaker_Handler,
+		},
+		{
+			MethodName: "StoredTradesByChecker",
+			Handler:    _Query_StoredTradesByChecker_Handler,
+		},
+		{
+			MethodName: "StoredTradesByReceiver",
+			Handler:    _Query_StoredTradesByReceiver_Handler,
+		},
+		{
+			MethodName: "StoredTradesByDateRange",
+			Handler:    _Query_StoredTradesByDateRange_Handler,
+		},
+		{
+			MethodName: "StoredTradeByExternalReference",
+			Handler:    _Query_StoredTradeByExternalReference_Handler,
+		},
+		{
+			MethodName: "MintBurnCapacity",
+			Handler:    _Query_MintBurnCapacity_Handler,
+		},
+		{
+			MethodName: "TradeHistory",
+			Handler:    _Query_TradeHistory_Handler,
+		},
+		{
+			MethodName: "TradeEscrow",
+			Handler:    _Query_TradeEscrow_Handler,
+		},
+		{
+			MethodName: "TradeEscrowAll",
+			Handler:    _Query_TradeEscrowAll_Handler,
+		},
+		{
+			MethodName: "SupplyReconciliation",
+			Handler:    _Query_SupplyReconciliation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vvtxchain/trade/query.proto",
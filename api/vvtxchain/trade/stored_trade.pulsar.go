@@ -5,6 +5,7 @@ import (
 	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -13,12 +14,160 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_StoredTrade_20_list)(nil)
+
+type _StoredTrade_20_list struct {
+	list *[]string
+}
+
+func (x *_StoredTrade_20_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StoredTrade_20_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_StoredTrade_20_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StoredTrade_20_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StoredTrade_20_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message StoredTrade at list field Approvals as it is not of Message kind"))
+}
+
+func (x *_StoredTrade_20_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StoredTrade_20_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_StoredTrade_20_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_StoredTrade_22_list)(nil)
+
+type _StoredTrade_22_list struct {
+	list *[]*CoinMintingPriceJson
+}
+
+func (x *_StoredTrade_22_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StoredTrade_22_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StoredTrade_22_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CoinMintingPriceJson)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StoredTrade_22_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CoinMintingPriceJson)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StoredTrade_22_list) AppendMutable() protoreflect.Value {
+	v := new(CoinMintingPriceJson)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StoredTrade_22_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StoredTrade_22_list) NewElement() protoreflect.Value {
+	v := new(CoinMintingPriceJson)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StoredTrade_22_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_StoredTrade_23_list)(nil)
+
+type _StoredTrade_23_list struct {
+	list *[]*ExchangeRateJson
+}
+
+func (x *_StoredTrade_23_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StoredTrade_23_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StoredTrade_23_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExchangeRateJson)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StoredTrade_23_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExchangeRateJson)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StoredTrade_23_list) AppendMutable() protoreflect.Value {
+	v := new(ExchangeRateJson)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StoredTrade_23_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StoredTrade_23_list) NewElement() protoreflect.Value {
+	v := new(ExchangeRateJson)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StoredTrade_23_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_StoredTrade                         protoreflect.MessageDescriptor
 	fd_StoredTrade_trade_index             protoreflect.FieldDescriptor
 	fd_StoredTrade_trade_type              protoreflect.FieldDescriptor
 	fd_StoredTrade_amount                  protoreflect.FieldDescriptor
-	fd_StoredTrade_coin_minting_price  protoreflect.FieldDescriptor
+	fd_StoredTrade_coin_minting_price      protoreflect.FieldDescriptor
 	fd_StoredTrade_receiver_address        protoreflect.FieldDescriptor
 	fd_StoredTrade_status                  protoreflect.FieldDescriptor
 	fd_StoredTrade_maker                   protoreflect.FieldDescriptor
@@ -32,6 +181,16 @@ var (
 	fd_StoredTrade_exchange_rate_json      protoreflect.FieldDescriptor
 	fd_StoredTrade_banking_system_data     protoreflect.FieldDescriptor
 	fd_StoredTrade_result                  protoreflect.FieldDescriptor
+	fd_StoredTrade_revision                protoreflect.FieldDescriptor
+	fd_StoredTrade_previous_payload_hash   protoreflect.FieldDescriptor
+	fd_StoredTrade_approvals               protoreflect.FieldDescriptor
+	fd_StoredTrade_typed_trade_data        protoreflect.FieldDescriptor
+	fd_StoredTrade_coin_minting_prices     protoreflect.FieldDescriptor
+	fd_StoredTrade_exchange_rates          protoreflect.FieldDescriptor
+	fd_StoredTrade_external_reference      protoreflect.FieldDescriptor
+	fd_StoredTrade_failure_codespace       protoreflect.FieldDescriptor
+	fd_StoredTrade_failure_code            protoreflect.FieldDescriptor
+	fd_StoredTrade_attempts                protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -54,6 +213,16 @@ func init() {
 	fd_StoredTrade_exchange_rate_json = md_StoredTrade.Fields().ByName("exchange_rate_json")
 	fd_StoredTrade_banking_system_data = md_StoredTrade.Fields().ByName("banking_system_data")
 	fd_StoredTrade_result = md_StoredTrade.Fields().ByName("result")
+	fd_StoredTrade_revision = md_StoredTrade.Fields().ByName("revision")
+	fd_StoredTrade_previous_payload_hash = md_StoredTrade.Fields().ByName("previous_payload_hash")
+	fd_StoredTrade_approvals = md_StoredTrade.Fields().ByName("approvals")
+	fd_StoredTrade_typed_trade_data = md_StoredTrade.Fields().ByName("typed_trade_data")
+	fd_StoredTrade_coin_minting_prices = md_StoredTrade.Fields().ByName("coin_minting_prices")
+	fd_StoredTrade_exchange_rates = md_StoredTrade.Fields().ByName("exchange_rates")
+	fd_StoredTrade_external_reference = md_StoredTrade.Fields().ByName("external_reference")
+	fd_StoredTrade_failure_codespace = md_StoredTrade.Fields().ByName("failure_codespace")
+	fd_StoredTrade_failure_code = md_StoredTrade.Fields().ByName("failure_code")
+	fd_StoredTrade_attempts = md_StoredTrade.Fields().ByName("attempts")
 }
 
 var _ protoreflect.Message = (*fastReflection_StoredTrade)(nil)
@@ -223,6 +392,66 @@ func (x *fastReflection_StoredTrade) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.Revision != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Revision)
+		if !f(fd_StoredTrade_revision, value) {
+			return
+		}
+	}
+	if x.PreviousPayloadHash != "" {
+		value := protoreflect.ValueOfString(x.PreviousPayloadHash)
+		if !f(fd_StoredTrade_previous_payload_hash, value) {
+			return
+		}
+	}
+	if len(x.Approvals) != 0 {
+		value := protoreflect.ValueOfList(&_StoredTrade_20_list{list: &x.Approvals})
+		if !f(fd_StoredTrade_approvals, value) {
+			return
+		}
+	}
+	if x.TypedTradeData != nil {
+		value := protoreflect.ValueOfMessage(x.TypedTradeData.ProtoReflect())
+		if !f(fd_StoredTrade_typed_trade_data, value) {
+			return
+		}
+	}
+	if len(x.CoinMintingPrices) != 0 {
+		value := protoreflect.ValueOfList(&_StoredTrade_22_list{list: &x.CoinMintingPrices})
+		if !f(fd_StoredTrade_coin_minting_prices, value) {
+			return
+		}
+	}
+	if len(x.ExchangeRates) != 0 {
+		value := protoreflect.ValueOfList(&_StoredTrade_23_list{list: &x.ExchangeRates})
+		if !f(fd_StoredTrade_exchange_rates, value) {
+			return
+		}
+	}
+	if x.ExternalReference != "" {
+		value := protoreflect.ValueOfString(x.ExternalReference)
+		if !f(fd_StoredTrade_external_reference, value) {
+			return
+		}
+	}
+	if x.FailureCodespace != "" {
+		value := protoreflect.ValueOfString(x.FailureCodespace)
+		if !f(fd_StoredTrade_failure_codespace, value) {
+			return
+		}
+	}
+	if x.FailureCode != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.FailureCode)
+		if !f(fd_StoredTrade_failure_code, value) {
+			return
+		}
+	}
+	if x.Attempts != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Attempts)
+		if !f(fd_StoredTrade_attempts, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -272,6 +501,26 @@ func (x *fastReflection_StoredTrade) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.BankingSystemData != ""
 	case "vvtxchain.trade.StoredTrade.result":
 		return x.Result != ""
+	case "vvtxchain.trade.StoredTrade.revision":
+		return x.Revision != uint64(0)
+	case "vvtxchain.trade.StoredTrade.previous_payload_hash":
+		return x.PreviousPayloadHash != ""
+	case "vvtxchain.trade.StoredTrade.approvals":
+		return len(x.Approvals) != 0
+	case "vvtxchain.trade.StoredTrade.typed_trade_data":
+		return x.TypedTradeData != nil
+	case "vvtxchain.trade.StoredTrade.coin_minting_prices":
+		return len(x.CoinMintingPrices) != 0
+	case "vvtxchain.trade.StoredTrade.exchange_rates":
+		return len(x.ExchangeRates) != 0
+	case "vvtxchain.trade.StoredTrade.external_reference":
+		return x.ExternalReference != ""
+	case "vvtxchain.trade.StoredTrade.failure_codespace":
+		return x.FailureCodespace != ""
+	case "vvtxchain.trade.StoredTrade.failure_code":
+		return x.FailureCode != uint32(0)
+	case "vvtxchain.trade.StoredTrade.attempts":
+		return x.Attempts != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.StoredTrade"))
@@ -322,6 +571,26 @@ func (x *fastReflection_StoredTrade) Clear(fd protoreflect.FieldDescriptor) {
 		x.BankingSystemData = ""
 	case "vvtxchain.trade.StoredTrade.result":
 		x.Result = ""
+	case "vvtxchain.trade.StoredTrade.revision":
+		x.Revision = uint64(0)
+	case "vvtxchain.trade.StoredTrade.previous_payload_hash":
+		x.PreviousPayloadHash = ""
+	case "vvtxchain.trade.StoredTrade.approvals":
+		x.Approvals = nil
+	case "vvtxchain.trade.StoredTrade.typed_trade_data":
+		x.TypedTradeData = nil
+	case "vvtxchain.trade.StoredTrade.coin_minting_prices":
+		x.CoinMintingPrices = nil
+	case "vvtxchain.trade.StoredTrade.exchange_rates":
+		x.ExchangeRates = nil
+	case "vvtxchain.trade.StoredTrade.external_reference":
+		x.ExternalReference = ""
+	case "vvtxchain.trade.StoredTrade.failure_codespace":
+		x.FailureCodespace = ""
+	case "vvtxchain.trade.StoredTrade.failure_code":
+		x.FailureCode = uint32(0)
+	case "vvtxchain.trade.StoredTrade.attempts":
+		x.Attempts = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.StoredTrade"))
@@ -389,6 +658,45 @@ func (x *fastReflection_StoredTrade) Get(descriptor protoreflect.FieldDescriptor
 	case "vvtxchain.trade.StoredTrade.result":
 		value := x.Result
 		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.StoredTrade.revision":
+		value := x.Revision
+		return protoreflect.ValueOfUint64(value)
+	case "vvtxchain.trade.StoredTrade.previous_payload_hash":
+		value := x.PreviousPayloadHash
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.StoredTrade.approvals":
+		if len(x.Approvals) == 0 {
+			return protoreflect.ValueOfList(&_StoredTrade_20_list{})
+		}
+		listValue := &_StoredTrade_20_list{list: &x.Approvals}
+		return protoreflect.ValueOfList(listValue)
+	case "vvtxchain.trade.StoredTrade.typed_trade_data":
+		value := x.TypedTradeData
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "vvtxchain.trade.StoredTrade.coin_minting_prices":
+		if len(x.CoinMintingPrices) == 0 {
+			return protoreflect.ValueOfList(&_StoredTrade_22_list{})
+		}
+		listValue := &_StoredTrade_22_list{list: &x.CoinMintingPrices}
+		return protoreflect.ValueOfList(listValue)
+	case "vvtxchain.trade.StoredTrade.exchange_rates":
+		if len(x.ExchangeRates) == 0 {
+			return protoreflect.ValueOfList(&_StoredTrade_23_list{})
+		}
+		listValue := &_StoredTrade_23_list{list: &x.ExchangeRates}
+		return protoreflect.ValueOfList(listValue)
+	case "vvtxchain.trade.StoredTrade.external_reference":
+		value := x.ExternalReference
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.StoredTrade.failure_codespace":
+		value := x.FailureCodespace
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.StoredTrade.failure_code":
+		value := x.FailureCode
+		return protoreflect.ValueOfUint32(value)
+	case "vvtxchain.trade.StoredTrade.attempts":
+		value := x.Attempts
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.StoredTrade"))
@@ -443,6 +751,32 @@ func (x *fastReflection_StoredTrade) Set(fd protoreflect.FieldDescriptor, value
 		x.BankingSystemData = value.Interface().(string)
 	case "vvtxchain.trade.StoredTrade.result":
 		x.Result = value.Interface().(string)
+	case "vvtxchain.trade.StoredTrade.revision":
+		x.Revision = value.Uint()
+	case "vvtxchain.trade.StoredTrade.previous_payload_hash":
+		x.PreviousPayloadHash = value.Interface().(string)
+	case "vvtxchain.trade.StoredTrade.approvals":
+		lv := value.List()
+		clv := lv.(*_StoredTrade_20_list)
+		x.Approvals = *clv.list
+	case "vvtxchain.trade.StoredTrade.typed_trade_data":
+		x.TypedTradeData = value.Message().Interface().(*TradeData)
+	case "vvtxchain.trade.StoredTrade.coin_minting_prices":
+		lv := value.List()
+		clv := lv.(*_StoredTrade_22_list)
+		x.CoinMintingPrices = *clv.list
+	case "vvtxchain.trade.StoredTrade.exchange_rates":
+		lv := value.List()
+		clv := lv.(*_StoredTrade_23_list)
+		x.ExchangeRates = *clv.list
+	case "vvtxchain.trade.StoredTrade.external_reference":
+		x.ExternalReference = value.Interface().(string)
+	case "vvtxchain.trade.StoredTrade.failure_codespace":
+		x.FailureCodespace = value.Interface().(string)
+	case "vvtxchain.trade.StoredTrade.failure_code":
+		x.FailureCode = uint32(value.Uint())
+	case "vvtxchain.trade.StoredTrade.attempts":
+		x.Attempts = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.StoredTrade"))
@@ -468,6 +802,29 @@ func (x *fastReflection_StoredTrade) Mutable(fd protoreflect.FieldDescriptor) pr
 			x.Amount = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "vvtxchain.trade.StoredTrade.approvals":
+		if x.Approvals == nil {
+			x.Approvals = []string{}
+		}
+		value := &_StoredTrade_20_list{list: &x.Approvals}
+		return protoreflect.ValueOfList(value)
+	case "vvtxchain.trade.StoredTrade.typed_trade_data":
+		if x.TypedTradeData == nil {
+			x.TypedTradeData = new(TradeData)
+		}
+		return protoreflect.ValueOfMessage(x.TypedTradeData.ProtoReflect())
+	case "vvtxchain.trade.StoredTrade.coin_minting_prices":
+		if x.CoinMintingPrices == nil {
+			x.CoinMintingPrices = []*CoinMintingPriceJson{}
+		}
+		value := &_StoredTrade_22_list{list: &x.CoinMintingPrices}
+		return protoreflect.ValueOfList(value)
+	case "vvtxchain.trade.StoredTrade.exchange_rates":
+		if x.ExchangeRates == nil {
+			x.ExchangeRates = []*ExchangeRateJson{}
+		}
+		value := &_StoredTrade_23_list{list: &x.ExchangeRates}
+		return protoreflect.ValueOfList(value)
 	case "vvtxchain.trade.StoredTrade.trade_index":
 		panic(fmt.Errorf("field trade_index of message vvtxchain.trade.StoredTrade is not mutable"))
 	case "vvtxchain.trade.StoredTrade.trade_type":
@@ -500,6 +857,18 @@ func (x *fastReflection_StoredTrade) Mutable(fd protoreflect.FieldDescriptor) pr
 		panic(fmt.Errorf("field banking_system_data of message vvtxchain.trade.StoredTrade is not mutable"))
 	case "vvtxchain.trade.StoredTrade.result":
 		panic(fmt.Errorf("field result of message vvtxchain.trade.StoredTrade is not mutable"))
+	case "vvtxchain.trade.StoredTrade.revision":
+		panic(fmt.Errorf("field revision of message vvtxchain.trade.StoredTrade is not mutable"))
+	case "vvtxchain.trade.StoredTrade.previous_payload_hash":
+		panic(fmt.Errorf("field previous_payload_hash of message vvtxchain.trade.StoredTrade is not mutable"))
+	case "vvtxchain.trade.StoredTrade.external_reference":
+		panic(fmt.Errorf("field external_reference of message vvtxchain.trade.StoredTrade is not mutable"))
+	case "vvtxchain.trade.StoredTrade.failure_codespace":
+		panic(fmt.Errorf("field failure_codespace of message vvtxchain.trade.StoredTrade is not mutable"))
+	case "vvtxchain.trade.StoredTrade.failure_code":
+		panic(fmt.Errorf("field failure_code of message vvtxchain.trade.StoredTrade is not mutable"))
+	case "vvtxchain.trade.StoredTrade.attempts":
+		panic(fmt.Errorf("field attempts of message vvtxchain.trade.StoredTrade is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.StoredTrade"))
@@ -548,6 +917,30 @@ func (x *fastReflection_StoredTrade) NewField(fd protoreflect.FieldDescriptor) p
 		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.StoredTrade.result":
 		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.StoredTrade.revision":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "vvtxchain.trade.StoredTrade.previous_payload_hash":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.StoredTrade.approvals":
+		list := []string{}
+		return protoreflect.ValueOfList(&_StoredTrade_20_list{list: &list})
+	case "vvtxchain.trade.StoredTrade.typed_trade_data":
+		m := new(TradeData)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "vvtxchain.trade.StoredTrade.coin_minting_prices":
+		list := []*CoinMintingPriceJson{}
+		return protoreflect.ValueOfList(&_StoredTrade_22_list{list: &list})
+	case "vvtxchain.trade.StoredTrade.exchange_rates":
+		list := []*ExchangeRateJson{}
+		return protoreflect.ValueOfList(&_StoredTrade_23_list{list: &list})
+	case "vvtxchain.trade.StoredTrade.external_reference":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.StoredTrade.failure_codespace":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.StoredTrade.failure_code":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "vvtxchain.trade.StoredTrade.attempts":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.StoredTrade"))
@@ -682,6 +1075,49 @@ func (x *fastReflection_StoredTrade) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
+		if x.Revision != 0 {
+			n += 2 + runtime.Sov(uint64(x.Revision))
+		}
+		l = len(x.PreviousPayloadHash)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Approvals) > 0 {
+			for _, s := range x.Approvals {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TypedTradeData != nil {
+			l = options.Size(x.TypedTradeData)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.CoinMintingPrices) > 0 {
+			for _, e := range x.CoinMintingPrices {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ExchangeRates) > 0 {
+			for _, e := range x.ExchangeRates {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.ExternalReference)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FailureCodespace)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.FailureCode != 0 {
+			n += 2 + runtime.Sov(uint64(x.FailureCode))
+		}
+		if x.Attempts != 0 {
+			n += 2 + runtime.Sov(uint64(x.Attempts))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -711,6 +1147,117 @@ func (x *fastReflection_StoredTrade) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Attempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempts))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd8
+		}
+		if x.FailureCode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FailureCode))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd0
+		}
+		if len(x.FailureCodespace) > 0 {
+			i -= len(x.FailureCodespace)
+			copy(dAtA[i:], x.FailureCodespace)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FailureCodespace)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if len(x.ExternalReference) > 0 {
+			i -= len(x.ExternalReference)
+			copy(dAtA[i:], x.ExternalReference)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExternalReference)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+		if len(x.ExchangeRates) > 0 {
+			for iNdEx := len(x.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExchangeRates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xba
+			}
+		}
+		if len(x.CoinMintingPrices) > 0 {
+			for iNdEx := len(x.CoinMintingPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CoinMintingPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if x.TypedTradeData != nil {
+			encoded, err := options.Marshal(x.TypedTradeData)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.Approvals) > 0 {
+			for iNdEx := len(x.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Approvals[iNdEx])
+				copy(dAtA[i:], x.Approvals[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Approvals[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if len(x.PreviousPayloadHash) > 0 {
+			i -= len(x.PreviousPayloadHash)
+			copy(dAtA[i:], x.PreviousPayloadHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousPayloadHash)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if x.Revision != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Revision))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
 		if len(x.Result) > 0 {
 			i -= len(x.Result)
 			copy(dAtA[i:], x.Result)
@@ -1393,6 +1940,295 @@ func (x *fastReflection_StoredTrade) ProtoMethods() *protoiface.Methods {
 				}
 				x.Result = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				}
+				x.Revision = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Revision |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousPayloadHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousPayloadHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Approvals = append(x.Approvals, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TypedTradeData", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TypedTradeData == nil {
+					x.TypedTradeData = &TradeData{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TypedTradeData); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoinMintingPrices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CoinMintingPrices = append(x.CoinMintingPrices, &CoinMintingPriceJson{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CoinMintingPrices[len(x.CoinMintingPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 23:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExchangeRates = append(x.ExchangeRates, &ExchangeRateJson{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExchangeRates[len(x.ExchangeRates)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExternalReference", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExternalReference = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailureCodespace", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FailureCodespace = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 26:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailureCode", wireType)
+				}
+				x.FailureCode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FailureCode |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 27:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+				}
+				x.Attempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attempts |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1449,7 +2285,7 @@ type StoredTrade struct {
 	TradeIndex           uint64        `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
 	TradeType            TradeType     `protobuf:"varint,2,opt,name=trade_type,json=tradeType,proto3,enum=vvtxchain.trade.TradeType" json:"trade_type,omitempty"`
 	Amount               *v1beta1.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	CoinMintingPrice  string        `protobuf:"bytes,4,opt,name=coin_minting_price,json=coinMintingPrice,proto3" json:"coin_minting_price,omitempty"`
+	CoinMintingPrice     string        `protobuf:"bytes,4,opt,name=coin_minting_price,json=coinMintingPrice,proto3" json:"coin_minting_price,omitempty"`
 	ReceiverAddress      string        `protobuf:"bytes,5,opt,name=receiver_address,json=receiverAddress,proto3" json:"receiver_address,omitempty"`
 	Status               TradeStatus   `protobuf:"varint,6,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
 	Maker                string        `protobuf:"bytes,7,opt,name=maker,proto3" json:"maker,omitempty"`
@@ -1463,6 +2299,27 @@ type StoredTrade struct {
 	ExchangeRateJson     string        `protobuf:"bytes,15,opt,name=exchange_rate_json,json=exchangeRateJson,proto3" json:"exchange_rate_json,omitempty"`
 	BankingSystemData    string        `protobuf:"bytes,16,opt,name=banking_system_data,json=bankingSystemData,proto3" json:"banking_system_data,omitempty"`
 	Result               string        `protobuf:"bytes,17,opt,name=result,proto3" json:"result,omitempty"`
+	// revision is incremented each time the trade is amended by its maker.
+	Revision uint64 `protobuf:"varint,18,opt,name=revision,proto3" json:"revision,omitempty"`
+	// previous_payload_hash is the hash of the trade payload before the last amendment.
+	PreviousPayloadHash string `protobuf:"bytes,19,opt,name=previous_payload_hash,json=previousPayloadHash,proto3" json:"previous_payload_hash,omitempty"`
+	// approvals are the checkers that have confirmed the current revision of the trade.
+	Approvals []string `protobuf:"bytes,20,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// typed_trade_data is the typed form of trade_data.
+	TypedTradeData *TradeData `protobuf:"bytes,21,opt,name=typed_trade_data,json=typedTradeData,proto3" json:"typed_trade_data,omitempty"`
+	// coin_minting_prices is the typed form of coin_minting_price_json.
+	CoinMintingPrices []*CoinMintingPriceJson `protobuf:"bytes,22,rep,name=coin_minting_prices,json=coinMintingPrices,proto3" json:"coin_minting_prices,omitempty"`
+	// exchange_rates is the typed form of exchange_rate_json.
+	ExchangeRates []*ExchangeRateJson `protobuf:"bytes,23,rep,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates,omitempty"`
+	// external_reference is the optional reference of the trade in the banking system.
+	ExternalReference string `protobuf:"bytes,24,opt,name=external_reference,json=externalReference,proto3" json:"external_reference,omitempty"`
+	// failure_codespace and failure_code are the registered error of the mint or
+	// burn of a failed trade, result holds its message.
+	FailureCodespace string `protobuf:"bytes,25,opt,name=failure_codespace,json=failureCodespace,proto3" json:"failure_codespace,omitempty"`
+	FailureCode      uint32 `protobuf:"varint,26,opt,name=failure_code,json=failureCode,proto3" json:"failure_code,omitempty"`
+	// attempts is the number of times the mint or burn of the trade has been executed,
+	// it is incremented when the trade is confirmed and each time it is retried.
+	Attempts uint32 `protobuf:"varint,27,opt,name=attempts,proto3" json:"attempts,omitempty"`
 }
 
 func (x *StoredTrade) Reset() {
@@ -1604,72 +2461,176 @@ func (x *StoredTrade) GetResult() string {
 	return ""
 }
 
+func (x *StoredTrade) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *StoredTrade) GetPreviousPayloadHash() string {
+	if x != nil {
+		return x.PreviousPayloadHash
+	}
+	return ""
+}
+
+func (x *StoredTrade) GetApprovals() []string {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *StoredTrade) GetTypedTradeData() *TradeData {
+	if x != nil {
+		return x.TypedTradeData
+	}
+	return nil
+}
+
+func (x *StoredTrade) GetCoinMintingPrices() []*CoinMintingPriceJson {
+	if x != nil {
+		return x.CoinMintingPrices
+	}
+	return nil
+}
+
+func (x *StoredTrade) GetExchangeRates() []*ExchangeRateJson {
+	if x != nil {
+		return x.ExchangeRates
+	}
+	return nil
+}
+
+func (x *StoredTrade) GetExternalReference() string {
+	if x != nil {
+		return x.ExternalReference
+	}
+	return ""
+}
+
+func (x *StoredTrade) GetFailureCodespace() string {
+	if x != nil {
+		return x.FailureCodespace
+	}
+	return ""
+}
+
+func (x *StoredTrade) GetFailureCode() uint32 {
+	if x != nil {
+		return x.FailureCode
+	}
+	return 0
+}
+
+func (x *StoredTrade) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
 var File_vvtxchain_trade_stored_trade_proto protoreflect.FileDescriptor
 
 var file_vvtxchain_trade_stored_trade_proto_rawDesc = []byte{
-	0x0a, 0x22, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64,
+	0x0a, 0x22, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64,
 	0x65, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
 	0x74, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61,
 	0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xac, 0x05, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x72, 0x61,
-	0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e,
-	0x64, 0x65, 0x78, 0x12, 0x39, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x33, 0x0a, 0x16, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x13, 0x63, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x55, 0x73, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1c, 0x2e, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72,
-	0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x44, 0x61, 0x74, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x17, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e,
-	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x69,
-	0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x65,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6a, 0x73, 0x6f,
-	0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x61, 0x74, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x42, 0xb7, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x42, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x64, 0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x47, 0x45, 0x5a, 0x4c, 0x61,
-	0x62, 0x73, 0x2f, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65,
-	0xa2, 0x02, 0x03, 0x47, 0x54, 0x58, 0xaa, 0x02, 0x0f, 0x47, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0xca, 0x02, 0x0f, 0x47, 0x67, 0x65, 0x7a, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0xe2, 0x02, 0x1b, 0x47, 0x67, 0x65,
-	0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x47, 0x67, 0x65, 0x7a, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x76, 0x76, 0x74,
+	0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa1, 0x09, 0x0a, 0x0b, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x39, 0x0a, 0x0a, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x69,
+	0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x17, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x6d, 0x69,
+	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x63, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12,
+	0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32,
+	0x0a, 0x15, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18,
+	0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73,
+	0x12, 0x44, 0x0a, 0x10, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x76, 0x74,
+	0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x74, 0x79, 0x70, 0x65, 0x64, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x5b, 0x0a, 0x13, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x6d,
+	0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x16, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x11, 0x63, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x76, 0x76,
+	0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x1b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x42, 0xb7,
+	0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x42, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x47, 0x45, 0x5a, 0x4c, 0x61, 0x62, 0x73, 0x2f,
+	0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x76,
+	0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0xa2, 0x02, 0x03,
+	0x56, 0x54, 0x58, 0xaa, 0x02, 0x0f, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0xca, 0x02, 0x0f, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0xe2, 0x02, 0x1b, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1686,20 +2647,26 @@ func file_vvtxchain_trade_stored_trade_proto_rawDescGZIP() []byte {
 
 var file_vvtxchain_trade_stored_trade_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_vvtxchain_trade_stored_trade_proto_goTypes = []interface{}{
-	(*StoredTrade)(nil),  // 0: vvtxchain.trade.StoredTrade
-	(TradeType)(0),       // 1: vvtxchain.trade.TradeType
-	(*v1beta1.Coin)(nil), // 2: cosmos.base.v1beta1.Coin
-	(TradeStatus)(0),     // 3: vvtxchain.trade.TradeStatus
+	(*StoredTrade)(nil),          // 0: vvtxchain.trade.StoredTrade
+	(TradeType)(0),               // 1: vvtxchain.trade.TradeType
+	(*v1beta1.Coin)(nil),         // 2: cosmos.base.v1beta1.Coin
+	(TradeStatus)(0),             // 3: vvtxchain.trade.TradeStatus
+	(*TradeData)(nil),            // 4: vvtxchain.trade.TradeData
+	(*CoinMintingPriceJson)(nil), // 5: vvtxchain.trade.CoinMintingPriceJson
+	(*ExchangeRateJson)(nil),     // 6: vvtxchain.trade.ExchangeRateJson
 }
 var file_vvtxchain_trade_stored_trade_proto_depIdxs = []int32{
 	1, // 0: vvtxchain.trade.StoredTrade.trade_type:type_name -> vvtxchain.trade.TradeType
 	2, // 1: vvtxchain.trade.StoredTrade.amount:type_name -> cosmos.base.v1beta1.Coin
 	3, // 2: vvtxchain.trade.StoredTrade.status:type_name -> vvtxchain.trade.TradeStatus
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: vvtxchain.trade.StoredTrade.typed_trade_data:type_name -> vvtxchain.trade.TradeData
+	5, // 4: vvtxchain.trade.StoredTrade.coin_minting_prices:type_name -> vvtxchain.trade.CoinMintingPriceJson
+	6, // 5: vvtxchain.trade.StoredTrade.exchange_rates:type_name -> vvtxchain.trade.ExchangeRateJson
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_vvtxchain_trade_stored_trade_proto_init() }
@@ -1708,6 +2675,7 @@ func file_vvtxchain_trade_stored_trade_proto_init() {
 		return
 	}
 	file_vvtxchain_trade_trade_proto_init()
+	file_vvtxchain_trade_trade_data_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_vvtxchain_trade_stored_trade_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StoredTrade); i {
@@ -2,14 +2,14 @@
 package trade
 
 import (
-	binary "encoding/binary"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
-	math "math"
 	reflect "reflect"
 	sync "sync"
 )
@@ -112,20 +112,20 @@ func (x *fastReflection_ExchangeRateJson) Range(f func(protoreflect.FieldDescrip
 			return
 		}
 	}
-	if x.OriginalAmount != float64(0) || math.Signbit(x.OriginalAmount) {
-		value := protoreflect.ValueOfFloat64(x.OriginalAmount)
+	if x.OriginalAmount != "" {
+		value := protoreflect.ValueOfString(x.OriginalAmount)
 		if !f(fd_ExchangeRateJson_original_amount, value) {
 			return
 		}
 	}
-	if x.ConvertedAmount != float64(0) || math.Signbit(x.ConvertedAmount) {
-		value := protoreflect.ValueOfFloat64(x.ConvertedAmount)
+	if x.ConvertedAmount != "" {
+		value := protoreflect.ValueOfString(x.ConvertedAmount)
 		if !f(fd_ExchangeRateJson_converted_amount, value) {
 			return
 		}
 	}
-	if x.CurrencyRate != float64(0) || math.Signbit(x.CurrencyRate) {
-		value := protoreflect.ValueOfFloat64(x.CurrencyRate)
+	if x.CurrencyRate != "" {
+		value := protoreflect.ValueOfString(x.CurrencyRate)
 		if !f(fd_ExchangeRateJson_currency_rate, value) {
 			return
 		}
@@ -156,11 +156,11 @@ func (x *fastReflection_ExchangeRateJson) Has(fd protoreflect.FieldDescriptor) b
 	case "vvtxchain.trade.ExchangeRateJson.to_currency":
 		return x.ToCurrency != ""
 	case "vvtxchain.trade.ExchangeRateJson.original_amount":
-		return x.OriginalAmount != float64(0) || math.Signbit(x.OriginalAmount)
+		return x.OriginalAmount != ""
 	case "vvtxchain.trade.ExchangeRateJson.converted_amount":
-		return x.ConvertedAmount != float64(0) || math.Signbit(x.ConvertedAmount)
+		return x.ConvertedAmount != ""
 	case "vvtxchain.trade.ExchangeRateJson.currency_rate":
-		return x.CurrencyRate != float64(0) || math.Signbit(x.CurrencyRate)
+		return x.CurrencyRate != ""
 	case "vvtxchain.trade.ExchangeRateJson.timestamp":
 		return x.Timestamp != ""
 	default:
@@ -184,11 +184,11 @@ func (x *fastReflection_ExchangeRateJson) Clear(fd protoreflect.FieldDescriptor)
 	case "vvtxchain.trade.ExchangeRateJson.to_currency":
 		x.ToCurrency = ""
 	case "vvtxchain.trade.ExchangeRateJson.original_amount":
-		x.OriginalAmount = float64(0)
+		x.OriginalAmount = ""
 	case "vvtxchain.trade.ExchangeRateJson.converted_amount":
-		x.ConvertedAmount = float64(0)
+		x.ConvertedAmount = ""
 	case "vvtxchain.trade.ExchangeRateJson.currency_rate":
-		x.CurrencyRate = float64(0)
+		x.CurrencyRate = ""
 	case "vvtxchain.trade.ExchangeRateJson.timestamp":
 		x.Timestamp = ""
 	default:
@@ -215,13 +215,13 @@ func (x *fastReflection_ExchangeRateJson) Get(descriptor protoreflect.FieldDescr
 		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.ExchangeRateJson.original_amount":
 		value := x.OriginalAmount
-		return protoreflect.ValueOfFloat64(value)
+		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.ExchangeRateJson.converted_amount":
 		value := x.ConvertedAmount
-		return protoreflect.ValueOfFloat64(value)
+		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.ExchangeRateJson.currency_rate":
 		value := x.CurrencyRate
-		return protoreflect.ValueOfFloat64(value)
+		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.ExchangeRateJson.timestamp":
 		value := x.Timestamp
 		return protoreflect.ValueOfString(value)
@@ -250,11 +250,11 @@ func (x *fastReflection_ExchangeRateJson) Set(fd protoreflect.FieldDescriptor, v
 	case "vvtxchain.trade.ExchangeRateJson.to_currency":
 		x.ToCurrency = value.Interface().(string)
 	case "vvtxchain.trade.ExchangeRateJson.original_amount":
-		x.OriginalAmount = value.Float()
+		x.OriginalAmount = value.Interface().(string)
 	case "vvtxchain.trade.ExchangeRateJson.converted_amount":
-		x.ConvertedAmount = value.Float()
+		x.ConvertedAmount = value.Interface().(string)
 	case "vvtxchain.trade.ExchangeRateJson.currency_rate":
-		x.CurrencyRate = value.Float()
+		x.CurrencyRate = value.Interface().(string)
 	case "vvtxchain.trade.ExchangeRateJson.timestamp":
 		x.Timestamp = value.Interface().(string)
 	default:
@@ -307,11 +307,11 @@ func (x *fastReflection_ExchangeRateJson) NewField(fd protoreflect.FieldDescript
 	case "vvtxchain.trade.ExchangeRateJson.to_currency":
 		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.ExchangeRateJson.original_amount":
-		return protoreflect.ValueOfFloat64(float64(0))
+		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.ExchangeRateJson.converted_amount":
-		return protoreflect.ValueOfFloat64(float64(0))
+		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.ExchangeRateJson.currency_rate":
-		return protoreflect.ValueOfFloat64(float64(0))
+		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.ExchangeRateJson.timestamp":
 		return protoreflect.ValueOfString("")
 	default:
@@ -391,14 +391,17 @@ func (x *fastReflection_ExchangeRateJson) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.OriginalAmount != 0 || math.Signbit(x.OriginalAmount) {
-			n += 9
+		l = len(x.OriginalAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ConvertedAmount != 0 || math.Signbit(x.ConvertedAmount) {
-			n += 9
+		l = len(x.ConvertedAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.CurrencyRate != 0 || math.Signbit(x.CurrencyRate) {
-			n += 9
+		l = len(x.CurrencyRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		l = len(x.Timestamp)
 		if l > 0 {
@@ -440,23 +443,26 @@ func (x *fastReflection_ExchangeRateJson) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x32
 		}
-		if x.CurrencyRate != 0 || math.Signbit(x.CurrencyRate) {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.CurrencyRate))))
+		if len(x.CurrencyRate) > 0 {
+			i -= len(x.CurrencyRate)
+			copy(dAtA[i:], x.CurrencyRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CurrencyRate)))
 			i--
-			dAtA[i] = 0x29
+			dAtA[i] = 0x2a
 		}
-		if x.ConvertedAmount != 0 || math.Signbit(x.ConvertedAmount) {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.ConvertedAmount))))
+		if len(x.ConvertedAmount) > 0 {
+			i -= len(x.ConvertedAmount)
+			copy(dAtA[i:], x.ConvertedAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ConvertedAmount)))
 			i--
-			dAtA[i] = 0x21
+			dAtA[i] = 0x22
 		}
-		if x.OriginalAmount != 0 || math.Signbit(x.OriginalAmount) {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.OriginalAmount))))
+		if len(x.OriginalAmount) > 0 {
+			i -= len(x.OriginalAmount)
+			copy(dAtA[i:], x.OriginalAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OriginalAmount)))
 			i--
-			dAtA[i] = 0x19
+			dAtA[i] = 0x1a
 		}
 		if len(x.ToCurrency) > 0 {
 			i -= len(x.ToCurrency)
@@ -586,38 +592,101 @@ func (x *fastReflection_ExchangeRateJson) ProtoMethods() *protoiface.Methods {
 				x.ToCurrency = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
-				if wireType != 1 {
+				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OriginalAmount", wireType)
 				}
-				var v uint64
-				if (iNdEx + 8) > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.OriginalAmount = float64(math.Float64frombits(v))
+				x.OriginalAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 4:
-				if wireType != 1 {
+				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConvertedAmount", wireType)
 				}
-				var v uint64
-				if (iNdEx + 8) > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.ConvertedAmount = float64(math.Float64frombits(v))
+				x.ConvertedAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 5:
-				if wireType != 1 {
+				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyRate", wireType)
 				}
-				var v uint64
-				if (iNdEx + 8) > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.CurrencyRate = float64(math.Float64frombits(v))
+				x.CurrencyRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 6:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
@@ -769,8 +838,8 @@ func (x *fastReflection_CoinMintingPriceJson) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
-	if x.MintingPrice != float64(0) || math.Signbit(x.MintingPrice) {
-		value := protoreflect.ValueOfFloat64(x.MintingPrice)
+	if x.MintingPrice != "" {
+		value := protoreflect.ValueOfString(x.MintingPrice)
 		if !f(fd_CoinMintingPriceJson_minting_price, value) {
 			return
 		}
@@ -793,7 +862,7 @@ func (x *fastReflection_CoinMintingPriceJson) Has(fd protoreflect.FieldDescripto
 	case "vvtxchain.trade.CoinMintingPriceJson.currency_code":
 		return x.CurrencyCode != ""
 	case "vvtxchain.trade.CoinMintingPriceJson.minting_price":
-		return x.MintingPrice != float64(0) || math.Signbit(x.MintingPrice)
+		return x.MintingPrice != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.CoinMintingPriceJson"))
@@ -813,7 +882,7 @@ func (x *fastReflection_CoinMintingPriceJson) Clear(fd protoreflect.FieldDescrip
 	case "vvtxchain.trade.CoinMintingPriceJson.currency_code":
 		x.CurrencyCode = ""
 	case "vvtxchain.trade.CoinMintingPriceJson.minting_price":
-		x.MintingPrice = float64(0)
+		x.MintingPrice = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.CoinMintingPriceJson"))
@@ -835,7 +904,7 @@ func (x *fastReflection_CoinMintingPriceJson) Get(descriptor protoreflect.FieldD
 		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.CoinMintingPriceJson.minting_price":
 		value := x.MintingPrice
-		return protoreflect.ValueOfFloat64(value)
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.CoinMintingPriceJson"))
@@ -859,7 +928,7 @@ func (x *fastReflection_CoinMintingPriceJson) Set(fd protoreflect.FieldDescripto
 	case "vvtxchain.trade.CoinMintingPriceJson.currency_code":
 		x.CurrencyCode = value.Interface().(string)
 	case "vvtxchain.trade.CoinMintingPriceJson.minting_price":
-		x.MintingPrice = value.Float()
+		x.MintingPrice = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.CoinMintingPriceJson"))
@@ -900,7 +969,7 @@ func (x *fastReflection_CoinMintingPriceJson) NewField(fd protoreflect.FieldDesc
 	case "vvtxchain.trade.CoinMintingPriceJson.currency_code":
 		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.CoinMintingPriceJson.minting_price":
-		return protoreflect.ValueOfFloat64(float64(0))
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.CoinMintingPriceJson"))
@@ -974,8 +1043,9 @@ func (x *fastReflection_CoinMintingPriceJson) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.MintingPrice != 0 || math.Signbit(x.MintingPrice) {
-			n += 9
+		l = len(x.MintingPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1006,11 +1076,12 @@ func (x *fastReflection_CoinMintingPriceJson) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MintingPrice != 0 || math.Signbit(x.MintingPrice) {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.MintingPrice))))
+		if len(x.MintingPrice) > 0 {
+			i -= len(x.MintingPrice)
+			copy(dAtA[i:], x.MintingPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintingPrice)))
 			i--
-			dAtA[i] = 0x11
+			dAtA[i] = 0x12
 		}
 		if len(x.CurrencyCode) > 0 {
 			i -= len(x.CurrencyCode)
@@ -1101,16 +1172,37 @@ func (x *fastReflection_CoinMintingPriceJson) ProtoMethods() *protoiface.Methods
 				x.CurrencyCode = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 1 {
+				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintingPrice", wireType)
 				}
-				var v uint64
-				if (iNdEx + 8) > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.MintingPrice = float64(math.Float64frombits(v))
+				x.MintingPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1280,14 +1372,17 @@ func (ProcessType) EnumDescriptor() ([]byte, []int) {
 type TradeType int32
 
 const (
-	TradeType_TRADE_TYPE_UNSPECIFIED   TradeType = 0
-	TradeType_TRADE_TYPE_BUY           TradeType = 1
-	TradeType_TRADE_TYPE_SELL          TradeType = 2
-	TradeType_TRADE_TYPE_SPLIT         TradeType = 3
+	TradeType_TRADE_TYPE_UNSPECIFIED     TradeType = 0
+	TradeType_TRADE_TYPE_FIAT_DEPOSIT    TradeType = 1
+	TradeType_TRADE_TYPE_FIAT_WITHDRAWAL TradeType = 2
+	// TRADE_TYPE_SPLIT records a share split, no coins are minted or burned
+	TradeType_TRADE_TYPE_SPLIT TradeType = 3
+	// TRADE_TYPE_REVERSE_SPLIT records a reverse share split, no coins are minted or burned
 	TradeType_TRADE_TYPE_REVERSE_SPLIT TradeType = 4
 	// TRADE_TYPE_REINVESTMENT buy new shares using dividends
 	TradeType_TRADE_TYPE_REINVESTMENT TradeType = 5
-	TradeType_TRADE_TYPE_DIVIDENDS    TradeType = 6
+	// TRADE_TYPE_DIVIDENDS records dividends paid to the fund
+	TradeType_TRADE_TYPE_DIVIDENDS TradeType = 6
 	// TRADE_TYPE_DIVIDEND_DEDUCTION deduct dividends when reinvested
 	TradeType_TRADE_TYPE_DIVIDEND_DEDUCTION TradeType = 7
 )
@@ -1296,8 +1391,8 @@ const (
 var (
 	TradeType_name = map[int32]string{
 		0: "TRADE_TYPE_UNSPECIFIED",
-		1: "TRADE_TYPE_BUY",
-		2: "TRADE_TYPE_SELL",
+		1: "TRADE_TYPE_FIAT_DEPOSIT",
+		2: "TRADE_TYPE_FIAT_WITHDRAWAL",
 		3: "TRADE_TYPE_SPLIT",
 		4: "TRADE_TYPE_REVERSE_SPLIT",
 		5: "TRADE_TYPE_REINVESTMENT",
@@ -1306,8 +1401,8 @@ var (
 	}
 	TradeType_value = map[string]int32{
 		"TRADE_TYPE_UNSPECIFIED":        0,
-		"TRADE_TYPE_BUY":                1,
-		"TRADE_TYPE_SELL":               2,
+		"TRADE_TYPE_FIAT_DEPOSIT":       1,
+		"TRADE_TYPE_FIAT_WITHDRAWAL":    2,
 		"TRADE_TYPE_SPLIT":              3,
 		"TRADE_TYPE_REVERSE_SPLIT":      4,
 		"TRADE_TYPE_REINVESTMENT":       5,
@@ -1343,17 +1438,127 @@ func (TradeType) EnumDescriptor() ([]byte, []int) {
 	return file_vvtxchain_trade_trade_proto_rawDescGZIP(), []int{2}
 }
 
+// TradeDateField selects the date of a trade used by date range queries.
+type TradeDateField int32
+
+const (
+	// TRADE_DATE_FIELD_UNSPECIFIED defines an unspecified date field.
+	TradeDateField_TRADE_DATE_FIELD_UNSPECIFIED TradeDateField = 0
+	// TRADE_DATE_FIELD_CREATE_DATE selects the create date of a trade.
+	TradeDateField_TRADE_DATE_FIELD_CREATE_DATE TradeDateField = 1
+	// TRADE_DATE_FIELD_TX_DATE selects the transaction date of a trade.
+	TradeDateField_TRADE_DATE_FIELD_TX_DATE TradeDateField = 2
+	// TRADE_DATE_FIELD_PROCESS_DATE selects the process date of a trade.
+	TradeDateField_TRADE_DATE_FIELD_PROCESS_DATE TradeDateField = 3
+)
+
+// Enum value maps for TradeDateField.
+var (
+	TradeDateField_name = map[int32]string{
+		0: "TRADE_DATE_FIELD_UNSPECIFIED",
+		1: "TRADE_DATE_FIELD_CREATE_DATE",
+		2: "TRADE_DATE_FIELD_TX_DATE",
+		3: "TRADE_DATE_FIELD_PROCESS_DATE",
+	}
+	TradeDateField_value = map[string]int32{
+		"TRADE_DATE_FIELD_UNSPECIFIED":  0,
+		"TRADE_DATE_FIELD_CREATE_DATE":  1,
+		"TRADE_DATE_FIELD_TX_DATE":      2,
+		"TRADE_DATE_FIELD_PROCESS_DATE": 3,
+	}
+)
+
+func (x TradeDateField) Enum() *TradeDateField {
+	p := new(TradeDateField)
+	*p = x
+	return p
+}
+
+func (x TradeDateField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TradeDateField) Descriptor() protoreflect.EnumDescriptor {
+	return file_vvtxchain_trade_trade_proto_enumTypes[3].Descriptor()
+}
+
+func (TradeDateField) Type() protoreflect.EnumType {
+	return &file_vvtxchain_trade_trade_proto_enumTypes[3]
+}
+
+func (x TradeDateField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TradeDateField.Descriptor instead.
+func (TradeDateField) EnumDescriptor() ([]byte, []int) {
+	return file_vvtxchain_trade_trade_proto_rawDescGZIP(), []int{3}
+}
+
+// BatchMode selects how the entries of a batch message are handled when one of them fails.
+type BatchMode int32
+
+const (
+	// BATCH_MODE_UNSPECIFIED defines an unspecified batch mode.
+	BatchMode_BATCH_MODE_UNSPECIFIED BatchMode = 0
+	// BATCH_MODE_ALL_OR_NOTHING fails the whole message when an entry fails.
+	BatchMode_BATCH_MODE_ALL_OR_NOTHING BatchMode = 1
+	// BATCH_MODE_BEST_EFFORT skips the failed entries and reports their error in the results.
+	BatchMode_BATCH_MODE_BEST_EFFORT BatchMode = 2
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "BATCH_MODE_UNSPECIFIED",
+		1: "BATCH_MODE_ALL_OR_NOTHING",
+		2: "BATCH_MODE_BEST_EFFORT",
+	}
+	BatchMode_value = map[string]int32{
+		"BATCH_MODE_UNSPECIFIED":    0,
+		"BATCH_MODE_ALL_OR_NOTHING": 1,
+		"BATCH_MODE_BEST_EFFORT":    2,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
+}
+
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_vvtxchain_trade_trade_proto_enumTypes[4].Descriptor()
+}
+
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_vvtxchain_trade_trade_proto_enumTypes[4]
+}
+
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_vvtxchain_trade_trade_proto_rawDescGZIP(), []int{4}
+}
+
 type ExchangeRateJson struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FromCurrency    string  `protobuf:"bytes,1,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
-	ToCurrency      string  `protobuf:"bytes,2,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
-	OriginalAmount  float64 `protobuf:"fixed64,3,opt,name=original_amount,json=originalAmount,proto3" json:"original_amount,omitempty"`
-	ConvertedAmount float64 `protobuf:"fixed64,4,opt,name=converted_amount,json=convertedAmount,proto3" json:"converted_amount,omitempty"`
-	CurrencyRate    float64 `protobuf:"fixed64,5,opt,name=currency_rate,json=currencyRate,proto3" json:"currency_rate,omitempty"`
-	Timestamp       string  `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FromCurrency    string `protobuf:"bytes,1,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
+	ToCurrency      string `protobuf:"bytes,2,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+	OriginalAmount  string `protobuf:"bytes,3,opt,name=original_amount,json=originalAmount,proto3" json:"original_amount,omitempty"`
+	ConvertedAmount string `protobuf:"bytes,4,opt,name=converted_amount,json=convertedAmount,proto3" json:"converted_amount,omitempty"`
+	CurrencyRate    string `protobuf:"bytes,5,opt,name=currency_rate,json=currencyRate,proto3" json:"currency_rate,omitempty"`
+	Timestamp       string `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
 func (x *ExchangeRateJson) Reset() {
@@ -1390,25 +1595,25 @@ func (x *ExchangeRateJson) GetToCurrency() string {
 	return ""
 }
 
-func (x *ExchangeRateJson) GetOriginalAmount() float64 {
+func (x *ExchangeRateJson) GetOriginalAmount() string {
 	if x != nil {
 		return x.OriginalAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *ExchangeRateJson) GetConvertedAmount() float64 {
+func (x *ExchangeRateJson) GetConvertedAmount() string {
 	if x != nil {
 		return x.ConvertedAmount
 	}
-	return 0
+	return ""
 }
 
-func (x *ExchangeRateJson) GetCurrencyRate() float64 {
+func (x *ExchangeRateJson) GetCurrencyRate() string {
 	if x != nil {
 		return x.CurrencyRate
 	}
-	return 0
+	return ""
 }
 
 func (x *ExchangeRateJson) GetTimestamp() string {
@@ -1423,8 +1628,8 @@ type CoinMintingPriceJson struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CurrencyCode string  `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
-	MintingPrice float64 `protobuf:"fixed64,2,opt,name=minting_price,json=mintingPrice,proto3" json:"minting_price,omitempty"`
+	CurrencyCode string `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	MintingPrice string `protobuf:"bytes,2,opt,name=minting_price,json=mintingPrice,proto3" json:"minting_price,omitempty"`
 }
 
 func (x *CoinMintingPriceJson) Reset() {
@@ -1454,84 +1659,116 @@ func (x *CoinMintingPriceJson) GetCurrencyCode() string {
 	return ""
 }
 
-func (x *CoinMintingPriceJson) GetMintingPrice() float64 {
+func (x *CoinMintingPriceJson) GetMintingPrice() string {
 	if x != nil {
 		return x.MintingPrice
 	}
-	return 0
+	return ""
 }
 
 var File_vvtxchain_trade_trade_proto protoreflect.FileDescriptor
 
 var file_vvtxchain_trade_trade_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64,
-	0x65, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x67,
-	0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x22, 0xef,
-	0x01, 0x0a, 0x10, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4a,
-	0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
-	0x6f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x63, 0x6f,
-	0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a,
-	0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x61,
-	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x22, 0x60, 0x0a, 0x14, 0x43, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x2a, 0xb0, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x18, 0x0a, 0x14, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x52,
-	0x41, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45,
-	0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10,
-	0x03, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13,
-	0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49,
-	0x4c, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x5e, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
-	0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4a,
-	0x45, 0x43, 0x54, 0x10, 0x02, 0x2a, 0xde, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x12, 0x0a, 0x0e, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x55,
-	0x59, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x53, 0x45, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x52, 0x41, 0x44,
-	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x03, 0x12, 0x1c,
-	0x0a, 0x18, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x56,
-	0x45, 0x52, 0x53, 0x45, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17,
-	0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x49, 0x4e, 0x56,
-	0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x52, 0x41,
-	0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x44, 0x45, 0x4e, 0x44,
-	0x53, 0x10, 0x06, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x44, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x44, 0x55, 0x43,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x42, 0xb1, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x67,
-	0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x42, 0x0a,
-	0x54, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x47, 0x45, 0x5a, 0x4c, 0x61, 0x62,
-	0x73, 0x2f, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0xa2,
-	0x02, 0x03, 0x47, 0x54, 0x58, 0xaa, 0x02, 0x0f, 0x47, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0xca, 0x02, 0x0f, 0x47, 0x67, 0x65, 0x7a, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0xe2, 0x02, 0x1b, 0x47, 0x67, 0x65, 0x7a,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x47, 0x67, 0x65, 0x7a, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x0a, 0x1b, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x76,
+	0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x19,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x88, 0x03, 0x0a, 0x10, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f,
+	0x6d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x74, 0x6f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x5a, 0x0a, 0x0f, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x5c, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x93, 0x01, 0x0a, 0x14, 0x43,
+	0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4a,
+	0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x56, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x2a, 0xb0, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1c, 0x0a, 0x18, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x52, 0x41, 0x44,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x19, 0x0a, 0x15, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x52,
+	0x41, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0x05, 0x2a, 0x5e, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x18, 0x0a, 0x14, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52,
+	0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43,
+	0x54, 0x10, 0x02, 0x2a, 0xf2, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a,
+	0x17, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x41, 0x54,
+	0x5f, 0x44, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x52,
+	0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x49, 0x41, 0x54, 0x5f, 0x57, 0x49,
+	0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x52,
+	0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x03,
+	0x12, 0x1c, 0x0a, 0x18, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52,
+	0x45, 0x56, 0x45, 0x52, 0x53, 0x45, 0x5f, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x04, 0x12, 0x1b,
+	0x0a, 0x17, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x49,
+	0x4e, 0x56, 0x45, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x54,
+	0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x44, 0x45,
+	0x4e, 0x44, 0x53, 0x10, 0x06, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x56, 0x49, 0x44, 0x45, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x44,
+	0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x2a, 0x95, 0x01, 0x0a, 0x0e, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x44, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x1c, 0x54,
+	0x52, 0x41, 0x44, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a,
+	0x1c, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x45, 0x4c,
+	0x44, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12,
+	0x1c, 0x0a, 0x18, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49,
+	0x45, 0x4c, 0x44, 0x5f, 0x54, 0x58, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x21, 0x0a,
+	0x1d, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x45, 0x4c,
+	0x44, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x10, 0x03,
+	0x2a, 0x62, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a,
+	0x16, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x4f, 0x52, 0x5f, 0x4e,
+	0x4f, 0x54, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x41, 0x54, 0x43,
+	0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x46, 0x46, 0x4f,
+	0x52, 0x54, 0x10, 0x02, 0x42, 0xb1, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x76, 0x74,
+	0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x42, 0x0a, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x47, 0x45, 0x5a, 0x4c, 0x61, 0x62, 0x73, 0x2f,
+	0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x76,
+	0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0xa2, 0x02, 0x03,
+	0x56, 0x54, 0x58, 0xaa, 0x02, 0x0f, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x54, 0x72, 0x61, 0x64, 0x65, 0xca, 0x02, 0x0f, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0xe2, 0x02, 0x1b, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1546,14 +1783,16 @@ func file_vvtxchain_trade_trade_proto_rawDescGZIP() []byte {
 	return file_vvtxchain_trade_trade_proto_rawDescData
 }
 
-var file_vvtxchain_trade_trade_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_vvtxchain_trade_trade_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
 var file_vvtxchain_trade_trade_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_vvtxchain_trade_trade_proto_goTypes = []interface{}{
 	(TradeStatus)(0),             // 0: vvtxchain.trade.TradeStatus
 	(ProcessType)(0),             // 1: vvtxchain.trade.ProcessType
 	(TradeType)(0),               // 2: vvtxchain.trade.TradeType
-	(*ExchangeRateJson)(nil),     // 3: vvtxchain.trade.ExchangeRateJson
-	(*CoinMintingPriceJson)(nil), // 4: vvtxchain.trade.CoinMintingPriceJson
+	(TradeDateField)(0),          // 3: vvtxchain.trade.TradeDateField
+	(BatchMode)(0),               // 4: vvtxchain.trade.BatchMode
+	(*ExchangeRateJson)(nil),     // 5: vvtxchain.trade.ExchangeRateJson
+	(*CoinMintingPriceJson)(nil), // 6: vvtxchain.trade.CoinMintingPriceJson
 }
 var file_vvtxchain_trade_trade_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -1599,7 +1838,7 @@ func file_vvtxchain_trade_trade_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_vvtxchain_trade_trade_proto_rawDesc,
-			NumEnums:      3,
+			NumEnums:      5,
 			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -3,14 +3,14 @@ package trade
 
 import (
 	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
-	binary "encoding/binary"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	io "io"
-	math "math"
 	reflect "reflect"
 	sync "sync"
 )
@@ -530,26 +530,26 @@ func (x *fastReflection_TradeData) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TradeInfo                        protoreflect.MessageDescriptor
-	fd_TradeInfo_asset_holder_id        protoreflect.FieldDescriptor
-	fd_TradeInfo_asset_id               protoreflect.FieldDescriptor
-	fd_TradeInfo_trade_type             protoreflect.FieldDescriptor
-	fd_TradeInfo_trade_value            protoreflect.FieldDescriptor
-	fd_TradeInfo_base_currency          protoreflect.FieldDescriptor
-	fd_TradeInfo_settlement_currency    protoreflect.FieldDescriptor
-	fd_TradeInfo_exchange_rate          protoreflect.FieldDescriptor
-	fd_TradeInfo_exchange               protoreflect.FieldDescriptor
-	fd_TradeInfo_fund_name              protoreflect.FieldDescriptor
-	fd_TradeInfo_issuer                 protoreflect.FieldDescriptor
-	fd_TradeInfo_number_of_shares       protoreflect.FieldDescriptor
-	fd_TradeInfo_coin_minting_price protoreflect.FieldDescriptor
-	fd_TradeInfo_quantity               protoreflect.FieldDescriptor
-	fd_TradeInfo_segment                protoreflect.FieldDescriptor
-	fd_TradeInfo_share_price            protoreflect.FieldDescriptor
-	fd_TradeInfo_ticker                 protoreflect.FieldDescriptor
-	fd_TradeInfo_trade_fee              protoreflect.FieldDescriptor
-	fd_TradeInfo_share_net_price        protoreflect.FieldDescriptor
-	fd_TradeInfo_trade_net_value        protoreflect.FieldDescriptor
+	md_TradeInfo                     protoreflect.MessageDescriptor
+	fd_TradeInfo_asset_holder_id     protoreflect.FieldDescriptor
+	fd_TradeInfo_asset_id            protoreflect.FieldDescriptor
+	fd_TradeInfo_trade_type          protoreflect.FieldDescriptor
+	fd_TradeInfo_trade_value         protoreflect.FieldDescriptor
+	fd_TradeInfo_base_currency       protoreflect.FieldDescriptor
+	fd_TradeInfo_settlement_currency protoreflect.FieldDescriptor
+	fd_TradeInfo_exchange_rate       protoreflect.FieldDescriptor
+	fd_TradeInfo_exchange            protoreflect.FieldDescriptor
+	fd_TradeInfo_fund_name           protoreflect.FieldDescriptor
+	fd_TradeInfo_issuer              protoreflect.FieldDescriptor
+	fd_TradeInfo_number_of_shares    protoreflect.FieldDescriptor
+	fd_TradeInfo_coin_minting_price  protoreflect.FieldDescriptor
+	fd_TradeInfo_quantity            protoreflect.FieldDescriptor
+	fd_TradeInfo_segment             protoreflect.FieldDescriptor
+	fd_TradeInfo_share_price         protoreflect.FieldDescriptor
+	fd_TradeInfo_ticker              protoreflect.FieldDescriptor
+	fd_TradeInfo_trade_fee           protoreflect.FieldDescriptor
+	fd_TradeInfo_share_net_price     protoreflect.FieldDescriptor
+	fd_TradeInfo_trade_net_value     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -659,8 +659,8 @@ func (x *fastReflection_TradeInfo) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
-	if x.TradeValue != float64(0) || math.Signbit(x.TradeValue) {
-		value := protoreflect.ValueOfFloat64(x.TradeValue)
+	if x.TradeValue != "" {
+		value := protoreflect.ValueOfString(x.TradeValue)
 		if !f(fd_TradeInfo_trade_value, value) {
 			return
 		}
@@ -677,8 +677,8 @@ func (x *fastReflection_TradeInfo) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
-	if x.ExchangeRate != float64(0) || math.Signbit(x.ExchangeRate) {
-		value := protoreflect.ValueOfFloat64(x.ExchangeRate)
+	if x.ExchangeRate != "" {
+		value := protoreflect.ValueOfString(x.ExchangeRate)
 		if !f(fd_TradeInfo_exchange_rate, value) {
 			return
 		}
@@ -701,14 +701,14 @@ func (x *fastReflection_TradeInfo) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
-	if x.NumberOfShares != float64(0) || math.Signbit(x.NumberOfShares) {
-		value := protoreflect.ValueOfFloat64(x.NumberOfShares)
+	if x.NumberOfShares != "" {
+		value := protoreflect.ValueOfString(x.NumberOfShares)
 		if !f(fd_TradeInfo_number_of_shares, value) {
 			return
 		}
 	}
-	if x.CoinMintingPrice != float64(0) || math.Signbit(x.CoinMintingPrice) {
-		value := protoreflect.ValueOfFloat64(x.CoinMintingPrice)
+	if x.CoinMintingPrice != "" {
+		value := protoreflect.ValueOfString(x.CoinMintingPrice)
 		if !f(fd_TradeInfo_coin_minting_price, value) {
 			return
 		}
@@ -725,8 +725,8 @@ func (x *fastReflection_TradeInfo) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
-	if x.SharePrice != float64(0) || math.Signbit(x.SharePrice) {
-		value := protoreflect.ValueOfFloat64(x.SharePrice)
+	if x.SharePrice != "" {
+		value := protoreflect.ValueOfString(x.SharePrice)
 		if !f(fd_TradeInfo_share_price, value) {
 			return
 		}
@@ -737,20 +737,20 @@ func (x *fastReflection_TradeInfo) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
-	if x.TradeFee != float64(0) || math.Signbit(x.TradeFee) {
-		value := protoreflect.ValueOfFloat64(x.TradeFee)
+	if x.TradeFee != "" {
+		value := protoreflect.ValueOfString(x.TradeFee)
 		if !f(fd_TradeInfo_trade_fee, value) {
 			return
 		}
 	}
-	if x.ShareNetPrice != float64(0) || math.Signbit(x.ShareNetPrice) {
-		value := protoreflect.ValueOfFloat64(x.ShareNetPrice)
+	if x.ShareNetPrice != "" {
+		value := protoreflect.ValueOfString(x.ShareNetPrice)
 		if !f(fd_TradeInfo_share_net_price, value) {
 			return
 		}
 	}
-	if x.TradeNetValue != float64(0) || math.Signbit(x.TradeNetValue) {
-		value := protoreflect.ValueOfFloat64(x.TradeNetValue)
+	if x.TradeNetValue != "" {
+		value := protoreflect.ValueOfString(x.TradeNetValue)
 		if !f(fd_TradeInfo_trade_net_value, value) {
 			return
 		}
@@ -777,13 +777,13 @@ func (x *fastReflection_TradeInfo) Has(fd protoreflect.FieldDescriptor) bool {
 	case "vvtxchain.trade.TradeInfo.trade_type":
 		return x.TradeType != 0
 	case "vvtxchain.trade.TradeInfo.trade_value":
-		return x.TradeValue != float64(0) || math.Signbit(x.TradeValue)
+		return x.TradeValue != ""
 	case "vvtxchain.trade.TradeInfo.base_currency":
 		return x.BaseCurrency != ""
 	case "vvtxchain.trade.TradeInfo.settlement_currency":
 		return x.SettlementCurrency != ""
 	case "vvtxchain.trade.TradeInfo.exchange_rate":
-		return x.ExchangeRate != float64(0) || math.Signbit(x.ExchangeRate)
+		return x.ExchangeRate != ""
 	case "vvtxchain.trade.TradeInfo.exchange":
 		return x.Exchange != ""
 	case "vvtxchain.trade.TradeInfo.fund_name":
@@ -791,23 +791,23 @@ func (x *fastReflection_TradeInfo) Has(fd protoreflect.FieldDescriptor) bool {
 	case "vvtxchain.trade.TradeInfo.issuer":
 		return x.Issuer != ""
 	case "vvtxchain.trade.TradeInfo.number_of_shares":
-		return x.NumberOfShares != float64(0) || math.Signbit(x.NumberOfShares)
+		return x.NumberOfShares != ""
 	case "vvtxchain.trade.TradeInfo.coin_minting_price":
-		return x.CoinMintingPrice != float64(0) || math.Signbit(x.CoinMintingPrice)
+		return x.CoinMintingPrice != ""
 	case "vvtxchain.trade.TradeInfo.quantity":
 		return x.Quantity != nil
 	case "vvtxchain.trade.TradeInfo.segment":
 		return x.Segment != ""
 	case "vvtxchain.trade.TradeInfo.share_price":
-		return x.SharePrice != float64(0) || math.Signbit(x.SharePrice)
+		return x.SharePrice != ""
 	case "vvtxchain.trade.TradeInfo.ticker":
 		return x.Ticker != ""
 	case "vvtxchain.trade.TradeInfo.trade_fee":
-		return x.TradeFee != float64(0) || math.Signbit(x.TradeFee)
+		return x.TradeFee != ""
 	case "vvtxchain.trade.TradeInfo.share_net_price":
-		return x.ShareNetPrice != float64(0) || math.Signbit(x.ShareNetPrice)
+		return x.ShareNetPrice != ""
 	case "vvtxchain.trade.TradeInfo.trade_net_value":
-		return x.TradeNetValue != float64(0) || math.Signbit(x.TradeNetValue)
+		return x.TradeNetValue != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.TradeInfo"))
@@ -831,13 +831,13 @@ func (x *fastReflection_TradeInfo) Clear(fd protoreflect.FieldDescriptor) {
 	case "vvtxchain.trade.TradeInfo.trade_type":
 		x.TradeType = 0
 	case "vvtxchain.trade.TradeInfo.trade_value":
-		x.TradeValue = float64(0)
+		x.TradeValue = ""
 	case "vvtxchain.trade.TradeInfo.base_currency":
 		x.BaseCurrency = ""
 	case "vvtxchain.trade.TradeInfo.settlement_currency":
 		x.SettlementCurrency = ""
 	case "vvtxchain.trade.TradeInfo.exchange_rate":
-		x.ExchangeRate = float64(0)
+		x.ExchangeRate = ""
 	case "vvtxchain.trade.TradeInfo.exchange":
 		x.Exchange = ""
 	case "vvtxchain.trade.TradeInfo.fund_name":
@@ -845,23 +845,23 @@ func (x *fastReflection_TradeInfo) Clear(fd protoreflect.FieldDescriptor) {
 	case "vvtxchain.trade.TradeInfo.issuer":
 		x.Issuer = ""
 	case "vvtxchain.trade.TradeInfo.number_of_shares":
-		x.NumberOfShares = float64(0)
+		x.NumberOfShares = ""
 	case "vvtxchain.trade.TradeInfo.coin_minting_price":
-		x.CoinMintingPrice = float64(0)
+		x.CoinMintingPrice = ""
 	case "vvtxchain.trade.TradeInfo.quantity":
 		x.Quantity = nil
 	case "vvtxchain.trade.TradeInfo.segment":
 		x.Segment = ""
 	case "vvtxchain.trade.TradeInfo.share_price":
-		x.SharePrice = float64(0)
+		x.SharePrice = ""
 	case "vvtxchain.trade.TradeInfo.ticker":
 		x.Ticker = ""
 	case "vvtxchain.trade.TradeInfo.trade_fee":
-		x.TradeFee = float64(0)
+		x.TradeFee = ""
 	case "vvtxchain.trade.TradeInfo.share_net_price":
-		x.ShareNetPrice = float64(0)
+		x.ShareNetPrice = ""
 	case "vvtxchain.trade.TradeInfo.trade_net_value":
-		x.TradeNetValue = float64(0)
+		x.TradeNetValue = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.TradeInfo"))
@@ -889,7 +889,7 @@ func (x *fastReflection_TradeInfo) Get(descriptor protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	case "vvtxchain.trade.TradeInfo.trade_value":
 		value := x.TradeValue
-		return protoreflect.ValueOfFloat64(value)
+		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.TradeInfo.base_currency":
 		value := x.BaseCurrency
 		return protoreflect.ValueOfString(value)
@@ -898,7 +898,7 @@ func (x *fastReflection_TradeInfo) Get(descriptor protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.TradeInfo.exchange_rate":
 		value := x.ExchangeRate
-		return protoreflect.ValueOfFloat64(value)
+		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.TradeInfo.exchange":
 		value := x.Exchange
 		return protoreflect.ValueOfString(value)
@@ -910,10 +910,10 @@ func (x *fastReflection_TradeInfo) Get(descriptor protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.TradeInfo.number_of_shares":
 		value := x.NumberOfShares
-		return protoreflect.ValueOfFloat64(value)
+		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.TradeInfo.coin_minting_price":
 		value := x.CoinMintingPrice
-		return protoreflect.ValueOfFloat64(value)
+		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.TradeInfo.quantity":
 		value := x.Quantity
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
@@ -922,19 +922,19 @@ func (x *fastReflection_TradeInfo) Get(descriptor protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.TradeInfo.share_price":
 		value := x.SharePrice
-		return protoreflect.ValueOfFloat64(value)
+		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.TradeInfo.ticker":
 		value := x.Ticker
 		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.TradeInfo.trade_fee":
 		value := x.TradeFee
-		return protoreflect.ValueOfFloat64(value)
+		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.TradeInfo.share_net_price":
 		value := x.ShareNetPrice
-		return protoreflect.ValueOfFloat64(value)
+		return protoreflect.ValueOfString(value)
 	case "vvtxchain.trade.TradeInfo.trade_net_value":
 		value := x.TradeNetValue
-		return protoreflect.ValueOfFloat64(value)
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.TradeInfo"))
@@ -962,13 +962,13 @@ func (x *fastReflection_TradeInfo) Set(fd protoreflect.FieldDescriptor, value pr
 	case "vvtxchain.trade.TradeInfo.trade_type":
 		x.TradeType = (TradeType)(value.Enum())
 	case "vvtxchain.trade.TradeInfo.trade_value":
-		x.TradeValue = value.Float()
+		x.TradeValue = value.Interface().(string)
 	case "vvtxchain.trade.TradeInfo.base_currency":
 		x.BaseCurrency = value.Interface().(string)
 	case "vvtxchain.trade.TradeInfo.settlement_currency":
 		x.SettlementCurrency = value.Interface().(string)
 	case "vvtxchain.trade.TradeInfo.exchange_rate":
-		x.ExchangeRate = value.Float()
+		x.ExchangeRate = value.Interface().(string)
 	case "vvtxchain.trade.TradeInfo.exchange":
 		x.Exchange = value.Interface().(string)
 	case "vvtxchain.trade.TradeInfo.fund_name":
@@ -976,23 +976,23 @@ func (x *fastReflection_TradeInfo) Set(fd protoreflect.FieldDescriptor, value pr
 	case "vvtxchain.trade.TradeInfo.issuer":
 		x.Issuer = value.Interface().(string)
 	case "vvtxchain.trade.TradeInfo.number_of_shares":
-		x.NumberOfShares = value.Float()
+		x.NumberOfShares = value.Interface().(string)
 	case "vvtxchain.trade.TradeInfo.coin_minting_price":
-		x.CoinMintingPrice = value.Float()
+		x.CoinMintingPrice = value.Interface().(string)
 	case "vvtxchain.trade.TradeInfo.quantity":
 		x.Quantity = value.Message().Interface().(*v1beta1.Coin)
 	case "vvtxchain.trade.TradeInfo.segment":
 		x.Segment = value.Interface().(string)
 	case "vvtxchain.trade.TradeInfo.share_price":
-		x.SharePrice = value.Float()
+		x.SharePrice = value.Interface().(string)
 	case "vvtxchain.trade.TradeInfo.ticker":
 		x.Ticker = value.Interface().(string)
 	case "vvtxchain.trade.TradeInfo.trade_fee":
-		x.TradeFee = value.Float()
+		x.TradeFee = value.Interface().(string)
 	case "vvtxchain.trade.TradeInfo.share_net_price":
-		x.ShareNetPrice = value.Float()
+		x.ShareNetPrice = value.Interface().(string)
 	case "vvtxchain.trade.TradeInfo.trade_net_value":
-		x.TradeNetValue = value.Float()
+		x.TradeNetValue = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.TradeInfo"))
@@ -1074,13 +1074,13 @@ func (x *fastReflection_TradeInfo) NewField(fd protoreflect.FieldDescriptor) pro
 	case "vvtxchain.trade.TradeInfo.trade_type":
 		return protoreflect.ValueOfEnum(0)
 	case "vvtxchain.trade.TradeInfo.trade_value":
-		return protoreflect.ValueOfFloat64(float64(0))
+		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.TradeInfo.base_currency":
 		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.TradeInfo.settlement_currency":
 		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.TradeInfo.exchange_rate":
-		return protoreflect.ValueOfFloat64(float64(0))
+		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.TradeInfo.exchange":
 		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.TradeInfo.fund_name":
@@ -1088,24 +1088,24 @@ func (x *fastReflection_TradeInfo) NewField(fd protoreflect.FieldDescriptor) pro
 	case "vvtxchain.trade.TradeInfo.issuer":
 		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.TradeInfo.number_of_shares":
-		return protoreflect.ValueOfFloat64(float64(0))
+		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.TradeInfo.coin_minting_price":
-		return protoreflect.ValueOfFloat64(float64(0))
+		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.TradeInfo.quantity":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "vvtxchain.trade.TradeInfo.segment":
 		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.TradeInfo.share_price":
-		return protoreflect.ValueOfFloat64(float64(0))
+		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.TradeInfo.ticker":
 		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.TradeInfo.trade_fee":
-		return protoreflect.ValueOfFloat64(float64(0))
+		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.TradeInfo.share_net_price":
-		return protoreflect.ValueOfFloat64(float64(0))
+		return protoreflect.ValueOfString("")
 	case "vvtxchain.trade.TradeInfo.trade_net_value":
-		return protoreflect.ValueOfFloat64(float64(0))
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.TradeInfo"))
@@ -1184,8 +1184,9 @@ func (x *fastReflection_TradeInfo) ProtoMethods() *protoiface.Methods {
 		if x.TradeType != 0 {
 			n += 1 + runtime.Sov(uint64(x.TradeType))
 		}
-		if x.TradeValue != 0 || math.Signbit(x.TradeValue) {
-			n += 9
+		l = len(x.TradeValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		l = len(x.BaseCurrency)
 		if l > 0 {
@@ -1195,8 +1196,9 @@ func (x *fastReflection_TradeInfo) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ExchangeRate != 0 || math.Signbit(x.ExchangeRate) {
-			n += 9
+		l = len(x.ExchangeRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		l = len(x.Exchange)
 		if l > 0 {
@@ -1210,11 +1212,13 @@ func (x *fastReflection_TradeInfo) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.NumberOfShares != 0 || math.Signbit(x.NumberOfShares) {
-			n += 9
+		l = len(x.NumberOfShares)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.CoinMintingPrice != 0 || math.Signbit(x.CoinMintingPrice) {
-			n += 9
+		l = len(x.CoinMintingPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Quantity != nil {
 			l = options.Size(x.Quantity)
@@ -1224,21 +1228,25 @@ func (x *fastReflection_TradeInfo) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.SharePrice != 0 || math.Signbit(x.SharePrice) {
-			n += 9
+		l = len(x.SharePrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		l = len(x.Ticker)
 		if l > 0 {
 			n += 2 + l + runtime.Sov(uint64(l))
 		}
-		if x.TradeFee != 0 || math.Signbit(x.TradeFee) {
-			n += 10
+		l = len(x.TradeFee)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
 		}
-		if x.ShareNetPrice != 0 || math.Signbit(x.ShareNetPrice) {
-			n += 10
+		l = len(x.ShareNetPrice)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
 		}
-		if x.TradeNetValue != 0 || math.Signbit(x.TradeNetValue) {
-			n += 10
+		l = len(x.TradeNetValue)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1269,29 +1277,32 @@ func (x *fastReflection_TradeInfo) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TradeNetValue != 0 || math.Signbit(x.TradeNetValue) {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.TradeNetValue))))
+		if len(x.TradeNetValue) > 0 {
+			i -= len(x.TradeNetValue)
+			copy(dAtA[i:], x.TradeNetValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TradeNetValue)))
 			i--
 			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x99
+			dAtA[i] = 0x9a
 		}
-		if x.ShareNetPrice != 0 || math.Signbit(x.ShareNetPrice) {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.ShareNetPrice))))
+		if len(x.ShareNetPrice) > 0 {
+			i -= len(x.ShareNetPrice)
+			copy(dAtA[i:], x.ShareNetPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ShareNetPrice)))
 			i--
 			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x91
+			dAtA[i] = 0x92
 		}
-		if x.TradeFee != 0 || math.Signbit(x.TradeFee) {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.TradeFee))))
+		if len(x.TradeFee) > 0 {
+			i -= len(x.TradeFee)
+			copy(dAtA[i:], x.TradeFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TradeFee)))
 			i--
 			dAtA[i] = 0x1
 			i--
-			dAtA[i] = 0x89
+			dAtA[i] = 0x8a
 		}
 		if len(x.Ticker) > 0 {
 			i -= len(x.Ticker)
@@ -1302,11 +1313,12 @@ func (x *fastReflection_TradeInfo) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x82
 		}
-		if x.SharePrice != 0 || math.Signbit(x.SharePrice) {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.SharePrice))))
+		if len(x.SharePrice) > 0 {
+			i -= len(x.SharePrice)
+			copy(dAtA[i:], x.SharePrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SharePrice)))
 			i--
-			dAtA[i] = 0x79
+			dAtA[i] = 0x7a
 		}
 		if len(x.Segment) > 0 {
 			i -= len(x.Segment)
@@ -1329,17 +1341,19 @@ func (x *fastReflection_TradeInfo) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x6a
 		}
-		if x.CoinMintingPrice != 0 || math.Signbit(x.CoinMintingPrice) {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.CoinMintingPrice))))
+		if len(x.CoinMintingPrice) > 0 {
+			i -= len(x.CoinMintingPrice)
+			copy(dAtA[i:], x.CoinMintingPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CoinMintingPrice)))
 			i--
-			dAtA[i] = 0x61
+			dAtA[i] = 0x62
 		}
-		if x.NumberOfShares != 0 || math.Signbit(x.NumberOfShares) {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.NumberOfShares))))
+		if len(x.NumberOfShares) > 0 {
+			i -= len(x.NumberOfShares)
+			copy(dAtA[i:], x.NumberOfShares)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NumberOfShares)))
 			i--
-			dAtA[i] = 0x59
+			dAtA[i] = 0x5a
 		}
 		if len(x.Issuer) > 0 {
 			i -= len(x.Issuer)
@@ -1362,11 +1376,12 @@ func (x *fastReflection_TradeInfo) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x42
 		}
-		if x.ExchangeRate != 0 || math.Signbit(x.ExchangeRate) {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.ExchangeRate))))
+		if len(x.ExchangeRate) > 0 {
+			i -= len(x.ExchangeRate)
+			copy(dAtA[i:], x.ExchangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExchangeRate)))
 			i--
-			dAtA[i] = 0x39
+			dAtA[i] = 0x3a
 		}
 		if len(x.SettlementCurrency) > 0 {
 			i -= len(x.SettlementCurrency)
@@ -1382,11 +1397,12 @@ func (x *fastReflection_TradeInfo) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x2a
 		}
-		if x.TradeValue != 0 || math.Signbit(x.TradeValue) {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(x.TradeValue))))
+		if len(x.TradeValue) > 0 {
+			i -= len(x.TradeValue)
+			copy(dAtA[i:], x.TradeValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TradeValue)))
 			i--
-			dAtA[i] = 0x21
+			dAtA[i] = 0x22
 		}
 		if x.TradeType != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TradeType))
@@ -1510,16 +1526,37 @@ func (x *fastReflection_TradeInfo) ProtoMethods() *protoiface.Methods {
 					}
 				}
 			case 4:
-				if wireType != 1 {
+				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeValue", wireType)
 				}
-				var v uint64
-				if (iNdEx + 8) > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.TradeValue = float64(math.Float64frombits(v))
+				x.TradeValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 5:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseCurrency", wireType)
@@ -1585,16 +1622,37 @@ func (x *fastReflection_TradeInfo) ProtoMethods() *protoiface.Methods {
 				x.SettlementCurrency = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 7:
-				if wireType != 1 {
+				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 				}
-				var v uint64
-				if (iNdEx + 8) > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.ExchangeRate = float64(math.Float64frombits(v))
+				x.ExchangeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 8:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exchange", wireType)
@@ -1692,27 +1750,69 @@ func (x *fastReflection_TradeInfo) ProtoMethods() *protoiface.Methods {
 				x.Issuer = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 11:
-				if wireType != 1 {
+				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumberOfShares", wireType)
 				}
-				var v uint64
-				if (iNdEx + 8) > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.NumberOfShares = float64(math.Float64frombits(v))
+				x.NumberOfShares = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 12:
-				if wireType != 1 {
+				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoinMintingPrice", wireType)
 				}
-				var v uint64
-				if (iNdEx + 8) > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.CoinMintingPrice = float64(math.Float64frombits(v))
+				x.CoinMintingPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 13:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
@@ -1782,16 +1882,37 @@ func (x *fastReflection_TradeInfo) ProtoMethods() *protoiface.Methods {
 				x.Segment = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 15:
-				if wireType != 1 {
+				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SharePrice", wireType)
 				}
-				var v uint64
-				if (iNdEx + 8) > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.SharePrice = float64(math.Float64frombits(v))
+				x.SharePrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 16:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
@@ -1825,38 +1946,101 @@ func (x *fastReflection_TradeInfo) ProtoMethods() *protoiface.Methods {
 				x.Ticker = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 17:
-				if wireType != 1 {
+				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeFee", wireType)
 				}
-				var v uint64
-				if (iNdEx + 8) > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.TradeFee = float64(math.Float64frombits(v))
+				x.TradeFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 18:
-				if wireType != 1 {
+				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ShareNetPrice", wireType)
 				}
-				var v uint64
-				if (iNdEx + 8) > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.ShareNetPrice = float64(math.Float64frombits(v))
+				x.ShareNetPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 19:
-				if wireType != 1 {
+				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeNetValue", wireType)
 				}
-				var v uint64
-				if (iNdEx + 8) > l {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				x.TradeNetValue = float64(math.Float64frombits(v))
+				x.TradeNetValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2496,30 +2680,32 @@ func (x *TradeData) GetBrokerage() *Brokerage {
 	return nil
 }
 
+// TradeInfo is the trade information of the trade data. The prices, values, rates
+// and number of shares are decimal strings, legacy JSON numbers are accepted on input.
 type TradeInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AssetHolderId       uint64        `protobuf:"varint,1,opt,name=asset_holder_id,json=assetHolderId,proto3" json:"asset_holder_id,omitempty"`
-	AssetId             uint64        `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	TradeType           TradeType     `protobuf:"varint,3,opt,name=trade_type,json=tradeType,proto3,enum=vvtxchain.trade.TradeType" json:"trade_type,omitempty"`
-	TradeValue          float64       `protobuf:"fixed64,4,opt,name=trade_value,json=tradeValue,proto3" json:"trade_value,omitempty"`
-	BaseCurrency        string        `protobuf:"bytes,5,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
-	SettlementCurrency  string        `protobuf:"bytes,6,opt,name=settlement_currency,json=settlementCurrency,proto3" json:"settlement_currency,omitempty"`
-	ExchangeRate        float64       `protobuf:"fixed64,7,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
-	Exchange            string        `protobuf:"bytes,8,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	FundName            string        `protobuf:"bytes,9,opt,name=fund_name,json=fundName,proto3" json:"fund_name,omitempty"`
-	Issuer              string        `protobuf:"bytes,10,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	NumberOfShares      float64       `protobuf:"fixed64,11,opt,name=number_of_shares,json=numberOfShares,proto3" json:"number_of_shares,omitempty"`
-	CoinMintingPrice float64       `protobuf:"fixed64,12,opt,name=coin_minting_price,json=coinMintingPrice,proto3" json:"coin_minting_price,omitempty"`
-	Quantity            *v1beta1.Coin `protobuf:"bytes,13,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Segment             string        `protobuf:"bytes,14,opt,name=segment,proto3" json:"segment,omitempty"`
-	SharePrice          float64       `protobuf:"fixed64,15,opt,name=share_price,json=sharePrice,proto3" json:"share_price,omitempty"`
-	Ticker              string        `protobuf:"bytes,16,opt,name=ticker,proto3" json:"ticker,omitempty"`
-	TradeFee            float64       `protobuf:"fixed64,17,opt,name=trade_fee,json=tradeFee,proto3" json:"trade_fee,omitempty"`
-	ShareNetPrice       float64       `protobuf:"fixed64,18,opt,name=share_net_price,json=shareNetPrice,proto3" json:"share_net_price,omitempty"`
-	TradeNetValue       float64       `protobuf:"fixed64,19,opt,name=trade_net_value,json=tradeNetValue,proto3" json:"trade_net_value,omitempty"`
+	AssetHolderId      uint64        `protobuf:"varint,1,opt,name=asset_holder_id,json=assetHolderId,proto3" json:"asset_holder_id,omitempty"`
+	AssetId            uint64        `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	TradeType          TradeType     `protobuf:"varint,3,opt,name=trade_type,json=tradeType,proto3,enum=vvtxchain.trade.TradeType" json:"trade_type,omitempty"`
+	TradeValue         string        `protobuf:"bytes,4,opt,name=trade_value,json=tradeValue,proto3" json:"trade_value,omitempty"`
+	BaseCurrency       string        `protobuf:"bytes,5,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	SettlementCurrency string        `protobuf:"bytes,6,opt,name=settlement_currency,json=settlementCurrency,proto3" json:"settlement_currency,omitempty"`
+	ExchangeRate       string        `protobuf:"bytes,7,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	Exchange           string        `protobuf:"bytes,8,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	FundName           string        `protobuf:"bytes,9,opt,name=fund_name,json=fundName,proto3" json:"fund_name,omitempty"`
+	Issuer             string        `protobuf:"bytes,10,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	NumberOfShares     string        `protobuf:"bytes,11,opt,name=number_of_shares,json=numberOfShares,proto3" json:"number_of_shares,omitempty"`
+	CoinMintingPrice   string        `protobuf:"bytes,12,opt,name=coin_minting_price,json=coinMintingPrice,proto3" json:"coin_minting_price,omitempty"`
+	Quantity           *v1beta1.Coin `protobuf:"bytes,13,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Segment            string        `protobuf:"bytes,14,opt,name=segment,proto3" json:"segment,omitempty"`
+	SharePrice         string        `protobuf:"bytes,15,opt,name=share_price,json=sharePrice,proto3" json:"share_price,omitempty"`
+	Ticker             string        `protobuf:"bytes,16,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	TradeFee           string        `protobuf:"bytes,17,opt,name=trade_fee,json=tradeFee,proto3" json:"trade_fee,omitempty"`
+	ShareNetPrice      string        `protobuf:"bytes,18,opt,name=share_net_price,json=shareNetPrice,proto3" json:"share_net_price,omitempty"`
+	TradeNetValue      string        `protobuf:"bytes,19,opt,name=trade_net_value,json=tradeNetValue,proto3" json:"trade_net_value,omitempty"`
 }
 
 func (x *TradeInfo) Reset() {
@@ -2563,11 +2749,11 @@ func (x *TradeInfo) GetTradeType() TradeType {
 	return TradeType_TRADE_TYPE_UNSPECIFIED
 }
 
-func (x *TradeInfo) GetTradeValue() float64 {
+func (x *TradeInfo) GetTradeValue() string {
 	if x != nil {
 		return x.TradeValue
 	}
-	return 0
+	return ""
 }
 
 func (x *TradeInfo) GetBaseCurrency() string {
@@ -2584,11 +2770,11 @@ func (x *TradeInfo) GetSettlementCurrency() string {
 	return ""
 }
 
-func (x *TradeInfo) GetExchangeRate() float64 {
+func (x *TradeInfo) GetExchangeRate() string {
 	if x != nil {
 		return x.ExchangeRate
 	}
-	return 0
+	return ""
 }
 
 func (x *TradeInfo) GetExchange() string {
@@ -2612,18 +2798,18 @@ func (x *TradeInfo) GetIssuer() string {
 	return ""
 }
 
-func (x *TradeInfo) GetNumberOfShares() float64 {
+func (x *TradeInfo) GetNumberOfShares() string {
 	if x != nil {
 		return x.NumberOfShares
 	}
-	return 0
+	return ""
 }
 
-func (x *TradeInfo) GetCoinMintingPrice() float64 {
+func (x *TradeInfo) GetCoinMintingPrice() string {
 	if x != nil {
 		return x.CoinMintingPrice
 	}
-	return 0
+	return ""
 }
 
 func (x *TradeInfo) GetQuantity() *v1beta1.Coin {
@@ -2640,11 +2826,11 @@ func (x *TradeInfo) GetSegment() string {
 	return ""
 }
 
-func (x *TradeInfo) GetSharePrice() float64 {
+func (x *TradeInfo) GetSharePrice() string {
 	if x != nil {
 		return x.SharePrice
 	}
-	return 0
+	return ""
 }
 
 func (x *TradeInfo) GetTicker() string {
@@ -2654,25 +2840,25 @@ func (x *TradeInfo) GetTicker() string {
 	return ""
 }
 
-func (x *TradeInfo) GetTradeFee() float64 {
+func (x *TradeInfo) GetTradeFee() string {
 	if x != nil {
 		return x.TradeFee
 	}
-	return 0
+	return ""
 }
 
-func (x *TradeInfo) GetShareNetPrice() float64 {
+func (x *TradeInfo) GetShareNetPrice() string {
 	if x != nil {
 		return x.ShareNetPrice
 	}
-	return 0
+	return ""
 }
 
-func (x *TradeInfo) GetTradeNetValue() float64 {
+func (x *TradeInfo) GetTradeNetValue() string {
 	if x != nil {
 		return x.TradeNetValue
 	}
-	return 0
+	return ""
 }
 
 type Brokerage struct {
@@ -2729,84 +2915,112 @@ func (x *Brokerage) GetCountry() string {
 var File_vvtxchain_trade_trade_data_proto protoreflect.FileDescriptor
 
 var file_vvtxchain_trade_trade_data_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64,
+	0x0a, 0x20, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64,
 	0x65, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x0f, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72,
-	0x61, 0x64, 0x65, 0x1a, 0x1b, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x80, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x39,
-	0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74,
-	0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09,
-	0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x09, 0x62, 0x72, 0x6f,
-	0x6b, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x42,
-	0x72, 0x6f, 0x6b, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x09, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72,
-	0x61, 0x67, 0x65, 0x22, 0xcc, 0x05, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x67, 0x67, 0x65, 0x7a, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x64, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x64,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66,
-	0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x6d,
-	0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x64,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x63, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74,
-	0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x55, 0x73, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
-	0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x66,
-	0x65, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x46,
-	0x65, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x4e, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x72,
-	0x61, 0x64, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x13, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x65, 0x74, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x22, 0x4d, 0x0a, 0x09, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x42, 0xb5, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x42, 0x0e, 0x54, 0x72, 0x61, 0x64, 0x65,
-	0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x47, 0x45, 0x5a, 0x4c, 0x61, 0x62, 0x73,
-	0x2f, 0x67, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67,
-	0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0xa2, 0x02,
-	0x03, 0x47, 0x54, 0x58, 0xaa, 0x02, 0x0f, 0x47, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0xca, 0x02, 0x0f, 0x47, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0xe2, 0x02, 0x1b, 0x47, 0x67, 0x65, 0x7a, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x47, 0x67, 0x65, 0x7a, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x74, 0x6f, 0x12, 0x0f, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
+	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x80, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x39, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x09, 0x62, 0x72,
+	0x6f, 0x6b, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e,
+	0x42, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x09, 0x62, 0x72, 0x6f, 0x6b, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x22, 0xdd, 0x08, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x68, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x76, 0x76, 0x74, 0x78,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x52, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73,
+	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x56, 0x0a, 0x0d, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66,
+	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73,
+	0x12, 0x5f, 0x0a, 0x12, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x10, 0x63, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x35, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x52, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x4e,
+	0x0a, 0x09, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x44, 0x65, 0x63, 0x52, 0x08, 0x74, 0x72, 0x61, 0x64, 0x65, 0x46, 0x65, 0x65, 0x12, 0x59,
+	0x0a, 0x0f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x4e, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x0f, 0x74, 0x72, 0x61,
+	0x64, 0x65, 0x5f, 0x6e, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x64, 0x65, 0x4e, 0x65, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x4d, 0x0a, 0x09, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0xb5, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x76, 0x76, 0x74, 0x78,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x42, 0x0e, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x47, 0x45, 0x5a, 0x4c, 0x61,
+	0x62, 0x73, 0x2f, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0xa2, 0x02, 0x03, 0x56, 0x54, 0x58, 0xaa, 0x02, 0x0f, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0xca, 0x02, 0x0f, 0x56, 0x76, 0x74, 0x78, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0xe2, 0x02, 0x1b, 0x56, 0x76, 0x74,
+	0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61, 0x64, 0x65, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x56, 0x76, 0x74, 0x78, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x54, 0x72, 0x61, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
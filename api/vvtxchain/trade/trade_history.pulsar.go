@@ -0,0 +1,1134 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package trade
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_TradeHistoryEntry              protoreflect.MessageDescriptor
+	fd_TradeHistoryEntry_trade_index  protoreflect.FieldDescriptor
+	fd_TradeHistoryEntry_sequence     protoreflect.FieldDescriptor
+	fd_TradeHistoryEntry_transition   protoreflect.FieldDescriptor
+	fd_TradeHistoryEntry_status       protoreflect.FieldDescriptor
+	fd_TradeHistoryEntry_actor        protoreflect.FieldDescriptor
+	fd_TradeHistoryEntry_block_height protoreflect.FieldDescriptor
+	fd_TradeHistoryEntry_time         protoreflect.FieldDescriptor
+	fd_TradeHistoryEntry_reason       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_vvtxchain_trade_trade_history_proto_init()
+	md_TradeHistoryEntry = File_vvtxchain_trade_trade_history_proto.Messages().ByName("TradeHistoryEntry")
+	fd_TradeHistoryEntry_trade_index = md_TradeHistoryEntry.Fields().ByName("trade_index")
+	fd_TradeHistoryEntry_sequence = md_TradeHistoryEntry.Fields().ByName("sequence")
+	fd_TradeHistoryEntry_transition = md_TradeHistoryEntry.Fields().ByName("transition")
+	fd_TradeHistoryEntry_status = md_TradeHistoryEntry.Fields().ByName("status")
+	fd_TradeHistoryEntry_actor = md_TradeHistoryEntry.Fields().ByName("actor")
+	fd_TradeHistoryEntry_block_height = md_TradeHistoryEntry.Fields().ByName("block_height")
+	fd_TradeHistoryEntry_time = md_TradeHistoryEntry.Fields().ByName("time")
+	fd_TradeHistoryEntry_reason = md_TradeHistoryEntry.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_TradeHistoryEntry)(nil)
+
+type fastReflection_TradeHistoryEntry TradeHistoryEntry
+
+func (x *TradeHistoryEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TradeHistoryEntry)(x)
+}
+
+func (x *TradeHistoryEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_vvtxchain_trade_trade_history_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TradeHistoryEntry_messageType fastReflection_TradeHistoryEntry_messageType
+var _ protoreflect.MessageType = fastReflection_TradeHistoryEntry_messageType{}
+
+type fastReflection_TradeHistoryEntry_messageType struct{}
+
+func (x fastReflection_TradeHistoryEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TradeHistoryEntry)(nil)
+}
+func (x fastReflection_TradeHistoryEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_TradeHistoryEntry)
+}
+func (x fastReflection_TradeHistoryEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TradeHistoryEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TradeHistoryEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_TradeHistoryEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TradeHistoryEntry) Type() protoreflect.MessageType {
+	return _fastReflection_TradeHistoryEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TradeHistoryEntry) New() protoreflect.Message {
+	return new(fastReflection_TradeHistoryEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TradeHistoryEntry) Interface() protoreflect.ProtoMessage {
+	return (*TradeHistoryEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TradeHistoryEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TradeIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TradeIndex)
+		if !f(fd_TradeHistoryEntry_trade_index, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_TradeHistoryEntry_sequence, value) {
+			return
+		}
+	}
+	if x.Transition != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Transition))
+		if !f(fd_TradeHistoryEntry_transition, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_TradeHistoryEntry_status, value) {
+			return
+		}
+	}
+	if x.Actor != "" {
+		value := protoreflect.ValueOfString(x.Actor)
+		if !f(fd_TradeHistoryEntry_actor, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_TradeHistoryEntry_block_height, value) {
+			return
+		}
+	}
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_TradeHistoryEntry_time, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_TradeHistoryEntry_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TradeHistoryEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "vvtxchain.trade.TradeHistoryEntry.trade_index":
+		return x.TradeIndex != uint64(0)
+	case "vvtxchain.trade.TradeHistoryEntry.sequence":
+		return x.Sequence != uint64(0)
+	case "vvtxchain.trade.TradeHistoryEntry.transition":
+		return x.Transition != 0
+	case "vvtxchain.trade.TradeHistoryEntry.status":
+		return x.Status != 0
+	case "vvtxchain.trade.TradeHistoryEntry.actor":
+		return x.Actor != ""
+	case "vvtxchain.trade.TradeHistoryEntry.block_height":
+		return x.BlockHeight != int64(0)
+	case "vvtxchain.trade.TradeHistoryEntry.time":
+		return x.Time != nil
+	case "vvtxchain.trade.TradeHistoryEntry.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.TradeHistoryEntry"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.TradeHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TradeHistoryEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.TradeHistoryEntry.trade_index":
+		x.TradeIndex = uint64(0)
+	case "vvtxchain.trade.TradeHistoryEntry.sequence":
+		x.Sequence = uint64(0)
+	case "vvtxchain.trade.TradeHistoryEntry.transition":
+		x.Transition = 0
+	case "vvtxchain.trade.TradeHistoryEntry.status":
+		x.Status = 0
+	case "vvtxchain.trade.TradeHistoryEntry.actor":
+		x.Actor = ""
+	case "vvtxchain.trade.TradeHistoryEntry.block_height":
+		x.BlockHeight = int64(0)
+	case "vvtxchain.trade.TradeHistoryEntry.time":
+		x.Time = nil
+	case "vvtxchain.trade.TradeHistoryEntry.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.TradeHistoryEntry"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.TradeHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TradeHistoryEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "vvtxchain.trade.TradeHistoryEntry.trade_index":
+		value := x.TradeIndex
+		return protoreflect.ValueOfUint64(value)
+	case "vvtxchain.trade.TradeHistoryEntry.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "vvtxchain.trade.TradeHistoryEntry.transition":
+		value := x.Transition
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "vvtxchain.trade.TradeHistoryEntry.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "vvtxchain.trade.TradeHistoryEntry.actor":
+		value := x.Actor
+		return protoreflect.ValueOfString(value)
+	case "vvtxchain.trade.TradeHistoryEntry.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	case "vvtxchain.trade.TradeHistoryEntry.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "vvtxchain.trade.TradeHistoryEntry.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.TradeHistoryEntry"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.TradeHistoryEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TradeHistoryEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "vvtxchain.trade.TradeHistoryEntry.trade_index":
+		x.TradeIndex = value.Uint()
+	case "vvtxchain.trade.TradeHistoryEntry.sequence":
+		x.Sequence = value.Uint()
+	case "vvtxchain.trade.TradeHistoryEntry.transition":
+		x.Transition = (TradeTransition)(value.Enum())
+	case "vvtxchain.trade.TradeHistoryEntry.status":
+		x.Status = (TradeStatus)(value.Enum())
+	case "vvtxchain.trade.TradeHistoryEntry.actor":
+		x.Actor = value.Interface().(string)
+	case "vvtxchain.trade.TradeHistoryEntry.block_height":
+		x.BlockHeight = value.Int()
+	case "vvtxchain.trade.TradeHistoryEntry.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	case "vvtxchain.trade.TradeHistoryEntry.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.TradeHistoryEntry"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.TradeHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TradeHistoryEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.TradeHistoryEntry.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "vvtxchain.trade.TradeHistoryEntry.trade_index":
+		panic(fmt.Errorf("field trade_index of message vvtxchain.trade.TradeHistoryEntry is not mutable"))
+	case "vvtxchain.trade.TradeHistoryEntry.sequence":
+		panic(fmt.Errorf("field sequence of message vvtxchain.trade.TradeHistoryEntry is not mutable"))
+	case "vvtxchain.trade.TradeHistoryEntry.transition":
+		panic(fmt.Errorf("field transition of message vvtxchain.trade.TradeHistoryEntry is not mutable"))
+	case "vvtxchain.trade.TradeHistoryEntry.status":
+		panic(fmt.Errorf("field status of message vvtxchain.trade.TradeHistoryEntry is not mutable"))
+	case "vvtxchain.trade.TradeHistoryEntry.actor":
+		panic(fmt.Errorf("field actor of message vvtxchain.trade.TradeHistoryEntry is not mutable"))
+	case "vvtxchain.trade.TradeHistoryEntry.block_height":
+		panic(fmt.Errorf("field block_height of message vvtxchain.trade.TradeHistoryEntry is not mutable"))
+	case "vvtxchain.trade.TradeHistoryEntry.reason":
+		panic(fmt.Errorf("field reason of message vvtxchain.trade.TradeHistoryEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.TradeHistoryEntry"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.TradeHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TradeHistoryEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "vvtxchain.trade.TradeHistoryEntry.trade_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "vvtxchain.trade.TradeHistoryEntry.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "vvtxchain.trade.TradeHistoryEntry.transition":
+		return protoreflect.ValueOfEnum(0)
+	case "vvtxchain.trade.TradeHistoryEntry.status":
+		return protoreflect.ValueOfEnum(0)
+	case "vvtxchain.trade.TradeHistoryEntry.actor":
+		return protoreflect.ValueOfString("")
+	case "vvtxchain.trade.TradeHistoryEntry.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "vvtxchain.trade.TradeHistoryEntry.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "vvtxchain.trade.TradeHistoryEntry.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: vvtxchain.trade.TradeHistoryEntry"))
+		}
+		panic(fmt.Errorf("message vvtxchain.trade.TradeHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TradeHistoryEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in vvtxchain.trade.TradeHistoryEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TradeHistoryEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TradeHistoryEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TradeHistoryEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TradeHistoryEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TradeHistoryEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TradeIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.TradeIndex))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.Transition != 0 {
+			n += 1 + runtime.Sov(uint64(x.Transition))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Actor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TradeHistoryEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Actor) > 0 {
+			i -= len(x.Actor)
+			copy(dAtA[i:], x.Actor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Actor)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Transition != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Transition))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.TradeIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TradeIndex))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TradeHistoryEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TradeHistoryEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TradeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+				}
+				x.TradeIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TradeIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				}
+				x.Sequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Sequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Transition", wireType)
+				}
+				x.Transition = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Transition |= TradeTransition(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= TradeStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Actor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: vvtxchain/trade/trade_history.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TradeTransition is a change of the state of a trade.
+type TradeTransition int32
+
+const (
+	TradeTransition_TRADE_TRANSITION_UNSPECIFIED TradeTransition = 0
+	TradeTransition_TRADE_TRANSITION_CREATED     TradeTransition = 1
+	TradeTransition_TRADE_TRANSITION_AMENDED     TradeTransition = 2
+	// approved is a confirmation of a checker, the trade stays pending while it is
+	// awaiting approvals.
+	TradeTransition_TRADE_TRANSITION_APPROVED TradeTransition = 3
+	TradeTransition_TRADE_TRANSITION_REJECTED TradeTransition = 4
+	TradeTransition_TRADE_TRANSITION_CANCELED TradeTransition = 5
+	TradeTransition_TRADE_TRANSITION_EXPIRED  TradeTransition = 6
+	TradeTransition_TRADE_TRANSITION_FAILED   TradeTransition = 7
+	TradeTransition_TRADE_TRANSITION_RETRIED  TradeTransition = 8
+)
+
+// Enum value maps for TradeTransition.
+var (
+	TradeTransition_name = map[int32]string{
+		0: "TRADE_TRANSITION_UNSPECIFIED",
+		1: "TRADE_TRANSITION_CREATED",
+		2: "TRADE_TRANSITION_AMENDED",
+		3: "TRADE_TRANSITION_APPROVED",
+		4: "TRADE_TRANSITION_REJECTED",
+		5: "TRADE_TRANSITION_CANCELED",
+		6: "TRADE_TRANSITION_EXPIRED",
+		7: "TRADE_TRANSITION_FAILED",
+		8: "TRADE_TRANSITION_RETRIED",
+	}
+	TradeTransition_value = map[string]int32{
+		"TRADE_TRANSITION_UNSPECIFIED": 0,
+		"TRADE_TRANSITION_CREATED":     1,
+		"TRADE_TRANSITION_AMENDED":     2,
+		"TRADE_TRANSITION_APPROVED":    3,
+		"TRADE_TRANSITION_REJECTED":    4,
+		"TRADE_TRANSITION_CANCELED":    5,
+		"TRADE_TRANSITION_EXPIRED":     6,
+		"TRADE_TRANSITION_FAILED":      7,
+		"TRADE_TRANSITION_RETRIED":     8,
+	}
+)
+
+func (x TradeTransition) Enum() *TradeTransition {
+	p := new(TradeTransition)
+	*p = x
+	return p
+}
+
+func (x TradeTransition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TradeTransition) Descriptor() protoreflect.EnumDescriptor {
+	return file_vvtxchain_trade_trade_history_proto_enumTypes[0].Descriptor()
+}
+
+func (TradeTransition) Type() protoreflect.EnumType {
+	return &file_vvtxchain_trade_trade_history_proto_enumTypes[0]
+}
+
+func (x TradeTransition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TradeTransition.Descriptor instead.
+func (TradeTransition) EnumDescriptor() ([]byte, []int) {
+	return file_vvtxchain_trade_trade_history_proto_rawDescGZIP(), []int{0}
+}
+
+// TradeHistoryEntry is a transition of a trade. The entries of a trade are
+// append only and ordered by sequence.
+type TradeHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TradeIndex uint64          `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Sequence   uint64          `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Transition TradeTransition `protobuf:"varint,3,opt,name=transition,proto3,enum=vvtxchain.trade.TradeTransition" json:"transition,omitempty"`
+	// status is the status of the trade after the transition.
+	Status TradeStatus `protobuf:"varint,4,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
+	// actor is the signer of the transition, empty for the transitions made by the module.
+	Actor       string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	BlockHeight int64                  `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Time        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`
+	Reason      string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *TradeHistoryEntry) Reset() {
+	*x = TradeHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_vvtxchain_trade_trade_history_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TradeHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeHistoryEntry) ProtoMessage() {}
+
+// Deprecated: Use TradeHistoryEntry.ProtoReflect.Descriptor instead.
+func (*TradeHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_vvtxchain_trade_trade_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TradeHistoryEntry) GetTradeIndex() uint64 {
+	if x != nil {
+		return x.TradeIndex
+	}
+	return 0
+}
+
+func (x *TradeHistoryEntry) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *TradeHistoryEntry) GetTransition() TradeTransition {
+	if x != nil {
+		return x.Transition
+	}
+	return TradeTransition_TRADE_TRANSITION_UNSPECIFIED
+}
+
+func (x *TradeHistoryEntry) GetStatus() TradeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
+}
+
+func (x *TradeHistoryEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *TradeHistoryEntry) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *TradeHistoryEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *TradeHistoryEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_vvtxchain_trade_trade_history_proto protoreflect.FileDescriptor
+
+var file_vvtxchain_trade_trade_history_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x74, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x76,
+	0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x74,
+	0x72, 0x61, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd3, 0x02, 0x0a, 0x11, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x40, 0x0a,
+	0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x20, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72,
+	0x61, 0x64, 0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf,
+	0x1f, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x2a, 0xa5, 0x02, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x64, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f,
+	0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4d, 0x45, 0x4e, 0x44, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x1d, 0x0a, 0x19, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12,
+	0x1c, 0x0a, 0x18, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1b, 0x0a,
+	0x17, 0x54, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x52,
+	0x41, 0x44, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x45, 0x54, 0x52, 0x49, 0x45, 0x44, 0x10, 0x08, 0x42, 0xb8, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d,
+	0x2e, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x74, 0x72, 0x61, 0x64, 0x65,
+	0x42, 0x11, 0x54, 0x72, 0x61, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x47, 0x47, 0x45, 0x5a, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x76, 0x74, 0x78, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x64, 0x65, 0xa2, 0x02, 0x03, 0x56, 0x54, 0x58, 0xaa, 0x02,
+	0x0f, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65,
+	0xca, 0x02, 0x0f, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54, 0x72, 0x61,
+	0x64, 0x65, 0xe2, 0x02, 0x1b, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x54,
+	0x72, 0x61, 0x64, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x10, 0x56, 0x76, 0x74, 0x78, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x54, 0x72,
+	0x61, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_vvtxchain_trade_trade_history_proto_rawDescOnce sync.Once
+	file_vvtxchain_trade_trade_history_proto_rawDescData = file_vvtxchain_trade_trade_history_proto_rawDesc
+)
+
+func file_vvtxchain_trade_trade_history_proto_rawDescGZIP() []byte {
+	file_vvtxchain_trade_trade_history_proto_rawDescOnce.Do(func() {
+		file_vvtxchain_trade_trade_history_proto_rawDescData = protoimpl.X.CompressGZIP(file_vvtxchain_trade_trade_history_proto_rawDescData)
+	})
+	return file_vvtxchain_trade_trade_history_proto_rawDescData
+}
+
+var file_vvtxchain_trade_trade_history_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_vvtxchain_trade_trade_history_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_vvtxchain_trade_trade_history_proto_goTypes = []interface{}{
+	(TradeTransition)(0),          // 0: vvtxchain.trade.TradeTransition
+	(*TradeHistoryEntry)(nil),     // 1: vvtxchain.trade.TradeHistoryEntry
+	(TradeStatus)(0),              // 2: vvtxchain.trade.TradeStatus
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_vvtxchain_trade_trade_history_proto_depIdxs = []int32{
+	0, // 0: vvtxchain.trade.TradeHistoryEntry.transition:type_name -> vvtxchain.trade.TradeTransition
+	2, // 1: vvtxchain.trade.TradeHistoryEntry.status:type_name -> vvtxchain.trade.TradeStatus
+	3, // 2: vvtxchain.trade.TradeHistoryEntry.time:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_vvtxchain_trade_trade_history_proto_init() }
+func file_vvtxchain_trade_trade_history_proto_init() {
+	if File_vvtxchain_trade_trade_history_proto != nil {
+		return
+	}
+	file_vvtxchain_trade_trade_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_vvtxchain_trade_trade_history_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TradeHistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_vvtxchain_trade_trade_history_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_vvtxchain_trade_trade_history_proto_goTypes,
+		DependencyIndexes: file_vvtxchain_trade_trade_history_proto_depIdxs,
+		EnumInfos:         file_vvtxchain_trade_trade_history_proto_enumTypes,
+		MessageInfos:      file_vvtxchain_trade_trade_history_proto_msgTypes,
+	}.Build()
+	File_vvtxchain_trade_trade_history_proto = out.File
+	file_vvtxchain_trade_trade_history_proto_rawDesc = nil
+	file_vvtxchain_trade_trade_history_proto_goTypes = nil
+	file_vvtxchain_trade_trade_history_proto_depIdxs = nil
+}
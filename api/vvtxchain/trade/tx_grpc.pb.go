@@ -19,9 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/vvtxchain.trade.Msg/UpdateParams"
-	Msg_CreateTrade_FullMethodName  = "/vvtxchain.trade.Msg/CreateTrade"
-	Msg_ProcessTrade_FullMethodName = "/vvtxchain.trade.Msg/ProcessTrade"
+	Msg_UpdateParams_FullMethodName  = "/vvtxchain.trade.Msg/UpdateParams"
+	Msg_CreateTrade_FullMethodName   = "/vvtxchain.trade.Msg/CreateTrade"
+	Msg_ProcessTrade_FullMethodName  = "/vvtxchain.trade.Msg/ProcessTrade"
+	Msg_CancelTrade_FullMethodName   = "/vvtxchain.trade.Msg/CancelTrade"
+	Msg_AmendTrade_FullMethodName    = "/vvtxchain.trade.Msg/AmendTrade"
+	Msg_CreateTradeV2_FullMethodName = "/vvtxchain.trade.Msg/CreateTradeV2"
+	Msg_CreateTrades_FullMethodName  = "/vvtxchain.trade.Msg/CreateTrades"
+	Msg_ProcessTrades_FullMethodName = "/vvtxchain.trade.Msg/ProcessTrades"
+	Msg_RetryTrade_FullMethodName    = "/vvtxchain.trade.Msg/RetryTrade"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,6 +39,21 @@ type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	CreateTrade(ctx context.Context, in *MsgCreateTrade, opts ...grpc.CallOption) (*MsgCreateTradeResponse, error)
 	ProcessTrade(ctx context.Context, in *MsgProcessTrade, opts ...grpc.CallOption) (*MsgProcessTradeResponse, error)
+	// CancelTrade cancels a pending trade. Only the maker of the trade or an
+	// acl admin can cancel it.
+	CancelTrade(ctx context.Context, in *MsgCancelTrade, opts ...grpc.CallOption) (*MsgCancelTradeResponse, error)
+	// AmendTrade corrects the payload of a pending trade. Only the maker of the
+	// trade can amend it.
+	AmendTrade(ctx context.Context, in *MsgAmendTrade, opts ...grpc.CallOption) (*MsgAmendTradeResponse, error)
+	// CreateTradeV2 creates a trade from typed payloads instead of JSON strings.
+	CreateTradeV2(ctx context.Context, in *MsgCreateTradeV2, opts ...grpc.CallOption) (*MsgCreateTradeV2Response, error)
+	// CreateTrades creates a batch of trades.
+	CreateTrades(ctx context.Context, in *MsgCreateTrades, opts ...grpc.CallOption) (*MsgCreateTradesResponse, error)
+	// ProcessTrades confirms or rejects a batch of trades.
+	ProcessTrades(ctx context.Context, in *MsgProcessTrades, opts ...grpc.CallOption) (*MsgProcessTradesResponse, error)
+	// RetryTrade re-attempts the mint or burn of a failed trade. Only a checker
+	// other than the maker of the trade can retry it.
+	RetryTrade(ctx context.Context, in *MsgRetryTrade, opts ...grpc.CallOption) (*MsgRetryTradeResponse, error)
 }
 
 type msgClient struct {
@@ -70,6 +91,60 @@ func (c *msgClient) ProcessTrade(ctx context.Context, in *MsgProcessTrade, opts
 	return out, nil
 }
 
+func (c *msgClient) CancelTrade(ctx context.Context, in *MsgCancelTrade, opts ...grpc.CallOption) (*MsgCancelTradeResponse, error) {
+	out := new(MsgCancelTradeResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelTrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AmendTrade(ctx context.Context, in *MsgAmendTrade, opts ...grpc.CallOption) (*MsgAmendTradeResponse, error) {
+	out := new(MsgAmendTradeResponse)
+	err := c.cc.Invoke(ctx, Msg_AmendTrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateTradeV2(ctx context.Context, in *MsgCreateTradeV2, opts ...grpc.CallOption) (*MsgCreateTradeV2Response, error) {
+	out := new(MsgCreateTradeV2Response)
+	err := c.cc.Invoke(ctx, Msg_CreateTradeV2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateTrades(ctx context.Context, in *MsgCreateTrades, opts ...grpc.CallOption) (*MsgCreateTradesResponse, error) {
+	out := new(MsgCreateTradesResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateTrades_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProcessTrades(ctx context.Context, in *MsgProcessTrades, opts ...grpc.CallOption) (*MsgProcessTradesResponse, error) {
+	out := new(MsgProcessTradesResponse)
+	err := c.cc.Invoke(ctx, Msg_ProcessTrades_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetryTrade(ctx context.Context, in *MsgRetryTrade, opts ...grpc.CallOption) (*MsgRetryTradeResponse, error) {
+	out := new(MsgRetryTradeResponse)
+	err := c.cc.Invoke(ctx, Msg_RetryTrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -79,6 +154,21 @@ type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	CreateTrade(context.Context, *MsgCreateTrade) (*MsgCreateTradeResponse, error)
 	ProcessTrade(context.Context, *MsgProcessTrade) (*MsgProcessTradeResponse, error)
+	// CancelTrade cancels a pending trade. Only the maker of the trade or an
+	// acl admin can cancel it.
+	CancelTrade(context.Context, *MsgCancelTrade) (*MsgCancelTradeResponse, error)
+	// AmendTrade corrects the payload of a pending trade. Only the maker of the
+	// trade can amend it.
+	AmendTrade(context.Context, *MsgAmendTrade) (*MsgAmendTradeResponse, error)
+	// CreateTradeV2 creates a trade from typed payloads instead of JSON strings.
+	CreateTradeV2(context.Context, *MsgCreateTradeV2) (*MsgCreateTradeV2Response, error)
+	// CreateTrades creates a batch of trades.
+	CreateTrades(context.Context, *MsgCreateTrades) (*MsgCreateTradesResponse, error)
+	// ProcessTrades confirms or rejects a batch of trades.
+	ProcessTrades(context.Context, *MsgProcessTrades) (*MsgProcessTradesResponse, error)
+	// RetryTrade re-attempts the mint or burn of a failed trade. Only a checker
+	// other than the maker of the trade can retry it.
+	RetryTrade(context.Context, *MsgRetryTrade) (*MsgRetryTradeResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -95,6 +185,24 @@ func (UnimplementedMsgServer) CreateTrade(context.Context, *MsgCreateTrade) (*Ms
 func (UnimplementedMsgServer) ProcessTrade(context.Context, *MsgProcessTrade) (*MsgProcessTradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessTrade not implemented")
 }
+func (UnimplementedMsgServer) CancelTrade(context.Context, *MsgCancelTrade) (*MsgCancelTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTrade not implemented")
+}
+func (UnimplementedMsgServer) AmendTrade(context.Context, *MsgAmendTrade) (*MsgAmendTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendTrade not implemented")
+}
+func (UnimplementedMsgServer) CreateTradeV2(context.Context, *MsgCreateTradeV2) (*MsgCreateTradeV2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTradeV2 not implemented")
+}
+func (UnimplementedMsgServer) CreateTrades(context.Context, *MsgCreateTrades) (*MsgCreateTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrades not implemented")
+}
+func (UnimplementedMsgServer) ProcessTrades(context.Context, *MsgProcessTrades) (*MsgProcessTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessTrades not implemented")
+}
+func (UnimplementedMsgServer) RetryTrade(context.Context, *MsgRetryTrade) (*MsgRetryTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryTrade not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +270,114 @@ func _Msg_ProcessTrade_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelTrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTrade(ctx, req.(*MsgCancelTrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendTrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AmendTrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendTrade(ctx, req.(*MsgAmendTrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTradeV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTradeV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTradeV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateTradeV2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTradeV2(ctx, req.(*MsgCreateTradeV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTrades)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateTrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTrades(ctx, req.(*MsgCreateTrades))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProcessTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProcessTrades)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProcessTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ProcessTrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProcessTrades(ctx, req.(*MsgProcessTrades))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetryTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryTrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RetryTrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryTrade(ctx, req.(*MsgRetryTrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,6 +397,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessTrade",
 			Handler:    _Msg_ProcessTrade_Handler,
 		},
+		{
+			MethodName: "CancelTrade",
+			Handler:    _Msg_CancelTrade_Handler,
+		},
+		{
+			MethodName: "AmendTrade",
+			Handler:    _Msg_AmendTrade_Handler,
+		},
+		{
+			MethodName: "CreateTradeV2",
+			Handler:    _Msg_CreateTradeV2_Handler,
+		},
+		{
+			MethodName: "CreateTrades",
+			Handler:    _Msg_CreateTrades_Handler,
+		},
+		{
+			MethodName: "ProcessTrades",
+			Handler:    _Msg_ProcessTrades_Handler,
+		},
+		{
+			MethodName: "RetryTrade",
+			Handler:    _Msg_RetryTrade_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "vvtxchain/trade/tx.proto",
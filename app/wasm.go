@@ -7,6 +7,7 @@ import (
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/GGEZLabs/vvtxchain/wasmbinding"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -32,6 +33,8 @@ func (app *App) registerWasmModules(
 		panic(err)
 	}
 
+	wasmOpts = append(wasmOpts, wasmbinding.RegisterCustomPlugins(app.TradeKeeper, app.AclKeeper)...)
+
 	wasmConfig, err := wasm.ReadNodeConfig(appOpts)
 	if err != nil {
 		return nil, fmt.Errorf("error while reading wasm config: %s", err)
@@ -54,7 +57,7 @@ func (app *App) registerWasmModules(
 		DefaultNodeHome,
 		wasmConfig,
 		wasmtypes.VMConfig{},
-		wasmkeeper.BuiltInCapabilities(),
+		append(wasmkeeper.BuiltInCapabilities(), wasmbinding.Capability),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		wasmOpts...,
 	)
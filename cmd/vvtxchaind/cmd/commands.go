@@ -9,6 +9,7 @@ import (
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmcli "github.com/CosmWasm/wasmd/x/wasm/client/cli"
 	"github.com/GGEZLabs/vvtxchain/app"
+	tradecli "github.com/GGEZLabs/vvtxchain/x/trade/client/cli"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/debug"
@@ -53,6 +54,7 @@ func initRootCmd(
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
+		tradecli.GetTradeCmd(),
 	)
 	wasmcli.ExtendUnsafeResetAllCmd(rootCmd)
 }
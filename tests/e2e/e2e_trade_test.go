@@ -79,53 +79,88 @@ func (s *IntegrationTestSuite) testTrade() {
 	// Process already processed trade
 	s.execProcessTrade(s.chainA, 0, "1", "confirm", admin2.String(), vvtxHomePath, fees.String(), true)
 
-	// // Create trade with type split
-	// s.execCreateTrade(s.chainA, 0, "", tradetypes.GetSampleTradeDataJson(tradetypes.TradeTypeSplit), `{}`, tradetypes.GetSampleCoinMintingPriceJson(), tradetypes.GetSampleExchangeRateJson(), admin1.String(), vvtxHomePath, fees.String())
-
-	// s.Require().Eventually(
-	// 	func() bool {
-	// 		storedTrade, err := queryStoredTrade(chainEndpoint, "2")
-	// 		s.Require().NoError(err)
-
-	// 		storedTrades, err := queryAllStoredTrade(chainEndpoint)
-	// 		s.Require().NoError(err)
-
-	// 		storedTempTrade, err := queryStoredTempTrade(chainEndpoint, "2")
-	// 		s.Require().NoError(err)
-
-	// 		storedTempTrades, err := queryAllStoredTempTrade(chainEndpoint)
-	// 		s.Require().NoError(err)
-
-	// 		s.Require().Equal(storedTrade.StoredTrade.Status, tradetypes.StatusPending)
-	// 		s.Require().Equal(storedTempTrade.StoredTempTrade.TradeIndex, uint64(2))
-	// 		s.Require().Len(storedTempTrades.StoredTempTrade, 1)
-
-	// 		return len(storedTrades.StoredTrade) == 2
-	// 	},
-	// 	20*time.Second,
-	// 	5*time.Second,
-	// )
-
-	// // Process trade
-	// s.execProcessTrade(s.chainA, 0, "2", "confirm", admin2.String(), vvtxHomePath, fees.String(), false)
-
-	// s.Require().Eventually(
-	// 	func() bool {
-	// 		storedTrade, err := queryStoredTrade(chainEndpoint, "2")
-	// 		s.Require().NoError(err)
-
-	// 		storedTempTrades, err := queryAllStoredTempTrade(chainEndpoint)
-	// 		s.Require().NoError(err)
-
-	// 		s.Require().Equal(storedTrade.StoredTrade.Status, tradetypes.StatusProcessed)
-	// 		// Supply should not be changed
-	// 		gbpvSupply, err := querySupplyOf(chainEndpoint, tradetypes.DefaultDenom)
-	// 		s.Require().NoError(err)
-	// 		s.Require().Equal(int64(100000), gbpvSupply.Amount.Int64())
-
-	// 		return len(storedTempTrades.StoredTempTrade) == 0
-	// 	},
-	// 	20*time.Second,
-	// 	5*time.Second,
-	// )
+	// Create trade with type split
+	s.execCreateTrade(s.chainA, 0, "", tradetypes.GetSampleTradeDataJson(tradetypes.TradeTypeSplit), `{}`, tradetypes.GetSampleCoinMintingPriceJson(), tradetypes.GetSampleExchangeRateJson(), admin1.String(), vvtxHomePath, fees.String())
+
+	s.Require().Eventually(
+		func() bool {
+			storedTrade, err := queryStoredTrade(chainEndpoint, "2")
+			s.Require().NoError(err)
+
+			storedTrades, err := queryAllStoredTrade(chainEndpoint)
+			s.Require().NoError(err)
+
+			storedTempTrade, err := queryStoredTempTrade(chainEndpoint, "2")
+			s.Require().NoError(err)
+
+			storedTempTrades, err := queryAllStoredTempTrade(chainEndpoint)
+			s.Require().NoError(err)
+
+			s.Require().Equal(storedTrade.StoredTrade.Status, tradetypes.StatusPending)
+			s.Require().Equal(storedTempTrade.StoredTempTrade.TradeIndex, uint64(2))
+			s.Require().Len(storedTempTrades.StoredTempTrade, 1)
+
+			return len(storedTrades.StoredTrade) == 2
+		},
+		20*time.Second,
+		5*time.Second,
+	)
+
+	// Process trade
+	s.execProcessTrade(s.chainA, 0, "2", "confirm", admin2.String(), vvtxHomePath, fees.String(), false)
+
+	s.Require().Eventually(
+		func() bool {
+			storedTrade, err := queryStoredTrade(chainEndpoint, "2")
+			s.Require().NoError(err)
+
+			storedTempTrades, err := queryAllStoredTempTrade(chainEndpoint)
+			s.Require().NoError(err)
+
+			s.Require().Equal(storedTrade.StoredTrade.Status, tradetypes.StatusProcessed)
+			// Supply should not be changed
+			gbpvSupply, err := querySupplyOf(chainEndpoint, tradetypes.DefaultDenom)
+			s.Require().NoError(err)
+			s.Require().Equal(int64(100000), gbpvSupply.Amount.Int64())
+
+			return len(storedTempTrades.StoredTempTrade) == 0
+		},
+		20*time.Second,
+		5*time.Second,
+	)
+
+	// Create and process trade with type dividends
+	s.execCreateTrade(s.chainA, 0, "", tradetypes.GetSampleTradeDataJson(tradetypes.TradeTypeDividends), `{}`, tradetypes.GetSampleCoinMintingPriceJson(), tradetypes.GetSampleExchangeRateJson(), admin1.String(), vvtxHomePath, fees.String())
+
+	s.Require().Eventually(
+		func() bool {
+			storedTrades, err := queryAllStoredTrade(chainEndpoint)
+			s.Require().NoError(err)
+
+			return len(storedTrades.StoredTrade) == 3
+		},
+		20*time.Second,
+		5*time.Second,
+	)
+
+	s.execProcessTrade(s.chainA, 0, "3", "confirm", admin2.String(), vvtxHomePath, fees.String(), false)
+
+	s.Require().Eventually(
+		func() bool {
+			storedTrade, err := queryStoredTrade(chainEndpoint, "3")
+			s.Require().NoError(err)
+
+			s.Require().Equal(tradetypes.TradeTypeDividends, storedTrade.StoredTrade.TradeType)
+			s.Require().Nil(storedTrade.StoredTrade.Amount)
+
+			// Supply should not be changed
+			gbpvSupply, err := querySupplyOf(chainEndpoint, tradetypes.DefaultDenom)
+			s.Require().NoError(err)
+			s.Require().Equal(int64(100000), gbpvSupply.Amount.Int64())
+
+			return storedTrade.StoredTrade.Status == tradetypes.StatusProcessed
+		},
+		20*time.Second,
+		5*time.Second,
+	)
 }
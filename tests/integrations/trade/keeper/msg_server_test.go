@@ -78,7 +78,7 @@ func TestCreateTrade(t *testing.T) {
 	}
 }
 
-func TestCanceledTradeAfterCreateTrade(t *testing.T) {
+func TestCanceledTradeAtEndBlock(t *testing.T) {
 	f := initFixture(t)
 	header := f.ctx.BlockHeader()
 	header.Time = time.Now()
@@ -155,6 +155,12 @@ func TestCanceledTradeAfterCreateTrade(t *testing.T) {
 	)
 	assert.NilError(t, err)
 
+	// Creating a trade does not cancel expired trades
+	allTempTrades = f.tradeKeeper.GetAllStoredTempTrade(f.ctx)
+	assert.Equal(t, len(allTempTrades), 4)
+
+	f.tradeKeeper.CancelExpiredPendingTrades(f.ctx)
+
 	allTrades = f.tradeKeeper.GetAllStoredTrade(f.ctx)
 	assert.Equal(t, len(allTrades), 4)
 
@@ -303,11 +309,13 @@ func TestSupplyAndBalancesAfterProcessTrade(t *testing.T) {
 	balance := f.bankKeeper.GetBalance(f.ctx, receiverAddress, types.DefaultDenom)
 	assert.Assert(t, balance.Amount.Int64() == 500000000)
 
-	// Trade 2
-	_, err = msgServer.CreateTrade(f.ctx, types.GetMsgCreateTradeWithTypeAndAmount(types.TradeTypeFiatWithdrawal, 700000000))
-
+	// Trade 2, the withdrawal is escrowed and refunded on reject
+	_, err = msgServer.CreateTrade(f.ctx, types.GetMsgCreateTradeWithTypeAndAmount(types.TradeTypeFiatWithdrawal, 300000000))
 	assert.NilError(t, err)
 
+	balance = f.bankKeeper.GetBalance(f.ctx, receiverAddress, types.DefaultDenom)
+	assert.Assert(t, balance.Amount.Int64() == 200000000)
+
 	_, err = msgServer.ProcessTrade(f.ctx, &types.MsgProcessTrade{
 		Creator:     testutil.Bob,
 		ProcessType: types.ProcessTypeReject,
@@ -322,33 +330,31 @@ func TestSupplyAndBalancesAfterProcessTrade(t *testing.T) {
 	supply = f.bankKeeper.GetSupply(f.ctx, types.DefaultDenom)
 	assert.Assert(t, supply.Amount.Int64() == 500000000)
 
-	receiverAddress, err = sdk.AccAddressFromBech32(testutil.Alice)
-	assert.NilError(t, err)
-
 	balance = f.bankKeeper.GetBalance(f.ctx, receiverAddress, types.DefaultDenom)
 	assert.Assert(t, balance.Amount.Int64() == 500000000)
 
-	// Trade 3
+	// A withdrawal above the balance of the receiver can not be escrowed
 	_, err = msgServer.CreateTrade(f.ctx, types.GetMsgCreateTradeWithTypeAndAmount(types.TradeTypeFiatWithdrawal, 700000000))
+	assert.ErrorContains(t, err, "insufficient funds")
+
+	// Trade 3, the withdrawal is escrowed and refunded on cancel
+	_, err = msgServer.CreateTrade(f.ctx, types.GetMsgCreateTradeWithTypeAndAmount(types.TradeTypeFiatWithdrawal, 200000000))
 	assert.NilError(t, err)
 
-	_, err = msgServer.ProcessTrade(f.ctx, &types.MsgProcessTrade{
-		Creator:     testutil.Bob,
-		ProcessType: types.ProcessTypeConfirm,
-		TradeIndex:  3,
+	_, err = msgServer.CancelTrade(f.ctx, &types.MsgCancelTrade{
+		Creator:    testutil.Alice,
+		TradeIndex: 3,
+		Reason:     "wrong amount",
 	})
 	assert.NilError(t, err)
 
 	trade, found = f.tradeKeeper.GetStoredTrade(f.ctx, 3)
 	assert.Assert(t, found == true)
-	assert.Assert(t, trade.Status == types.StatusFailed)
+	assert.Assert(t, trade.Status == types.StatusCanceled)
 
 	supply = f.bankKeeper.GetSupply(f.ctx, types.DefaultDenom)
 	assert.Assert(t, supply.Amount.Int64() == 500000000)
 
-	receiverAddress, err = sdk.AccAddressFromBech32(testutil.Alice)
-	assert.NilError(t, err)
-
 	balance = f.bankKeeper.GetBalance(f.ctx, receiverAddress, types.DefaultDenom)
 	assert.Assert(t, balance.Amount.Int64() == 500000000)
 
@@ -376,30 +382,68 @@ func TestSupplyAndBalancesAfterProcessTrade(t *testing.T) {
 	balance = f.bankKeeper.GetBalance(f.ctx, receiverAddress, types.DefaultDenom)
 	assert.Assert(t, balance.Amount.Int64() == 400000000)
 
-	// // Trade 5
-	// _, err = msgServer.CreateTrade(f.ctx, &types.MsgCreateTrade{
-	// 	Creator:              testutil.Alice,
-	// 	ReceiverAddress:      "",
-	// 	TradeData:            types.GetSampleTradeDataJson(types.TradeTypeSplit),
-	// 	BankingSystemData:    "{}",
-	// 	CoinMintingPriceJson: types.GetSampleCoinMintingPriceJson(),
-	// 	ExchangeRateJson:     types.GetSampleExchangeRateJson(),
-	// })
-	// assert.NilError(t, err)
-
-	// _, err = msgServer.ProcessTrade(f.ctx, &types.MsgProcessTrade{
-	// 	Creator:     testutil.Bob,
-	// 	ProcessType: types.ProcessTypeConfirm,
-	// 	TradeIndex:  5,
-	// })
-	// assert.NilError(t, err)
-
-	// trade, found = f.tradeKeeper.GetStoredTrade(f.ctx, 5)
-	// assert.Assert(t, found == true)
-	// assert.Assert(t, trade.Status == types.StatusProcessed)
-
-	// supply = f.bankKeeper.GetSupply(f.ctx, types.DefaultDenom)
-
-	// // Supply should not be changed
-	// assert.Assert(t, supply.Amount.Int64() == 400000000)
+	// Trade 5
+	_, err = msgServer.CreateTrade(f.ctx, &types.MsgCreateTrade{
+		Creator:              testutil.Alice,
+		ReceiverAddress:      "",
+		TradeData:            types.GetSampleTradeDataJson(types.TradeTypeSplit),
+		BankingSystemData:    "{}",
+		CoinMintingPriceJson: types.GetSampleCoinMintingPriceJson(),
+		ExchangeRateJson:     types.GetSampleExchangeRateJson(),
+	})
+	assert.NilError(t, err)
+
+	_, err = msgServer.ProcessTrade(f.ctx, &types.MsgProcessTrade{
+		Creator:     testutil.Bob,
+		ProcessType: types.ProcessTypeConfirm,
+		TradeIndex:  5,
+	})
+	assert.NilError(t, err)
+
+	trade, found = f.tradeKeeper.GetStoredTrade(f.ctx, 5)
+	assert.Assert(t, found == true)
+	assert.Assert(t, trade.Status == types.StatusProcessed)
+
+	supply = f.bankKeeper.GetSupply(f.ctx, types.DefaultDenom)
+
+	// Supply should not be changed
+	assert.Assert(t, supply.Amount.Int64() == 400000000)
+	assert.Assert(t, trade.Amount == nil)
+
+	// Trades 6 to 9, the other record only trade types
+	for i, tradeType := range []types.TradeType{
+		types.TradeTypeReverseSplit,
+		types.TradeTypeReinvestment,
+		types.TradeTypeDividends,
+		types.TradeTypeDividendsDeduction,
+	} {
+		tradeIndex := uint64(6 + i)
+		_, err = msgServer.CreateTrade(f.ctx, &types.MsgCreateTrade{
+			Creator:              testutil.Alice,
+			TradeData:            types.GetSampleTradeDataJson(tradeType),
+			BankingSystemData:    "{}",
+			CoinMintingPriceJson: types.GetSampleCoinMintingPriceJson(),
+			ExchangeRateJson:     types.GetSampleExchangeRateJson(),
+		})
+		assert.NilError(t, err)
+
+		_, err = msgServer.ProcessTrade(f.ctx, &types.MsgProcessTrade{
+			Creator:     testutil.Bob,
+			ProcessType: types.ProcessTypeConfirm,
+			TradeIndex:  tradeIndex,
+		})
+		assert.NilError(t, err)
+
+		trade, found = f.tradeKeeper.GetStoredTrade(f.ctx, tradeIndex)
+		assert.Assert(t, found == true)
+		assert.Assert(t, trade.Status == types.StatusProcessed)
+		assert.Assert(t, trade.TradeType == tradeType)
+	}
+
+	supply = f.bankKeeper.GetSupply(f.ctx, types.DefaultDenom)
+	assert.Assert(t, supply.Amount.Int64() == 400000000)
+
+	// The supply is reconciled with the processed trades
+	msg, broken := keeper.SupplyInvariant(*f.tradeKeeper)(f.ctx)
+	assert.Assert(t, !broken, msg)
 }
@@ -0,0 +1,89 @@
+package wasm_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/GGEZLabs/vvtxchain/app"
+	"github.com/GGEZLabs/vvtxchain/wasmbinding/bindings"
+	acltypes "github.com/GGEZLabs/vvtxchain/x/acl/types"
+	tradetypes "github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gotest.tools/v3/assert"
+)
+
+func executeCustom(ctx sdk.Context, vvtxApp *app.App, contractAddr sdk.AccAddress, msg bindings.VvtxchainMsg) error {
+	msgBz, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	contractKeeper := keeper.NewDefaultPermissionKeeper(&vvtxApp.WasmKeeper)
+	_, err = contractKeeper.Execute(ctx, contractAddr, contractAddr, msgBz, nil)
+	return err
+}
+
+func setAuthority(ctx sdk.Context, vvtxApp *app.App, address sdk.AccAddress, isMaker, isChecker bool) {
+	vvtxApp.AclKeeper.SetAclAuthority(ctx, acltypes.AclAuthority{
+		Address: address.String(),
+		Name:    "contract",
+		AccessDefinitions: []*acltypes.AccessDefinition{
+			{Module: tradetypes.ModuleName, IsMaker: isMaker, IsChecker: isChecker},
+		},
+	})
+}
+
+func createTradeMsg(receiver sdk.AccAddress) bindings.VvtxchainMsg {
+	return bindings.VvtxchainMsg{Trade: &bindings.TradeMsg{CreateTrade: &bindings.CreateTrade{
+		ReceiverAddress:      receiver.String(),
+		TradeData:            tradetypes.GetSampleTradeDataJson(tradetypes.TradeTypeFiatDeposit),
+		BankingSystemData:    "{}",
+		CoinMintingPriceJson: tradetypes.GetSampleCoinMintingPriceJson(),
+		ExchangeRateJson:     tradetypes.GetSampleExchangeRateJson(),
+		ExternalReference:    "treasury-1",
+	}}}
+}
+
+func TestCustomMsgCreateAndProcessTrade(t *testing.T) {
+	vvtxApp, ctx, maker := setupContract(t)
+	checker := instantiateContract(t, vvtxApp, ctx, 1)
+	setAuthority(ctx, vvtxApp, maker, true, false)
+	setAuthority(ctx, vvtxApp, checker, false, true)
+
+	err := executeCustom(ctx, vvtxApp, maker, createTradeMsg(maker))
+	assert.NilError(t, err)
+
+	storedTrade, found := vvtxApp.TradeKeeper.GetStoredTrade(ctx, 1)
+	assert.Assert(t, found)
+	assert.Equal(t, maker.String(), storedTrade.Maker)
+	assert.Equal(t, tradetypes.StatusPending, storedTrade.Status)
+	assert.Equal(t, "treasury-1", storedTrade.ExternalReference)
+
+	err = executeCustom(ctx, vvtxApp, maker, bindings.VvtxchainMsg{Trade: &bindings.TradeMsg{ProcessTrade: &bindings.ProcessTrade{
+		ProcessType: "confirm",
+		TradeIndex:  1,
+	}}})
+	assert.ErrorIs(t, err, tradetypes.ErrInvalidCheckerPermission)
+
+	err = executeCustom(ctx, vvtxApp, checker, bindings.VvtxchainMsg{Trade: &bindings.TradeMsg{ProcessTrade: &bindings.ProcessTrade{
+		ProcessType: "confirm",
+		TradeIndex:  1,
+	}}})
+	assert.NilError(t, err)
+
+	storedTrade, found = vvtxApp.TradeKeeper.GetStoredTrade(ctx, 1)
+	assert.Assert(t, found)
+	assert.Equal(t, checker.String(), storedTrade.Checker)
+	assert.Equal(t, tradetypes.StatusProcessed, storedTrade.Status)
+}
+
+func TestCustomMsgUnregisteredContract(t *testing.T) {
+	vvtxApp, ctx, contractAddr := setupContract(t)
+
+	err := executeCustom(ctx, vvtxApp, contractAddr, createTradeMsg(contractAddr))
+	assert.ErrorIs(t, err, acltypes.ErrAuthorityAddressDoesNotExist)
+
+	_, found := vvtxApp.TradeKeeper.GetStoredTrade(ctx, 1)
+	assert.Assert(t, !found)
+}
@@ -0,0 +1,185 @@
+package wasm_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/GGEZLabs/vvtxchain/app"
+	"github.com/GGEZLabs/vvtxchain/wasmbinding/bindings"
+	acltypes "github.com/GGEZLabs/vvtxchain/x/acl/types"
+	tradetypes "github.com/GGEZLabs/vvtxchain/x/trade/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gotest.tools/v3/assert"
+)
+
+func setupContract(t *testing.T) (*app.App, sdk.Context, sdk.AccAddress) {
+	t.Helper()
+
+	vvtxApp := app.Setup(t, false)
+	ctx := vvtxApp.NewUncachedContext(false, cmtproto.Header{Height: 2, Time: time.Now().UTC()})
+
+	wasmCode, err := os.ReadFile("../../../wasmbinding/testdata/custom_bindings.wasm")
+	assert.NilError(t, err)
+
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	contractKeeper := keeper.NewDefaultPermissionKeeper(&vvtxApp.WasmKeeper)
+
+	codeID, _, err := contractKeeper.Create(ctx, creator, wasmCode, nil)
+	assert.NilError(t, err)
+
+	return vvtxApp, ctx, instantiateContract(t, vvtxApp, ctx, codeID)
+}
+
+func instantiateContract(t *testing.T, vvtxApp *app.App, ctx sdk.Context, codeID uint64) sdk.AccAddress {
+	t.Helper()
+
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	contractKeeper := keeper.NewDefaultPermissionKeeper(&vvtxApp.WasmKeeper)
+
+	contractAddr, _, err := contractKeeper.Instantiate(ctx, codeID, creator, nil, []byte("{}"), "custom bindings", nil)
+	assert.NilError(t, err)
+
+	return contractAddr
+}
+
+func queryCustom(ctx sdk.Context, vvtxApp *app.App, contractAddr sdk.AccAddress, query bindings.VvtxchainQuery, res any) error {
+	customBz, err := json.Marshal(query)
+	if err != nil {
+		return err
+	}
+	request := map[string]json.RawMessage{"custom": customBz}
+	requestBz, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	resBz, err := vvtxApp.WasmKeeper.QuerySmart(ctx, contractAddr, requestBz)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(resBz, res)
+}
+
+func TestCustomQueryStoredTrade(t *testing.T) {
+	vvtxApp, ctx, contractAddr := setupContract(t)
+
+	amount := sdk.NewCoin("uvvtx", sdkmath.NewInt(1000))
+	vvtxApp.TradeKeeper.SetStoredTrade(ctx, tradetypes.StoredTrade{
+		TradeIndex:        1,
+		TradeType:         tradetypes.TradeTypeFiatDeposit,
+		Amount:            &amount,
+		ReceiverAddress:   contractAddr.String(),
+		Status:            tradetypes.StatusPending,
+		Maker:             contractAddr.String(),
+		CreateDate:        "2025-01-01T00:00:00Z",
+		ExternalReference: "ref-1",
+	})
+
+	for _, tc := range []struct {
+		desc  string
+		index uint64
+		exp   *bindings.StoredTrade
+	}{
+		{
+			desc:  "found",
+			index: 1,
+			exp: &bindings.StoredTrade{
+				TradeIndex:        1,
+				TradeType:         tradetypes.TradeTypeFiatDeposit.String(),
+				Amount:            &wasmvmtypes.Coin{Denom: "uvvtx", Amount: "1000"},
+				ReceiverAddress:   contractAddr.String(),
+				Status:            tradetypes.StatusPending.String(),
+				Maker:             contractAddr.String(),
+				CreateDate:        "2025-01-01T00:00:00Z",
+				ExternalReference: "ref-1",
+			},
+		},
+		{
+			desc:  "not found",
+			index: 2,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			var res bindings.StoredTradeResponse
+			err := queryCustom(ctx, vvtxApp, contractAddr, bindings.VvtxchainQuery{
+				Trade: &bindings.TradeQuery{StoredTrade: &bindings.StoredTradeQuery{Index: tc.index}},
+			}, &res)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tc.exp, res.StoredTrade)
+		})
+	}
+}
+
+func TestCustomQueryAcl(t *testing.T) {
+	vvtxApp, ctx, contractAddr := setupContract(t)
+
+	maker := contractAddr.String()
+	vvtxApp.AclKeeper.SetAclAuthority(ctx, acltypes.AclAuthority{
+		Address: maker,
+		Name:    "maker",
+		AccessDefinitions: []*acltypes.AccessDefinition{
+			{Module: tradetypes.ModuleName, IsMaker: true},
+		},
+	})
+
+	t.Run("authority", func(t *testing.T) {
+		var res bindings.AuthorityResponse
+		err := queryCustom(ctx, vvtxApp, contractAddr, bindings.VvtxchainQuery{
+			Acl: &bindings.AclQuery{Authority: &bindings.AuthorityQuery{Address: maker}},
+		}, &res)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, &bindings.AclAuthority{
+			Address:           maker,
+			Name:              "maker",
+			AccessDefinitions: []bindings.AccessDefinition{{Module: tradetypes.ModuleName, IsMaker: true}},
+		}, res.Authority)
+	})
+
+	t.Run("authority not found", func(t *testing.T) {
+		var res bindings.AuthorityResponse
+		err := queryCustom(ctx, vvtxApp, contractAddr, bindings.VvtxchainQuery{
+			Acl: &bindings.AclQuery{Authority: &bindings.AuthorityQuery{Address: "vvtx1unknown"}},
+		}, &res)
+		assert.NilError(t, err)
+		assert.Assert(t, res.Authority == nil)
+	})
+
+	for _, tc := range []struct {
+		desc      string
+		address   string
+		module    string
+		role      string
+		exp       bool
+		expErrMsg string
+	}{
+		{desc: "maker", address: maker, module: tradetypes.ModuleName, role: "maker", exp: true},
+		{desc: "checker", address: maker, module: tradetypes.ModuleName, role: "checker"},
+		{desc: "other module", address: maker, module: acltypes.ModuleName, role: "maker"},
+		{desc: "unknown address", address: "vvtx1unknown", module: tradetypes.ModuleName, role: "maker"},
+		{desc: "invalid role", address: maker, module: tradetypes.ModuleName, role: "admin", expErrMsg: "codespace: sdk, code: 18"},
+	} {
+		t.Run("has permission "+tc.desc, func(t *testing.T) {
+			var res bindings.HasPermissionResponse
+			err := queryCustom(ctx, vvtxApp, contractAddr, bindings.VvtxchainQuery{
+				Acl: &bindings.AclQuery{HasPermission: &bindings.HasPermissionQuery{
+					Address: tc.address,
+					Module:  tc.module,
+					Role:    tc.role,
+				}},
+			}, &res)
+			if tc.expErrMsg != "" {
+				assert.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, tc.exp, res.HasPermission)
+		})
+	}
+}
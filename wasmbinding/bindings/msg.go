@@ -0,0 +1,33 @@
+package bindings
+
+// VvtxchainMsg is the custom message a contract sends through
+// CosmosMsg::Custom. Exactly one field must be set.
+type VvtxchainMsg struct {
+	Trade *TradeMsg `json:"trade,omitempty"`
+}
+
+// TradeMsg contains the messages handled by the trade module. The contract
+// address is the creator of the resulting message.
+type TradeMsg struct {
+	CreateTrade  *CreateTrade  `json:"create_trade,omitempty"`
+	ProcessTrade *ProcessTrade `json:"process_trade,omitempty"`
+}
+
+// CreateTrade is encoded to MsgCreateTrade.
+type CreateTrade struct {
+	ReceiverAddress      string `json:"receiver_address"`
+	TradeData            string `json:"trade_data"`
+	BankingSystemData    string `json:"banking_system_data"`
+	CoinMintingPriceJson string `json:"coin_minting_price_json"`
+	ExchangeRateJson     string `json:"exchange_rate_json"`
+	CreateDate           string `json:"create_date,omitempty"`
+	ExternalReference    string `json:"external_reference,omitempty"`
+}
+
+// ProcessTrade is encoded to MsgProcessTrade. ProcessType is either
+// "confirm" or "reject".
+type ProcessTrade struct {
+	ProcessType      string `json:"process_type"`
+	TradeIndex       uint64 `json:"trade_index"`
+	ExpectedRevision uint64 `json:"expected_revision,omitempty"`
+}
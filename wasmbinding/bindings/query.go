@@ -0,0 +1,87 @@
+package bindings
+
+import wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+// VvtxchainQuery is the custom query a contract sends through
+// QueryRequest::Custom. Exactly one field must be set.
+type VvtxchainQuery struct {
+	Trade *TradeQuery `json:"trade,omitempty"`
+	Acl   *AclQuery   `json:"acl,omitempty"`
+}
+
+// TradeQuery contains the queries served by the trade module.
+type TradeQuery struct {
+	StoredTrade *StoredTradeQuery `json:"stored_trade,omitempty"`
+}
+
+// StoredTradeQuery returns the stored trade with the given index.
+type StoredTradeQuery struct {
+	Index uint64 `json:"index"`
+}
+
+// AclQuery contains the queries served by the acl module.
+type AclQuery struct {
+	Authority     *AuthorityQuery     `json:"authority,omitempty"`
+	HasPermission *HasPermissionQuery `json:"has_permission,omitempty"`
+}
+
+// AuthorityQuery returns the acl authority registered for the given address.
+type AuthorityQuery struct {
+	Address string `json:"address"`
+}
+
+// HasPermissionQuery reports whether the address holds the given role
+// ("maker" or "checker") on the given module.
+type HasPermissionQuery struct {
+	Address string `json:"address"`
+	Module  string `json:"module"`
+	Role    string `json:"role"`
+}
+
+// StoredTradeResponse is the response to StoredTradeQuery. StoredTrade is
+// null when no trade exists with the requested index.
+type StoredTradeResponse struct {
+	StoredTrade *StoredTrade `json:"stored_trade"`
+}
+
+// StoredTrade is the contract facing view of a stored trade.
+type StoredTrade struct {
+	TradeIndex        uint64            `json:"trade_index"`
+	TradeType         string            `json:"trade_type"`
+	Amount            *wasmvmtypes.Coin `json:"amount"`
+	ReceiverAddress   string            `json:"receiver_address"`
+	Status            string            `json:"status"`
+	Maker             string            `json:"maker"`
+	Checker           string            `json:"checker"`
+	CreateDate        string            `json:"create_date"`
+	UpdateDate        string            `json:"update_date"`
+	ProcessDate       string            `json:"process_date"`
+	Result            string            `json:"result"`
+	Revision          uint64            `json:"revision"`
+	ExternalReference string            `json:"external_reference"`
+}
+
+// AuthorityResponse is the response to AuthorityQuery. Authority is null when
+// the address is not registered in the acl module.
+type AuthorityResponse struct {
+	Authority *AclAuthority `json:"authority"`
+}
+
+// AclAuthority is the contract facing view of an acl authority.
+type AclAuthority struct {
+	Address           string             `json:"address"`
+	Name              string             `json:"name"`
+	AccessDefinitions []AccessDefinition `json:"access_definitions"`
+}
+
+// AccessDefinition is the contract facing view of a module access definition.
+type AccessDefinition struct {
+	Module    string `json:"module"`
+	IsMaker   bool   `json:"is_maker"`
+	IsChecker bool   `json:"is_checker"`
+}
+
+// HasPermissionResponse is the response to HasPermissionQuery.
+type HasPermissionResponse struct {
+	HasPermission bool `json:"has_permission"`
+}
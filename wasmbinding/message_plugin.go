@@ -0,0 +1,70 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/GGEZLabs/vvtxchain/wasmbinding/bindings"
+	tradetypes "github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ProcessTypeConfirm is the process_trade process type encoded to PROCESS_TYPE_CONFIRM.
+	ProcessTypeConfirm = "confirm"
+	// ProcessTypeReject is the process_trade process type encoded to PROCESS_TYPE_REJECT.
+	ProcessTypeReject = "reject"
+)
+
+// CustomMessageEncoder encodes a vvtxchain custom message to the matching sdk
+// messages, with the contract as creator. Permissions are checked when the
+// messages are executed, so the contract must be registered as an acl authority.
+func CustomMessageEncoder(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+	var customMsg bindings.VvtxchainMsg
+	if err := json.Unmarshal(msg, &customMsg); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	switch {
+	case customMsg.Trade != nil && customMsg.Trade.CreateTrade != nil:
+		return encodeCreateTrade(sender, customMsg.Trade.CreateTrade), nil
+	case customMsg.Trade != nil && customMsg.Trade.ProcessTrade != nil:
+		return encodeProcessTrade(sender, customMsg.Trade.ProcessTrade)
+	default:
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown vvtxchain message variant"}
+	}
+}
+
+func encodeCreateTrade(sender sdk.AccAddress, msg *bindings.CreateTrade) []sdk.Msg {
+	return []sdk.Msg{&tradetypes.MsgCreateTrade{
+		Creator:              sender.String(),
+		ReceiverAddress:      msg.ReceiverAddress,
+		TradeData:            msg.TradeData,
+		BankingSystemData:    msg.BankingSystemData,
+		CoinMintingPriceJson: msg.CoinMintingPriceJson,
+		ExchangeRateJson:     msg.ExchangeRateJson,
+		CreateDate:           msg.CreateDate,
+		ExternalReference:    msg.ExternalReference,
+	}}
+}
+
+func encodeProcessTrade(sender sdk.AccAddress, msg *bindings.ProcessTrade) ([]sdk.Msg, error) {
+	var processType tradetypes.ProcessType
+	switch msg.ProcessType {
+	case ProcessTypeConfirm:
+		processType = tradetypes.ProcessTypeConfirm
+	case ProcessTypeReject:
+		processType = tradetypes.ProcessTypeReject
+	default:
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid process type %q, expected %q or %q", msg.ProcessType, ProcessTypeConfirm, ProcessTypeReject)
+	}
+
+	return []sdk.Msg{&tradetypes.MsgProcessTrade{
+		Creator:          sender.String(),
+		ProcessType:      processType,
+		TradeIndex:       msg.TradeIndex,
+		ExpectedRevision: msg.ExpectedRevision,
+	}}, nil
+}
@@ -0,0 +1,80 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/GGEZLabs/vvtxchain/wasmbinding"
+	tradetypes "github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomMessageEncoder(t *testing.T) {
+	sender := sdk.AccAddress("contract____________")
+
+	for _, tc := range []struct {
+		desc      string
+		msg       string
+		exp       []sdk.Msg
+		expErrMsg string
+	}{
+		{
+			desc: "create trade",
+			msg:  `{"trade":{"create_trade":{"receiver_address":"receiver","trade_data":"{}","banking_system_data":"{}","coin_minting_price_json":"[]","exchange_rate_json":"[]","external_reference":"ref-1"}}}`,
+			exp: []sdk.Msg{&tradetypes.MsgCreateTrade{
+				Creator:              sender.String(),
+				ReceiverAddress:      "receiver",
+				TradeData:            "{}",
+				BankingSystemData:    "{}",
+				CoinMintingPriceJson: "[]",
+				ExchangeRateJson:     "[]",
+				ExternalReference:    "ref-1",
+			}},
+		},
+		{
+			desc: "confirm trade",
+			msg:  `{"trade":{"process_trade":{"process_type":"confirm","trade_index":3,"expected_revision":1}}}`,
+			exp: []sdk.Msg{&tradetypes.MsgProcessTrade{
+				Creator:          sender.String(),
+				ProcessType:      tradetypes.ProcessTypeConfirm,
+				TradeIndex:       3,
+				ExpectedRevision: 1,
+			}},
+		},
+		{
+			desc: "reject trade",
+			msg:  `{"trade":{"process_trade":{"process_type":"reject","trade_index":3}}}`,
+			exp: []sdk.Msg{&tradetypes.MsgProcessTrade{
+				Creator:     sender.String(),
+				ProcessType: tradetypes.ProcessTypeReject,
+				TradeIndex:  3,
+			}},
+		},
+		{
+			desc:      "invalid process type",
+			msg:       `{"trade":{"process_trade":{"process_type":"PROCESS_TYPE_CONFIRM","trade_index":3}}}`,
+			expErrMsg: `invalid process type "PROCESS_TYPE_CONFIRM"`,
+		},
+		{
+			desc:      "unknown variant",
+			msg:       `{"trade":{"cancel_trade":{"trade_index":3}}}`,
+			expErrMsg: "unknown vvtxchain message variant",
+		},
+		{
+			desc:      "invalid json",
+			msg:       `{"trade":`,
+			expErrMsg: "failed to unmarshal JSON bytes",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msgs, err := wasmbinding.CustomMessageEncoder(sender, json.RawMessage(tc.msg))
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, msgs)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package wasmbinding
+
+import (
+	"context"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/GGEZLabs/vvtxchain/wasmbinding/bindings"
+	acltypes "github.com/GGEZLabs/vvtxchain/x/acl/types"
+	tradetypes "github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// RoleMaker is the has_permission role matching the acl is_maker flag.
+	RoleMaker = "maker"
+	// RoleChecker is the has_permission role matching the acl is_checker flag.
+	RoleChecker = "checker"
+)
+
+// TradeKeeper defines the trade keeper methods used by the query plugin.
+type TradeKeeper interface {
+	GetStoredTrade(ctx context.Context, tradeIndex uint64) (val tradetypes.StoredTrade, found bool)
+}
+
+// AclKeeper defines the acl keeper methods used by the query plugin.
+type AclKeeper interface {
+	GetAclAuthority(ctx context.Context, address string) (val acltypes.AclAuthority, found bool)
+}
+
+// QueryPlugin serves the vvtxchain custom queries.
+type QueryPlugin struct {
+	tradeKeeper TradeKeeper
+	aclKeeper   AclKeeper
+}
+
+// NewQueryPlugin returns a new QueryPlugin.
+func NewQueryPlugin(tradeKeeper TradeKeeper, aclKeeper AclKeeper) *QueryPlugin {
+	return &QueryPlugin{
+		tradeKeeper: tradeKeeper,
+		aclKeeper:   aclKeeper,
+	}
+}
+
+// CustomQuerier dispatches a vvtxchain custom query to the matching handler.
+func CustomQuerier(qp *QueryPlugin) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query bindings.VvtxchainQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+
+		var (
+			res any
+			err error
+		)
+		switch {
+		case query.Trade != nil && query.Trade.StoredTrade != nil:
+			res = qp.StoredTrade(ctx, query.Trade.StoredTrade)
+		case query.Acl != nil && query.Acl.Authority != nil:
+			res = qp.Authority(ctx, query.Acl.Authority)
+		case query.Acl != nil && query.Acl.HasPermission != nil:
+			res, err = qp.HasPermission(ctx, query.Acl.HasPermission)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown vvtxchain query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		return bz, nil
+	}
+}
+
+// StoredTrade returns the stored trade with the requested index.
+func (qp QueryPlugin) StoredTrade(ctx sdk.Context, query *bindings.StoredTradeQuery) bindings.StoredTradeResponse {
+	storedTrade, found := qp.tradeKeeper.GetStoredTrade(ctx, query.Index)
+	if !found {
+		return bindings.StoredTradeResponse{}
+	}
+
+	res := &bindings.StoredTrade{
+		TradeIndex:        storedTrade.TradeIndex,
+		TradeType:         storedTrade.TradeType.String(),
+		ReceiverAddress:   storedTrade.ReceiverAddress,
+		Status:            storedTrade.Status.String(),
+		Maker:             storedTrade.Maker,
+		Checker:           storedTrade.Checker,
+		CreateDate:        storedTrade.CreateDate,
+		UpdateDate:        storedTrade.UpdateDate,
+		ProcessDate:       storedTrade.ProcessDate,
+		Result:            storedTrade.Result,
+		Revision:          storedTrade.Revision,
+		ExternalReference: storedTrade.ExternalReference,
+	}
+	if storedTrade.Amount != nil {
+		res.Amount = &wasmvmtypes.Coin{
+			Denom:  storedTrade.Amount.Denom,
+			Amount: storedTrade.Amount.Amount.String(),
+		}
+	}
+	return bindings.StoredTradeResponse{StoredTrade: res}
+}
+
+// Authority returns the acl authority registered for the requested address.
+func (qp QueryPlugin) Authority(ctx sdk.Context, query *bindings.AuthorityQuery) bindings.AuthorityResponse {
+	authority, found := qp.aclKeeper.GetAclAuthority(ctx, query.Address)
+	if !found {
+		return bindings.AuthorityResponse{}
+	}
+
+	res := &bindings.AclAuthority{
+		Address:           authority.Address,
+		Name:              authority.Name,
+		AccessDefinitions: make([]bindings.AccessDefinition, 0, len(authority.AccessDefinitions)),
+	}
+	for _, ad := range authority.AccessDefinitions {
+		res.AccessDefinitions = append(res.AccessDefinitions, bindings.AccessDefinition{
+			Module:    ad.Module,
+			IsMaker:   ad.IsMaker,
+			IsChecker: ad.IsChecker,
+		})
+	}
+	return bindings.AuthorityResponse{Authority: res}
+}
+
+// HasPermission reports whether the requested address holds the requested role
+// on the requested module. Unregistered addresses have no permission.
+func (qp QueryPlugin) HasPermission(ctx sdk.Context, query *bindings.HasPermissionQuery) (bindings.HasPermissionResponse, error) {
+	if query.Role != RoleMaker && query.Role != RoleChecker {
+		return bindings.HasPermissionResponse{}, sdkerrors.ErrInvalidRequest.Wrapf("invalid role %q, expected %q or %q", query.Role, RoleMaker, RoleChecker)
+	}
+
+	authority, found := qp.aclKeeper.GetAclAuthority(ctx, query.Address)
+	if !found {
+		return bindings.HasPermissionResponse{}, nil
+	}
+
+	for _, ad := range authority.AccessDefinitions {
+		if ad.Module != query.Module {
+			continue
+		}
+		if query.Role == RoleMaker {
+			return bindings.HasPermissionResponse{HasPermission: ad.IsMaker}, nil
+		}
+		return bindings.HasPermissionResponse{HasPermission: ad.IsChecker}, nil
+	}
+	return bindings.HasPermissionResponse{}, nil
+}
@@ -0,0 +1,92 @@
+package wasmbinding_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/GGEZLabs/vvtxchain/wasmbinding"
+	acltypes "github.com/GGEZLabs/vvtxchain/x/acl/types"
+	tradetypes "github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type tradeKeeper map[uint64]tradetypes.StoredTrade
+
+func (k tradeKeeper) GetStoredTrade(_ context.Context, tradeIndex uint64) (tradetypes.StoredTrade, bool) {
+	storedTrade, found := k[tradeIndex]
+	return storedTrade, found
+}
+
+type aclKeeper map[string]acltypes.AclAuthority
+
+func (k aclKeeper) GetAclAuthority(_ context.Context, address string) (acltypes.AclAuthority, bool) {
+	authority, found := k[address]
+	return authority, found
+}
+
+func TestCustomQuerier(t *testing.T) {
+	amount := sdk.NewCoin("uvvtx", sdkmath.NewInt(5))
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(
+		tradeKeeper{1: {TradeIndex: 1, Amount: &amount, Status: tradetypes.StatusProcessed}},
+		aclKeeper{"checker": {
+			Address:           "checker",
+			AccessDefinitions: []*acltypes.AccessDefinition{{Module: tradetypes.ModuleName, IsChecker: true}},
+		}},
+	))
+
+	for _, tc := range []struct {
+		desc      string
+		request   string
+		exp       string
+		expErrMsg string
+	}{
+		{
+			desc:    "stored trade",
+			request: `{"trade":{"stored_trade":{"index":1}}}`,
+			exp:     `{"stored_trade":{"trade_index":1,"trade_type":"TRADE_TYPE_UNSPECIFIED","amount":{"denom":"uvvtx","amount":"5"},"receiver_address":"","status":"TRADE_STATUS_PROCESSED","maker":"","checker":"","create_date":"","update_date":"","process_date":"","result":"","revision":0,"external_reference":""}}`,
+		},
+		{
+			desc:    "stored trade not found",
+			request: `{"trade":{"stored_trade":{"index":2}}}`,
+			exp:     `{"stored_trade":null}`,
+		},
+		{
+			desc:    "authority not found",
+			request: `{"acl":{"authority":{"address":"maker"}}}`,
+			exp:     `{"authority":null}`,
+		},
+		{
+			desc:    "has permission",
+			request: `{"acl":{"has_permission":{"address":"checker","module":"trade","role":"checker"}}}`,
+			exp:     `{"has_permission":true}`,
+		},
+		{
+			desc:      "invalid role",
+			request:   `{"acl":{"has_permission":{"address":"checker","module":"trade","role":"admin"}}}`,
+			expErrMsg: `invalid role "admin"`,
+		},
+		{
+			desc:      "unknown variant",
+			request:   `{"trade":{"trade_index":{}}}`,
+			expErrMsg: "unknown vvtxchain query variant",
+		},
+		{
+			desc:      "invalid json",
+			request:   `{"trade":`,
+			expErrMsg: "failed to unmarshal JSON bytes",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := querier(sdk.Context{}, json.RawMessage(tc.request))
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.JSONEq(t, tc.exp, string(res))
+		})
+	}
+}
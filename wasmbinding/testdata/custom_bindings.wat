@@ -0,0 +1,148 @@
+;; custom_bindings is a minimal CosmWasm contract used by the wasmbinding tests.
+;;
+;; The smart query message must be a serialized QueryRequest, for example
+;; {"custom":{"trade":{"stored_trade":{"index":1}}}}. The contract forwards it
+;; unchanged to query_chain and unwraps the returned SystemResult, so the
+;; contract result is the chain's ContractResult of the custom query.
+;;
+;; The execute message must be a serialized vvtxchain custom message, for
+;; example {"trade":{"process_trade":{...}}}. The contract returns it as the
+;; only sub message of its response.
+;;
+;; Assemble with: wat2wasm custom_bindings.wat -o custom_bindings.wasm
+(module
+  (type (;0;) (func (param i32) (result i32)))
+  (type (;1;) (func))
+  (type (;2;) (func (param i32)))
+  (type (;3;) (func (param i32 i32 i32) (result i32)))
+  (type (;4;) (func (param i32 i32) (result i32)))
+  (import "env" "query_chain" (func $query_chain (type 0)))
+  (func $interface_version_8 (type 1))
+  (func $requires_vvtxchain (type 1))
+  ;; allocate returns a new Region {offset, capacity, length} followed by
+  ;; capacity bytes. Memory is never reclaimed.
+  (func $allocate (type 0) (param $size i32) (result i32)
+    (local $region i32)
+    global.get $heap
+    local.set $region
+    local.get $region
+    local.get $region
+    i32.const 12
+    i32.add
+    i32.store
+    local.get $region
+    local.get $size
+    i32.store offset=4
+    local.get $region
+    i32.const 0
+    i32.store offset=8
+    local.get $region
+    i32.const 12
+    i32.add
+    local.get $size
+    i32.add
+    global.set $heap
+    local.get $region)
+  (func $deallocate (type 2) (param $region i32))
+  ;; instantiate returns the static empty response region.
+  (func $instantiate (type 3) (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    i32.const 16)
+  ;; query strips the {"ok": ... } SystemResult envelope from the query_chain
+  ;; response: 6 leading and 1 trailing bytes.
+  (func $query (type 4) (param $env i32) (param $msg i32) (result i32)
+    (local $response i32) (local $region i32)
+    local.get $msg
+    call $query_chain
+    local.set $response
+    i32.const 0
+    call $allocate
+    local.set $region
+    local.get $region
+    local.get $response
+    i32.load
+    i32.const 6
+    i32.add
+    i32.store
+    local.get $region
+    local.get $response
+    i32.load offset=8
+    i32.const 7
+    i32.sub
+    i32.store offset=4
+    local.get $region
+    local.get $response
+    i32.load offset=8
+    i32.const 7
+    i32.sub
+    i32.store offset=8
+    local.get $region)
+  ;; execute wraps the message between the static response prefix and suffix.
+  (func $execute (type 3) (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    (local $region i32) (local $length i32) (local $ptr i32)
+    local.get $msg
+    i32.load offset=8
+    i32.const 124
+    i32.add
+    local.set $length
+    local.get $length
+    call $allocate
+    local.set $region
+    local.get $region
+    i32.load
+    i32.const 128
+    i32.const 43
+    call $copy
+    local.get $msg
+    i32.load
+    local.get $msg
+    i32.load offset=8
+    call $copy
+    i32.const 192
+    i32.const 81
+    call $copy
+    drop
+    local.get $region
+    local.get $length
+    i32.store offset=8
+    local.get $region)
+  ;; copy copies length bytes from src to dst and returns the end of dst.
+  (func $copy (type 3) (param $dst i32) (param $src i32) (param $length i32) (result i32)
+    block
+      loop
+        local.get $length
+        i32.eqz
+        br_if 1
+        local.get $dst
+        local.get $src
+        i32.load8_u
+        i32.store8
+        local.get $dst
+        i32.const 1
+        i32.add
+        local.set $dst
+        local.get $src
+        i32.const 1
+        i32.add
+        local.set $src
+        local.get $length
+        i32.const 1
+        i32.sub
+        local.set $length
+        br 0
+      end
+    end
+    local.get $dst)
+  (memory (;0;) 16)
+  (global $heap (mut i32) (i32.const 1024))
+  (export "memory" (memory 0))
+  (export "interface_version_8" (func $interface_version_8))
+  (export "requires_vvtxchain" (func $requires_vvtxchain))
+  (export "allocate" (func $allocate))
+  (export "deallocate" (func $deallocate))
+  (export "instantiate" (func $instantiate))
+  (export "query" (func $query))
+  (export "execute" (func $execute))
+  (data (;0;) (i32.const 16) "\40\00\00\00\3e\00\00\00\3e\00\00\00")
+  (data (;1;) (i32.const 64) "{\22ok\22:{\22messages\22:[],\22attributes\22:[],\22events\22:[],\22data\22:null}}")
+  (data (;2;) (i32.const 128) "{\22ok\22:{\22messages\22:[{\22id\22:0,\22msg\22:{\22custom\22:")
+  (data (;3;) (i32.const 192) "},\22gas_limit\22:null,\22reply_on\22:\22never\22}],\22attributes\22:[],\22events\22:[],\22data\22:null}}"))
@@ -0,0 +1,28 @@
+package wasmbinding
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+)
+
+// Capability is the capability a contract requires, by exporting a
+// requires_vvtxchain function, to use the vvtxchain custom queries and messages.
+const Capability = "vvtxchain"
+
+// RegisterCustomPlugins returns the wasm keeper options that register the
+// vvtxchain custom query plugin and custom message encoder.
+func RegisterCustomPlugins(tradeKeeper TradeKeeper, aclKeeper AclKeeper) []wasmkeeper.Option {
+	queryPlugin := NewQueryPlugin(tradeKeeper, aclKeeper)
+
+	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+		Custom: CustomQuerier(queryPlugin),
+	})
+
+	messageEncoderOpt := wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{
+		Custom: CustomMessageEncoder,
+	})
+
+	return []wasmkeeper.Option{
+		queryPluginOpt,
+		messageEncoderOpt,
+	}
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/GGEZLabs/vvtxchain/testutil/sample"
+	"github.com/GGEZLabs/vvtxchain/x/acl/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+)
+
+// requireTypedEvent decodes the last typed event of the type of the expected event
+func requireTypedEvent(t *testing.T, ctx sdk.Context, expected proto.Message) {
+	t.Helper()
+
+	var last proto.Message
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != proto.MessageName(expected) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		require.NoError(t, err)
+		last = msg
+	}
+	require.NotNil(t, last, "no %s event", proto.MessageName(expected))
+	require.Equal(t, expected, last)
+}
+
+func TestTypedEvents(t *testing.T) {
+	_, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+	superAdmin := sample.AccAddress()
+	newSuperAdmin := sample.AccAddress()
+	admin := sample.AccAddress()
+	alice := sample.AccAddress()
+
+	_, err := ms.Init(wctx, &types.MsgInit{Creator: superAdmin, SuperAdmin: superAdmin})
+	require.NoError(t, err)
+	requireTypedEvent(t, wctx, &types.EventSuperAdminChanged{SuperAdmin: superAdmin})
+
+	_, err = ms.AddAdmin(wctx, &types.MsgAddAdmin{Creator: superAdmin, Admins: []string{admin}})
+	require.NoError(t, err)
+	requireTypedEvent(t, wctx, &types.EventAdminsAdded{Admins: []string{admin}})
+
+	_, err = ms.AddAuthority(wctx, &types.MsgAddAuthority{
+		Creator:           admin,
+		AuthAddress:       alice,
+		Name:              "Alice",
+		AccessDefinitions: `[{"module":"trade","is_maker":true,"is_checker":false}]`,
+	})
+	require.NoError(t, err)
+	requireTypedEvent(t, wctx, &types.EventAuthorityAdded{
+		Address:           alice,
+		Name:              "Alice",
+		AccessDefinitions: []*types.AccessDefinition{{Module: "trade", IsMaker: true}},
+	})
+
+	_, err = ms.DeleteAuthority(wctx, &types.MsgDeleteAuthority{Creator: admin, AuthAddress: alice})
+	require.NoError(t, err)
+	requireTypedEvent(t, wctx, &types.EventAuthorityDeleted{Address: alice})
+
+	_, err = ms.UpdateSuperAdmin(wctx, &types.MsgUpdateSuperAdmin{Creator: superAdmin, NewSuperAdmin: newSuperAdmin})
+	require.NoError(t, err)
+	requireTypedEvent(t, wctx, &types.EventSuperAdminChanged{PreviousSuperAdmin: superAdmin, SuperAdmin: newSuperAdmin})
+}
@@ -29,6 +29,11 @@ func (k msgServer) AddAdmin(goCtx context.Context, msg *types.MsgAddAdmin) (*typ
 			sdk.NewAttribute(types.AttributeKeyAdmins, strings.Join(msg.Admins, ",")),
 		),
 	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAdminsAdded{
+		Admins: msg.Admins,
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgAddAdminResponse{}, nil
 }
@@ -40,5 +40,12 @@ func (k msgServer) AddAuthority(goCtx context.Context, msg *types.MsgAddAuthorit
 			sdk.NewAttribute(types.AttributeKeyAccessDefinitions, aclAuthority.AccessDefinitionsJson()),
 		),
 	)
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventAuthorityAdded{
+		Address:           aclAuthority.Address,
+		Name:              aclAuthority.Name,
+		AccessDefinitions: aclAuthority.AccessDefinitions,
+	}); err != nil {
+		return nil, err
+	}
 	return &types.MsgAddAuthorityResponse{}, nil
 }
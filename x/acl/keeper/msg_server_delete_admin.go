@@ -28,5 +28,10 @@ func (k msgServer) DeleteAdmin(goCtx context.Context, msg *types.MsgDeleteAdmin)
 			sdk.NewAttribute(types.AttributeKeyAdmins, strings.Join(msg.Admins, ",")),
 		),
 	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAdminsDeleted{
+		Admins: msg.Admins,
+	}); err != nil {
+		return nil, err
+	}
 	return &types.MsgDeleteAdminResponse{}, nil
 }
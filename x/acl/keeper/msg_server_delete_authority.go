@@ -27,6 +27,11 @@ func (k msgServer) DeleteAuthority(goCtx context.Context, msg *types.MsgDeleteAu
 			sdk.NewAttribute(types.AttributeKeyAuthorityAddress, msg.AuthAddress),
 		),
 	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAuthorityDeleted{
+		Address: msg.AuthAddress,
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgDeleteAuthorityResponse{}, nil
 }
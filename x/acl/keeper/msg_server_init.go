@@ -24,5 +24,10 @@ func (k msgServer) Init(goCtx context.Context, msg *types.MsgInit) (*types.MsgIn
 			sdk.NewAttribute(types.AttributeKeySuperAdmin, msg.SuperAdmin),
 		),
 	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSuperAdminChanged{
+		SuperAdmin: msg.SuperAdmin,
+	}); err != nil {
+		return nil, err
+	}
 	return &types.MsgInitResponse{}, nil
 }
@@ -79,6 +79,13 @@ func (k msgServer) UpdateAuthority(goCtx context.Context, msg *types.MsgUpdateAu
 			sdk.NewAttribute(types.AttributeKeyAccessDefinitions, aclAuthority.AccessDefinitionsJson()),
 		),
 	)
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventAuthorityUpdated{
+		Address:           aclAuthority.Address,
+		Name:              aclAuthority.Name,
+		AccessDefinitions: aclAuthority.AccessDefinitions,
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgUpdateAuthorityResponse{}, nil
 }
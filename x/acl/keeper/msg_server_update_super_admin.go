@@ -14,6 +14,7 @@ func (k msgServer) UpdateSuperAdmin(goCtx context.Context, msg *types.MsgUpdateS
 		return nil, types.ErrUnauthorized
 	}
 
+	previousSuperAdmin, _ := k.GetSuperAdmin(ctx)
 	k.SetSuperAdmin(ctx, types.SuperAdmin{Admin: msg.NewSuperAdmin})
 
 	ctx.EventManager().EmitEvent(
@@ -22,5 +23,11 @@ func (k msgServer) UpdateSuperAdmin(goCtx context.Context, msg *types.MsgUpdateS
 			sdk.NewAttribute(types.AttributeKeySuperAdmin, msg.NewSuperAdmin),
 		),
 	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSuperAdminChanged{
+		PreviousSuperAdmin: previousSuperAdmin.Admin,
+		SuperAdmin:         msg.NewSuperAdmin,
+	}); err != nil {
+		return nil, err
+	}
 	return &types.MsgUpdateSuperAdminResponse{}, nil
 }
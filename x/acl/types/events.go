@@ -1,6 +1,7 @@
 package types
 
-// acl module event types
+// acl module event types. The untyped events are deprecated in favor of the typed events of
+// events.proto, both are emitted until the untyped events are removed.
 const (
 	EventTypeAddAuthority     = "add_authority"
 	EventTypeDeleteAuthority  = "delete_authority"
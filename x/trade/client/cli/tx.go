@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// GetTxCmd returns the custom transaction commands of the module, the other
+// commands are generated by autocli.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdCreateTrade())
+	cmd.AddCommand(CmdCreateTradeV2())
+	cmd.AddCommand(CmdCreateTrades())
+
+	return cmd
+}
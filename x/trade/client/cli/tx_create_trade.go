@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+const FlagFromFile = "from-file"
+
+// tradeDocument is a trade read from a file. The payloads are JSON values, or JSON strings
+// holding the payloads as given to the positional arguments of create-trade.
+type tradeDocument struct {
+	ReceiverAddress   string          `json:"receiver_address"`
+	TradeData         json.RawMessage `json:"trade_data"`
+	BankingSystemData json.RawMessage `json:"banking_system_data"`
+	CoinMintingPrices json.RawMessage `json:"coin_minting_prices"`
+	ExchangeRates     json.RawMessage `json:"exchange_rates"`
+	CreateDate        string          `json:"create_date"`
+	ExternalReference string          `json:"external_reference"`
+}
+
+// CmdCreateTrade creates a trade from JSON string arguments, or from a trade document file
+// with the --from-file flag.
+func CmdCreateTrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-trade [trade-data] [banking-system-data] [coin-minting-price-json] [exchange-rate-json] [receiver-address]",
+		Short: "Create the StoredTrade. Must have authority to do so.",
+		Example: `create-trade --from-file trade.json
+where trade.json holds all the sections of the trade:
+{"receiver_address":"vvtx1...","trade_data":{"trade_info":{...},"brokerage":{...}},"banking_system_data":{},` +
+			`"coin_minting_prices":[{"currency_code":"GBP","minting_price":"0.001"}],"exchange_rates":[...],"external_reference":"WIRE-1"}`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if fromFile, _ := cmd.Flags().GetString(FlagFromFile); fromFile != "" {
+				if len(args) > 0 {
+					return fmt.Errorf("the trade is read from %s, no arguments are accepted with --%s", fromFile, FlagFromFile)
+				}
+				return nil
+			}
+			return cobra.RangeArgs(4, 5)(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var msg *types.MsgCreateTrade
+			if fromFile, _ := cmd.Flags().GetString(FlagFromFile); fromFile != "" {
+				doc, err := readTradeDocument(fromFile)
+				if err != nil {
+					return err
+				}
+				if msg, err = doc.toMsgCreateTrade(clientCtx.GetFromAddress().String()); err != nil {
+					return err
+				}
+			} else {
+				var receiverAddress string
+				if len(args) > 4 {
+					receiverAddress = args[4]
+				}
+				msg = types.NewMsgCreateTrade(clientCtx.GetFromAddress().String(), receiverAddress, args[0], args[1], args[2], args[3])
+			}
+
+			if err := setCreateTradeFlags(cmd, msg); err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFromFile, "", "Read the trade from a JSON document holding all its sections instead of the arguments")
+	cmd.Flags().String(FlagCreateDate, "", "Set a create date. Default is current date")
+	cmd.Flags().String(FlagExternalReference, "", "Set the reference of the trade in the banking system, used to reject duplicate trades")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// setCreateTradeFlags sets the create date and external reference of the flags given to the
+// command, the flags override the values of the trade document
+func setCreateTradeFlags(cmd *cobra.Command, msg *types.MsgCreateTrade) (err error) {
+	if cmd.Flags().Changed(FlagCreateDate) {
+		if msg.CreateDate, err = cmd.Flags().GetString(FlagCreateDate); err != nil {
+			return err
+		}
+	}
+	if cmd.Flags().Changed(FlagExternalReference) {
+		if msg.ExternalReference, err = cmd.Flags().GetString(FlagExternalReference); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readTradeDocument reads a trade document, unknown fields are rejected
+func readTradeDocument(path string) (tradeDocument, error) {
+	var doc tradeDocument
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return doc, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(contents))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&doc); err != nil {
+		return doc, fmt.Errorf("trade file must hold a json object with the sections of the trade: %w", err)
+	}
+	return doc, nil
+}
+
+// toMsgCreateTrade returns the MsgCreateTrade of the document
+func (doc tradeDocument) toMsgCreateTrade(creator string) (*types.MsgCreateTrade, error) {
+	payloads := make([]string, 4)
+	for i, section := range []struct {
+		name  string
+		value json.RawMessage
+	}{
+		{"trade_data", doc.TradeData},
+		{"banking_system_data", doc.BankingSystemData},
+		{"coin_minting_prices", doc.CoinMintingPrices},
+		{"exchange_rates", doc.ExchangeRates},
+	} {
+		payload, err := payloadString(section.value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", section.name, err)
+		}
+		payloads[i] = payload
+	}
+
+	msg := types.NewMsgCreateTrade(creator, doc.ReceiverAddress, payloads[0], payloads[1], payloads[2], payloads[3])
+	msg.CreateDate = doc.CreateDate
+	msg.ExternalReference = doc.ExternalReference
+	return msg, nil
+}
+
+// payloadString returns a payload of a trade document as a JSON string. A payload given as
+// a JSON string is returned unquoted, a missing payload is returned empty.
+func payloadString(value json.RawMessage) (string, error) {
+	value = bytes.TrimSpace(value)
+	if len(value) == 0 || bytes.Equal(value, []byte("null")) {
+		return "", nil
+	}
+
+	if value[0] == '"' {
+		var payload string
+		if err := json.Unmarshal(value, &payload); err != nil {
+			return "", err
+		}
+		return payload, nil
+	}
+
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, value); err != nil {
+		return "", err
+	}
+	return compacted.String(), nil
+}
@@ -0,0 +1,238 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/GGEZLabs/vvtxchain/x/trade/testutil"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTradeDocument writes the contents of a trade document to a temporary file and returns its path
+func writeTradeDocument(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "trade.json")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
+
+// sampleTradeDocument returns a trade document of a deposit with the payloads as JSON values
+func sampleTradeDocument() string {
+	return `{
+		"receiver_address": "` + testutil.Alice + `",
+		"trade_data": ` + types.GetSampleTradeDataJson(types.TradeTypeFiatDeposit) + `,
+		"banking_system_data": {"bank": "sample"},
+		"coin_minting_prices": ` + types.GetSampleCoinMintingPriceJson() + `,
+		"exchange_rates": ` + types.GetSampleExchangeRateJson() + `,
+		"create_date": "2023-05-11T08:44:00Z",
+		"external_reference": "WIRE-1"
+	}`
+}
+
+func TestPayloadString(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		value   string
+		payload string
+		err     bool
+	}{
+		{
+			name:    "missing payload",
+			value:   "",
+			payload: "",
+		},
+		{
+			name:    "null payload",
+			value:   " null ",
+			payload: "",
+		},
+		{
+			name:    "object payload is compacted",
+			value:   "{ \"bank\" : \"sample\",\n \"values\": [1, 2] }",
+			payload: `{"bank":"sample","values":[1,2]}`,
+		},
+		{
+			name:    "array payload is compacted",
+			value:   `[ {"currency_code": "GBP"} ]`,
+			payload: `[{"currency_code":"GBP"}]`,
+		},
+		{
+			name:    "string payload is unquoted",
+			value:   `"{\"bank\": \"sample\"}"`,
+			payload: `{"bank": "sample"}`,
+		},
+		{
+			name:  "invalid string payload",
+			value: `"{\"bank\"`,
+			err:   true,
+		},
+		{
+			name:  "invalid object payload",
+			value: `{"bank":`,
+			err:   true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			payload, err := payloadString(json.RawMessage(tc.value))
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.payload, payload)
+		})
+	}
+}
+
+func TestReadTradeDocument(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		contents string
+		err      string
+	}{
+		{
+			name:     "payloads as json values",
+			contents: sampleTradeDocument(),
+		},
+		{
+			name: "payloads as json strings",
+			contents: `{
+				"receiver_address": "` + testutil.Alice + `",
+				"trade_data": ` + quote(t, types.GetSampleTradeDataJson(types.TradeTypeFiatDeposit)) + `,
+				"banking_system_data": "{}",
+				"coin_minting_prices": ` + quote(t, types.GetSampleCoinMintingPriceJson()) + `,
+				"exchange_rates": ` + quote(t, types.GetSampleExchangeRateJson()) + `
+			}`,
+		},
+		{
+			name:     "missing sections",
+			contents: `{"trade_data": {}}`,
+		},
+		{
+			name:     "unknown field",
+			contents: `{"trade_data": {}, "coin_minting_price_json": []}`,
+			err:      `unknown field "coin_minting_price_json"`,
+		},
+		{
+			name:     "not an object",
+			contents: `[{"trade_data": {}}]`,
+			err:      "trade file must hold a json object with the sections of the trade",
+		},
+		{
+			name:     "invalid json",
+			contents: `{"trade_data": `,
+			err:      "trade file must hold a json object with the sections of the trade",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := readTradeDocument(writeTradeDocument(t, tc.contents))
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	_, err := readTradeDocument(filepath.Join(t.TempDir(), "missing.json"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestTradeDocumentToMsgCreateTrade(t *testing.T) {
+	doc, err := readTradeDocument(writeTradeDocument(t, sampleTradeDocument()))
+	require.NoError(t, err)
+
+	msg, err := doc.toMsgCreateTrade(testutil.Alice)
+	require.NoError(t, err)
+
+	expected := types.NewMsgCreateTrade(
+		testutil.Alice,
+		testutil.Alice,
+		compact(t, types.GetSampleTradeDataJson(types.TradeTypeFiatDeposit)),
+		`{"bank":"sample"}`,
+		compact(t, types.GetSampleCoinMintingPriceJson()),
+		compact(t, types.GetSampleExchangeRateJson()),
+	)
+	expected.CreateDate = "2023-05-11T08:44:00Z"
+	expected.ExternalReference = "WIRE-1"
+	require.Equal(t, expected, msg)
+
+	// a null section is an empty payload
+	doc, err = readTradeDocument(writeTradeDocument(t, `{"trade_data": "{}", "banking_system_data": null}`))
+	require.NoError(t, err)
+	msg, err = doc.toMsgCreateTrade(testutil.Alice)
+	require.NoError(t, err)
+	require.Equal(t, types.NewMsgCreateTrade(testutil.Alice, "", "{}", "", "", ""), msg)
+
+	// the error names the section of the payload
+	doc = tradeDocument{ExchangeRates: json.RawMessage(`"[`)}
+	_, err = doc.toMsgCreateTrade(testutil.Alice)
+	require.ErrorContains(t, err, "exchange_rates: ")
+}
+
+func TestSetCreateTradeFlags(t *testing.T) {
+	for _, tc := range []struct {
+		name              string
+		flags             map[string]string
+		createDate        string
+		externalReference string
+	}{
+		{
+			name:              "no flag keeps the values of the document",
+			createDate:        "2023-05-11T08:44:00Z",
+			externalReference: "WIRE-1",
+		},
+		{
+			name:              "create date flag",
+			flags:             map[string]string{FlagCreateDate: "2023-05-12T10:00:00Z"},
+			createDate:        "2023-05-12T10:00:00Z",
+			externalReference: "WIRE-1",
+		},
+		{
+			name:              "external reference flag",
+			flags:             map[string]string{FlagExternalReference: "WIRE-2"},
+			createDate:        "2023-05-11T08:44:00Z",
+			externalReference: "WIRE-2",
+		},
+		{
+			name:              "flags set to empty values",
+			flags:             map[string]string{FlagCreateDate: "", FlagExternalReference: ""},
+			createDate:        "",
+			externalReference: "",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := CmdCreateTrade()
+			for name, value := range tc.flags {
+				require.NoError(t, cmd.Flags().Set(name, value))
+			}
+
+			msg := types.GetSampleMsgCreateTrade()
+			msg.CreateDate = "2023-05-11T08:44:00Z"
+			msg.ExternalReference = "WIRE-1"
+
+			require.NoError(t, setCreateTradeFlags(cmd, msg))
+			require.Equal(t, tc.createDate, msg.CreateDate)
+			require.Equal(t, tc.externalReference, msg.ExternalReference)
+		})
+	}
+}
+
+// quote returns a payload as a JSON string
+func quote(t *testing.T, payload string) string {
+	t.Helper()
+	quoted, err := json.Marshal(payload)
+	require.NoError(t, err)
+	return string(quoted)
+}
+
+// compact returns a payload without insignificant whitespace
+func compact(t *testing.T, payload string) string {
+	t.Helper()
+	compacted, err := payloadString(json.RawMessage(payload))
+	require.NoError(t, err)
+	return compacted
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagCoinMintingPrices = "coin-minting-prices"
+	FlagExchangeRates     = "exchange-rates"
+	FlagCreateDate        = "create-date"
+	FlagExternalReference = "external-reference"
+)
+
+// CmdCreateTradeV2 creates a trade from typed payloads. The payloads are given as JSON and
+// their decimals can be decimal strings or JSON numbers.
+func CmdCreateTradeV2() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-trade-v2 [trade-data] [banking-system-data] [receiver-address]",
+		Short: "Create the StoredTrade from typed payloads. Must have authority to do so.",
+		Example: `create-trade-v2 '{"trade_info":{...},"brokerage":{...}}' '{}' [receiver-address] ` +
+			`--coin-minting-prices '[{"currency_code":"GBP","minting_price":"0.001"}]' ` +
+			`--exchange-rates '[{"from_currency":"GBP","to_currency":"EUR",...}]'`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tradeData, err := types.ParseTradeData(args[0])
+			if err != nil {
+				return err
+			}
+
+			var receiverAddress string
+			if len(args) > 2 {
+				receiverAddress = args[2]
+			}
+
+			msg := types.NewMsgCreateTradeV2(
+				clientCtx.GetFromAddress().String(),
+				receiverAddress,
+				tradeData,
+				args[1],
+				nil,
+				nil,
+			)
+
+			if coinMintingPrices, _ := cmd.Flags().GetString(FlagCoinMintingPrices); coinMintingPrices != "" {
+				if msg.CoinMintingPrices, err = types.ParseCoinMintingPriceJson(coinMintingPrices); err != nil {
+					return err
+				}
+			}
+
+			if exchangeRates, _ := cmd.Flags().GetString(FlagExchangeRates); exchangeRates != "" {
+				if msg.ExchangeRates, err = types.ParseExchangeRateJson(exchangeRates); err != nil {
+					return err
+				}
+			}
+
+			if msg.CreateDate, err = cmd.Flags().GetString(FlagCreateDate); err != nil {
+				return err
+			}
+
+			if msg.ExternalReference, err = cmd.Flags().GetString(FlagExternalReference); err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagCoinMintingPrices, "", "Set the coin minting price json array")
+	cmd.Flags().String(FlagExchangeRates, "", "Set the exchange rate json array")
+	cmd.Flags().String(FlagCreateDate, "", "Set a create date. Default is current date")
+	cmd.Flags().String(FlagExternalReference, "", "Set the reference of the trade in the banking system, used to reject duplicate trades")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
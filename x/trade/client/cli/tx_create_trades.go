@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+const FlagMode = "mode"
+
+// CmdCreateTrades creates a batch of trades from a JSON file holding an array of
+// CreateTradeEntry objects.
+func CmdCreateTrades() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-trades [entries-file]",
+		Short: "Create a batch of StoredTrades from a JSON file. Must have authority to do so.",
+		Example: `create-trades entries.json --mode best-effort
+where entries.json holds an array of entries:
+[{"receiver_address":"vvtx1...","trade_data":"{...}","banking_system_data":"{}",` +
+			`"coin_minting_price_json":"[...]","exchange_rate_json":"[...]","external_reference":"WIRE-1"}]`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			entries, err := readCreateTradeEntries(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			modeFlag, err := cmd.Flags().GetString(FlagMode)
+			if err != nil {
+				return err
+			}
+			mode, err := parseBatchMode(modeFlag)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateTrades(clientCtx.GetFromAddress().String(), entries, mode)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMode, "all-or-nothing", "Set the batch mode, one of: all-or-nothing, best-effort")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func readCreateTradeEntries(clientCtx client.Context, path string) ([]types.CreateTradeEntry, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawEntries []json.RawMessage
+	if err := json.Unmarshal(contents, &rawEntries); err != nil {
+		return nil, fmt.Errorf("entries file must hold a json array: %w", err)
+	}
+
+	entries := make([]types.CreateTradeEntry, len(rawEntries))
+	for i, rawEntry := range rawEntries {
+		if err := clientCtx.Codec.UnmarshalJSON(rawEntry, &entries[i]); err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+	}
+	return entries, nil
+}
+
+// parseBatchMode accepts the batch mode in the same form as the generated commands,
+// e.g. "best-effort" for BATCH_MODE_BEST_EFFORT.
+func parseBatchMode(mode string) (types.BatchMode, error) {
+	name := "BATCH_MODE_" + strings.ToUpper(strings.ReplaceAll(mode, "-", "_"))
+	value, ok := types.BatchMode_value[name]
+	if !ok || types.BatchMode(value) == types.BatchModeNil {
+		return types.BatchModeNil, fmt.Errorf("invalid batch mode %q, expected one of: all-or-nothing, best-effort", mode)
+	}
+	return types.BatchMode(value), nil
+}
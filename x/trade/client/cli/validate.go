@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+)
+
+const FlagParamsFile = "params-file"
+
+// errInvalidTradeDocument is returned by the validate command after the errors are printed,
+// so it exits with a non zero code
+var errInvalidTradeDocument = errors.New("trade document is invalid")
+
+// ValidationError is an error of a section of a trade document
+type ValidationError struct {
+	Section   string `json:"section"`
+	Codespace string `json:"codespace"`
+	Code      uint32 `json:"code"`
+	Error     string `json:"error"`
+}
+
+// ValidationResult is the result of the validation of a trade document
+type ValidationResult struct {
+	Valid  bool              `json:"valid"`
+	Errors []ValidationError `json:"errors"`
+}
+
+// GetTradeCmd returns the trade commands that run without a node, added to the root command.
+func GetTradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s offline subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdValidateTrade())
+
+	return cmd
+}
+
+// CmdValidateTrade validates the payloads of a trade document locally, with the checks the
+// chain runs when the trade is created.
+func CmdValidateTrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate [trade-file]",
+		Short: "Validate the trade data, coin minting prices and exchange rates of a trade document without broadcasting it",
+		Long: `Validate the trade data, coin minting prices and exchange rates of a trade document, the file
+given to create-trade --from-file. The trade data is validated against the default params of the
+module, or against the params of the --params-file flag, a file holding the output of:
+vvtxchaind query trade params --output json`,
+		Example: `validate trade.json --params-file params.json`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			doc, err := readTradeDocument(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.DefaultParams()
+			if paramsFile, _ := cmd.Flags().GetString(FlagParamsFile); paramsFile != "" {
+				if params, err = readParams(clientCtx.Codec, paramsFile); err != nil {
+					return err
+				}
+			}
+
+			// the output format of the client config defaults to text
+			if !cmd.Flags().Changed(flags.FlagOutput) {
+				clientCtx = clientCtx.WithOutputFormat(flags.OutputFormatJSON)
+			}
+
+			result := validateTradeDocument(doc, params)
+			if err := clientCtx.PrintObjectLegacy(result); err != nil {
+				return err
+			}
+			if !result.Valid {
+				cmd.SilenceUsage = true
+				return errInvalidTradeDocument
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagParamsFile, "", "Read the params of the module from the output of the params query")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatJSON, "Output format (text|json)")
+
+	return cmd
+}
+
+// readParams reads the params from the JSON output of the params query
+func readParams(cdc codec.JSONCodec, path string) (types.Params, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return types.Params{}, err
+	}
+
+	var res types.QueryParamsResponse
+	if err := cdc.UnmarshalJSON(contents, &res); err != nil {
+		return types.Params{}, fmt.Errorf("params file must hold the output of the params query: %w", err)
+	}
+	if err := res.Params.Validate(); err != nil {
+		return types.Params{}, fmt.Errorf("invalid params: %w", err)
+	}
+	return res.Params, nil
+}
+
+// validateTradeDocument runs the validation of each payload of the document and collects
+// their errors
+func validateTradeDocument(doc tradeDocument, params types.Params) ValidationResult {
+	result := ValidationResult{Errors: []ValidationError{}}
+	for _, section := range []struct {
+		name     string
+		value    []byte
+		validate func(string) error
+	}{
+		{"trade_data", doc.TradeData, func(payload string) error {
+			_, err := types.ValidateTradeData(payload, params)
+			return err
+		}},
+		{"coin_minting_prices", doc.CoinMintingPrices, types.ValidateCoinMintingPriceJson},
+		{"exchange_rates", doc.ExchangeRates, types.ValidateExchangeRateJson},
+	} {
+		payload, err := payloadString(section.value)
+		if err == nil {
+			err = section.validate(payload)
+		}
+		if err != nil {
+			codespace, code, _ := errorsmod.ABCIInfo(err, false)
+			result.Errors = append(result.Errors, ValidationError{
+				Section:   section.name,
+				Codespace: codespace,
+				Code:      code,
+				Error:     err.Error(),
+			})
+		}
+	}
+
+	result.Valid = len(result.Errors) == 0
+	return result
+}
@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTradeDocument(t *testing.T) {
+	withoutMintableDenoms := types.DefaultParams()
+	withoutMintableDenoms.MintableDenoms = nil
+
+	for _, tc := range []struct {
+		name   string
+		doc    tradeDocument
+		params types.Params
+		errors []ValidationError
+	}{
+		{
+			name:   "valid document",
+			doc:    sampleDocument(t),
+			params: types.DefaultParams(),
+			errors: []ValidationError{},
+		},
+		{
+			name: "invalid payloads",
+			doc: func() tradeDocument {
+				doc := sampleDocument(t)
+				doc.TradeData = json.RawMessage(`{"trade_info":{}}`)
+				doc.CoinMintingPrices = json.RawMessage(`[{"currency_code":"GBP","minting_price":"0"}]`)
+				doc.ExchangeRates = json.RawMessage(`"[{\"from_currency\":\"\"}]"`)
+				return doc
+			}(),
+			params: types.DefaultParams(),
+			errors: []ValidationError{
+				{Section: "trade_data", Codespace: types.ModuleName, Code: types.ErrInvalidTradeData.ABCICode()},
+				{Section: "coin_minting_prices", Codespace: types.ModuleName, Code: types.ErrInvalidCoinMintingPriceJson.ABCICode()},
+				{Section: "exchange_rates", Codespace: types.ModuleName, Code: types.ErrInvalidExchangeRateJson.ABCICode()},
+			},
+		},
+		{
+			name: "missing trade data",
+			doc: func() tradeDocument {
+				doc := sampleDocument(t)
+				doc.TradeData = nil
+				return doc
+			}(),
+			params: types.DefaultParams(),
+			errors: []ValidationError{
+				{Section: "trade_data", Codespace: types.ModuleName, Code: types.ErrInvalidTradeData.ABCICode()},
+			},
+		},
+		{
+			name:   "denom not registered in the params",
+			doc:    sampleDocument(t),
+			params: withoutMintableDenoms,
+			errors: []ValidationError{
+				{Section: "trade_data", Codespace: types.ModuleName, Code: types.ErrInvalidTradeInfo.ABCICode()},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			result := validateTradeDocument(tc.doc, tc.params)
+			require.Equal(t, len(tc.errors) == 0, result.Valid)
+			require.Len(t, result.Errors, len(tc.errors))
+			for i, expected := range tc.errors {
+				require.NotEmpty(t, result.Errors[i].Error)
+				expected.Error = result.Errors[i].Error
+				require.Equal(t, expected, result.Errors[i])
+			}
+		})
+	}
+}
+
+func TestReadParams(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	invalidParams := types.DefaultParams()
+	invalidParams.PendingTradeTtl = -1
+
+	for _, tc := range []struct {
+		name     string
+		contents string
+		params   types.Params
+		err      string
+	}{
+		{
+			name:     "output of the params query",
+			contents: string(cdc.MustMarshalJSON(&types.QueryParamsResponse{Params: types.DefaultParams()})),
+			params:   types.DefaultParams(),
+		},
+		{
+			name:     "not the output of the params query",
+			contents: `{"pending_trade_ttl":"1s"}`,
+			err:      "params file must hold the output of the params query",
+		},
+		{
+			name:     "invalid params",
+			contents: string(cdc.MustMarshalJSON(&types.QueryParamsResponse{Params: invalidParams})),
+			err:      "invalid params",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "params.json")
+			require.NoError(t, os.WriteFile(path, []byte(tc.contents), 0o600))
+
+			params, err := readParams(cdc, path)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			// the empty lists of the params are decoded as empty slices
+			require.True(t, tc.params.Equal(params))
+		})
+	}
+}
+
+// sampleDocument returns the sample trade document of a deposit
+func sampleDocument(t *testing.T) tradeDocument {
+	t.Helper()
+	doc, err := readTradeDocument(writeTradeDocument(t, sampleTradeDocument()))
+	require.NoError(t, err)
+	return doc
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"github.com/GGEZLabs/vvtxchain/x/trade/testutil"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"go.uber.org/mock/gomock"
+)
+
+// requireTypedEvent decodes the last typed event of the type of the expected event
+func (suite *KeeperTestSuite) requireTypedEvent(ctx sdk.Context, expected proto.Message) {
+	var last proto.Message
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		if event.Type != proto.MessageName(expected) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		suite.Require().NoError(err)
+		last = msg
+	}
+	suite.Require().NotNil(last, "no %s event", proto.MessageName(expected))
+	suite.Require().Equal(expected, last)
+}
+
+func (suite *KeeperTestSuite) TestTypedEventsCreateAndProcess() {
+	indexes := suite.createNTrades(1)
+	trade := types.GetBaseStoredTrade()
+
+	suite.requireTypedEvent(suite.ctx, &types.EventTradeCreated{
+		TradeIndex:      indexes[0],
+		Status:          types.StatusPending,
+		Maker:           testutil.Alice,
+		TradeType:       types.TradeTypeFiatDeposit,
+		Amount:          trade.Amount,
+		ReceiverAddress: testutil.Alice,
+	})
+
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.confirmTrade(indexes[0])
+
+	suite.requireTypedEvent(suite.ctx, &types.EventTradeProcessed{
+		TradeIndex:        indexes[0],
+		Status:            types.StatusProcessed,
+		Checker:           testutil.Bob,
+		Maker:             testutil.Alice,
+		ProcessDate:       trade.ProcessDate,
+		Result:            types.TradeProcessedSuccessfully,
+		Approvals:         1,
+		RequiredApprovals: 1,
+	})
+}
+
+func (suite *KeeperTestSuite) TestTypedEventsCancelAndExpire() {
+	indexes := suite.createNTrades(2)
+
+	_, err := suite.msgServer.CancelTrade(suite.ctx, types.NewMsgCancelTrade(testutil.Alice, indexes[0], "typo in quantity"))
+	suite.Require().NoError(err)
+	suite.requireTypedEvent(suite.ctx, &types.EventTradeCanceled{
+		TradeIndex: indexes[0],
+		Status:     types.StatusCanceled,
+		CanceledBy: testutil.Alice,
+		Maker:      testutil.Alice,
+		Reason:     "typo in quantity",
+		Result:     types.TradeIsCanceled + ": typo in quantity",
+	})
+
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.DefaultPendingTradeTTL)).WithEventManager(sdk.NewEventManager())
+	suite.tradeKeeper.CancelExpiredPendingTrades(ctx)
+	suite.requireTypedEvent(ctx, &types.EventTradesExpired{TradeIndexes: []uint64{indexes[1]}})
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+)
+
+// SetHooks sets the trade hooks, they can be set only once
+func (k Keeper) SetHooks(th types.TradeHooks) {
+	if *k.hooks != nil {
+		panic("cannot set trade hooks twice")
+	}
+
+	*k.hooks = th
+}
+
+// Hooks returns the trade hooks, or hooks doing nothing if none are set
+func (k Keeper) Hooks() types.TradeHooks {
+	if k.hooks == nil || *k.hooks == nil {
+		return types.MultiTradeHooks{}
+	}
+
+	return *k.hooks
+}
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/GGEZLabs/vvtxchain/x/trade/testutil"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/mock/gomock"
+)
+
+var errHookVeto = errors.New("vetoed by hook")
+
+// recordingHooks records the trades passed to the hooks and returns the configured errors
+type recordingHooks struct {
+	created, processed, canceled []types.StoredTrade
+	minted, burned               []sdk.Coins
+
+	createdErr, canceledErr, mintErr, burnErr error
+}
+
+var _ types.TradeHooks = &recordingHooks{}
+
+func (h *recordingHooks) AfterTradeCreated(_ context.Context, trade types.StoredTrade) error {
+	h.created = append(h.created, trade)
+	return h.createdErr
+}
+
+func (h *recordingHooks) AfterTradeProcessed(_ context.Context, trade types.StoredTrade) error {
+	h.processed = append(h.processed, trade)
+	return nil
+}
+
+func (h *recordingHooks) AfterTradeCanceled(ctx context.Context, trade types.StoredTrade) error {
+	h.canceled = append(h.canceled, trade)
+	// a change made by the hook, discarded when the hook fails on expiry
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent("hook_canceled"))
+	return h.canceledErr
+}
+
+func (h *recordingHooks) BeforeMint(_ context.Context, _ types.StoredTrade, _ sdk.AccAddress, amount sdk.Coins) error {
+	h.minted = append(h.minted, amount)
+	return h.mintErr
+}
+
+func (h *recordingHooks) BeforeBurn(_ context.Context, _ types.StoredTrade, _ sdk.AccAddress, amount sdk.Coins) error {
+	h.burned = append(h.burned, amount)
+	return h.burnErr
+}
+
+func (suite *KeeperTestSuite) setRecordingHooks() *recordingHooks {
+	hooks := &recordingHooks{}
+	suite.tradeKeeper.SetHooks(types.NewMultiTradeHooks(hooks))
+	return hooks
+}
+
+func (suite *KeeperTestSuite) TestHooksCreateAndConfirm() {
+	suite.setupTest()
+	hooks := suite.setRecordingHooks()
+
+	_, err := suite.msgServer.CreateTrade(suite.ctx, types.GetSampleMsgCreateTrade())
+	suite.Require().NoError(err)
+	suite.Require().Len(hooks.created, 1)
+	suite.Require().Equal(types.StatusPending, hooks.created[0].Status)
+
+	trade, found := suite.tradeKeeper.GetStoredTrade(suite.ctx, 1)
+	suite.Require().True(found)
+
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.Require().Equal(types.StatusProcessed, suite.confirmTrade(1).Status)
+
+	suite.Require().Equal([]sdk.Coins{sdk.NewCoins(*trade.Amount)}, hooks.minted)
+	suite.Require().Len(hooks.processed, 1)
+	suite.Require().Equal(types.StatusProcessed, hooks.processed[0].Status)
+}
+
+func (suite *KeeperTestSuite) TestHooksAfterTradeCreatedError() {
+	suite.setupTest()
+	hooks := suite.setRecordingHooks()
+	hooks.createdErr = errHookVeto
+
+	_, err := suite.msgServer.CreateTrade(suite.ctx, types.GetSampleMsgCreateTrade())
+	suite.Require().ErrorIs(err, errHookVeto)
+}
+
+func (suite *KeeperTestSuite) TestHooksBeforeMintVeto() {
+	suite.createNTrades(1)
+	hooks := suite.setRecordingHooks()
+	hooks.mintErr = errHookVeto
+
+	// no coins are minted
+	suite.Require().Equal(types.StatusFailed, suite.confirmTrade(1).Status)
+
+	trade, found := suite.tradeKeeper.GetStoredTrade(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(errHookVeto.Error(), trade.Result)
+	suite.Require().Equal(types.ModuleName, trade.FailureCodespace)
+	suite.Require().Equal(types.ErrTradeExecutionFailed.ABCICode(), trade.FailureCode)
+	suite.Require().Len(hooks.processed, 1)
+	suite.Require().Equal(types.StatusFailed, hooks.processed[0].Status)
+}
+
+func (suite *KeeperTestSuite) TestHooksBeforeBurnVeto() {
+	suite.setupTest()
+	hooks := suite.setRecordingHooks()
+	hooks.burnErr = errHookVeto
+
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+
+	_, err := suite.msgServer.CreateTrade(suite.ctx, types.GetMsgCreateTradeWithTypeAndAmount(types.TradeTypeFiatWithdrawal, 1000))
+	suite.Require().NoError(err)
+
+	// no coins are burned and the escrow is refunded
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	suite.Require().Equal(types.StatusFailed, suite.confirmTrade(1).Status)
+	suite.Require().Len(hooks.burned, 1)
+	suite.Require().Empty(hooks.minted)
+
+	_, found := suite.tradeKeeper.GetTradeEscrow(suite.ctx, 1)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestHooksRejectAndAwaitingApprovals() {
+	suite.createNTrades(1)
+	hooks := suite.setRecordingHooks()
+
+	// trades of the sample amount need two distinct checkers
+	params := types.DefaultParams()
+	params.ApprovalTiers = []types.ApprovalTier{
+		{Denom: types.DefaultDenom, MinAmount: sdkmath.NewInt(1000), RequiredApprovals: 2},
+	}
+	suite.Require().NoError(suite.tradeKeeper.SetParams(suite.ctx, params))
+
+	// the trade is still pending after the first confirmation
+	suite.Require().Equal(types.StatusPending, suite.confirmTrade(1).Status)
+	suite.Require().Empty(hooks.processed)
+
+	_, err := suite.msgServer.ProcessTrade(suite.ctx, types.NewMsgProcessTrade(testutil.Trent, types.ProcessTypeReject, 1))
+	suite.Require().NoError(err)
+	suite.Require().Len(hooks.processed, 1)
+	suite.Require().Equal(types.StatusRejected, hooks.processed[0].Status)
+	suite.Require().Empty(hooks.minted)
+}
+
+func (suite *KeeperTestSuite) TestHooksCancel() {
+	suite.createNTrades(2)
+	hooks := suite.setRecordingHooks()
+
+	_, err := suite.msgServer.CancelTrade(suite.ctx, &types.MsgCancelTrade{
+		Creator:    testutil.Alice,
+		TradeIndex: 1,
+		Reason:     "typo in quantity",
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(hooks.canceled, 1)
+	suite.Require().Equal(types.StatusCanceled, hooks.canceled[0].Status)
+
+	// a failed hook does not stop the expiry of a trade
+	hooks.canceledErr = errHookVeto
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.DefaultPendingTradeTTL)).WithEventManager(sdk.NewEventManager())
+	suite.tradeKeeper.CancelExpiredPendingTrades(ctx)
+
+	suite.Require().Len(hooks.canceled, 2)
+	trade, found := suite.tradeKeeper.GetStoredTrade(ctx, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(types.StatusCanceled, trade.Status)
+	for _, event := range ctx.EventManager().Events() {
+		suite.Require().NotEqual("hook_canceled", event.Type)
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetHooksTwice() {
+	suite.setupTest()
+	suite.setRecordingHooks()
+
+	suite.Require().Panics(func() { suite.setRecordingHooks() })
+}
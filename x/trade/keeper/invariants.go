@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all trade invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "supply", SupplyInvariant(k))
+}
+
+// SupplyInvariant checks that the supply of each denom minted or burned by trades is its
+// baseline plus the processed deposits minus the processed withdrawals
+func SupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		reconciliations, err := k.ReconcileSupply(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "supply", fmt.Sprintf("failed to reconcile the supply: %s\n", err)), true
+		}
+
+		for _, reconciliation := range reconciliations {
+			if reconciliation.Reconciled {
+				continue
+			}
+			broken++
+			msg += fmt.Sprintf("\t%s: expected supply %s (baseline %s, deposits %s, withdrawals %s), actual supply %s\n",
+				reconciliation.Denom, reconciliation.ExpectedSupply, reconciliation.Baseline,
+				reconciliation.ProcessedDeposits, reconciliation.ProcessedWithdrawals, reconciliation.ActualSupply)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "supply",
+			fmt.Sprintf("amount of denoms with an unreconciled supply %d\n%s", broken, msg),
+		), broken != 0
+	}
+}
@@ -22,6 +22,10 @@ type (
 
 		bankKeeper types.BankKeeper
 		aclKeeper  types.AclKeeper
+
+		// hooks are shared by the copies of the keeper, so they can be set after
+		// the keeper is given to the module
+		hooks *types.TradeHooks
 	}
 )
 
@@ -46,6 +50,7 @@ func NewKeeper(
 
 		bankKeeper: bankKeeper,
 		aclKeeper:  aclKeeper,
+		hooks:      new(types.TradeHooks),
 	}
 }
 
@@ -165,6 +165,14 @@ func (suite *KeeperTestSuite) setAclAuthority() {
 			},
 		},
 	}, true).AnyTimes()
+
+	// Carol is an acl admin
+	suite.aclKeeper.EXPECT().IsAdmin(suite.ctx, testutil.Carol).Return(true).AnyTimes()
+	suite.aclKeeper.EXPECT().IsAdmin(suite.ctx, gomock.Not(testutil.Carol)).Return(false).AnyTimes()
+
+	// Eve is the acl super admin
+	suite.aclKeeper.EXPECT().IsSuperAdmin(suite.ctx, testutil.Eve).Return(true).AnyTimes()
+	suite.aclKeeper.EXPECT().IsSuperAdmin(suite.ctx, gomock.Not(testutil.Eve)).Return(false).AnyTimes()
 }
 
 func (suite *KeeperTestSuite) createNTrades(numberOfTrades uint64) (tradeIndex []uint64) {
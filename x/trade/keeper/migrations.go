@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates the x/trade module state from consensus version 2 to 3.
+// It sets the default pending trade ttl and builds the expiry index of all pending trades.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.PendingTradeTtl == 0 {
+		params.PendingTradeTtl = types.DefaultPendingTradeTTL
+	}
+	if err := m.keeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	m.keeper.RebuildStoredTempTradeExpiryIndex(ctx)
+	return nil
+}
+
+// Migrate3to4 migrates the x/trade module state from consensus version 3 to 4.
+// It builds the status, maker, checker and receiver indexes of all stored trades.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.RebuildStoredTradeSecondaryIndexes(ctx)
+	return nil
+}
+
+// Migrate4to5 migrates the x/trade module state from consensus version 4 to 5.
+// It rebuilds the secondary indexes of all stored trades to add the date indexes.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.RebuildStoredTradeSecondaryIndexes(ctx)
+	return nil
+}
+
+// Migrate5to6 migrates the x/trade module state from consensus version 5 to 6.
+// It rewrites the decimals of the payloads of all stored trades, stored as legacy
+// JSON numbers, as canonical decimal strings. The decimals with more than 18 decimal
+// places are rounded half to even to the decimal precision, and the trade is logged.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	for _, storedTrade := range m.keeper.GetAllStoredTrade(ctx) {
+		rounded, err := storedTrade.RoundDecimals()
+		if err != nil {
+			return fmt.Errorf("failed to canonicalize decimals of trade %d: %w", storedTrade.TradeIndex, err)
+		}
+		if rounded {
+			m.keeper.Logger().Info("rounded the decimals of a trade with more than 18 decimal places", "trade_index", storedTrade.TradeIndex)
+		}
+		m.keeper.SetStoredTrade(ctx, storedTrade)
+	}
+	return nil
+}
+
+// Migrate6to7 migrates the x/trade module state from consensus version 6 to 7.
+// It sets the typed trade data, coin minting prices and exchange rates of all
+// stored trades from their JSON payloads.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	for _, storedTrade := range m.keeper.GetAllStoredTrade(ctx) {
+		if err := storedTrade.DecodePayload(); err != nil {
+			return fmt.Errorf("failed to decode payload of trade %d: %w", storedTrade.TradeIndex, err)
+		}
+		m.keeper.SetStoredTrade(ctx, storedTrade)
+	}
+	return nil
+}
+
+// Migrate7to8 migrates the x/trade module state from consensus version 7 to 8.
+// It sets the default trade data tolerances, the params of version 7 have no tolerances.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.TradeDataTolerances = types.DefaultTradeDataTolerances
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate8to9 migrates the x/trade module state from consensus version 8 to 9.
+// It sets the totals minted and burned of each denom from the processed trades.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	m.keeper.RebuildMintBurnTotals(ctx)
+	return nil
+}
+
+// Migrate9to10 migrates the x/trade module state from consensus version 9 to 10.
+// It sets the default mintable denoms when the registry is empty. The registry was added
+// without a migration, and the FIAT deposits and withdrawals are rejected without it.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if len(params.MintableDenoms) > 0 {
+		return nil
+	}
+	params.MintableDenoms = types.DefaultMintableDenoms
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate10to11 migrates the x/trade module state from consensus version 10 to 11.
+// It moves the mint and burn records to the keys with a length prefixed denom.
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	m.keeper.RebuildMintBurnRecordKeys(ctx)
+	return nil
+}
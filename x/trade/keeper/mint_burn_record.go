@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetMintBurnRecord set a specific mintBurnRecord in the store
+func (k Keeper) SetMintBurnRecord(ctx context.Context, record types.MintBurnRecord) {
+	direction, ok := types.MintBurnDirection(record.TradeType)
+	if !ok {
+		return
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MintBurnRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&record)
+	store.Set(types.MintBurnRecordKey(
+		record.Amount.Denom,
+		direction,
+		record.ProcessTime,
+		record.TradeIndex,
+	), b)
+}
+
+// GetAllMintBurnRecord returns all mintBurnRecord
+func (k Keeper) GetAllMintBurnRecord(ctx context.Context) (list []types.MintBurnRecord) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MintBurnRecordKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MintBurnRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RebuildMintBurnRecordKeys stores all mintBurnRecord again under the key of their denom,
+// direction, process time and trade index
+func (k Keeper) RebuildMintBurnRecordKeys(ctx context.Context) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MintBurnRecordKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	var records []types.MintBurnRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MintBurnRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		keys = append(keys, iterator.Key())
+		records = append(records, val)
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	for _, record := range records {
+		k.SetMintBurnRecord(ctx, record)
+	}
+}
+
+// GetMintBurnVolume returns the volume of a denom minted or burned since the given time,
+// by all makers and by the given maker
+func (k Keeper) GetMintBurnVolume(
+	ctx context.Context,
+	denom, direction string,
+	since time.Time,
+	maker string,
+) (global, byMaker math.Int) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MintBurnRecordKeyPrefix))
+	iterator := store.Iterator(
+		types.MintBurnRecordTimeKey(denom, direction, since),
+		storetypes.PrefixEndBytes(types.MintBurnRecordDirectionKey(denom, direction)),
+	)
+
+	defer iterator.Close()
+
+	global, byMaker = math.ZeroInt(), math.ZeroInt()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MintBurnRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+
+		global = global.Add(val.Amount.Amount)
+		if val.Maker == maker {
+			byMaker = byMaker.Add(val.Amount.Amount)
+		}
+	}
+
+	return global, byMaker
+}
+
+// PruneMintBurnRecords removes the records of a denom and direction processed before the given time,
+// they are out of the window of the mint and burn limits
+func (k Keeper) PruneMintBurnRecords(ctx context.Context, denom, direction string, before time.Time) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MintBurnRecordKeyPrefix))
+	iterator := store.Iterator(
+		types.MintBurnRecordDirectionKey(denom, direction),
+		types.MintBurnRecordTimeKey(denom, direction, before),
+	)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// CheckMintBurnLimit returns an error if minting or burning the amount of a trade would exceed the
+// mint and burn limits of its denom, for its maker or for all makers, within the rolling window
+func (k Keeper) CheckMintBurnLimit(ctx sdk.Context, storedTrade types.StoredTrade) error {
+	direction, ok := types.MintBurnDirection(storedTrade.TradeType)
+	if !ok || storedTrade.Amount == nil {
+		return nil
+	}
+
+	limit, found := k.GetParams(ctx).GetMintBurnLimit(storedTrade.Amount.Denom)
+	if !found {
+		return nil
+	}
+
+	makerLimit, globalLimit := limit.MakerMintLimit, limit.GlobalMintLimit
+	if direction == types.BurnDirection {
+		makerLimit, globalLimit = limit.MakerBurnLimit, limit.GlobalBurnLimit
+	}
+
+	global, byMaker := k.GetMintBurnVolume(ctx, storedTrade.Amount.Denom, direction, ctx.BlockTime().Add(-limit.Window), storedTrade.Maker)
+	amount := storedTrade.Amount.Amount
+
+	if makerLimit.IsPositive() && byMaker.Add(amount).GT(makerLimit) {
+		return types.ErrMintBurnLimitExceeded.Wrapf(
+			"maker %s limit of %s%s per %s: used %s, requested %s",
+			direction, makerLimit, storedTrade.Amount.Denom, limit.Window, byMaker, amount,
+		)
+	}
+
+	if globalLimit.IsPositive() && global.Add(amount).GT(globalLimit) {
+		return types.ErrMintBurnLimitExceeded.Wrapf(
+			"global %s limit of %s%s per %s: used %s, requested %s",
+			direction, globalLimit, storedTrade.Amount.Denom, limit.Window, global, amount,
+		)
+	}
+
+	return nil
+}
+
+// recordMintBurn adds the volume of a processed trade to the total minted or burned of its denom and to
+// the window of its mint and burn limits, and prunes the records out of the window. Only the denoms with
+// limits have records.
+func (k Keeper) recordMintBurn(ctx sdk.Context, storedTrade types.StoredTrade) {
+	direction, ok := types.MintBurnDirection(storedTrade.TradeType)
+	if !ok || storedTrade.Amount == nil {
+		return
+	}
+
+	k.addMintBurnTotal(ctx, direction, *storedTrade.Amount)
+
+	limit, found := k.GetParams(ctx).GetMintBurnLimit(storedTrade.Amount.Denom)
+	if !found {
+		return
+	}
+
+	k.PruneMintBurnRecords(ctx, storedTrade.Amount.Denom, direction, ctx.BlockTime().Add(-limit.Window))
+	k.SetMintBurnRecord(ctx, types.MintBurnRecord{
+		TradeIndex:  storedTrade.TradeIndex,
+		TradeType:   storedTrade.TradeType,
+		Maker:       storedTrade.Maker,
+		Amount:      *storedTrade.Amount,
+		ProcessTime: ctx.BlockTime(),
+	})
+}
@@ -0,0 +1,251 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	keepertest "github.com/GGEZLabs/vvtxchain/testutil/keeper"
+	"github.com/GGEZLabs/vvtxchain/x/trade/testutil"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMintBurnVolumeWindow(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	for i, record := range []types.MintBurnRecord{
+		{TradeType: types.TradeTypeFiatDeposit, Maker: testutil.Alice, ProcessTime: now.Add(-2 * time.Hour)},
+		{TradeType: types.TradeTypeFiatDeposit, Maker: testutil.Alice, ProcessTime: now.Add(-30 * time.Minute)},
+		{TradeType: types.TradeTypeFiatDeposit, Maker: testutil.Trent, ProcessTime: now},
+		{TradeType: types.TradeTypeFiatWithdrawal, Maker: testutil.Alice, ProcessTime: now},
+	} {
+		record.TradeIndex = uint64(i + 1)
+		record.Amount = sdk.NewInt64Coin(types.DefaultDenom, 100)
+		keeper.SetMintBurnRecord(ctx, record)
+	}
+
+	global, byMaker := keeper.GetMintBurnVolume(ctx, types.DefaultDenom, types.MintDirection, now.Add(-time.Hour), testutil.Alice)
+	require.Equal(t, sdkmath.NewInt(200), global)
+	require.Equal(t, sdkmath.NewInt(100), byMaker)
+
+	global, byMaker = keeper.GetMintBurnVolume(ctx, types.DefaultDenom, types.BurnDirection, now.Add(-time.Hour), testutil.Alice)
+	require.Equal(t, sdkmath.NewInt(100), global)
+	require.Equal(t, sdkmath.NewInt(100), byMaker)
+
+	// only the mint records before the window are pruned
+	keeper.PruneMintBurnRecords(ctx, types.DefaultDenom, types.MintDirection, now.Add(-time.Hour))
+	records := keeper.GetAllMintBurnRecord(ctx)
+	require.Len(t, records, 3)
+	for _, record := range records {
+		require.NotEqual(t, uint64(1), record.TradeIndex)
+	}
+}
+
+func TestMintBurnVolumeOfOverlappingDenoms(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// the denom ibc/mint starts with the denom ibc and the mint direction
+	for i, record := range []types.MintBurnRecord{
+		{TradeType: types.TradeTypeFiatDeposit, Amount: sdk.NewInt64Coin("ibc", 100)},
+		{TradeType: types.TradeTypeFiatWithdrawal, Amount: sdk.NewInt64Coin("ibc/mint", 50)},
+		{TradeType: types.TradeTypeFiatDeposit, Amount: sdk.NewInt64Coin("ibc/mint", 20)},
+	} {
+		record.TradeIndex = uint64(i + 1)
+		record.Maker = testutil.Alice
+		record.ProcessTime = now
+		keeper.SetMintBurnRecord(ctx, record)
+	}
+
+	requireVolumes := func() {
+		for _, tc := range []struct {
+			denom, direction string
+			volume           int64
+		}{
+			{"ibc", types.MintDirection, 100},
+			{"ibc", types.BurnDirection, 0},
+			{"ibc/mint", types.MintDirection, 20},
+			{"ibc/mint", types.BurnDirection, 50},
+		} {
+			global, byMaker := keeper.GetMintBurnVolume(ctx, tc.denom, tc.direction, now.Add(-time.Hour), testutil.Alice)
+			require.Equal(t, sdkmath.NewInt(tc.volume), global, "%s %s", tc.denom, tc.direction)
+			require.Equal(t, sdkmath.NewInt(tc.volume), byMaker, "%s %s", tc.denom, tc.direction)
+		}
+	}
+	requireVolumes()
+
+	keeper.RebuildMintBurnRecordKeys(ctx)
+	require.Len(t, keeper.GetAllMintBurnRecord(ctx), 3)
+	requireVolumes()
+
+	// pruning the records of a denom keeps the records of the other denom
+	keeper.PruneMintBurnRecords(ctx, "ibc", types.MintDirection, now.Add(time.Hour))
+	records := keeper.GetAllMintBurnRecord(ctx)
+	require.Len(t, records, 2)
+	for _, record := range records {
+		require.Equal(t, "ibc/mint", record.Amount.Denom)
+	}
+}
+
+func (suite *KeeperTestSuite) setMintBurnLimit(makerMintLimit, globalMintLimit sdkmath.Int) {
+	params := types.DefaultParams()
+	params.MintBurnLimits = []types.MintBurnLimit{
+		types.NewMintBurnLimit(types.DefaultDenom, time.Hour, makerMintLimit, sdkmath.ZeroInt(), globalMintLimit, sdkmath.ZeroInt()),
+	}
+	suite.Require().NoError(suite.tradeKeeper.SetParams(suite.ctx, params))
+}
+
+func (suite *KeeperTestSuite) confirmTrade(tradeIndex uint64) *types.MsgProcessTradeResponse {
+	processResponse, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:     testutil.Bob,
+		ProcessType: types.ProcessTypeConfirm,
+		TradeIndex:  tradeIndex,
+	})
+	suite.Require().NoError(err)
+	return processResponse
+}
+
+func (suite *KeeperTestSuite) TestProcessTradeMakerMintLimit() {
+	indexes := suite.createNTrades(2)
+	keeper := suite.tradeKeeper
+
+	trade, found := keeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	amount := trade.Amount.Amount
+	suite.setMintBurnLimit(amount.MulRaw(3).QuoRaw(2), sdkmath.ZeroInt())
+
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.Require().Equal(types.StatusProcessed, suite.confirmTrade(indexes[0]).Status)
+
+	records := keeper.GetAllMintBurnRecord(suite.ctx)
+	suite.Require().Len(records, 1)
+	suite.Require().Equal(indexes[0], records[0].TradeIndex)
+	suite.Require().Equal(testutil.Alice, records[0].Maker)
+	suite.Require().Equal(*trade.Amount, records[0].Amount)
+
+	// the second trade of the maker exceeds the limit within the window
+	suite.Require().Equal(types.StatusFailed, suite.confirmTrade(indexes[1]).Status)
+	trade, found = keeper.GetStoredTrade(suite.ctx, indexes[1])
+	suite.Require().True(found)
+	suite.Require().Contains(trade.Result, types.ErrMintBurnLimitExceeded.Error())
+	suite.Require().Contains(trade.Result, "maker mint limit")
+	suite.Require().Equal(types.ModuleName, trade.FailureCodespace)
+	suite.Require().Equal(types.ErrMintBurnLimitExceeded.ABCICode(), trade.FailureCode)
+
+	_, found = keeper.GetStoredTempTrade(suite.ctx, indexes[1])
+	suite.Require().False(found)
+	suite.Require().Len(keeper.GetAllMintBurnRecord(suite.ctx), 1)
+}
+
+func (suite *KeeperTestSuite) TestProcessTradeGlobalMintLimit() {
+	indexes := suite.createNTrades(1)
+	keeper := suite.tradeKeeper
+
+	msg := types.GetSampleMsgCreateTrade()
+	msg.Creator = testutil.Trent
+	createResponse, err := suite.msgServer.CreateTrade(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	trade, found := keeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.setMintBurnLimit(sdkmath.ZeroInt(), trade.Amount.Amount)
+
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.Require().Equal(types.StatusProcessed, suite.confirmTrade(indexes[0]).Status)
+
+	// the trade of another maker exceeds the global limit
+	suite.Require().Equal(types.StatusFailed, suite.confirmTrade(createResponse.TradeIndex).Status)
+	trade, found = keeper.GetStoredTrade(suite.ctx, createResponse.TradeIndex)
+	suite.Require().True(found)
+	suite.Require().Contains(trade.Result, "global mint limit")
+}
+
+func (suite *KeeperTestSuite) TestProcessTradeWithoutMintBurnLimit() {
+	indexes := suite.createNTrades(1)
+
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.Require().Equal(types.StatusProcessed, suite.confirmTrade(indexes[0]).Status)
+
+	// denoms without limits are not recorded
+	suite.Require().Empty(suite.tradeKeeper.GetAllMintBurnRecord(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestQueryMintBurnCapacity() {
+	indexes := suite.createNTrades(1)
+	keeper := suite.tradeKeeper
+
+	trade, found := keeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	amount := trade.Amount.Amount
+	suite.setMintBurnLimit(amount.MulRaw(2), amount.MulRaw(10))
+
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.confirmTrade(indexes[0])
+
+	response, err := keeper.MintBurnCapacity(suite.ctx, &types.QueryMintBurnCapacityRequest{
+		Denom: types.DefaultDenom,
+		Maker: testutil.Alice,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Hour, response.Window)
+	suite.Require().Equal(types.MintBurnCapacity{
+		Limit:     amount.MulRaw(2),
+		Used:      amount,
+		Remaining: amount,
+	}, response.MakerMint)
+	suite.Require().Equal(types.MintBurnCapacity{
+		Limit:     amount.MulRaw(10),
+		Used:      amount,
+		Remaining: amount.MulRaw(9),
+	}, response.GlobalMint)
+	suite.Require().Equal(types.MintBurnCapacity{
+		Limit:     sdkmath.ZeroInt(),
+		Used:      sdkmath.ZeroInt(),
+		Remaining: sdkmath.ZeroInt(),
+	}, response.MakerBurn)
+
+	// another maker has used none of its capacity
+	response, err = keeper.MintBurnCapacity(suite.ctx, &types.QueryMintBurnCapacityRequest{
+		Denom: types.DefaultDenom,
+		Maker: testutil.Trent,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(amount.MulRaw(2), response.MakerMint.Remaining)
+	suite.Require().Equal(amount.MulRaw(9), response.GlobalMint.Remaining)
+
+	for _, tc := range []struct {
+		desc string
+		req  *types.QueryMintBurnCapacityRequest
+		err  error
+	}{
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+		{
+			desc: "InvalidMaker",
+			req:  &types.QueryMintBurnCapacityRequest{Denom: types.DefaultDenom, Maker: "invalid"},
+			err:  status.Error(codes.InvalidArgument, "invalid maker address: decoding bech32 failed: invalid bech32 string length 7"),
+		},
+		{
+			desc: "DenomWithoutLimit",
+			req:  &types.QueryMintBurnCapacityRequest{Denom: "ueurv", Maker: testutil.Alice},
+			err:  status.Error(codes.NotFound, "no mint burn limit for denom: ueurv"),
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			_, err := keeper.MintBurnCapacity(suite.ctx, tc.req)
+			suite.Require().ErrorIs(err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) AmendTrade(goCtx context.Context, msg *types.MsgAmendTrade) (*types.MsgAmendTradeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hasPermission, err := k.HasPermission(ctx, msg.Creator, types.TxTypeCreateTrade)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPermission {
+		return nil, types.ErrInvalidMakerPermission
+	}
+
+	st, found := k.GetStoredTrade(ctx, msg.TradeIndex)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "trade with index %d not found", msg.TradeIndex)
+	}
+
+	if msg.Creator != st.Maker {
+		return nil, types.ErrInvalidAmendPermission
+	}
+
+	if st.Status != types.StatusPending {
+		return nil, types.ErrInvalidTradeStatus.Wrapf("cannot amend trade with status %s; only trades with status %s can be amended", st.Status.String(), types.StatusPending.String())
+	}
+
+	amended := msg.Apply(st)
+	td, err := validateTradePayload(k.GetParams(ctx), amended.ReceiverAddress, amended.TradeData, amended.CoinMintingPriceJson, amended.ExchangeRateJson)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = amended.CanonicalizeDecimals(); err != nil {
+		return nil, err
+	}
+	if err = amended.DecodePayload(); err != nil {
+		return nil, err
+	}
+
+	previousPayloadHash := st.PayloadHash()
+	if amended.PayloadHash() == previousPayloadHash {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("amendment does not change the trade payload")
+	}
+
+	formattedDate := ctx.BlockTime().Format(time.RFC3339)
+
+	amended.TradeType = td.TradeInfo.TradeType
+	amended.Amount = td.TradeInfo.Quantity
+	amended.CoinMintingPrice = types.FormatPrice(td.TradeInfo.CoinMintingPrice)
+	amended.UpdateDate = formattedDate
+	amended.Revision++
+	amended.PreviousPayloadHash = previousPayloadHash
+	// approvals were given to the previous revision
+	amended.Approvals = nil
+
+	// the escrow follows the amended amount and holder
+	if err = k.refundEscrow(ctx, st.TradeIndex); err != nil {
+		return nil, err
+	}
+	if err = k.escrowWithdrawal(ctx, amended); err != nil {
+		return nil, err
+	}
+
+	k.SetStoredTrade(ctx, amended)
+	k.recordTradeTransition(ctx, amended, types.TransitionAmended, msg.Creator, "")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAmendTrade,
+			sdk.NewAttribute(types.AttributeKeyTradeIndex, fmt.Sprintf("%d", msg.TradeIndex)),
+			sdk.NewAttribute(types.AttributeKeyRevision, fmt.Sprintf("%d", amended.Revision)),
+			sdk.NewAttribute(types.AttributeKeyMaker, amended.Maker),
+			sdk.NewAttribute(types.AttributeKeyPayloadHash, amended.PayloadHash()),
+			sdk.NewAttribute(types.AttributeKeyPreviousPayloadHash, previousPayloadHash),
+			sdk.NewAttribute(types.AttributeKeyUpdateDate, formattedDate),
+		),
+	)
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventTradeAmended{
+		TradeIndex:          msg.TradeIndex,
+		Revision:            amended.Revision,
+		Maker:               amended.Maker,
+		PayloadHash:         amended.PayloadHash(),
+		PreviousPayloadHash: previousPayloadHash,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAmendTradeResponse{
+		TradeIndex: msg.TradeIndex,
+		Revision:   amended.Revision,
+	}, nil
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"github.com/GGEZLabs/vvtxchain/x/trade/testutil"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func (suite *KeeperTestSuite) TestAmendTrade() {
+	indexes := suite.createNTrades(1)
+	keeper := suite.tradeKeeper
+
+	before, found := keeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Zero(before.Revision)
+
+	amendResponse, err := suite.msgServer.AmendTrade(suite.ctx, &types.MsgAmendTrade{
+		Creator:           testutil.Alice,
+		TradeIndex:        indexes[0],
+		BankingSystemData: `{"bank":"updated"}`,
+	})
+	suite.Require().NoError(err)
+	suite.Require().EqualValues(types.MsgAmendTradeResponse{
+		TradeIndex: indexes[0],
+		Revision:   1,
+	}, *amendResponse)
+
+	after, found := keeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), after.Revision)
+	suite.Require().Equal(before.PayloadHash(), after.PreviousPayloadHash)
+	suite.Require().Equal(`{"bank":"updated"}`, after.BankingSystemData)
+	suite.Require().Equal(before.TradeData, after.TradeData)
+	suite.Require().Equal(before.ExchangeRateJson, after.ExchangeRateJson)
+	suite.Require().Equal(types.StatusPending, after.Status)
+
+	// the pending trade keeps its expiry
+	tempTrade, found := keeper.GetStoredTempTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Equal(before.TxDate, tempTrade.TxDate)
+
+	// amending the trade data updates the derived fields
+	amendResponse, err = suite.msgServer.AmendTrade(suite.ctx, &types.MsgAmendTrade{
+		Creator:    testutil.Alice,
+		TradeIndex: indexes[0],
+		TradeData:  types.GetMsgCreateTradeWithTypeAndAmount(types.TradeTypeFiatDeposit, 1000).TradeData,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), amendResponse.Revision)
+
+	amended, found := keeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Equal(int64(1000), amended.Amount.Amount.Int64())
+	suite.Require().Equal(int64(1000), amended.TypedTradeData.TradeInfo.Quantity.Amount.Int64())
+	suite.Require().Equal(after.PayloadHash(), amended.PreviousPayloadHash)
+}
+
+func (suite *KeeperTestSuite) TestAmendTradeInvalid() {
+	indexes := suite.createNTrades(1)
+
+	for _, tc := range []struct {
+		name string
+		msg  *types.MsgAmendTrade
+		err  error
+	}{
+		{
+			name: "not the maker",
+			msg:  &types.MsgAmendTrade{Creator: testutil.Trent, TradeIndex: indexes[0], BankingSystemData: `{"a":1}`},
+			err:  types.ErrInvalidAmendPermission,
+		},
+		{
+			name: "no maker permission",
+			msg:  &types.MsgAmendTrade{Creator: testutil.Bob, TradeIndex: indexes[0], BankingSystemData: `{"a":1}`},
+			err:  types.ErrInvalidMakerPermission,
+		},
+		{
+			name: "trade not found",
+			msg:  &types.MsgAmendTrade{Creator: testutil.Alice, TradeIndex: 100, BankingSystemData: `{"a":1}`},
+			err:  sdkerrors.ErrNotFound,
+		},
+		{
+			name: "invalid exchange rate json",
+			msg:  &types.MsgAmendTrade{Creator: testutil.Alice, TradeIndex: indexes[0], ExchangeRateJson: `[{"from_currency":""}]`},
+			err:  types.ErrInvalidExchangeRateJson,
+		},
+		{
+			name: "invalid trade data",
+			msg:  &types.MsgAmendTrade{Creator: testutil.Alice, TradeIndex: indexes[0], TradeData: `{"trade_info":{}}`},
+			err:  types.ErrInvalidTradeData,
+		},
+		{
+			name: "unchanged payload",
+			msg:  &types.MsgAmendTrade{Creator: testutil.Alice, TradeIndex: indexes[0], BankingSystemData: "{}"},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.AmendTrade(suite.ctx, tc.msg)
+			suite.Require().ErrorIs(err, tc.err)
+		})
+	}
+
+	_, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:     testutil.Bob,
+		ProcessType: types.ProcessTypeReject,
+		TradeIndex:  indexes[0],
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.AmendTrade(suite.ctx, &types.MsgAmendTrade{
+		Creator:           testutil.Alice,
+		TradeIndex:        indexes[0],
+		BankingSystemData: `{"a":1}`,
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidTradeStatus)
+}
+
+func (suite *KeeperTestSuite) TestAmendTradeIntoTradeTypeWithoutReceiver() {
+	indexes := suite.createNTrades(1)
+
+	// the receiver of the deposit cannot be kept for a split
+	_, err := suite.msgServer.AmendTrade(suite.ctx, &types.MsgAmendTrade{
+		Creator:         testutil.Alice,
+		TradeIndex:      indexes[0],
+		ReceiverAddress: testutil.Carol,
+		TradeData:       types.GetSampleTradeDataJson(types.TradeTypeSplit),
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = suite.msgServer.AmendTrade(suite.ctx, &types.MsgAmendTrade{
+		Creator:    testutil.Alice,
+		TradeIndex: indexes[0],
+		TradeData:  types.GetSampleTradeDataJson(types.TradeTypeSplit),
+	})
+	suite.Require().NoError(err)
+
+	amended, found := suite.tradeKeeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Equal(types.TradeTypeSplit, amended.TradeType)
+	suite.Require().Empty(amended.ReceiverAddress)
+	suite.Require().Nil(amended.Amount)
+
+	byReceiver, err := suite.tradeKeeper.StoredTradesByReceiver(suite.ctx, &types.QueryStoredTradesByReceiverRequest{ReceiverAddress: testutil.Alice})
+	suite.Require().NoError(err)
+	suite.Require().Empty(byReceiver.StoredTrade)
+
+	// the receiver is set again when the trade is amended back into a deposit
+	_, err = suite.msgServer.AmendTrade(suite.ctx, &types.MsgAmendTrade{
+		Creator:         testutil.Alice,
+		TradeIndex:      indexes[0],
+		ReceiverAddress: testutil.Carol,
+		TradeData:       types.GetSampleTradeDataJson(types.TradeTypeFiatDeposit),
+	})
+	suite.Require().NoError(err)
+
+	amended, found = suite.tradeKeeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Equal(types.TradeTypeFiatDeposit, amended.TradeType)
+	suite.Require().Equal(testutil.Carol, amended.ReceiverAddress)
+}
+
+func (suite *KeeperTestSuite) TestProcessTradeAfterAmend() {
+	indexes := suite.createNTrades(1)
+
+	_, err := suite.msgServer.AmendTrade(suite.ctx, &types.MsgAmendTrade{
+		Creator:           testutil.Alice,
+		TradeIndex:        indexes[0],
+		BankingSystemData: `{"bank":"updated"}`,
+	})
+	suite.Require().NoError(err)
+
+	// the checker approves the revision it has reviewed before the amendment
+	_, err = suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:          testutil.Bob,
+		ProcessType:      types.ProcessTypeConfirm,
+		TradeIndex:       indexes[0],
+		ExpectedRevision: 0,
+	})
+	suite.Require().ErrorIs(err, types.ErrTradeRevisionMismatch)
+
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	processResponse, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:          testutil.Bob,
+		ProcessType:      types.ProcessTypeConfirm,
+		TradeIndex:       indexes[0],
+		ExpectedRevision: 1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.StatusProcessed, processResponse.Status)
+}
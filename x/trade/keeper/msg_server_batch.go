@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateTrades creates a trade for each entry of the batch. The maker permission of the creator
+// is checked once for the whole batch, and a create_trade_error event is emitted for each entry
+// skipped in the best effort mode.
+func (k msgServer) CreateTrades(goCtx context.Context, msg *types.MsgCreateTrades) (*types.MsgCreateTradesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hasPermission, err := k.HasPermission(ctx, msg.Creator, types.TxTypeCreateTrade)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPermission {
+		return nil, types.ErrInvalidMakerPermission
+	}
+
+	// the batch runs in a cache context so no entry is kept when the message fails
+	batchCtx, writeBatch := ctx.CacheContext()
+	results := make([]types.BatchTradeResult, 0, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		result, err := runBatchEntry(batchCtx, msg.Mode, func(ctx sdk.Context) (types.BatchTradeResult, error) {
+			res, err := k.createTrade(ctx, entry.ToMsgCreateTrade(msg.Creator))
+			if err != nil {
+				return types.BatchTradeResult{}, err
+			}
+			return types.BatchTradeResult{TradeIndex: res.TradeIndex, Status: res.Status}, nil
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "entry %d", i)
+		}
+		if result.Error != "" {
+			batchCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCreateTradeError,
+					sdk.NewAttribute(types.AttributeKeyEntry, fmt.Sprintf("%d", i)),
+					sdk.NewAttribute(types.AttributeKeyError, result.Error),
+				),
+			)
+			if err = batchCtx.EventManager().EmitTypedEvent(&types.EventCreateTradeEntryFailed{
+				Entry: uint32(i),
+				Error: result.Error,
+			}); err != nil {
+				return nil, err
+			}
+		}
+		results = append(results, result)
+	}
+
+	writeBatch()
+
+	return &types.MsgCreateTradesResponse{Results: results}, nil
+}
+
+// ProcessTrades confirms or rejects each trade of the batch. The checker permission of the
+// creator is checked once for the whole batch, and a process_trade_error event is emitted for
+// each trade skipped in the best effort mode.
+func (k msgServer) ProcessTrades(goCtx context.Context, msg *types.MsgProcessTrades) (*types.MsgProcessTradesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hasPermission, err := k.HasPermission(ctx, msg.Creator, types.TxTypeProcessTrade)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPermission {
+		return nil, types.ErrInvalidCheckerPermission
+	}
+
+	// the batch runs in a cache context so no entry is kept when the message fails
+	batchCtx, writeBatch := ctx.CacheContext()
+	results := make([]types.BatchTradeResult, 0, len(msg.TradeIndexes))
+	for i, tradeIndex := range msg.TradeIndexes {
+		result, err := runBatchEntry(batchCtx, msg.Mode, func(ctx sdk.Context) (types.BatchTradeResult, error) {
+			res, err := k.processTrade(ctx, msg.ToMsgProcessTrade(i))
+			if err != nil {
+				return types.BatchTradeResult{}, err
+			}
+			return types.BatchTradeResult{TradeIndex: res.TradeIndex, Status: res.Status}, nil
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "trade %d", tradeIndex)
+		}
+		if result.Error != "" {
+			result.TradeIndex = tradeIndex
+			batchCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeProcessTradeError,
+					sdk.NewAttribute(types.AttributeKeyTradeIndex, fmt.Sprintf("%d", tradeIndex)),
+					sdk.NewAttribute(types.AttributeKeyError, result.Error),
+				),
+			)
+			if err = batchCtx.EventManager().EmitTypedEvent(&types.EventProcessTradeEntryFailed{
+				TradeIndex: tradeIndex,
+				Error:      result.Error,
+			}); err != nil {
+				return nil, err
+			}
+		}
+		results = append(results, result)
+	}
+
+	writeBatch()
+
+	return &types.MsgProcessTradesResponse{Results: results}, nil
+}
+
+// runBatchEntry runs an entry of a batch. In the all or nothing mode the error of the entry is
+// returned so the whole message fails. In the best effort mode the entry runs in a cache context
+// whose writes and events are only kept when it succeeds, and its error is set in the result.
+func runBatchEntry(
+	ctx sdk.Context,
+	mode types.BatchMode,
+	run func(ctx sdk.Context) (types.BatchTradeResult, error),
+) (types.BatchTradeResult, error) {
+	if mode != types.BatchModeBestEffort {
+		return run(ctx)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	result, err := run(cacheCtx)
+	if err != nil {
+		return types.BatchTradeResult{Error: err.Error()}, nil
+	}
+	write()
+	return result, nil
+}
@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"github.com/GGEZLabs/vvtxchain/x/trade/testutil"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"go.uber.org/mock/gomock"
+)
+
+func sampleCreateTradeEntry() types.CreateTradeEntry {
+	msg := types.GetSampleMsgCreateTrade()
+	return types.CreateTradeEntry{
+		ReceiverAddress:      msg.ReceiverAddress,
+		TradeData:            msg.TradeData,
+		BankingSystemData:    msg.BankingSystemData,
+		CoinMintingPriceJson: msg.CoinMintingPriceJson,
+		ExchangeRateJson:     msg.ExchangeRateJson,
+	}
+}
+
+func invalidCreateTradeEntry() types.CreateTradeEntry {
+	entry := sampleCreateTradeEntry()
+	entry.TradeData = types.GetSampleTradeDataJson(types.TradeTypeSplit)
+	return entry
+}
+
+func countEvents(ctx sdk.Context, eventType string) int {
+	count := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+func (suite *KeeperTestSuite) TestCreateTradesAllOrNothing() {
+	suite.setupTest()
+	keeper := suite.tradeKeeper
+
+	msg := types.NewMsgCreateTrades(testutil.Alice, []types.CreateTradeEntry{
+		sampleCreateTradeEntry(),
+		sampleCreateTradeEntry(),
+		sampleCreateTradeEntry(),
+	}, types.BatchModeAllOrNothing)
+	response, err := suite.msgServer.CreateTrades(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.BatchTradeResult{
+		{TradeIndex: 1, Status: types.StatusPending},
+		{TradeIndex: 2, Status: types.StatusPending},
+		{TradeIndex: 3, Status: types.StatusPending},
+	}, response.Results)
+	suite.Require().Len(keeper.GetAllStoredTrade(suite.ctx), 3)
+	suite.Require().Len(keeper.GetAllStoredTempTrade(suite.ctx), 3)
+	suite.Require().Equal(3, countEvents(suite.ctx, types.EventTypeCreateTrade))
+
+	// a failed entry fails the whole batch
+	msg.Entries[1] = invalidCreateTradeEntry()
+	response, err = suite.msgServer.CreateTrades(suite.ctx, msg)
+	suite.Require().Nil(response)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+	suite.Require().ErrorContains(err, "entry 1")
+	suite.Require().Len(keeper.GetAllStoredTrade(suite.ctx), 3)
+	suite.Require().Equal(3, countEvents(suite.ctx, types.EventTypeCreateTrade))
+
+	tradeIndex, found := keeper.GetTradeIndex(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(4), tradeIndex.NextId)
+}
+
+func (suite *KeeperTestSuite) TestCreateTradesBestEffort() {
+	suite.setupTest()
+	keeper := suite.tradeKeeper
+
+	duplicate := sampleCreateTradeEntry()
+	duplicate.ExternalReference = "WIRE-1"
+	msg := types.NewMsgCreateTrades(testutil.Alice, []types.CreateTradeEntry{
+		duplicate,
+		invalidCreateTradeEntry(),
+		duplicate,
+		sampleCreateTradeEntry(),
+	}, types.BatchModeBestEffort)
+	response, err := suite.msgServer.CreateTrades(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Len(response.Results, 4)
+	suite.Require().Equal(types.BatchTradeResult{TradeIndex: 1, Status: types.StatusPending}, response.Results[0])
+	suite.Require().Zero(response.Results[1].TradeIndex)
+	suite.Require().Contains(response.Results[1].Error, "receiver address must not be set")
+	suite.Require().Zero(response.Results[2].TradeIndex)
+	suite.Require().Contains(response.Results[2].Error, types.ErrDuplicateExternalReference.Error())
+	suite.Require().Equal(types.BatchTradeResult{TradeIndex: 2, Status: types.StatusPending}, response.Results[3])
+
+	suite.Require().Len(keeper.GetAllStoredTrade(suite.ctx), 2)
+	suite.Require().Equal(2, countEvents(suite.ctx, types.EventTypeCreateTrade))
+	suite.Require().Equal(2, countEvents(suite.ctx, types.EventTypeCreateTradeError))
+}
+
+func (suite *KeeperTestSuite) TestCreateTradesWithInvalidMakerPermission() {
+	suite.setupTest()
+
+	msg := types.NewMsgCreateTrades(testutil.Bob, []types.CreateTradeEntry{sampleCreateTradeEntry()}, types.BatchModeBestEffort)
+	response, err := suite.msgServer.CreateTrades(suite.ctx, msg)
+	suite.Require().Nil(response)
+	suite.Require().ErrorIs(err, types.ErrInvalidMakerPermission)
+	suite.Require().Empty(suite.tradeKeeper.GetAllStoredTrade(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestProcessTradesAllOrNothing() {
+	indexes := suite.createNTrades(3)
+	keeper := suite.tradeKeeper
+
+	msg := types.NewMsgProcessTrades(testutil.Bob, types.ProcessTypeReject, indexes[:2], types.BatchModeAllOrNothing)
+	response, err := suite.msgServer.ProcessTrades(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.BatchTradeResult{
+		{TradeIndex: indexes[0], Status: types.StatusRejected},
+		{TradeIndex: indexes[1], Status: types.StatusRejected},
+	}, response.Results)
+	suite.Require().Equal(2, countEvents(suite.ctx, types.EventTypeProcessTrade))
+
+	// the rejected trade fails the whole batch
+	msg.TradeIndexes = []uint64{indexes[2], indexes[0]}
+	response, err = suite.msgServer.ProcessTrades(suite.ctx, msg)
+	suite.Require().Nil(response)
+	suite.Require().ErrorIs(err, types.ErrInvalidTradeStatus)
+	suite.Require().ErrorContains(err, "trade 1")
+
+	trade, found := keeper.GetStoredTrade(suite.ctx, indexes[2])
+	suite.Require().True(found)
+	suite.Require().Equal(types.StatusPending, trade.Status)
+	suite.Require().Equal(2, countEvents(suite.ctx, types.EventTypeProcessTrade))
+}
+
+func (suite *KeeperTestSuite) TestProcessTradesBestEffort() {
+	indexes := suite.createNTrades(3)
+	keeper := suite.tradeKeeper
+
+	_, err := suite.msgServer.ProcessTrade(suite.ctx, types.NewMsgProcessTrade(testutil.Bob, types.ProcessTypeReject, indexes[0]))
+	suite.Require().NoError(err)
+
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(2)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	msg := types.NewMsgProcessTrades(testutil.Bob, types.ProcessTypeConfirm, []uint64{indexes[1], indexes[0], indexes[2], 100}, types.BatchModeBestEffort)
+	response, err := suite.msgServer.ProcessTrades(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Len(response.Results, 4)
+	suite.Require().Equal(types.BatchTradeResult{TradeIndex: indexes[1], Status: types.StatusProcessed}, response.Results[0])
+	suite.Require().Equal(indexes[0], response.Results[1].TradeIndex)
+	suite.Require().Contains(response.Results[1].Error, "cannot process trade with status TRADE_STATUS_REJECTED")
+	suite.Require().Equal(types.BatchTradeResult{TradeIndex: indexes[2], Status: types.StatusProcessed}, response.Results[2])
+	suite.Require().Equal(uint64(100), response.Results[3].TradeIndex)
+	suite.Require().Contains(response.Results[3].Error, "trade with index 100 not found")
+
+	for _, tradeIndex := range []uint64{indexes[1], indexes[2]} {
+		trade, found := keeper.GetStoredTrade(suite.ctx, tradeIndex)
+		suite.Require().True(found)
+		suite.Require().Equal(types.StatusProcessed, trade.Status)
+		suite.Require().Equal(testutil.Bob, trade.Checker)
+	}
+	suite.Require().Equal(2, countEvents(suite.ctx, types.EventTypeProcessTradeError))
+}
+
+func (suite *KeeperTestSuite) TestProcessTradesWithExpectedRevisions() {
+	indexes := suite.createNTrades(2)
+
+	msg := types.NewMsgProcessTrades(testutil.Bob, types.ProcessTypeReject, indexes, types.BatchModeBestEffort)
+	msg.ExpectedRevisions = []uint64{0, 1}
+	response, err := suite.msgServer.ProcessTrades(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.BatchTradeResult{TradeIndex: indexes[0], Status: types.StatusRejected}, response.Results[0])
+	suite.Require().Contains(response.Results[1].Error, types.ErrTradeRevisionMismatch.Error())
+}
+
+func (suite *KeeperTestSuite) TestProcessTradesWithInvalidCheckerPermission() {
+	indexes := suite.createNTrades(1)
+
+	msg := types.NewMsgProcessTrades(testutil.Alice, types.ProcessTypeConfirm, indexes, types.BatchModeAllOrNothing)
+	response, err := suite.msgServer.ProcessTrades(suite.ctx, msg)
+	suite.Require().Nil(response)
+	suite.Require().ErrorIs(err, types.ErrInvalidCheckerPermission)
+}
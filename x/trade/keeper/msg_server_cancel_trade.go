@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CancelTrade(goCtx context.Context, msg *types.MsgCancelTrade) (*types.MsgCancelTradeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	st, found := k.GetStoredTrade(ctx, msg.TradeIndex)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "trade with index %d not found", msg.TradeIndex)
+	}
+
+	if msg.Creator != st.Maker && !k.aclKeeper.IsAdmin(ctx, msg.Creator) && !k.aclKeeper.IsSuperAdmin(ctx, msg.Creator) {
+		return nil, types.ErrInvalidCancelPermission
+	}
+
+	if st.Status != types.StatusPending {
+		return nil, types.ErrInvalidTradeStatus.Wrapf("cannot cancel trade with status %s; only trades with status %s can be canceled", st.Status.String(), types.StatusPending.String())
+	}
+
+	currentTime := ctx.BlockTime()
+	formattedDate := currentTime.Format(time.RFC3339)
+
+	st.Status = types.StatusCanceled
+	st.UpdateDate = formattedDate
+	st.ProcessDate = formattedDate
+	st.Result = fmt.Sprintf("%s: %s", types.TradeIsCanceled, msg.Reason)
+
+	if err := k.refundEscrow(ctx, st.TradeIndex); err != nil {
+		return nil, err
+	}
+
+	k.SetStoredTrade(ctx, st)
+	k.RemoveStoredTempTrade(ctx, msg.TradeIndex)
+	k.recordTradeTransition(ctx, st, types.TransitionCanceled, msg.Creator, msg.Reason)
+
+	if err := k.Hooks().AfterTradeCanceled(ctx, st); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelTrade,
+			sdk.NewAttribute(types.AttributeKeyTradeIndex, fmt.Sprintf("%d", msg.TradeIndex)),
+			sdk.NewAttribute(types.AttributeKeyStatus, st.Status.String()),
+			sdk.NewAttribute(types.AttributeKeyCanceledBy, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyMaker, st.Maker),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyUpdateDate, formattedDate),
+			sdk.NewAttribute(types.AttributeKeyResult, st.Result),
+		),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTradeCanceled{
+		TradeIndex: msg.TradeIndex,
+		Status:     st.Status,
+		CanceledBy: msg.Creator,
+		Maker:      st.Maker,
+		Reason:     msg.Reason,
+		Result:     st.Result,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelTradeResponse{
+		TradeIndex: msg.TradeIndex,
+		Status:     st.Status,
+	}, nil
+}
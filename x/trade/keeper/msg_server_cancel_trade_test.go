@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"github.com/GGEZLabs/vvtxchain/x/trade/testutil"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (suite *KeeperTestSuite) TestCancelTradeByMaker() {
+	indexes := suite.createNTrades(1)
+	keeper := suite.tradeKeeper
+
+	cancelResponse, err := suite.msgServer.CancelTrade(suite.ctx, &types.MsgCancelTrade{
+		Creator:    testutil.Alice,
+		TradeIndex: indexes[0],
+		Reason:     "typo in quantity",
+	})
+	suite.Require().NoError(err)
+	suite.Require().EqualValues(types.MsgCancelTradeResponse{
+		TradeIndex: indexes[0],
+		Status:     types.StatusCanceled,
+	}, *cancelResponse)
+
+	trade, found := keeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Equal(types.StatusCanceled, trade.Status)
+	suite.Require().Equal(types.TradeIsCanceled+": typo in quantity", trade.Result)
+	suite.Require().Empty(trade.Checker)
+
+	_, found = keeper.GetStoredTempTrade(suite.ctx, indexes[0])
+	suite.Require().False(found)
+
+	var cancelEvent *sdk.Event
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCancelTrade {
+			cancelEvent = &event
+		}
+	}
+	suite.Require().NotNil(cancelEvent)
+	reason, found := cancelEvent.GetAttribute(types.AttributeKeyReason)
+	suite.Require().True(found)
+	suite.Require().Equal("typo in quantity", reason.Value)
+	canceledBy, found := cancelEvent.GetAttribute(types.AttributeKeyCanceledBy)
+	suite.Require().True(found)
+	suite.Require().Equal(testutil.Alice, canceledBy.Value)
+}
+
+func (suite *KeeperTestSuite) TestCancelTradeByAclAdmin() {
+	indexes := suite.createNTrades(2)
+
+	for i, admin := range []string{testutil.Carol, testutil.Eve} {
+		cancelResponse, err := suite.msgServer.CancelTrade(suite.ctx, &types.MsgCancelTrade{
+			Creator:    admin,
+			TradeIndex: indexes[i],
+			Reason:     "duplicated trade",
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.StatusCanceled, cancelResponse.Status)
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelTradeWithInvalidPermission() {
+	indexes := suite.createNTrades(1)
+
+	for _, creator := range []string{testutil.Bob, testutil.Trent} {
+		_, err := suite.msgServer.CancelTrade(suite.ctx, &types.MsgCancelTrade{
+			Creator:    creator,
+			TradeIndex: indexes[0],
+			Reason:     "typo in quantity",
+		})
+		suite.Require().ErrorIs(err, types.ErrInvalidCancelPermission)
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelTradeNotFound() {
+	suite.setupTest()
+
+	_, err := suite.msgServer.CancelTrade(suite.ctx, &types.MsgCancelTrade{
+		Creator:    testutil.Alice,
+		TradeIndex: 100,
+		Reason:     "typo in quantity",
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+}
+
+func (suite *KeeperTestSuite) TestCancelTradeNotPending() {
+	indexes := suite.createNTrades(1)
+
+	_, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:     testutil.Bob,
+		ProcessType: types.ProcessTypeReject,
+		TradeIndex:  indexes[0],
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.CancelTrade(suite.ctx, &types.MsgCancelTrade{
+		Creator:    testutil.Alice,
+		TradeIndex: indexes[0],
+		Reason:     "typo in quantity",
+	})
+	suite.Require().ErrorIs(err, types.ErrInvalidTradeStatus)
+}
@@ -23,30 +23,21 @@ func (k msgServer) CreateTrade(goCtx context.Context, msg *types.MsgCreateTrade)
 		return nil, types.ErrInvalidMakerPermission
 	}
 
-	td, err := types.ValidateTradeData(msg.TradeData)
-	if err != nil {
-		return nil, err
-	}
-
-	err = types.ValidateCoinMintingPriceJson(msg.CoinMintingPriceJson)
-	if err != nil {
-		return nil, err
-	}
+	return k.createTrade(ctx, msg)
+}
 
-	err = types.ValidateExchangeRateJson(msg.ExchangeRateJson)
+// createTrade creates the trade of a message whose creator has the maker permission
+func (k msgServer) createTrade(ctx sdk.Context, msg *types.MsgCreateTrade) (*types.MsgCreateTradeResponse, error) {
+	td, err := validateTradePayload(k.GetParams(ctx), msg.ReceiverAddress, msg.TradeData, msg.CoinMintingPriceJson, msg.ExchangeRateJson)
 	if err != nil {
 		return nil, err
 	}
 
-	// Validate receiver address if trade type not split or reinvestment
-	if td.TradeInfo.TradeType == types.TradeTypeFiatDeposit ||
-		td.TradeInfo.TradeType == types.TradeTypeFiatWithdrawal {
-		_, err = sdk.AccAddressFromBech32(msg.ReceiverAddress)
-		if err != nil {
-			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid receiver address (%s)", err)
+	if msg.ExternalReference != "" {
+		if existingTrade, found := k.GetStoredTradeByExternalReference(ctx, msg.ExternalReference); found {
+			return nil, types.ErrDuplicateExternalReference.Wrapf("external reference %s is used by trade %d with status %s",
+				msg.ExternalReference, existingTrade.TradeIndex, existingTrade.Status.String())
 		}
-	} else if msg.ReceiverAddress != "" {
-		return nil, sdkerrors.ErrInvalidRequest.Wrapf("receiver address must not be set for trade type %s", td.TradeInfo.TradeType.String())
 	}
 
 	tradeIndex, found := k.GetTradeIndex(ctx)
@@ -86,6 +77,17 @@ func (k msgServer) CreateTrade(goCtx context.Context, msg *types.MsgCreateTrade)
 		CoinMintingPriceJson: msg.CoinMintingPriceJson,
 		ExchangeRateJson:     msg.ExchangeRateJson,
 		Result:               types.TradeCreatedSuccessfully,
+		ExternalReference:    msg.ExternalReference,
+	}
+
+	if err = storedTrade.CanonicalizeDecimals(); err != nil {
+		return nil, err
+	}
+	if err = storedTrade.DecodePayload(); err != nil {
+		return nil, err
+	}
+	if err = k.escrowWithdrawal(ctx, storedTrade); err != nil {
+		return nil, err
 	}
 
 	storedTempTrade := types.StoredTempTrade{
@@ -95,12 +97,14 @@ func (k msgServer) CreateTrade(goCtx context.Context, msg *types.MsgCreateTrade)
 
 	k.SetStoredTrade(ctx, storedTrade)
 	k.SetStoredTempTrade(ctx, storedTempTrade)
+	k.recordTradeTransition(ctx, storedTrade, types.TransitionCreated, msg.Creator, storedTrade.Result)
 
 	tradeIndex.NextId++
 	k.SetTradeIndex(ctx, tradeIndex)
 
-	// Cancel expired trades
-	k.CancelExpiredPendingTrades(ctx)
+	if err = k.Hooks().AfterTradeCreated(ctx, storedTrade); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -109,6 +113,17 @@ func (k msgServer) CreateTrade(goCtx context.Context, msg *types.MsgCreateTrade)
 			sdk.NewAttribute(types.AttributeKeyStatus, types.StatusPending.String()),
 		),
 	)
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventTradeCreated{
+		TradeIndex:        newIndex,
+		Status:            storedTrade.Status,
+		Maker:             storedTrade.Maker,
+		TradeType:         storedTrade.TradeType,
+		Amount:            storedTrade.Amount,
+		ReceiverAddress:   storedTrade.ReceiverAddress,
+		ExternalReference: storedTrade.ExternalReference,
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgCreateTradeResponse{
 		TradeIndex: newIndex,
@@ -1,11 +1,13 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"time"
 
 	acltypes "github.com/GGEZLabs/vvtxchain/x/acl/types"
 	"github.com/GGEZLabs/vvtxchain/x/trade/testutil"
 	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"go.uber.org/mock/gomock"
 )
 
 func (suite *KeeperTestSuite) TestCreateTrade() {
@@ -296,3 +298,157 @@ func (suite *KeeperTestSuite) TestCreateTradeWithValidCreateDate() {
 	suite.Require().True(found)
 	suite.Require().Equal(trade.CreateDate, "2024-05-11T08:44:00Z")
 }
+
+func (suite *KeeperTestSuite) TestCreateTradeWithMintableDenoms() {
+	suite.setupTest()
+
+	eurTradeData := func() string {
+		td := types.GetSampleTradeData(types.TradeTypeFiatDeposit)
+		td.TradeInfo.BaseCurrency = "EUR"
+		td.TradeInfo.SettlementCurrency = "EUR"
+		td.TradeInfo.Quantity.Denom = "ueurv"
+		tdBytes, err := json.Marshal(td)
+		suite.Require().NoError(err)
+		return string(tdBytes)
+	}
+
+	msg := types.GetSampleMsgCreateTrade()
+	msg.TradeData = eurTradeData()
+
+	// denom not registered
+	_, err := suite.msgServer.CreateTrade(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidTradeInfo)
+	suite.Require().Contains(err.Error(), "no mintable denom registered for base_currency: EUR, settlement_currency: EUR")
+
+	// denom registered but disabled
+	params := types.DefaultParams()
+	params.MintableDenoms = append(params.MintableDenoms, types.MintableDenom{
+		Denom:              "ueurv",
+		BaseCurrency:       "EUR",
+		SettlementCurrency: "EUR",
+		Enabled:            false,
+	})
+	suite.Require().NoError(suite.tradeKeeper.SetParams(suite.ctx, params))
+
+	_, err = suite.msgServer.CreateTrade(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrDenomDisabled)
+
+	// denom registered and enabled
+	params.MintableDenoms[1].Enabled = true
+	suite.Require().NoError(suite.tradeKeeper.SetParams(suite.ctx, params))
+
+	createResponse, err := suite.msgServer.CreateTrade(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	trade, found := suite.tradeKeeper.GetStoredTrade(suite.ctx, createResponse.TradeIndex)
+	suite.Require().True(found)
+	suite.Require().Equal("ueurv", trade.Amount.Denom)
+}
+
+func (suite *KeeperTestSuite) TestCreateAndProcessNonMintingTrades() {
+	suite.setupTest()
+	keeper := suite.tradeKeeper
+
+	for _, tradeType := range []types.TradeType{
+		types.TradeTypeSplit,
+		types.TradeTypeReverseSplit,
+		types.TradeTypeReinvestment,
+		types.TradeTypeDividends,
+		types.TradeTypeDividendsDeduction,
+	} {
+		msg := &types.MsgCreateTrade{
+			Creator:              testutil.Alice,
+			ReceiverAddress:      testutil.Alice,
+			TradeData:            types.GetSampleTradeDataJson(tradeType),
+			BankingSystemData:    "{}",
+			CoinMintingPriceJson: types.GetSampleCoinMintingPriceJson(),
+			ExchangeRateJson:     types.GetSampleExchangeRateJson(),
+		}
+
+		// record only trades have no receiver
+		_, err := suite.msgServer.CreateTrade(suite.ctx, msg)
+		suite.Require().ErrorContains(err, "receiver address must not be set for trade type "+tradeType.String())
+
+		msg.ReceiverAddress = ""
+		createResponse, err := suite.msgServer.CreateTrade(suite.ctx, msg)
+		suite.Require().NoError(err)
+
+		trade, found := keeper.GetStoredTrade(suite.ctx, createResponse.TradeIndex)
+		suite.Require().True(found)
+		suite.Require().Equal(tradeType, trade.TradeType)
+		suite.Require().Nil(trade.Amount)
+
+		// no coins are minted or burned, the bank keeper mock fails on any call
+		processResponse, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+			Creator:     testutil.Bob,
+			ProcessType: types.ProcessTypeConfirm,
+			TradeIndex:  createResponse.TradeIndex,
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.StatusProcessed, processResponse.Status)
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreateTradeWithExternalReference() {
+	suite.setupTest()
+	keeper := suite.tradeKeeper
+
+	msg := types.GetSampleMsgCreateTrade()
+	msg.ExternalReference = "WIRE-2023-0001"
+	createResponse, err := suite.msgServer.CreateTrade(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	trade, found := keeper.GetStoredTrade(suite.ctx, createResponse.TradeIndex)
+	suite.Require().True(found)
+	suite.Require().Equal(msg.ExternalReference, trade.ExternalReference)
+
+	queryResponse, err := suite.queryClient.StoredTradeByExternalReference(suite.ctx, &types.QueryStoredTradeByExternalReferenceRequest{
+		ExternalReference: msg.ExternalReference,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(trade, queryResponse.StoredTrade)
+
+	// a retry is rejected while the trade is pending
+	_, err = suite.msgServer.CreateTrade(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrDuplicateExternalReference)
+	suite.Require().ErrorContains(err, "external reference WIRE-2023-0001 is used by trade 1 with status TRADE_STATUS_PENDING")
+
+	// the reference is released when the trade is canceled
+	_, err = suite.msgServer.CancelTrade(suite.ctx, &types.MsgCancelTrade{
+		Creator:    testutil.Alice,
+		TradeIndex: createResponse.TradeIndex,
+		Reason:     "duplicate wire transfer",
+	})
+	suite.Require().NoError(err)
+	_, found = keeper.GetStoredTradeByExternalReference(suite.ctx, msg.ExternalReference)
+	suite.Require().False(found)
+
+	createResponse, err = suite.msgServer.CreateTrade(suite.ctx, msg)
+	suite.Require().NoError(err)
+	trade, found = keeper.GetStoredTradeByExternalReference(suite.ctx, msg.ExternalReference)
+	suite.Require().True(found)
+	suite.Require().Equal(createResponse.TradeIndex, trade.TradeIndex)
+
+	// a retry is rejected once the trade is processed
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.Require().Equal(types.StatusProcessed, suite.confirmTrade(createResponse.TradeIndex).Status)
+
+	_, err = suite.msgServer.CreateTrade(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrDuplicateExternalReference)
+	suite.Require().ErrorContains(err, "with status TRADE_STATUS_PROCESSED")
+
+	// the reference of a rejected trade is released
+	msg.ExternalReference = "WIRE-2023-0002"
+	createResponse, err = suite.msgServer.CreateTrade(suite.ctx, msg)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:     testutil.Bob,
+		ProcessType: types.ProcessTypeReject,
+		TradeIndex:  createResponse.TradeIndex,
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.CreateTrade(suite.ctx, msg)
+	suite.Require().NoError(err)
+}
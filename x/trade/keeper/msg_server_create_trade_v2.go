@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+)
+
+// CreateTradeV2 creates a trade from typed payloads. The payloads are encoded as the JSON
+// strings of MsgCreateTrade so both messages store and validate trades the same way.
+func (k msgServer) CreateTradeV2(goCtx context.Context, msg *types.MsgCreateTradeV2) (*types.MsgCreateTradeV2Response, error) {
+	legacyMsg, err := msg.ToMsgCreateTrade()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := k.CreateTrade(goCtx, legacyMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateTradeV2Response{
+		TradeIndex: res.TradeIndex,
+		Status:     res.Status,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateTradeV2() {
+	suite.setupTest()
+	keeper := suite.tradeKeeper
+	msg := types.GetSampleMsgCreateTradeV2()
+
+	createResponse, err := suite.msgServer.CreateTradeV2(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.MsgCreateTradeV2Response{
+		TradeIndex: 1,
+		Status:     types.StatusPending,
+	}, *createResponse)
+
+	// the same trade created from the legacy JSON payloads is stored the same way
+	legacyMsg, err := msg.ToMsgCreateTrade()
+	suite.Require().NoError(err)
+	legacyResponse, err := suite.msgServer.CreateTrade(suite.ctx, legacyMsg)
+	suite.Require().NoError(err)
+
+	trade, found := keeper.GetStoredTrade(suite.ctx, createResponse.TradeIndex)
+	suite.Require().True(found)
+	legacyTrade, found := keeper.GetStoredTrade(suite.ctx, legacyResponse.TradeIndex)
+	suite.Require().True(found)
+	legacyTrade.TradeIndex = trade.TradeIndex
+	suite.Require().Equal(legacyTrade, trade)
+
+	suite.Require().Equal(types.TradeTypeFiatDeposit, trade.TradeType)
+	suite.Require().Equal(msg.TradeData.TradeInfo.Quantity, trade.Amount)
+	suite.Require().Equal("0.001", trade.CoinMintingPrice)
+	suite.Require().NotNil(trade.TypedTradeData)
+	suite.Require().True(msg.TradeData.TradeInfo.TradeValue.Equal(trade.TypedTradeData.TradeInfo.TradeValue))
+	suite.Require().Equal(msg.TradeData.Brokerage, trade.TypedTradeData.Brokerage)
+	suite.Require().Len(trade.CoinMintingPrices, 1)
+	suite.Require().True(math.LegacyMustNewDecFromStr("0.001").Equal(trade.CoinMintingPrices[0].MintingPrice))
+	suite.Require().Len(trade.ExchangeRates, 1)
+	suite.Require().True(math.LegacyMustNewDecFromStr("0.85").Equal(trade.ExchangeRates[0].CurrencyRate))
+	suite.Require().Contains(trade.TradeData, `"trade_value":"95.000000000000000000"`)
+}
+
+func (suite *KeeperTestSuite) TestCreateTradeV2WithInvalidTradeData() {
+	suite.setupTest()
+	msg := types.GetSampleMsgCreateTradeV2()
+	msg.TradeData.TradeInfo.AssetHolderId = 0
+
+	createResponse, err := suite.msgServer.CreateTradeV2(suite.ctx, msg)
+	suite.Require().Nil(createResponse)
+	suite.Require().ErrorIs(err, types.ErrInvalidTradeInfo)
+}
+
+func (suite *KeeperTestSuite) TestCreateTradeV2WithUnknownDenom() {
+	suite.setupTest()
+	msg := types.GetSampleMsgCreateTradeV2()
+	msg.TradeData.TradeInfo.Quantity.Denom = "uunknown"
+
+	createResponse, err := suite.msgServer.CreateTradeV2(suite.ctx, msg)
+	suite.Require().Nil(createResponse)
+	suite.Require().ErrorIs(err, types.ErrInvalidTradeInfo)
+}
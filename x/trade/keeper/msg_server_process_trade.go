@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -23,12 +24,17 @@ func (k msgServer) ProcessTrade(goCtx context.Context, msg *types.MsgProcessTrad
 		return nil, types.ErrInvalidCheckerPermission
 	}
 
+	return k.processTrade(ctx, msg)
+}
+
+// processTrade processes the trade of a message whose creator has the checker permission
+func (k msgServer) processTrade(ctx sdk.Context, msg *types.MsgProcessTrade) (*types.MsgProcessTradeResponse, error) {
 	st, found := k.GetStoredTrade(ctx, msg.TradeIndex)
 	if !found {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "trade with index %d not found", msg.TradeIndex)
 	}
 
-	err = msg.Validate(st.Status, st.Maker)
+	err := msg.Validate(st.Status, st.Maker, st.Revision)
 	if err != nil {
 		return nil, err
 	}
@@ -36,28 +42,54 @@ func (k msgServer) ProcessTrade(goCtx context.Context, msg *types.MsgProcessTrad
 	currentTime := ctx.BlockTime()
 	formattedDate := currentTime.Format(time.RFC3339)
 
-	st.Checker = msg.Creator
 	st.UpdateDate = formattedDate
 	st.ProcessDate = formattedDate
 
 	defaultResult := types.TradeProcessedSuccessfully
 	var finalStatus types.TradeStatus
 	var finalResult string
+	transition := types.TransitionApproved
+
+	requiredApprovals := uint32(1)
+	if st.Amount != nil {
+		requiredApprovals = k.GetParams(ctx).RequiredApprovals(*st.Amount)
+	}
 
 	switch msg.ProcessType {
 	case types.ProcessTypeReject:
 		finalStatus = types.StatusRejected
 		finalResult = defaultResult
+		transition = types.TransitionRejected
+
+		if err = k.refundEscrow(ctx, st.TradeIndex); err != nil {
+			return nil, err
+		}
 
 	case types.ProcessTypeConfirm:
-		if st.TradeType != types.TradeTypeFiatDeposit &&
-			st.TradeType != types.TradeTypeFiatWithdrawal {
+		if slices.Contains(st.Approvals, msg.Creator) {
+			return nil, types.ErrDuplicateApproval.Wrapf("checker %s has already approved trade %d", msg.Creator, msg.TradeIndex)
+		}
+		st.Approvals = append(st.Approvals, msg.Creator)
+
+		if uint32(len(st.Approvals)) < requiredApprovals {
+			// the trade stays pending until enough distinct checkers confirm it
+			finalStatus = types.StatusPending
+			finalResult = fmt.Sprintf("%s: %d of %d", types.TradeAwaitingApprovals, len(st.Approvals), requiredApprovals)
+		} else if !st.TradeType.IsMintOrBurn() {
+			// the trade is a record only, no coins are minted or burned
 			finalStatus = types.StatusProcessed
 			finalResult = defaultResult
 		} else {
+			st.Attempts++
 			status, err := k.MintOrBurnCoins(ctx, st)
 			if err != nil {
-				finalResult = err.Error()
+				st.SetFailure(err)
+				finalResult = st.Result
+				transition = types.TransitionFailed
+
+				if err = k.refundEscrow(ctx, st.TradeIndex); err != nil {
+					return nil, err
+				}
 			} else {
 				finalResult = defaultResult
 			}
@@ -70,9 +102,22 @@ func (k msgServer) ProcessTrade(goCtx context.Context, msg *types.MsgProcessTrad
 
 	st.Status = finalStatus
 	st.Result = finalResult
+	if st.Status != types.StatusPending {
+		// the checkers of a partially approved trade are only recorded in its approvals
+		st.Checker = msg.Creator
+	}
 
 	k.SetStoredTrade(ctx, st)
-	k.RemoveStoredTempTrade(ctx, msg.TradeIndex)
+	if st.Status != types.StatusPending {
+		k.RemoveStoredTempTrade(ctx, msg.TradeIndex)
+	}
+	k.recordTradeTransition(ctx, st, transition, msg.Creator, st.Result)
+
+	if st.Status != types.StatusPending {
+		if err = k.Hooks().AfterTradeProcessed(ctx, st); err != nil {
+			return nil, err
+		}
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -86,8 +131,24 @@ func (k msgServer) ProcessTrade(goCtx context.Context, msg *types.MsgProcessTrad
 			sdk.NewAttribute(types.AttributeKeyUpdateDate, formattedDate),
 			sdk.NewAttribute(types.AttributeKeyProcessDate, formattedDate),
 			sdk.NewAttribute(types.AttributeKeyResult, st.Result),
+			sdk.NewAttribute(types.AttributeKeyRevision, fmt.Sprintf("%d", st.Revision)),
+			sdk.NewAttribute(types.AttributeKeyApprovals, fmt.Sprintf("%d", len(st.Approvals))),
+			sdk.NewAttribute(types.AttributeKeyRequiredApprovals, fmt.Sprintf("%d", requiredApprovals)),
 		),
 	)
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventTradeProcessed{
+		TradeIndex:        msg.TradeIndex,
+		Status:            st.Status,
+		Checker:           msg.Creator,
+		Maker:             st.Maker,
+		ProcessDate:       formattedDate,
+		Result:            st.Result,
+		Revision:          st.Revision,
+		Approvals:         uint32(len(st.Approvals)),
+		RequiredApprovals: requiredApprovals,
+	}); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgProcessTradeResponse{
 		TradeIndex: msg.TradeIndex,
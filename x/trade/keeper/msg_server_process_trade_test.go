@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdkmath "cosmossdk.io/math"
+	trade "github.com/GGEZLabs/vvtxchain/x/trade/module"
 	"github.com/GGEZLabs/vvtxchain/x/trade/testutil"
 	"github.com/GGEZLabs/vvtxchain/x/trade/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,8 +13,8 @@ import (
 func (suite *KeeperTestSuite) TestProcessTradeConfirm() {
 	indexes := suite.createNTrades(1)
 
-	suite.bankKeeper.EXPECT().MintCoins(suite.ctx, types.ModuleName, gomock.Any()).Return(nil).Times(1)
-	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	processResponse, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
 		Creator:     testutil.Bob,
@@ -57,8 +58,8 @@ func (suite *KeeperTestSuite) TestStoredTradeAfterConfirmTrade() {
 	indexes := suite.createNTrades(1)
 	keeper := suite.tradeKeeper
 
-	suite.bankKeeper.EXPECT().MintCoins(suite.ctx, types.ModuleName, gomock.Any()).Return(nil).Times(1)
-	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	trade, found := keeper.GetStoredTrade(suite.ctx, indexes[0])
 	suite.Require().True(found)
@@ -90,8 +91,8 @@ func (suite *KeeperTestSuite) TestTempTradeAfterConfirmTrade() {
 	indexes := suite.createNTrades(1)
 	keeper := suite.tradeKeeper
 
-	suite.bankKeeper.EXPECT().MintCoins(suite.ctx, types.ModuleName, gomock.Any()).Return(nil).Times(1)
-	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	tempTrade, found := keeper.GetStoredTempTrade(suite.ctx, indexes[0])
 	suite.Require().True(found)
@@ -193,8 +194,8 @@ func (suite *KeeperTestSuite) TestProcessTwoTrade() {
 	indexes := suite.createNTrades(2)
 	keeper := suite.tradeKeeper
 
-	suite.bankKeeper.EXPECT().MintCoins(suite.ctx, types.ModuleName, gomock.Any()).Return(nil).Times(2)
-	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(2)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(2)
 
 	tradeIndex, found := keeper.GetTradeIndex(suite.ctx)
 
@@ -237,8 +238,8 @@ func (suite *KeeperTestSuite) TestProcessTradeInsufficientFund() {
 	createResponse, err := suite.msgServer.CreateTrade(suite.ctx, msgCreateTradeBuy)
 	suite.Require().NoError(err)
 
-	suite.bankKeeper.EXPECT().MintCoins(suite.ctx, types.ModuleName, gomock.Any()).Return(nil).Times(1)
-	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	processResponse, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
 		Creator:     testutil.Bob,
@@ -251,25 +252,16 @@ func (suite *KeeperTestSuite) TestProcessTradeInsufficientFund() {
 		Status:     types.StatusProcessed,
 	}, *processResponse)
 
-	createResponse, err = suite.msgServer.CreateTrade(suite.ctx, msgCreateTradeSell)
-	suite.Require().NoError(err)
-
+	// the withdrawal is escrowed when created, so it cannot be created without the funds
 	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkerrors.ErrInsufficientFunds).Times(1)
 
-	processResponse, err = suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
-		Creator:     testutil.Bob,
-		ProcessType: types.ProcessTypeConfirm,
-		TradeIndex:  createResponse.TradeIndex,
-	})
-	suite.Require().Nil(err)
-	suite.Require().EqualValues(types.MsgProcessTradeResponse{
-		TradeIndex: createResponse.TradeIndex,
-		Status:     types.StatusFailed,
-	}, *processResponse)
+	_, err = suite.msgServer.CreateTrade(suite.ctx, msgCreateTradeSell)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
 
-	trade, found := keeper.GetStoredTrade(suite.ctx, 2)
-	suite.Require().True(found)
-	suite.Require().EqualValues(types.StatusFailed, trade.Status)
+	_, found := keeper.GetStoredTrade(suite.ctx, 2)
+	suite.Require().False(found)
+	_, found = keeper.GetTradeEscrow(suite.ctx, 2)
+	suite.Require().False(found)
 }
 
 func (suite *KeeperTestSuite) TestProcessTradeAlreadyConfirmed() {
@@ -280,8 +272,8 @@ func (suite *KeeperTestSuite) TestProcessTradeAlreadyConfirmed() {
 	suite.Require().True(found)
 	suite.Require().EqualValues(types.GetSampleStoredTrade(indexes[0]), trade)
 
-	suite.bankKeeper.EXPECT().MintCoins(suite.ctx, types.ModuleName, gomock.Any()).Return(nil).Times(1)
-	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	processResponse, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
 		Creator:     testutil.Bob,
@@ -388,8 +380,8 @@ func (suite *KeeperTestSuite) TestSupplyAfterProcessTrade() {
 	createResponse, err := suite.msgServer.CreateTrade(suite.ctx, msgCreateTradeBuy)
 	suite.Require().NoError(err)
 
-	suite.bankKeeper.EXPECT().MintCoins(suite.ctx, types.ModuleName, gomock.Any()).Return(nil).Times(1)
-	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	processResponse, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
 		Creator:     testutil.Bob,
@@ -410,11 +402,12 @@ func (suite *KeeperTestSuite) TestSupplyAfterProcessTrade() {
 	supply := suite.bankKeeper.GetSupply(suite.ctx, types.DefaultDenom)
 	suite.Require().Equal(sdkmath.NewInt(5000000000000), supply.Amount)
 
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
 	createResponse, err = suite.msgServer.CreateTrade(suite.ctx, msgCreateTradeSell)
 	suite.Require().NoError(err)
 
-	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	suite.bankKeeper.EXPECT().BurnCoins(suite.ctx, types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
 
 	processResponse, err = suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
 		Creator:     testutil.Bob,
@@ -445,8 +438,8 @@ func (suite *KeeperTestSuite) TestBalancesAfterProcessTrade() {
 	createResponse, err := suite.msgServer.CreateTrade(suite.ctx, msgCreateTradeBuy)
 	suite.Require().NoError(err)
 
-	suite.bankKeeper.EXPECT().MintCoins(suite.ctx, types.ModuleName, gomock.Any()).Return(nil).Times(1)
-	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
 	processResponse, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
 		Creator:     testutil.Bob,
@@ -470,11 +463,12 @@ func (suite *KeeperTestSuite) TestBalancesAfterProcessTrade() {
 	initialBalance := suite.bankKeeper.GetBalance(suite.ctx, receiverAddress, types.DefaultDenom)
 	suite.Require().Equal(sdkmath.NewInt(5000000000000), initialBalance.Amount)
 
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
 	createResponse, err = suite.msgServer.CreateTrade(suite.ctx, msgCreateTradeSell)
 	suite.Require().NoError(err)
 
-	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	suite.bankKeeper.EXPECT().BurnCoins(suite.ctx, types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
 
 	processResponse, err = suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
 		Creator:     testutil.Bob,
@@ -496,3 +490,141 @@ func (suite *KeeperTestSuite) TestBalancesAfterProcessTrade() {
 
 	suite.Require().Equal(sdkmath.NewInt(2000000000000), finalBalance.Amount)
 }
+
+func (suite *KeeperTestSuite) TestProcessTradeWithApprovalTier() {
+	indexes := suite.createNTrades(2)
+	keeper := suite.tradeKeeper
+
+	// trades of the sample amount need two distinct checkers
+	params := types.DefaultParams()
+	params.ApprovalTiers = []types.ApprovalTier{
+		{Denom: types.DefaultDenom, MinAmount: sdkmath.NewInt(1000), RequiredApprovals: 2},
+	}
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+
+	processResponse, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:     testutil.Bob,
+		ProcessType: types.ProcessTypeConfirm,
+		TradeIndex:  indexes[0],
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.StatusPending, processResponse.Status)
+
+	trade, found := keeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Equal([]string{testutil.Bob}, trade.Approvals)
+	suite.Require().Equal(types.TradeAwaitingApprovals+": 1 of 2", trade.Result)
+
+	_, found = keeper.GetStoredTempTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+
+	// the same checker cannot approve twice
+	_, err = suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:     testutil.Bob,
+		ProcessType: types.ProcessTypeConfirm,
+		TradeIndex:  indexes[0],
+	})
+	suite.Require().ErrorIs(err, types.ErrDuplicateApproval)
+
+	// the second checker finalizes the trade
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	processResponse, err = suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:     testutil.Trent,
+		ProcessType: types.ProcessTypeConfirm,
+		TradeIndex:  indexes[0],
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.StatusProcessed, processResponse.Status)
+
+	trade, found = keeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Equal([]string{testutil.Bob, testutil.Trent}, trade.Approvals)
+	suite.Require().Equal(testutil.Trent, trade.Checker)
+
+	_, found = keeper.GetStoredTempTrade(suite.ctx, indexes[0])
+	suite.Require().False(found)
+
+	// any single checker can reject a trade awaiting approvals
+	_, err = suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:     testutil.Bob,
+		ProcessType: types.ProcessTypeConfirm,
+		TradeIndex:  indexes[1],
+	})
+	suite.Require().NoError(err)
+
+	processResponse, err = suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:     testutil.Trent,
+		ProcessType: types.ProcessTypeReject,
+		TradeIndex:  indexes[1],
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.StatusRejected, processResponse.Status)
+}
+
+func (suite *KeeperTestSuite) TestExportGenesisWithPartiallyApprovedTrades() {
+	indexes := suite.createNTrades(2)
+	keeper := suite.tradeKeeper
+
+	params := types.DefaultParams()
+	params.ApprovalTiers = []types.ApprovalTier{
+		{Denom: types.DefaultDenom, MinAmount: sdkmath.NewInt(1000), RequiredApprovals: 2},
+	}
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+
+	// both trades are approved once, the second one is then canceled by its maker
+	for _, tradeIndex := range indexes {
+		_, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+			Creator:     testutil.Bob,
+			ProcessType: types.ProcessTypeConfirm,
+			TradeIndex:  tradeIndex,
+		})
+		suite.Require().NoError(err)
+	}
+	_, err := suite.msgServer.CancelTrade(suite.ctx, &types.MsgCancelTrade{
+		Creator:    testutil.Alice,
+		TradeIndex: indexes[1],
+		Reason:     "typo in quantity",
+	})
+	suite.Require().NoError(err)
+
+	for _, tradeIndex := range indexes {
+		storedTrade, found := keeper.GetStoredTrade(suite.ctx, tradeIndex)
+		suite.Require().True(found)
+		suite.Require().Empty(storedTrade.Checker)
+		suite.Require().Equal([]string{testutil.Bob}, storedTrade.Approvals)
+	}
+
+	genesis := trade.ExportGenesis(suite.ctx, *keeper)
+	suite.Require().NoError(genesis.Validate())
+}
+
+func (suite *KeeperTestSuite) TestAmendTradeResetsApprovals() {
+	indexes := suite.createNTrades(1)
+	keeper := suite.tradeKeeper
+
+	params := types.DefaultParams()
+	params.ApprovalTiers = []types.ApprovalTier{
+		{Denom: types.DefaultDenom, MinAmount: sdkmath.NewInt(1000), RequiredApprovals: 2},
+	}
+	suite.Require().NoError(keeper.SetParams(suite.ctx, params))
+
+	_, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:     testutil.Bob,
+		ProcessType: types.ProcessTypeConfirm,
+		TradeIndex:  indexes[0],
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.AmendTrade(suite.ctx, &types.MsgAmendTrade{
+		Creator:           testutil.Alice,
+		TradeIndex:        indexes[0],
+		BankingSystemData: `{"bank":"updated"}`,
+	})
+	suite.Require().NoError(err)
+
+	trade, found := keeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Empty(trade.Approvals)
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) RetryTrade(goCtx context.Context, msg *types.MsgRetryTrade) (*types.MsgRetryTradeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hasPermission, err := k.HasPermission(ctx, msg.Creator, types.TxTypeProcessTrade)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPermission {
+		return nil, types.ErrInvalidCheckerPermission
+	}
+
+	st, found := k.GetStoredTrade(ctx, msg.TradeIndex)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "trade with index %d not found", msg.TradeIndex)
+	}
+
+	if msg.Creator == st.Maker {
+		return nil, types.ErrCheckerMustBeDifferent
+	}
+
+	if st.Status != types.StatusFailed {
+		return nil, types.ErrInvalidTradeStatus.Wrapf("cannot retry trade with status %s; only trades with status %s can be retried", st.Status.String(), types.StatusFailed.String())
+	}
+
+	// a failed trade released its external reference, which may have been reused since
+	if st.ExternalReference != "" {
+		if existingTrade, found := k.GetStoredTradeByExternalReference(ctx, st.ExternalReference); found {
+			return nil, types.ErrDuplicateExternalReference.Wrapf("external reference %s is used by trade %d with status %s",
+				st.ExternalReference, existingTrade.TradeIndex, existingTrade.Status.String())
+		}
+	}
+
+	formattedDate := ctx.BlockTime().Format(time.RFC3339)
+	st.UpdateDate = formattedDate
+
+	reason := ""
+	if msg.ReceiverAddress != "" && msg.ReceiverAddress != st.ReceiverAddress {
+		reason = fmt.Sprintf("receiver address corrected from %s to %s", st.ReceiverAddress, msg.ReceiverAddress)
+		previousPayloadHash := st.PayloadHash()
+		st.ReceiverAddress = msg.ReceiverAddress
+		st.Revision++
+		st.PreviousPayloadHash = previousPayloadHash
+
+		// the approvals were given to the previous receiver, so the trade is pending again until
+		// the required checkers confirm it with the corrected receiver
+		st.Status = types.StatusPending
+		st.Result = types.TradeAwaitingApprovals
+		st.Checker = ""
+		st.Approvals = nil
+		st.ClearFailure()
+
+		// the escrow of the failed withdrawal has been refunded, the coins are escrowed again
+		// from the corrected receiver
+		if err = k.escrowWithdrawal(ctx, st); err != nil {
+			return nil, err
+		}
+		k.SetStoredTempTrade(ctx, types.StoredTempTrade{
+			TradeIndex: st.TradeIndex,
+			TxDate:     formattedDate,
+		})
+	} else {
+		st.Checker = msg.Creator
+		st.ProcessDate = formattedDate
+		st.Attempts++
+
+		// the escrow of a failed withdrawal has been refunded, so the coins are pulled from the
+		// receiver again, within the cached context of the burn
+		status, err := k.MintOrBurnCoins(ctx, st)
+		if err != nil {
+			st.SetFailure(err)
+		} else {
+			st.ClearFailure()
+			st.Result = types.TradeProcessedSuccessfully
+		}
+		st.Status = status
+	}
+
+	if reason == "" {
+		reason = st.Result
+	} else {
+		reason = fmt.Sprintf("%s: %s", reason, st.Result)
+	}
+
+	k.SetStoredTrade(ctx, st)
+	k.recordTradeTransition(ctx, st, types.TransitionRetried, msg.Creator, reason)
+
+	if st.Status != types.StatusPending {
+		if err = k.Hooks().AfterTradeProcessed(ctx, st); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRetryTrade,
+			sdk.NewAttribute(types.AttributeKeyTradeIndex, fmt.Sprintf("%d", msg.TradeIndex)),
+			sdk.NewAttribute(types.AttributeKeyStatus, st.Status.String()),
+			sdk.NewAttribute(types.AttributeKeyChecker, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyMaker, st.Maker),
+			sdk.NewAttribute(types.AttributeKeyReceiverAddress, st.ReceiverAddress),
+			sdk.NewAttribute(types.AttributeKeyAttempts, fmt.Sprintf("%d", st.Attempts)),
+			sdk.NewAttribute(types.AttributeKeyUpdateDate, formattedDate),
+			sdk.NewAttribute(types.AttributeKeyResult, st.Result),
+		),
+	)
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventTradeRetried{
+		TradeIndex:      msg.TradeIndex,
+		Status:          st.Status,
+		Checker:         msg.Creator,
+		Maker:           st.Maker,
+		ReceiverAddress: st.ReceiverAddress,
+		Attempts:        st.Attempts,
+		Result:          st.Result,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetryTradeResponse{
+		TradeIndex: msg.TradeIndex,
+		Status:     st.Status,
+		Attempts:   st.Attempts,
+	}, nil
+}
@@ -0,0 +1,276 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/GGEZLabs/vvtxchain/x/trade/testutil"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"go.uber.org/mock/gomock"
+)
+
+// failTrade confirms a trade whose mint fails
+func (suite *KeeperTestSuite) failTrade(tradeIndex uint64) {
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(errors.New("mint failed")).Times(1)
+	suite.Require().Equal(types.StatusFailed, suite.confirmTrade(tradeIndex).Status)
+}
+
+func (suite *KeeperTestSuite) TestRetryTradeWithCorrectedReceiver() {
+	indexes := suite.createNTrades(1)
+	suite.failTrade(indexes[0])
+
+	failed, found := suite.tradeKeeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Equal(uint32(1), failed.Attempts)
+	suite.Require().Equal(types.ErrTradeExecutionFailed.ABCICode(), failed.FailureCode)
+
+	// the trade is pending again with the corrected receiver, no coins are minted
+	res, err := suite.msgServer.RetryTrade(suite.ctx, types.NewMsgRetryTrade(testutil.Bob, indexes[0], testutil.Carol))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.MsgRetryTradeResponse{
+		TradeIndex: indexes[0],
+		Status:     types.StatusPending,
+		Attempts:   1,
+	}, *res)
+
+	trade, found := suite.tradeKeeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Equal(types.StatusPending, trade.Status)
+	suite.Require().Equal(testutil.Carol, trade.ReceiverAddress)
+	suite.Require().Empty(trade.Checker)
+	suite.Require().Empty(trade.Approvals)
+	suite.Require().Equal(uint64(1), trade.Revision)
+	suite.Require().Equal(failed.PayloadHash(), trade.PreviousPayloadHash)
+	suite.Require().Equal(types.TradeAwaitingApprovals, trade.Result)
+	suite.Require().Empty(trade.FailureCodespace)
+	suite.Require().Zero(trade.FailureCode)
+
+	tempTrade, found := suite.tradeKeeper.GetStoredTempTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Equal(trade.UpdateDate, tempTrade.TxDate)
+
+	// the approval given to the previous revision is rejected
+	_, err = suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:     testutil.Bob,
+		ProcessType: types.ProcessTypeConfirm,
+		TradeIndex:  indexes[0],
+	})
+	suite.Require().ErrorIs(err, types.ErrTradeRevisionMismatch)
+
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(testutil.Carol), gomock.Any()).Return(nil).Times(1)
+
+	processResponse, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:          testutil.Bob,
+		ProcessType:      types.ProcessTypeConfirm,
+		TradeIndex:       indexes[0],
+		ExpectedRevision: 1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.StatusProcessed, processResponse.Status)
+
+	trade, found = suite.tradeKeeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Equal(testutil.Bob, trade.Checker)
+	suite.Require().Equal(uint32(2), trade.Attempts)
+	suite.Require().Equal(types.TradeProcessedSuccessfully, trade.Result)
+
+	_, found = suite.tradeKeeper.GetStoredTempTrade(suite.ctx, indexes[0])
+	suite.Require().False(found)
+
+	suite.requireTradeHistory(indexes[0],
+		types.TradeHistoryEntry{Transition: types.TransitionCreated, Status: types.StatusPending, Actor: testutil.Alice, Reason: types.TradeCreatedSuccessfully},
+		types.TradeHistoryEntry{Transition: types.TransitionFailed, Status: types.StatusFailed, Actor: testutil.Bob, Reason: "mint failed"},
+		types.TradeHistoryEntry{
+			Transition: types.TransitionRetried,
+			Status:     types.StatusPending,
+			Actor:      testutil.Bob,
+			Reason:     "receiver address corrected from " + testutil.Alice + " to " + testutil.Carol + ": " + types.TradeAwaitingApprovals,
+		},
+		types.TradeHistoryEntry{Transition: types.TransitionApproved, Status: types.StatusProcessed, Actor: testutil.Bob, Reason: types.TradeProcessedSuccessfully},
+	)
+}
+
+func (suite *KeeperTestSuite) TestRetryTradeWithCorrectedReceiverAndApprovalTier() {
+	indexes := suite.createNTrades(1)
+
+	// trades of the sample amount need two distinct checkers
+	params := types.DefaultParams()
+	params.ApprovalTiers = []types.ApprovalTier{
+		{Denom: types.DefaultDenom, MinAmount: sdkmath.NewInt(1000), RequiredApprovals: 2},
+	}
+	suite.Require().NoError(suite.tradeKeeper.SetParams(suite.ctx, params))
+
+	suite.Require().Equal(types.StatusPending, suite.confirmTrade(indexes[0]).Status)
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(errors.New("mint failed")).Times(1)
+	processResponse, err := suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:     testutil.Trent,
+		ProcessType: types.ProcessTypeConfirm,
+		TradeIndex:  indexes[0],
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.StatusFailed, processResponse.Status)
+
+	// a single checker cannot redirect the trade to another receiver, the bank keeper
+	// mock fails on any mint until the two checkers confirm the corrected receiver
+	res, err := suite.msgServer.RetryTrade(suite.ctx, types.NewMsgRetryTrade(testutil.Bob, indexes[0], testutil.Carol))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.StatusPending, res.Status)
+
+	processResponse, err = suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:          testutil.Bob,
+		ProcessType:      types.ProcessTypeConfirm,
+		TradeIndex:       indexes[0],
+		ExpectedRevision: 1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.StatusPending, processResponse.Status)
+
+	trade, found := suite.tradeKeeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Equal([]string{testutil.Bob}, trade.Approvals)
+	suite.Require().Equal(types.TradeAwaitingApprovals+": 1 of 2", trade.Result)
+
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.MustAccAddressFromBech32(testutil.Carol), gomock.Any()).Return(nil).Times(1)
+
+	processResponse, err = suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:          testutil.Trent,
+		ProcessType:      types.ProcessTypeConfirm,
+		TradeIndex:       indexes[0],
+		ExpectedRevision: 1,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.StatusProcessed, processResponse.Status)
+}
+
+func (suite *KeeperTestSuite) TestRetryWithdrawalWithCorrectedReceiver() {
+	suite.setupTest()
+	tradeIndex, escrow := suite.createWithdrawal(1000)
+
+	// the failed burn refunds the escrow to Alice
+	suite.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, escrow).Return(sdkerrors.ErrInsufficientFunds).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sdk.MustAccAddressFromBech32(testutil.Alice), escrow).Return(nil).Times(1)
+	suite.Require().Equal(types.StatusFailed, suite.confirmTrade(tradeIndex).Status)
+
+	// the amount is escrowed again from the corrected receiver
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, sdk.MustAccAddressFromBech32(testutil.Carol), types.ModuleName, escrow).Return(nil).Times(1)
+	res, err := suite.msgServer.RetryTrade(suite.ctx, types.NewMsgRetryTrade(testutil.Bob, tradeIndex, testutil.Carol))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.StatusPending, res.Status)
+
+	tradeEscrow, found := suite.tradeKeeper.GetTradeEscrow(suite.ctx, tradeIndex)
+	suite.Require().True(found)
+	suite.Require().Equal(types.TradeEscrow{
+		TradeIndex: tradeIndex,
+		Holder:     testutil.Carol,
+		Amount:     escrow[0],
+	}, tradeEscrow)
+
+	// the corrected receiver cannot escrow the amount
+	suite.setupTest()
+	tradeIndex, escrow = suite.createWithdrawal(1000)
+	suite.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, escrow).Return(sdkerrors.ErrInsufficientFunds).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, sdk.MustAccAddressFromBech32(testutil.Alice), escrow).Return(nil).Times(1)
+	suite.Require().Equal(types.StatusFailed, suite.confirmTrade(tradeIndex).Status)
+
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, sdk.MustAccAddressFromBech32(testutil.Carol), types.ModuleName, escrow).Return(sdkerrors.ErrInsufficientFunds).Times(1)
+	_, err = suite.msgServer.RetryTrade(suite.ctx, types.NewMsgRetryTrade(testutil.Bob, tradeIndex, testutil.Carol))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+}
+
+func (suite *KeeperTestSuite) TestRetryTradeFailsAgain() {
+	indexes := suite.createNTrades(1)
+	suite.failTrade(indexes[0])
+
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(sdkerrors.ErrInsufficientFunds).Times(1)
+
+	res, err := suite.msgServer.RetryTrade(suite.ctx, types.NewMsgRetryTrade(testutil.Bob, indexes[0], ""))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.StatusFailed, res.Status)
+	suite.Require().Equal(uint32(2), res.Attempts)
+
+	trade, found := suite.tradeKeeper.GetStoredTrade(suite.ctx, indexes[0])
+	suite.Require().True(found)
+	suite.Require().Equal(testutil.Alice, trade.ReceiverAddress)
+	suite.Require().Equal(sdkerrors.ErrInsufficientFunds.Codespace(), trade.FailureCodespace)
+	suite.Require().Equal(sdkerrors.ErrInsufficientFunds.ABCICode(), trade.FailureCode)
+
+	history := suite.tradeKeeper.GetTradeHistory(suite.ctx, indexes[0])
+	suite.Require().Len(history, 3)
+	suite.Require().Equal(types.TransitionRetried, history[2].Transition)
+	suite.Require().Equal(types.StatusFailed, history[2].Status)
+	suite.Require().Equal(trade.Result, history[2].Reason)
+}
+
+func (suite *KeeperTestSuite) TestRetryTradeErrors() {
+	for _, tc := range []struct {
+		desc  string
+		setup func() *types.MsgRetryTrade
+		err   error
+	}{
+		{
+			desc: "trade not found",
+			setup: func() *types.MsgRetryTrade {
+				suite.setupTest()
+				return types.NewMsgRetryTrade(testutil.Bob, 1, "")
+			},
+			err: sdkerrors.ErrNotFound,
+		},
+		{
+			desc: "creator without checker permission",
+			setup: func() *types.MsgRetryTrade {
+				indexes := suite.createNTrades(1)
+				suite.failTrade(indexes[0])
+				return types.NewMsgRetryTrade(testutil.Alice, indexes[0], "")
+			},
+			err: types.ErrInvalidCheckerPermission,
+		},
+		{
+			desc: "checker is the maker",
+			setup: func() *types.MsgRetryTrade {
+				suite.setupTest()
+				msg := types.GetSampleMsgCreateTrade()
+				msg.Creator = testutil.Trent
+				res, err := suite.msgServer.CreateTrade(suite.ctx, msg)
+				suite.Require().NoError(err)
+				suite.failTrade(res.TradeIndex)
+				return types.NewMsgRetryTrade(testutil.Trent, res.TradeIndex, "")
+			},
+			err: types.ErrCheckerMustBeDifferent,
+		},
+		{
+			desc: "trade is not failed",
+			setup: func() *types.MsgRetryTrade {
+				indexes := suite.createNTrades(1)
+				return types.NewMsgRetryTrade(testutil.Bob, indexes[0], "")
+			},
+			err: types.ErrInvalidTradeStatus,
+		},
+		{
+			desc: "external reference reused by another trade",
+			setup: func() *types.MsgRetryTrade {
+				suite.setupTest()
+				msg := types.GetSampleMsgCreateTrade()
+				msg.ExternalReference = "WIRE-2023-0001"
+				res, err := suite.msgServer.CreateTrade(suite.ctx, msg)
+				suite.Require().NoError(err)
+				suite.failTrade(res.TradeIndex)
+
+				// the failed trade released its reference
+				_, err = suite.msgServer.CreateTrade(suite.ctx, msg)
+				suite.Require().NoError(err)
+				return types.NewMsgRetryTrade(testutil.Bob, res.TradeIndex, "")
+			},
+			err: types.ErrDuplicateExternalReference,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			msg := tc.setup()
+			_, err := suite.msgServer.RetryTrade(suite.ctx, msg)
+			suite.Require().ErrorIs(err, tc.err)
+		})
+	}
+}
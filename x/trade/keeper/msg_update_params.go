@@ -13,10 +13,20 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
 	}
 
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	oldParams := k.GetParams(ctx)
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
+	// Pending trades expire according to the new ttl
+	if oldParams.PendingTradeTtl != req.Params.PendingTradeTtl {
+		k.RebuildStoredTempTradeExpiryIndex(ctx)
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
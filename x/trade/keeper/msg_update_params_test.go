@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/GGEZLabs/vvtxchain/x/trade/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,11 +32,29 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "zero pending trade ttl",
 			input: &types.MsgUpdateParams{
 				Authority: k.GetAuthority(),
 				Params:    types.Params{},
 			},
+			expErr:    true,
+			expErrMsg: "pending trade ttl must be positive",
+		},
+		{
+			name: "negative pending trade ttl",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    types.NewParams(-time.Hour, types.DefaultMintableDenoms, types.DefaultApprovalTiers, types.DefaultMintBurnLimits, types.DefaultTradeDataTolerances, types.DefaultSupplyBaselines),
+			},
+			expErr:    true,
+			expErrMsg: "pending trade ttl must be positive",
+		},
+		{
+			name: "weekend pending trade ttl",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    types.NewParams(72*time.Hour, types.DefaultMintableDenoms, types.DefaultApprovalTiers, types.DefaultMintBurnLimits, types.DefaultTradeDataTolerances, types.DefaultSupplyBaselines),
+			},
 			expErr: false,
 		},
 		{
@@ -4,8 +4,11 @@ import (
 	"testing"
 
 	keepertest "github.com/GGEZLabs/vvtxchain/testutil/keeper"
+	"github.com/GGEZLabs/vvtxchain/x/trade/keeper"
 	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 func TestGetParams(t *testing.T) {
@@ -15,3 +18,40 @@ func TestGetParams(t *testing.T) {
 	require.NoError(t, k.SetParams(ctx, params))
 	require.Equal(t, params, k.GetParams(ctx))
 }
+
+func (suite *KeeperTestSuite) TestMigrate9to10SetsDefaultMintableDenoms() {
+	indexes := suite.createNTrades(1)
+
+	// the chains upgraded before the registry was added have no mintable denoms
+	params := suite.tradeKeeper.GetParams(suite.ctx)
+	params.MintableDenoms = nil
+	suite.Require().NoError(suite.tradeKeeper.SetParams(suite.ctx, params))
+
+	suite.Require().NoError(keeper.NewMigrator(*suite.tradeKeeper).Migrate9to10(suite.ctx))
+	suite.Require().Equal(types.DefaultMintableDenoms, suite.tradeKeeper.GetParams(suite.ctx).MintableDenoms)
+
+	// the deposit created before the upgrade can still be confirmed
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.Require().Equal(types.StatusProcessed, suite.confirmTrade(indexes[0]).Status)
+}
+
+func TestMigrate7to8SetsDefaultTradeDataTolerances(t *testing.T) {
+	k, ctx := keepertest.TradeKeeper(t)
+	params := types.DefaultParams()
+	params.TradeDataTolerances = types.TradeDataTolerances{}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate7to8(sdk.UnwrapSDKContext(ctx)))
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
+func TestMigrate9to10KeepsMintableDenoms(t *testing.T) {
+	k, ctx := keepertest.TradeKeeper(t)
+	params := types.DefaultParams()
+	params.MintableDenoms = []types.MintableDenom{params.MintableDenoms[0]}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate9to10(sdk.UnwrapSDKContext(ctx)))
+	require.Equal(t, params, k.GetParams(ctx))
+}
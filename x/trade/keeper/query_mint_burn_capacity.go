@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) MintBurnCapacity(goCtx context.Context, req *types.QueryMintBurnCapacityRequest) (*types.QueryMintBurnCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Maker); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid maker address: %s", err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	limit, found := k.GetParams(ctx).GetMintBurnLimit(req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no mint burn limit for denom: %s", req.Denom))
+	}
+
+	since := ctx.BlockTime().Add(-limit.Window)
+	globalMinted, makerMinted := k.GetMintBurnVolume(ctx, req.Denom, types.MintDirection, since, req.Maker)
+	globalBurned, makerBurned := k.GetMintBurnVolume(ctx, req.Denom, types.BurnDirection, since, req.Maker)
+
+	return &types.QueryMintBurnCapacityResponse{
+		Denom:      req.Denom,
+		Window:     limit.Window,
+		MakerMint:  newMintBurnCapacity(limit.MakerMintLimit, makerMinted),
+		MakerBurn:  newMintBurnCapacity(limit.MakerBurnLimit, makerBurned),
+		GlobalMint: newMintBurnCapacity(limit.GlobalMintLimit, globalMinted),
+		GlobalBurn: newMintBurnCapacity(limit.GlobalBurnLimit, globalBurned),
+	}, nil
+}
+
+// newMintBurnCapacity returns the capacity of a limit, the remaining volume is zero when the limit
+// is zero (not capped) or used up
+func newMintBurnCapacity(limit, used math.Int) types.MintBurnCapacity {
+	remaining := math.ZeroInt()
+	if limit.GT(used) {
+		remaining = limit.Sub(used)
+	}
+
+	return types.MintBurnCapacity{
+		Limit:     limit,
+		Used:      used,
+		Remaining: remaining,
+	}
+}
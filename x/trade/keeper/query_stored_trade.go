@@ -52,3 +52,20 @@ func (k Keeper) StoredTrade(ctx context.Context, req *types.QueryGetStoredTradeR
 
 	return &types.QueryGetStoredTradeResponse{StoredTrade: val}, nil
 }
+
+func (k Keeper) StoredTradeByExternalReference(ctx context.Context, req *types.QueryStoredTradeByExternalReferenceRequest) (*types.QueryStoredTradeByExternalReferenceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ExternalReference == "" {
+		return nil, status.Error(codes.InvalidArgument, "external reference cannot be empty")
+	}
+
+	val, found := k.GetStoredTradeByExternalReference(ctx, req.ExternalReference)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryStoredTradeByExternalReferenceResponse{StoredTrade: val}, nil
+}
@@ -0,0 +1,255 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) StoredTradesByStatus(ctx context.Context, req *types.QueryStoredTradesByStatusRequest) (*types.QueryStoredTradesByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, ok := types.TradeStatus_name[int32(req.Status)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid trade status: %d", req.Status))
+	}
+
+	storedTrades, pageRes, err := k.paginateStoredTradeSecondaryIndex(
+		ctx,
+		types.StoredTradeByStatusKeyPrefix,
+		types.StoredTradeStatusKey(req.Status),
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStoredTradesByStatusResponse{StoredTrade: storedTrades, Pagination: pageRes}, nil
+}
+
+func (k Keeper) StoredTradesByMaker(ctx context.Context, req *types.QueryStoredTradesByMakerRequest) (*types.QueryStoredTradesByMakerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Maker); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid maker address: %s", err))
+	}
+
+	storedTrades, pageRes, err := k.paginateStoredTradeSecondaryIndex(
+		ctx,
+		types.StoredTradeByMakerKeyPrefix,
+		types.StoredTradeAddressKey(req.Maker),
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStoredTradesByMakerResponse{StoredTrade: storedTrades, Pagination: pageRes}, nil
+}
+
+func (k Keeper) StoredTradesByChecker(ctx context.Context, req *types.QueryStoredTradesByCheckerRequest) (*types.QueryStoredTradesByCheckerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Checker); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid checker address: %s", err))
+	}
+
+	storedTrades, pageRes, err := k.paginateStoredTradeSecondaryIndex(
+		ctx,
+		types.StoredTradeByCheckerKeyPrefix,
+		types.StoredTradeAddressKey(req.Checker),
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStoredTradesByCheckerResponse{StoredTrade: storedTrades, Pagination: pageRes}, nil
+}
+
+func (k Keeper) StoredTradesByReceiver(ctx context.Context, req *types.QueryStoredTradesByReceiverRequest) (*types.QueryStoredTradesByReceiverResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.ReceiverAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid receiver address: %s", err))
+	}
+
+	storedTrades, pageRes, err := k.paginateStoredTradeSecondaryIndex(
+		ctx,
+		types.StoredTradeByReceiverKeyPrefix,
+		types.StoredTradeAddressKey(req.ReceiverAddress),
+		req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStoredTradesByReceiverResponse{StoredTrade: storedTrades, Pagination: pageRes}, nil
+}
+
+func (k Keeper) StoredTradesByDateRange(ctx context.Context, req *types.QueryStoredTradesByDateRangeRequest) (*types.QueryStoredTradesByDateRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	keyPrefix, ok := types.StoredTradeDateKeyPrefix(req.DateField)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid date field: %s", req.DateField))
+	}
+
+	if req.From.After(req.To) {
+		return nil, status.Error(codes.InvalidArgument, "from must not be after to")
+	}
+
+	if _, ok := types.TradeStatus_name[int32(req.Status)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid trade status: %d", req.Status))
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	dateStore := prefix.NewStore(store, types.KeyPrefix(keyPrefix))
+
+	storedTrades, pageRes, err := k.paginateStoredTradeRange(
+		ctx,
+		dateStore,
+		sdk.FormatTimeBytes(req.From),
+		storetypes.PrefixEndBytes(sdk.FormatTimeBytes(req.To)),
+		req.Pagination,
+		func(storedTrade types.StoredTrade) bool {
+			return req.Status == types.StatusNil || storedTrade.Status == req.Status
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStoredTradesByDateRangeResponse{StoredTrade: storedTrades, Pagination: pageRes}, nil
+}
+
+// paginateStoredTradeSecondaryIndex paginates over the trade indexes stored under the given
+// field key of a secondary index and returns the matching stored trades
+func (k Keeper) paginateStoredTradeSecondaryIndex(
+	ctx context.Context,
+	keyPrefix string,
+	fieldKey []byte,
+	pageReq *query.PageRequest,
+) ([]types.StoredTrade, *query.PageResponse, error) {
+	var storedTrades []types.StoredTrade
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(store, append(types.KeyPrefix(keyPrefix), fieldKey...))
+
+	pageRes, err := query.Paginate(indexStore, pageReq, func(key []byte, value []byte) error {
+		tradeIndex := binary.BigEndian.Uint64(value)
+		storedTrade, found := k.GetStoredTrade(ctx, tradeIndex)
+		if !found {
+			return fmt.Errorf("stored trade %d referenced by index not found", tradeIndex)
+		}
+
+		storedTrades = append(storedTrades, storedTrade)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return storedTrades, pageRes, nil
+}
+
+// paginateStoredTradeRange paginates over the trade indexes stored in the [start, end) key range of
+// a secondary index and returns the stored trades accepted by the filter. Only the entries of the
+// range are iterated.
+func (k Keeper) paginateStoredTradeRange(
+	ctx context.Context,
+	indexStore storetypes.KVStore,
+	start, end []byte,
+	pageReq *query.PageRequest,
+	filter func(types.StoredTrade) bool,
+) ([]types.StoredTrade, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	countTotal := pageReq.CountTotal && pageReq.Key == nil
+
+	// the page key narrows the range, it never extends it
+	if pageReq.Key != nil {
+		if pageReq.Reverse {
+			if keyEnd := append(bytes.Clone(pageReq.Key), 0x00); bytes.Compare(keyEnd, end) < 0 {
+				end = keyEnd
+			}
+		} else if bytes.Compare(pageReq.Key, start) > 0 {
+			start = pageReq.Key
+		}
+	}
+
+	var iterator storetypes.Iterator
+	if pageReq.Reverse {
+		iterator = indexStore.ReverseIterator(start, end)
+	} else {
+		iterator = indexStore.Iterator(start, end)
+	}
+	defer iterator.Close()
+
+	var (
+		storedTrades []types.StoredTrade
+		nextKey      []byte
+		count        uint64
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		tradeIndex := binary.BigEndian.Uint64(iterator.Value())
+		storedTrade, found := k.GetStoredTrade(ctx, tradeIndex)
+		if !found {
+			return nil, nil, status.Error(codes.Internal, fmt.Sprintf("stored trade %d referenced by index not found", tradeIndex))
+		}
+		if !filter(storedTrade) {
+			continue
+		}
+
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+		if count <= pageReq.Offset+limit {
+			storedTrades = append(storedTrades, storedTrade)
+			continue
+		}
+		if nextKey == nil {
+			nextKey = bytes.Clone(iterator.Key())
+		}
+		if !countTotal {
+			break
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return storedTrades, pageRes, nil
+}
@@ -0,0 +1,310 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	keepertest "github.com/GGEZLabs/vvtxchain/testutil/keeper"
+	"github.com/GGEZLabs/vvtxchain/testutil/nullify"
+	"github.com/GGEZLabs/vvtxchain/x/trade/keeper"
+	"github.com/GGEZLabs/vvtxchain/x/trade/testutil"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func createIndexedStoredTrades(keeper keeper.Keeper, ctx context.Context) []types.StoredTrade {
+	items := []types.StoredTrade{
+		{TradeIndex: 1, Status: types.StatusPending, Maker: testutil.Alice, ReceiverAddress: testutil.Carol},
+		{TradeIndex: 2, Status: types.StatusProcessed, Maker: testutil.Alice, Checker: testutil.Bob, ReceiverAddress: testutil.Carol},
+		{TradeIndex: 3, Status: types.StatusRejected, Maker: testutil.Bob, Checker: testutil.Alice, ReceiverAddress: testutil.Alice},
+		{TradeIndex: 4, Status: types.StatusPending, Maker: testutil.Bob, ReceiverAddress: testutil.Carol},
+	}
+	for _, item := range items {
+		keeper.SetStoredTrade(ctx, item)
+	}
+	return items
+}
+
+func TestStoredTradesByStatusQuery(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	items := createIndexedStoredTrades(keeper, ctx)
+
+	resp, err := keeper.StoredTradesByStatus(ctx, &types.QueryStoredTradesByStatusRequest{
+		Status:     types.StatusPending,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Pagination.Total)
+	require.Equal(t, nullify.Fill([]types.StoredTrade{items[0], items[3]}), nullify.Fill(resp.StoredTrade))
+
+	// the index follows the status update
+	items[0].Status = types.StatusCanceled
+	keeper.SetStoredTrade(ctx, items[0])
+
+	resp, err = keeper.StoredTradesByStatus(ctx, &types.QueryStoredTradesByStatusRequest{Status: types.StatusPending})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.StoredTrade{items[3]}), nullify.Fill(resp.StoredTrade))
+
+	resp, err = keeper.StoredTradesByStatus(ctx, &types.QueryStoredTradesByStatusRequest{Status: types.StatusCanceled})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.StoredTrade{items[0]}), nullify.Fill(resp.StoredTrade))
+
+	_, err = keeper.StoredTradesByStatus(ctx, &types.QueryStoredTradesByStatusRequest{Status: types.TradeStatus(100)})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid trade status: 100"))
+
+	_, err = keeper.StoredTradesByStatus(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestStoredTradesByStatusQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	items := createIndexedStoredTrades(keeper, ctx)
+
+	resp, err := keeper.StoredTradesByStatus(ctx, &types.QueryStoredTradesByStatusRequest{
+		Status:     types.StatusPending,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.StoredTrade{items[0]}), nullify.Fill(resp.StoredTrade))
+	require.NotNil(t, resp.Pagination.NextKey)
+
+	resp, err = keeper.StoredTradesByStatus(ctx, &types.QueryStoredTradesByStatusRequest{
+		Status:     types.StatusPending,
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.StoredTrade{items[3]}), nullify.Fill(resp.StoredTrade))
+	require.Nil(t, resp.Pagination.NextKey)
+}
+
+func TestStoredTradesByMakerQuery(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	items := createIndexedStoredTrades(keeper, ctx)
+
+	resp, err := keeper.StoredTradesByMaker(ctx, &types.QueryStoredTradesByMakerRequest{Maker: testutil.Alice})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.StoredTrade{items[0], items[1]}), nullify.Fill(resp.StoredTrade))
+
+	resp, err = keeper.StoredTradesByMaker(ctx, &types.QueryStoredTradesByMakerRequest{Maker: testutil.Carol})
+	require.NoError(t, err)
+	require.Empty(t, resp.StoredTrade)
+
+	_, err = keeper.StoredTradesByMaker(ctx, &types.QueryStoredTradesByMakerRequest{Maker: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = keeper.StoredTradesByMaker(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestStoredTradesByCheckerQuery(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	items := createIndexedStoredTrades(keeper, ctx)
+
+	resp, err := keeper.StoredTradesByChecker(ctx, &types.QueryStoredTradesByCheckerRequest{Checker: testutil.Alice})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.StoredTrade{items[2]}), nullify.Fill(resp.StoredTrade))
+
+	// the index follows the checker update
+	items[0].Checker = testutil.Alice
+	keeper.SetStoredTrade(ctx, items[0])
+
+	resp, err = keeper.StoredTradesByChecker(ctx, &types.QueryStoredTradesByCheckerRequest{Checker: testutil.Alice})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.StoredTrade{items[0], items[2]}), nullify.Fill(resp.StoredTrade))
+
+	_, err = keeper.StoredTradesByChecker(ctx, &types.QueryStoredTradesByCheckerRequest{Checker: ""})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = keeper.StoredTradesByChecker(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestStoredTradesByReceiverQuery(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	items := createIndexedStoredTrades(keeper, ctx)
+
+	resp, err := keeper.StoredTradesByReceiver(ctx, &types.QueryStoredTradesByReceiverRequest{
+		ReceiverAddress: testutil.Carol,
+		Pagination:      &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.Pagination.Total)
+	require.Equal(t, nullify.Fill([]types.StoredTrade{items[0], items[1], items[3]}), nullify.Fill(resp.StoredTrade))
+
+	// removing a trade removes it from the indexes
+	keeper.RemoveStoredTrade(ctx, items[1].TradeIndex)
+
+	resp, err = keeper.StoredTradesByReceiver(ctx, &types.QueryStoredTradesByReceiverRequest{ReceiverAddress: testutil.Carol})
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill([]types.StoredTrade{items[0], items[3]}), nullify.Fill(resp.StoredTrade))
+
+	_, err = keeper.StoredTradesByReceiver(ctx, &types.QueryStoredTradesByReceiverRequest{ReceiverAddress: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = keeper.StoredTradesByReceiver(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func createDatedStoredTrades(keeper keeper.Keeper, ctx context.Context, startDate time.Time) []types.StoredTrade {
+	items := make([]types.StoredTrade, 5)
+	for i := range items {
+		date := startDate.Add(time.Duration(i) * 24 * time.Hour)
+		items[i] = types.StoredTrade{
+			TradeIndex:  uint64(5 - i),
+			Status:      types.StatusProcessed,
+			CreateDate:  date.Format(time.RFC3339),
+			TxDate:      date.Add(time.Hour).Format(time.RFC3339),
+			ProcessDate: date.Add(2 * time.Hour).Format(time.RFC3339),
+		}
+		if i%2 == 1 {
+			items[i].Status = types.StatusRejected
+		}
+		keeper.SetStoredTrade(ctx, items[i])
+	}
+	return items
+}
+
+func TestStoredTradesByDateRangeQuery(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	items := createDatedStoredTrades(keeper, ctx, startDate)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryStoredTradesByDateRangeRequest
+		response []types.StoredTrade
+		err      error
+	}{
+		{
+			desc: "create date inclusive range",
+			request: &types.QueryStoredTradesByDateRangeRequest{
+				DateField: types.TradeDateFieldCreateDate,
+				From:      startDate.Add(24 * time.Hour),
+				To:        startDate.Add(72 * time.Hour),
+			},
+			response: []types.StoredTrade{items[1], items[2], items[3]},
+		},
+		{
+			desc: "tx date range",
+			request: &types.QueryStoredTradesByDateRangeRequest{
+				DateField: types.TradeDateFieldTxDate,
+				From:      startDate,
+				To:        startDate.Add(time.Hour),
+			},
+			response: []types.StoredTrade{items[0]},
+		},
+		{
+			desc: "process date range with status",
+			request: &types.QueryStoredTradesByDateRangeRequest{
+				DateField: types.TradeDateFieldProcessDate,
+				From:      startDate,
+				To:        startDate.Add(30 * 24 * time.Hour),
+				Status:    types.StatusRejected,
+			},
+			response: []types.StoredTrade{items[1], items[3]},
+		},
+		{
+			desc: "empty range",
+			request: &types.QueryStoredTradesByDateRangeRequest{
+				DateField: types.TradeDateFieldProcessDate,
+				From:      startDate,
+				To:        startDate.Add(time.Hour),
+			},
+		},
+		{
+			desc: "unspecified date field",
+			request: &types.QueryStoredTradesByDateRangeRequest{
+				From: startDate,
+				To:   startDate,
+			},
+			err: status.Error(codes.InvalidArgument, "invalid date field: TRADE_DATE_FIELD_UNSPECIFIED"),
+		},
+		{
+			desc: "from after to",
+			request: &types.QueryStoredTradesByDateRangeRequest{
+				DateField: types.TradeDateFieldCreateDate,
+				From:      startDate.Add(time.Hour),
+				To:        startDate,
+			},
+			err: status.Error(codes.InvalidArgument, "from must not be after to"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := keeper.StoredTradesByDateRange(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, nullify.Fill(tc.response), nullify.Fill(resp.StoredTrade))
+		})
+	}
+}
+
+func TestStoredTradesByDateRangeQueryPaginated(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	items := createDatedStoredTrades(keeper, ctx, startDate)
+
+	request := func(next []byte, offset, limit uint64, total, reverse bool) *types.QueryStoredTradesByDateRangeRequest {
+		return &types.QueryStoredTradesByDateRangeRequest{
+			DateField: types.TradeDateFieldCreateDate,
+			From:      startDate.Add(24 * time.Hour),
+			To:        startDate.Add(96 * time.Hour),
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+				Reverse:    reverse,
+			},
+		}
+	}
+	t.Run("ByKey", func(t *testing.T) {
+		var got []types.StoredTrade
+		var next []byte
+		for i := 0; i < 2; i++ {
+			resp, err := keeper.StoredTradesByDateRange(ctx, request(next, 0, 2, false, false))
+			require.NoError(t, err)
+			got = append(got, resp.StoredTrade...)
+			next = resp.Pagination.NextKey
+		}
+		require.Nil(t, next)
+		require.Equal(t, nullify.Fill(items[1:]), nullify.Fill(got))
+	})
+	t.Run("ByKeyReverse", func(t *testing.T) {
+		var got []types.StoredTrade
+		var next []byte
+		for i := 0; i < 2; i++ {
+			resp, err := keeper.StoredTradesByDateRange(ctx, request(next, 0, 2, false, true))
+			require.NoError(t, err)
+			got = append(got, resp.StoredTrade...)
+			next = resp.Pagination.NextKey
+		}
+		require.Nil(t, next)
+		require.Equal(t, nullify.Fill([]types.StoredTrade{items[4], items[3], items[2], items[1]}), nullify.Fill(got))
+	})
+	t.Run("ByOffset", func(t *testing.T) {
+		resp, err := keeper.StoredTradesByDateRange(ctx, request(nil, 1, 2, false, false))
+		require.NoError(t, err)
+		require.Equal(t, nullify.Fill(items[2:4]), nullify.Fill(resp.StoredTrade))
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := keeper.StoredTradesByDateRange(ctx, request(nil, 0, 1, true, false))
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), resp.Pagination.Total)
+		require.Equal(t, nullify.Fill(items[1:2]), nullify.Fill(resp.StoredTrade))
+	})
+	t.Run("KeyAndOffset", func(t *testing.T) {
+		_, err := keeper.StoredTradesByDateRange(ctx, request([]byte("key"), 1, 2, false, false))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
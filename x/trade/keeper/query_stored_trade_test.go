@@ -121,3 +121,62 @@ func TestStoredTradeQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestStoredTradeQueryByExternalReference(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	pending := types.GetSampleStoredTrade(1)
+	pending.ExternalReference = "WIRE-1"
+	canceled := types.GetSampleStoredTrade(2)
+	canceled.Status = types.StatusCanceled
+	canceled.ExternalReference = "WIRE-2"
+	keeper.SetStoredTrade(ctx, pending)
+	keeper.SetStoredTrade(ctx, canceled)
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryStoredTradeByExternalReferenceRequest
+		response *types.QueryStoredTradeByExternalReferenceResponse
+		err      error
+	}{
+		{
+			desc:     "Found",
+			request:  &types.QueryStoredTradeByExternalReferenceRequest{ExternalReference: "WIRE-1"},
+			response: &types.QueryStoredTradeByExternalReferenceResponse{StoredTrade: pending},
+		},
+		{
+			desc:    "ReleasedByCanceledTrade",
+			request: &types.QueryStoredTradeByExternalReferenceRequest{ExternalReference: "WIRE-2"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryStoredTradeByExternalReferenceRequest{ExternalReference: "WIRE-3"},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc:    "EmptyExternalReference",
+			request: &types.QueryStoredTradeByExternalReferenceRequest{},
+			err:     status.Error(codes.InvalidArgument, "external reference cannot be empty"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.StoredTradeByExternalReference(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+
+	// removing the trade releases its reference
+	keeper.RemoveStoredTrade(ctx, pending.TradeIndex)
+	_, found := keeper.GetStoredTradeByExternalReference(ctx, "WIRE-1")
+	require.False(t, found)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) SupplyReconciliation(goCtx context.Context, req *types.QuerySupplyReconciliationRequest) (*types.QuerySupplyReconciliationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom: %s", err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	deposits, withdrawals, err := k.GetProcessedTradeTotals(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := k.newSupplyReconciliation(ctx, req.Denom, k.GetParams(ctx).SupplyBaselines, deposits, withdrawals)
+
+	return &res, nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TradeEscrowAll(ctx context.Context, req *types.QueryAllTradeEscrowRequest) (*types.QueryAllTradeEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var tradeEscrows []types.TradeEscrow
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	tradeEscrowStore := prefix.NewStore(store, types.KeyPrefix(types.TradeEscrowKeyPrefix))
+
+	pageRes, err := query.Paginate(tradeEscrowStore, req.Pagination, func(key []byte, value []byte) error {
+		var tradeEscrow types.TradeEscrow
+		if err := k.cdc.Unmarshal(value, &tradeEscrow); err != nil {
+			return err
+		}
+
+		tradeEscrows = append(tradeEscrows, tradeEscrow)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// only escrowed withdrawals are held by the module account between blocks
+	balance := k.bankKeeper.SpendableCoins(ctx, authtypes.NewModuleAddress(types.ModuleName))
+
+	return &types.QueryAllTradeEscrowResponse{TradeEscrow: tradeEscrows, Pagination: pageRes, Balance: balance}, nil
+}
+
+func (k Keeper) TradeEscrow(ctx context.Context, req *types.QueryGetTradeEscrowRequest) (*types.QueryGetTradeEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, found := k.GetTradeEscrow(
+		ctx,
+		req.TradeIndex,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetTradeEscrowResponse{TradeEscrow: val}, nil
+}
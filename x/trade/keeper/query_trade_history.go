@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TradeHistory(ctx context.Context, req *types.QueryTradeHistoryRequest) (*types.QueryTradeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, found := k.GetStoredTrade(ctx, req.TradeIndex); !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	var entries []types.TradeHistoryEntry
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	historyStore := prefix.NewStore(store, append(types.KeyPrefix(types.TradeHistoryKeyPrefix), types.TradeHistoryTradeKey(req.TradeIndex)...))
+
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(key []byte, value []byte) error {
+		var entry types.TradeHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTradeHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+	"time"
 
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
@@ -10,13 +12,20 @@ import (
 )
 
 // SetStoredTempTrade set a specific storedTempTrade in the store from its index
+// and keeps the expiry index in sync
 func (k Keeper) SetStoredTempTrade(ctx context.Context, storedTempTrade types.StoredTempTrade) {
+	if existing, found := k.GetStoredTempTrade(ctx, storedTempTrade.TradeIndex); found {
+		k.removeStoredTempTradeExpiry(ctx, existing)
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StoredTempTradeKeyPrefix))
 	b := k.cdc.MustMarshal(&storedTempTrade)
 	store.Set(types.StoredTempTradeKey(
 		storedTempTrade.TradeIndex,
 	), b)
+
+	k.setStoredTempTradeExpiry(ctx, storedTempTrade)
 }
 
 // GetStoredTempTrade returns a storedTempTrade from its index
@@ -38,11 +47,15 @@ func (k Keeper) GetStoredTempTrade(
 	return val, true
 }
 
-// RemoveStoredTempTrade removes a storedTempTrade from the store
+// RemoveStoredTempTrade removes a storedTempTrade and its expiry index entry from the store
 func (k Keeper) RemoveStoredTempTrade(
 	ctx context.Context,
 	tradeIndex uint64,
 ) {
+	if existing, found := k.GetStoredTempTrade(ctx, tradeIndex); found {
+		k.removeStoredTempTradeExpiry(ctx, existing)
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StoredTempTradeKeyPrefix))
 	store.Delete(types.StoredTempTradeKey(
@@ -66,3 +79,72 @@ func (k Keeper) GetAllStoredTempTrade(ctx context.Context) (list []types.StoredT
 
 	return
 }
+
+// GetExpiredStoredTempTradeIndexes returns the indexes of all pending trades
+// expiring at or before the given time, ordered by expiry time
+func (k Keeper) GetExpiredStoredTempTradeIndexes(ctx context.Context, blockTime time.Time) (list []uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StoredTempTradeExpiryKeyPrefix))
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(types.StoredTempTradeExpiryTimeKey(blockTime)))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, binary.BigEndian.Uint64(iterator.Value()))
+	}
+
+	return
+}
+
+// RebuildStoredTempTradeExpiryIndex recomputes the expiry index of all pending trades
+// from their tx date and the current pending trade ttl
+func (k Keeper) RebuildStoredTempTradeExpiryIndex(ctx context.Context) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StoredTempTradeExpiryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, storedTempTrade := range k.GetAllStoredTempTrade(ctx) {
+		k.setStoredTempTradeExpiry(ctx, storedTempTrade)
+	}
+}
+
+// setStoredTempTradeExpiry adds a pending trade to the expiry index.
+// Trades with an unparsable tx date are not indexed and never expire.
+func (k Keeper) setStoredTempTradeExpiry(ctx context.Context, storedTempTrade types.StoredTempTrade) {
+	expiry, err := storedTempTrade.ExpiryTime(k.GetParams(ctx).PendingTradeTtl)
+	if err != nil {
+		k.Logger().Error("unable to index pending trade expiry",
+			"trade_index", storedTempTrade.TradeIndex,
+			"error", err.Error())
+		return
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StoredTempTradeExpiryKeyPrefix))
+
+	tradeIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(tradeIndexBytes, storedTempTrade.TradeIndex)
+	store.Set(types.StoredTempTradeExpiryKey(expiry, storedTempTrade.TradeIndex), tradeIndexBytes)
+}
+
+// removeStoredTempTradeExpiry removes a pending trade from the expiry index
+func (k Keeper) removeStoredTempTradeExpiry(ctx context.Context, storedTempTrade types.StoredTempTrade) {
+	expiry, err := storedTempTrade.ExpiryTime(k.GetParams(ctx).PendingTradeTtl)
+	if err != nil {
+		return
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StoredTempTradeExpiryKeyPrefix))
+	store.Delete(types.StoredTempTradeExpiryKey(expiry, storedTempTrade.TradeIndex))
+}
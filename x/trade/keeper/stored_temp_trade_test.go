@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 	"testing"
+	"time"
 
 	keepertest "github.com/GGEZLabs/vvtxchain/testutil/keeper"
 	"github.com/GGEZLabs/vvtxchain/testutil/nullify"
@@ -62,3 +63,49 @@ func TestStoredTempTradeGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllStoredTempTrade(ctx)),
 	)
 }
+
+func TestStoredTempTradeExpiryIndex(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	txDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := uint64(1); i <= 5; i++ {
+		keeper.SetStoredTempTrade(ctx, types.StoredTempTrade{
+			TradeIndex: i,
+			TxDate:     txDate.Add(time.Duration(5-i) * time.Hour).Format(time.RFC3339),
+		})
+	}
+	// Unparsable tx date is never indexed
+	keeper.SetStoredTempTrade(ctx, types.StoredTempTrade{TradeIndex: 6, TxDate: "2025-01-01"})
+
+	require.Empty(t, keeper.GetExpiredStoredTempTradeIndexes(ctx, txDate.Add(types.DefaultPendingTradeTTL-time.Second)))
+	require.Equal(t, []uint64{5, 4}, keeper.GetExpiredStoredTempTradeIndexes(ctx, txDate.Add(types.DefaultPendingTradeTTL+time.Hour)))
+	require.Equal(t, []uint64{5, 4, 3, 2, 1}, keeper.GetExpiredStoredTempTradeIndexes(ctx, txDate.Add(48*time.Hour)))
+
+	// Removing a temp trade removes its index entry
+	keeper.RemoveStoredTempTrade(ctx, 4)
+	require.Equal(t, []uint64{5, 3, 2, 1}, keeper.GetExpiredStoredTempTradeIndexes(ctx, txDate.Add(48*time.Hour)))
+
+	// Overwriting a temp trade moves its index entry
+	keeper.SetStoredTempTrade(ctx, types.StoredTempTrade{
+		TradeIndex: 5,
+		TxDate:     txDate.Add(24 * time.Hour).Format(time.RFC3339),
+	})
+	require.Equal(t, []uint64{3, 2, 1}, keeper.GetExpiredStoredTempTradeIndexes(ctx, txDate.Add(46*time.Hour)))
+}
+
+func TestRebuildStoredTempTradeExpiryIndex(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	txDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	keeper.SetStoredTempTrade(ctx, types.StoredTempTrade{
+		TradeIndex: 1,
+		TxDate:     txDate.Format(time.RFC3339),
+	})
+	require.Empty(t, keeper.GetExpiredStoredTempTradeIndexes(ctx, txDate.Add(12*time.Hour)))
+
+	require.NoError(t, keeper.SetParams(ctx, types.NewParams(12*time.Hour, types.DefaultMintableDenoms, types.DefaultApprovalTiers, types.DefaultMintBurnLimits, types.DefaultTradeDataTolerances, types.DefaultSupplyBaselines)))
+	keeper.RebuildStoredTempTradeExpiryIndex(ctx)
+	require.Equal(t, []uint64{1}, keeper.GetExpiredStoredTempTradeIndexes(ctx, txDate.Add(12*time.Hour)))
+
+	// The entry indexed with the new ttl is removed with the trade
+	keeper.RemoveStoredTempTrade(ctx, 1)
+	require.Empty(t, keeper.GetExpiredStoredTempTradeIndexes(ctx, txDate.Add(48*time.Hour)))
+}
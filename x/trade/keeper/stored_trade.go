@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+	"time"
 
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
@@ -10,7 +12,15 @@ import (
 )
 
 // SetStoredTrade set a specific storedTrade in the store from its index
+// and keeps its secondary indexes in sync
 func (k Keeper) SetStoredTrade(ctx context.Context, storedTrade types.StoredTrade) {
+	if oldStoredTrade, found := k.GetStoredTrade(ctx, storedTrade.TradeIndex); found {
+		k.removeStoredTradeSecondaryIndexes(ctx, oldStoredTrade)
+		k.removeStoredTradeExternalReference(ctx, oldStoredTrade)
+	}
+	k.setStoredTradeSecondaryIndexes(ctx, storedTrade)
+	k.setStoredTradeExternalReference(ctx, storedTrade)
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StoredTradeKeyPrefix))
 	b := k.cdc.MustMarshal(&storedTrade)
@@ -43,6 +53,11 @@ func (k Keeper) RemoveStoredTrade(
 	ctx context.Context,
 	tradeIndex uint64,
 ) {
+	if storedTrade, found := k.GetStoredTrade(ctx, tradeIndex); found {
+		k.removeStoredTradeSecondaryIndexes(ctx, storedTrade)
+		k.removeStoredTradeExternalReference(ctx, storedTrade)
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StoredTradeKeyPrefix))
 	store.Delete(types.StoredTradeKey(
@@ -50,6 +65,23 @@ func (k Keeper) RemoveStoredTrade(
 	))
 }
 
+// GetStoredTradeByExternalReference returns the pending or processed storedTrade
+// with the given external reference
+func (k Keeper) GetStoredTradeByExternalReference(
+	ctx context.Context,
+	externalReference string,
+) (val types.StoredTrade, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StoredTradeByExternalReferenceKeyPrefix))
+
+	b := store.Get(types.StoredTradeExternalReferenceKey(externalReference))
+	if b == nil {
+		return val, false
+	}
+
+	return k.GetStoredTrade(ctx, binary.BigEndian.Uint64(b))
+}
+
 // GetAllStoredTrade returns all storedTrade
 func (k Keeper) GetAllStoredTrade(ctx context.Context) (list []types.StoredTrade) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
@@ -66,3 +98,120 @@ func (k Keeper) GetAllStoredTrade(ctx context.Context) (list []types.StoredTrade
 
 	return
 }
+
+// RebuildStoredTradeSecondaryIndexes clears the storedTrade secondary indexes
+// and rebuilds them from all the stored trades
+func (k Keeper) RebuildStoredTradeSecondaryIndexes(ctx context.Context) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	for _, keyPrefix := range storedTradeSecondaryIndexPrefixes() {
+		store := prefix.NewStore(storeAdapter, types.KeyPrefix(keyPrefix))
+		iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	for _, storedTrade := range k.GetAllStoredTrade(ctx) {
+		k.setStoredTradeSecondaryIndexes(ctx, storedTrade)
+		k.setStoredTradeExternalReference(ctx, storedTrade)
+	}
+}
+
+func storedTradeSecondaryIndexPrefixes() []string {
+	return []string{
+		types.StoredTradeByStatusKeyPrefix,
+		types.StoredTradeByMakerKeyPrefix,
+		types.StoredTradeByCheckerKeyPrefix,
+		types.StoredTradeByReceiverKeyPrefix,
+		types.StoredTradeByCreateDateKeyPrefix,
+		types.StoredTradeByTxDateKeyPrefix,
+		types.StoredTradeByProcessDateKeyPrefix,
+		types.StoredTradeByExternalReferenceKeyPrefix,
+	}
+}
+
+// storedTradeSecondaryIndexFieldKeys returns the field key of the storedTrade in each
+// secondary index. Empty addresses and dates that are not RFC3339 are not indexed.
+func storedTradeSecondaryIndexFieldKeys(storedTrade types.StoredTrade) map[string][]byte {
+	fieldKeys := map[string][]byte{
+		types.StoredTradeByStatusKeyPrefix: types.StoredTradeStatusKey(storedTrade.Status),
+	}
+	if storedTrade.Maker != "" {
+		fieldKeys[types.StoredTradeByMakerKeyPrefix] = types.StoredTradeAddressKey(storedTrade.Maker)
+	}
+	if storedTrade.Checker != "" {
+		fieldKeys[types.StoredTradeByCheckerKeyPrefix] = types.StoredTradeAddressKey(storedTrade.Checker)
+	}
+	if storedTrade.ReceiverAddress != "" {
+		fieldKeys[types.StoredTradeByReceiverKeyPrefix] = types.StoredTradeAddressKey(storedTrade.ReceiverAddress)
+	}
+	for keyPrefix, date := range map[string]string{
+		types.StoredTradeByCreateDateKeyPrefix:  storedTrade.CreateDate,
+		types.StoredTradeByTxDateKeyPrefix:      storedTrade.TxDate,
+		types.StoredTradeByProcessDateKeyPrefix: storedTrade.ProcessDate,
+	} {
+		if parsedDate, err := time.Parse(time.RFC3339, date); err == nil {
+			fieldKeys[keyPrefix] = types.StoredTradeDateKey(parsedDate)
+		}
+	}
+	return fieldKeys
+}
+
+func (k Keeper) setStoredTradeSecondaryIndexes(ctx context.Context, storedTrade types.StoredTrade) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	tradeIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(tradeIndexBytes, storedTrade.TradeIndex)
+
+	for keyPrefix, fieldKey := range storedTradeSecondaryIndexFieldKeys(storedTrade) {
+		store := prefix.NewStore(storeAdapter, types.KeyPrefix(keyPrefix))
+		store.Set(types.StoredTradeSecondaryIndexKey(fieldKey, storedTrade.TradeIndex), tradeIndexBytes)
+	}
+}
+
+func (k Keeper) removeStoredTradeSecondaryIndexes(ctx context.Context, storedTrade types.StoredTrade) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	for keyPrefix, fieldKey := range storedTradeSecondaryIndexFieldKeys(storedTrade) {
+		store := prefix.NewStore(storeAdapter, types.KeyPrefix(keyPrefix))
+		store.Delete(types.StoredTradeSecondaryIndexKey(fieldKey, storedTrade.TradeIndex))
+	}
+}
+
+// setStoredTradeExternalReference sets the storedTrade in the unique external reference index
+// while it holds its external reference
+func (k Keeper) setStoredTradeExternalReference(ctx context.Context, storedTrade types.StoredTrade) {
+	if !storedTrade.HoldsExternalReference() {
+		return
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StoredTradeByExternalReferenceKeyPrefix))
+
+	tradeIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(tradeIndexBytes, storedTrade.TradeIndex)
+	store.Set(types.StoredTradeExternalReferenceKey(storedTrade.ExternalReference), tradeIndexBytes)
+}
+
+// removeStoredTradeExternalReference removes the external reference of the storedTrade from
+// the unique external reference index, unless the reference is held by another trade
+func (k Keeper) removeStoredTradeExternalReference(ctx context.Context, storedTrade types.StoredTrade) {
+	if storedTrade.ExternalReference == "" {
+		return
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StoredTradeByExternalReferenceKeyPrefix))
+
+	key := types.StoredTradeExternalReferenceKey(storedTrade.ExternalReference)
+	if b := store.Get(key); b != nil && binary.BigEndian.Uint64(b) == storedTrade.TradeIndex {
+		store.Delete(key)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/GGEZLabs/vvtxchain/testutil/nullify"
 	"github.com/GGEZLabs/vvtxchain/x/trade/keeper"
 	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -62,3 +63,63 @@ func TestStoredTradeGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllStoredTrade(ctx)),
 	)
 }
+
+func TestMigrate5to6CanonicalizesDecimals(t *testing.T) {
+	k, ctx := keepertest.TradeKeeper(t)
+	legacy := types.StoredTrade{
+		TradeIndex:           1,
+		TradeData:            `{"trade_info":{"trade_value":100.5,"trade_fee":5,"coin_minting_price":1e-3}}`,
+		CoinMintingPriceJson: `[{"currency_code":"USD","minting_price":0.001}]`,
+		ExchangeRateJson:     "",
+	}
+	k.SetStoredTrade(ctx, legacy)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate5to6(sdk.UnwrapSDKContext(ctx)))
+
+	migrated, found := k.GetStoredTrade(ctx, legacy.TradeIndex)
+	require.True(t, found)
+	require.Equal(t,
+		`{"trade_info":{"trade_value":"100.500000000000000000","trade_fee":"5.000000000000000000","coin_minting_price":"0.001000000000000000"}}`,
+		migrated.TradeData,
+	)
+	require.Equal(t, `[{"currency_code":"USD","minting_price":"0.001000000000000000"}]`, migrated.CoinMintingPriceJson)
+	require.Empty(t, migrated.ExchangeRateJson)
+}
+
+func TestMigrate5to6RoundsDecimalsWithTooMuchPrecision(t *testing.T) {
+	k, ctx := keepertest.TradeKeeper(t)
+	legacy := types.StoredTrade{
+		TradeIndex:           1,
+		TradeData:            `{"trade_info":{"trade_value":100.1234567890123456789,"coin_minting_price":1e-3}}`,
+		CoinMintingPriceJson: `[{"currency_code":"USD","minting_price":0.0000000000000000125}]`,
+	}
+	k.SetStoredTrade(ctx, legacy)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate5to6(sdk.UnwrapSDKContext(ctx)))
+
+	migrated, found := k.GetStoredTrade(ctx, legacy.TradeIndex)
+	require.True(t, found)
+	require.Equal(t,
+		`{"trade_info":{"trade_value":"100.123456789012345679","coin_minting_price":"0.001000000000000000"}}`,
+		migrated.TradeData,
+	)
+	require.Equal(t, `[{"currency_code":"USD","minting_price":"0.000000000000000012"}]`, migrated.CoinMintingPriceJson)
+}
+
+func TestMigrate6to7DecodesPayload(t *testing.T) {
+	k, ctx := keepertest.TradeKeeper(t)
+	legacy := types.GetBaseStoredTrade()
+	legacy.TradeIndex = 1
+	legacy.TypedTradeData = nil
+	legacy.CoinMintingPrices = nil
+	legacy.ExchangeRates = nil
+	k.SetStoredTrade(ctx, legacy)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate6to7(sdk.UnwrapSDKContext(ctx)))
+
+	migrated, found := k.GetStoredTrade(ctx, legacy.TradeIndex)
+	require.True(t, found)
+	expected := types.GetBaseStoredTrade()
+	expected.TradeIndex = 1
+	require.Equal(t, expected, migrated)
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// addMintBurnTotal adds the amount minted or burned by a processed trade to the total of its denom
+func (k Keeper) addMintBurnTotal(ctx context.Context, direction string, amount sdk.Coin) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MintBurnTotalKeyPrefix))
+	key := types.MintBurnTotalKey(amount.Denom, direction)
+
+	total := sdk.NewCoin(amount.Denom, math.ZeroInt())
+	if b := store.Get(key); b != nil {
+		k.cdc.MustUnmarshal(b, &total)
+	}
+	total = total.Add(amount)
+	store.Set(key, k.cdc.MustMarshal(&total))
+}
+
+// GetProcessedTradeTotals returns the coins minted by the processed deposits and burned by the
+// processed withdrawals. The totals are kept as the trades are processed.
+func (k Keeper) GetProcessedTradeTotals(ctx context.Context) (deposits, withdrawals sdk.Coins, err error) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MintBurnTotalKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	deposits, withdrawals = sdk.NewCoins(), sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var total sdk.Coin
+		if err := k.cdc.Unmarshal(iterator.Value(), &total); err != nil {
+			return nil, nil, fmt.Errorf("invalid mint and burn total %s: %w", iterator.Key(), err)
+		}
+
+		switch {
+		case bytes.Equal(iterator.Key(), types.MintBurnTotalKey(total.Denom, types.MintDirection)):
+			deposits = deposits.Add(total)
+		case bytes.Equal(iterator.Key(), types.MintBurnTotalKey(total.Denom, types.BurnDirection)):
+			withdrawals = withdrawals.Add(total)
+		default:
+			return nil, nil, fmt.Errorf("mint and burn total %s of denom %s has an invalid key", iterator.Key(), total.Denom)
+		}
+	}
+
+	return deposits, withdrawals, nil
+}
+
+// RebuildMintBurnTotals sets the totals minted and burned from the processed trades, it is used
+// when the totals are not kept yet, by the store migration and the genesis import
+func (k Keeper) RebuildMintBurnTotals(ctx context.Context) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.MintBurnTotalKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, storedTrade := range k.GetAllStoredTrade(ctx) {
+		direction, ok := types.MintBurnDirection(storedTrade.TradeType)
+		if !ok || storedTrade.Status != types.StatusProcessed || storedTrade.Amount == nil {
+			continue
+		}
+		k.addMintBurnTotal(ctx, direction, *storedTrade.Amount)
+	}
+}
+
+// ReconcileSupply compares the supply of each denom minted or burned by trades with its baseline
+// plus the processed deposits minus the processed withdrawals. The denoms are the registered
+// mintable denoms, the denoms with a supply baseline and the denoms of the processed trades,
+// in sorted order.
+func (k Keeper) ReconcileSupply(ctx context.Context) ([]types.QuerySupplyReconciliationResponse, error) {
+	params := k.GetParams(ctx)
+	deposits, withdrawals, err := k.GetProcessedTradeTotals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := make(map[string]struct{})
+	for _, md := range params.MintableDenoms {
+		denoms[md.Denom] = struct{}{}
+	}
+	for _, coins := range []sdk.Coins{params.SupplyBaselines, deposits, withdrawals} {
+		for _, coin := range coins {
+			denoms[coin.Denom] = struct{}{}
+		}
+	}
+
+	sortedDenoms := make([]string, 0, len(denoms))
+	for denom := range denoms {
+		sortedDenoms = append(sortedDenoms, denom)
+	}
+	sort.Strings(sortedDenoms)
+
+	reconciliations := make([]types.QuerySupplyReconciliationResponse, 0, len(sortedDenoms))
+	for _, denom := range sortedDenoms {
+		reconciliations = append(reconciliations, k.newSupplyReconciliation(ctx, denom, params.SupplyBaselines, deposits, withdrawals))
+	}
+	return reconciliations, nil
+}
+
+// newSupplyReconciliation returns the reconciliation of the supply of a denom with the given
+// baselines and processed trade totals
+func (k Keeper) newSupplyReconciliation(
+	ctx context.Context,
+	denom string,
+	baselines, deposits, withdrawals sdk.Coins,
+) types.QuerySupplyReconciliationResponse {
+	baseline := baselines.AmountOf(denom)
+	processedDeposits := deposits.AmountOf(denom)
+	processedWithdrawals := withdrawals.AmountOf(denom)
+	expectedSupply := baseline.Add(processedDeposits).Sub(processedWithdrawals)
+	actualSupply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if actualSupply.IsNil() {
+		actualSupply = math.ZeroInt()
+	}
+
+	return types.QuerySupplyReconciliationResponse{
+		Denom:                denom,
+		Baseline:             baseline,
+		ProcessedDeposits:    processedDeposits,
+		ProcessedWithdrawals: processedWithdrawals,
+		ExpectedSupply:       expectedSupply,
+		ActualSupply:         actualSupply,
+		Reconciled:           expectedSupply.Equal(actualSupply),
+	}
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/GGEZLabs/vvtxchain/x/trade/keeper"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// setupSupplyReconciliation processes a deposit of 1000 and a withdrawal of 400, leaves a deposit
+// of 50 pending and sets a supply baseline of 200
+func (suite *KeeperTestSuite) setupSupplyReconciliation() {
+	suite.setupTest()
+
+	createRes, err := suite.msgServer.CreateTrade(suite.ctx, types.GetMsgCreateTradeWithTypeAndAmount(types.TradeTypeFiatDeposit, 1000))
+	suite.Require().NoError(err)
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	suite.Require().Equal(types.StatusProcessed, suite.confirmTrade(createRes.TradeIndex).Status)
+
+	tradeIndex, escrow := suite.createWithdrawal(400)
+	suite.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, escrow).Return(nil).Times(1)
+	suite.Require().Equal(types.StatusProcessed, suite.confirmTrade(tradeIndex).Status)
+
+	_, err = suite.msgServer.CreateTrade(suite.ctx, types.GetMsgCreateTradeWithTypeAndAmount(types.TradeTypeFiatDeposit, 50))
+	suite.Require().NoError(err)
+
+	params := suite.tradeKeeper.GetParams(suite.ctx)
+	params.SupplyBaselines = sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDenom, 200))
+	suite.Require().NoError(suite.tradeKeeper.SetParams(suite.ctx, params))
+}
+
+func (suite *KeeperTestSuite) TestGetProcessedTradeTotals() {
+	suite.setupSupplyReconciliation()
+
+	deposits, withdrawals, err := suite.tradeKeeper.GetProcessedTradeTotals(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDenom, 1000)), deposits)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDenom, 400)), withdrawals)
+}
+
+func (suite *KeeperTestSuite) TestMigrate8to9RebuildsMintBurnTotals() {
+	suite.setupSupplyReconciliation()
+
+	// a deposit processed before the totals were kept is only counted once they are rebuilt
+	storedTrade := types.GetSampleStoredTradeConfirmed(10)
+	suite.tradeKeeper.SetStoredTrade(suite.ctx, storedTrade)
+
+	deposits, _, err := suite.tradeKeeper.GetProcessedTradeTotals(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDenom, 1000)), deposits)
+
+	suite.Require().NoError(keeper.NewMigrator(*suite.tradeKeeper).Migrate8to9(suite.ctx))
+
+	deposits, withdrawals, err := suite.tradeKeeper.GetProcessedTradeTotals(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDenom, 1000).Add(*storedTrade.Amount)), deposits)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDenom, 400)), withdrawals)
+}
+
+func (suite *KeeperTestSuite) TestSupplyReconciliationQuery() {
+	suite.setupSupplyReconciliation()
+
+	for _, tc := range []struct {
+		desc         string
+		denom        string
+		actualSupply int64
+		expected     types.QuerySupplyReconciliationResponse
+		expErr       error
+	}{
+		{
+			desc:         "reconciled",
+			denom:        types.DefaultDenom,
+			actualSupply: 800,
+			expected: types.QuerySupplyReconciliationResponse{
+				Denom:                types.DefaultDenom,
+				Baseline:             sdkmath.NewInt(200),
+				ProcessedDeposits:    sdkmath.NewInt(1000),
+				ProcessedWithdrawals: sdkmath.NewInt(400),
+				ExpectedSupply:       sdkmath.NewInt(800),
+				ActualSupply:         sdkmath.NewInt(800),
+				Reconciled:           true,
+			},
+		},
+		{
+			desc:         "not reconciled",
+			denom:        types.DefaultDenom,
+			actualSupply: 900,
+			expected: types.QuerySupplyReconciliationResponse{
+				Denom:                types.DefaultDenom,
+				Baseline:             sdkmath.NewInt(200),
+				ProcessedDeposits:    sdkmath.NewInt(1000),
+				ProcessedWithdrawals: sdkmath.NewInt(400),
+				ExpectedSupply:       sdkmath.NewInt(800),
+				ActualSupply:         sdkmath.NewInt(900),
+			},
+		},
+		{
+			desc:  "denom without trades",
+			denom: "ueurv",
+			expected: types.QuerySupplyReconciliationResponse{
+				Denom:                "ueurv",
+				Baseline:             sdkmath.ZeroInt(),
+				ProcessedDeposits:    sdkmath.ZeroInt(),
+				ProcessedWithdrawals: sdkmath.ZeroInt(),
+				ExpectedSupply:       sdkmath.ZeroInt(),
+				ActualSupply:         sdkmath.ZeroInt(),
+				Reconciled:           true,
+			},
+		},
+		{
+			desc:   "invalid denom",
+			denom:  "1",
+			expErr: status.Error(codes.InvalidArgument, "invalid denom: invalid denom: 1"),
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			if tc.expErr == nil {
+				suite.bankKeeper.EXPECT().GetSupply(gomock.Any(), tc.denom).Return(sdk.NewInt64Coin(tc.denom, tc.actualSupply)).Times(1)
+			}
+
+			res, err := suite.queryClient.SupplyReconciliation(suite.ctx, &types.QuerySupplyReconciliationRequest{Denom: tc.denom})
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expected, *res)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSupplyInvariant() {
+	suite.setupSupplyReconciliation()
+
+	suite.bankKeeper.EXPECT().GetSupply(suite.ctx, types.DefaultDenom).Return(sdk.NewInt64Coin(types.DefaultDenom, 800)).Times(1)
+	msg, broken := keeper.SupplyInvariant(*suite.tradeKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+
+	suite.bankKeeper.EXPECT().GetSupply(suite.ctx, types.DefaultDenom).Return(sdk.NewInt64Coin(types.DefaultDenom, 900)).Times(1)
+	msg, broken = keeper.SupplyInvariant(*suite.tradeKeeper)(suite.ctx)
+	suite.Require().True(broken)
+	suite.Require().Contains(msg, "amount of denoms with an unreconciled supply 1")
+	suite.Require().Contains(msg, "ugbpv: expected supply 800 (baseline 200, deposits 1000, withdrawals 400), actual supply 900")
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetTradeEscrow set a specific tradeEscrow in the store from its index
+func (k Keeper) SetTradeEscrow(ctx context.Context, tradeEscrow types.TradeEscrow) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TradeEscrowKeyPrefix))
+	b := k.cdc.MustMarshal(&tradeEscrow)
+	store.Set(types.TradeEscrowKey(tradeEscrow.TradeIndex), b)
+}
+
+// GetTradeEscrow returns a tradeEscrow from its index
+func (k Keeper) GetTradeEscrow(
+	ctx context.Context,
+	tradeIndex uint64,
+) (val types.TradeEscrow, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TradeEscrowKeyPrefix))
+
+	b := store.Get(types.TradeEscrowKey(tradeIndex))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveTradeEscrow removes a tradeEscrow from the store
+func (k Keeper) RemoveTradeEscrow(
+	ctx context.Context,
+	tradeIndex uint64,
+) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TradeEscrowKeyPrefix))
+	store.Delete(types.TradeEscrowKey(tradeIndex))
+}
+
+// GetAllTradeEscrow returns all tradeEscrow
+func (k Keeper) GetAllTradeEscrow(ctx context.Context) (list []types.TradeEscrow) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TradeEscrowKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TradeEscrow
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// escrowWithdrawal moves the amount of a withdrawal from its holder to the module account,
+// where it stays until the trade is confirmed and the amount burned, or the amount is refunded.
+// Other trade types are not escrowed.
+func (k Keeper) escrowWithdrawal(ctx sdk.Context, storedTrade types.StoredTrade) error {
+	if storedTrade.TradeType != types.TradeTypeFiatWithdrawal || storedTrade.Amount == nil {
+		return nil
+	}
+
+	holder, err := sdk.AccAddressFromBech32(storedTrade.ReceiverAddress)
+	if err != nil {
+		return types.ErrInvalidReceiverAddress.Wrap(err.Error())
+	}
+
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, sdk.NewCoins(*storedTrade.Amount)); err != nil {
+		return errorsmod.Wrapf(err, "failed to escrow the withdrawal of trade %d", storedTrade.TradeIndex)
+	}
+
+	k.SetTradeEscrow(ctx, types.TradeEscrow{
+		TradeIndex: storedTrade.TradeIndex,
+		Holder:     storedTrade.ReceiverAddress,
+		Amount:     *storedTrade.Amount,
+	})
+	return nil
+}
+
+// refundEscrow sends the escrow of a trade back to its holder. It does nothing if the trade
+// has no escrow.
+func (k Keeper) refundEscrow(ctx sdk.Context, tradeIndex uint64) error {
+	escrow, found := k.GetTradeEscrow(ctx, tradeIndex)
+	if !found {
+		return nil
+	}
+
+	holder, err := sdk.AccAddressFromBech32(escrow.Holder)
+	if err != nil {
+		return types.ErrInvalidReceiverAddress.Wrap(err.Error())
+	}
+
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, sdk.NewCoins(escrow.Amount)); err != nil {
+		return errorsmod.Wrapf(err, "failed to refund the escrow of trade %d", tradeIndex)
+	}
+
+	k.RemoveTradeEscrow(ctx, tradeIndex)
+	return nil
+}
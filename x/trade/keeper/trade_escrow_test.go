@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	keepertest "github.com/GGEZLabs/vvtxchain/testutil/keeper"
+	"github.com/GGEZLabs/vvtxchain/testutil/nullify"
+	"github.com/GGEZLabs/vvtxchain/x/trade/keeper"
+	"github.com/GGEZLabs/vvtxchain/x/trade/testutil"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func createNTradeEscrow(keeper keeper.Keeper, ctx context.Context, n int) []types.TradeEscrow {
+	items := make([]types.TradeEscrow, n)
+	for i := range items {
+		items[i].TradeIndex = uint64(i + 1)
+		items[i].Holder = testutil.Alice
+		items[i].Amount = sdk.NewInt64Coin(types.DefaultDenom, int64(i+1))
+
+		keeper.SetTradeEscrow(ctx, items[i])
+	}
+	return items
+}
+
+func TestTradeEscrowGet(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	items := createNTradeEscrow(keeper, ctx, 10)
+	for _, item := range items {
+		rst, found := keeper.GetTradeEscrow(ctx, item.TradeIndex)
+		require.True(t, found)
+		require.Equal(t,
+			nullify.Fill(&item),
+			nullify.Fill(&rst),
+		)
+	}
+}
+
+func TestTradeEscrowRemove(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	items := createNTradeEscrow(keeper, ctx, 10)
+	for _, item := range items {
+		keeper.RemoveTradeEscrow(ctx, item.TradeIndex)
+		_, found := keeper.GetTradeEscrow(ctx, item.TradeIndex)
+		require.False(t, found)
+	}
+}
+
+func TestTradeEscrowGetAll(t *testing.T) {
+	keeper, ctx := keepertest.TradeKeeper(t)
+	items := createNTradeEscrow(keeper, ctx, 10)
+	require.ElementsMatch(t,
+		nullify.Fill(items),
+		nullify.Fill(keeper.GetAllTradeEscrow(ctx)),
+	)
+}
+
+// createWithdrawal creates a withdrawal of Alice and expects its amount to be escrowed
+func (suite *KeeperTestSuite) createWithdrawal(amount int64) (uint64, sdk.Coins) {
+	holder := sdk.MustAccAddressFromBech32(testutil.Alice)
+	escrow := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDenom, amount))
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, holder, types.ModuleName, escrow).Return(nil).Times(1)
+
+	res, err := suite.msgServer.CreateTrade(suite.ctx, types.GetMsgCreateTradeWithTypeAndAmount(types.TradeTypeFiatWithdrawal, amount))
+	suite.Require().NoError(err)
+
+	tradeEscrow, found := suite.tradeKeeper.GetTradeEscrow(suite.ctx, res.TradeIndex)
+	suite.Require().True(found)
+	suite.Require().Equal(types.TradeEscrow{
+		TradeIndex: res.TradeIndex,
+		Holder:     testutil.Alice,
+		Amount:     escrow[0],
+	}, tradeEscrow)
+
+	return res.TradeIndex, escrow
+}
+
+func (suite *KeeperTestSuite) TestTradeEscrowNotCreatedForDeposit() {
+	indexes := suite.createNTrades(1)
+
+	_, found := suite.tradeKeeper.GetTradeEscrow(suite.ctx, indexes[0])
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestTradeEscrowBurnedOnConfirm() {
+	suite.setupTest()
+	tradeIndex, escrow := suite.createWithdrawal(1000)
+
+	// the escrow is burned, no coins are pulled from the holder
+	suite.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, escrow).Return(nil).Times(1)
+
+	suite.Require().Equal(types.StatusProcessed, suite.confirmTrade(tradeIndex).Status)
+
+	_, found := suite.tradeKeeper.GetTradeEscrow(suite.ctx, tradeIndex)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestTradeEscrowRefundedOnFailedBurn() {
+	suite.setupTest()
+	tradeIndex, escrow := suite.createWithdrawal(1000)
+	holder := sdk.MustAccAddressFromBech32(testutil.Alice)
+
+	suite.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, escrow).Return(sdkerrors.ErrInsufficientFunds).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, holder, escrow).Return(nil).Times(1)
+
+	suite.Require().Equal(types.StatusFailed, suite.confirmTrade(tradeIndex).Status)
+
+	_, found := suite.tradeKeeper.GetTradeEscrow(suite.ctx, tradeIndex)
+	suite.Require().False(found)
+
+	trade, found := suite.tradeKeeper.GetStoredTrade(suite.ctx, tradeIndex)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkerrors.ErrInsufficientFunds.Codespace(), trade.FailureCodespace)
+	suite.Require().Equal(sdkerrors.ErrInsufficientFunds.ABCICode(), trade.FailureCode)
+}
+
+func (suite *KeeperTestSuite) TestTradeEscrowRefundedOnReject() {
+	suite.setupTest()
+	tradeIndex, escrow := suite.createWithdrawal(1000)
+	holder := sdk.MustAccAddressFromBech32(testutil.Alice)
+
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, holder, escrow).Return(nil).Times(1)
+
+	res, err := suite.msgServer.ProcessTrade(suite.ctx, types.NewMsgProcessTrade(testutil.Bob, types.ProcessTypeReject, tradeIndex))
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.StatusRejected, res.Status)
+
+	_, found := suite.tradeKeeper.GetTradeEscrow(suite.ctx, tradeIndex)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestTradeEscrowRefundedOnCancel() {
+	suite.setupTest()
+	tradeIndex, escrow := suite.createWithdrawal(1000)
+	holder := sdk.MustAccAddressFromBech32(testutil.Alice)
+
+	// a failed refund fails the cancellation
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, holder, escrow).Return(sdkerrors.ErrUnauthorized).Times(1)
+
+	_, err := suite.msgServer.CancelTrade(suite.ctx, &types.MsgCancelTrade{Creator: testutil.Alice, TradeIndex: tradeIndex})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, holder, escrow).Return(nil).Times(1)
+
+	_, err = suite.msgServer.CancelTrade(suite.ctx, &types.MsgCancelTrade{Creator: testutil.Alice, TradeIndex: tradeIndex})
+	suite.Require().NoError(err)
+
+	_, found := suite.tradeKeeper.GetTradeEscrow(suite.ctx, tradeIndex)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestTradeEscrowRefundedOnExpiry() {
+	suite.setupTest()
+	refunded, escrow := suite.createWithdrawal(1000)
+	kept, _ := suite.createWithdrawal(2000)
+	holder := sdk.MustAccAddressFromBech32(testutil.Alice)
+
+	// the escrow of a failed refund is kept and the trade is canceled
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, holder, escrow).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, holder, gomock.Not(escrow)).Return(sdkerrors.ErrUnauthorized).Times(1)
+
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(types.DefaultPendingTradeTTL))
+	suite.tradeKeeper.CancelExpiredPendingTrades(ctx)
+
+	for _, tradeIndex := range []uint64{refunded, kept} {
+		trade, found := suite.tradeKeeper.GetStoredTrade(ctx, tradeIndex)
+		suite.Require().True(found)
+		suite.Require().Equal(types.StatusCanceled, trade.Status)
+	}
+
+	_, found := suite.tradeKeeper.GetTradeEscrow(ctx, refunded)
+	suite.Require().False(found)
+	_, found = suite.tradeKeeper.GetTradeEscrow(ctx, kept)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestTradeEscrowFollowsAmendment() {
+	suite.setupTest()
+	tradeIndex, escrow := suite.createWithdrawal(1000)
+	holder := sdk.MustAccAddressFromBech32(testutil.Alice)
+
+	amended := types.GetMsgCreateTradeWithTypeAndAmount(types.TradeTypeFiatWithdrawal, 3000)
+	amendedEscrow := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDenom, 3000))
+
+	gomock.InOrder(
+		suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, holder, escrow).Return(nil).Times(1),
+		suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, holder, types.ModuleName, amendedEscrow).Return(nil).Times(1),
+	)
+
+	_, err := suite.msgServer.AmendTrade(suite.ctx, &types.MsgAmendTrade{
+		Creator:    testutil.Alice,
+		TradeIndex: tradeIndex,
+		TradeData:  amended.TradeData,
+	})
+	suite.Require().NoError(err)
+
+	tradeEscrow, found := suite.tradeKeeper.GetTradeEscrow(suite.ctx, tradeIndex)
+	suite.Require().True(found)
+	suite.Require().Equal(amendedEscrow[0], tradeEscrow.Amount)
+}
+
+func (suite *KeeperTestSuite) TestTradeEscrowLegacyWithdrawal() {
+	suite.setupTest()
+	holder := sdk.MustAccAddressFromBech32(testutil.Alice)
+
+	// a withdrawal created before the escrow pulls the coins from the holder when confirmed
+	storedTrade := types.GetSampleStoredTrade(1)
+	storedTrade.TradeType = types.TradeTypeFiatWithdrawal
+	suite.tradeKeeper.SetStoredTrade(suite.ctx, storedTrade)
+	coins := sdk.NewCoins(*storedTrade.Amount)
+
+	gomock.InOrder(
+		suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), holder, types.ModuleName, coins).Return(nil).Times(1),
+		suite.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, coins).Return(nil).Times(1),
+	)
+
+	suite.Require().Equal(types.StatusProcessed, suite.confirmTrade(1).Status)
+}
+
+func (suite *KeeperTestSuite) TestTradeEscrowQuery() {
+	suite.setupTest()
+	tradeIndex, escrow := suite.createWithdrawal(1000)
+
+	res, err := suite.queryClient.TradeEscrow(suite.ctx, &types.QueryGetTradeEscrowRequest{TradeIndex: tradeIndex})
+	suite.Require().NoError(err)
+	suite.Require().Equal(escrow[0], res.TradeEscrow.Amount)
+
+	_, err = suite.queryClient.TradeEscrow(suite.ctx, &types.QueryGetTradeEscrowRequest{TradeIndex: tradeIndex + 1})
+	suite.Require().ErrorIs(err, status.Error(codes.NotFound, "not found"))
+
+	suite.bankKeeper.EXPECT().SpendableCoins(gomock.Any(), authtypes.NewModuleAddress(types.ModuleName)).Return(escrow).Times(1)
+
+	allRes, err := suite.queryClient.TradeEscrowAll(suite.ctx, &types.QueryAllTradeEscrowRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(allRes.TradeEscrow, 1)
+	suite.Require().Equal(uint64(1), allRes.Pagination.Total)
+	suite.Require().Equal(escrow, allRes.Balance)
+}
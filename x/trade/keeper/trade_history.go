@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetTradeHistoryEntry set a specific tradeHistoryEntry in the store
+func (k Keeper) SetTradeHistoryEntry(ctx context.Context, entry types.TradeHistoryEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TradeHistoryKeyPrefix))
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(types.TradeHistoryKey(entry.TradeIndex, entry.Sequence), b)
+}
+
+// GetTradeHistory returns the history of a trade, ordered by sequence
+func (k Keeper) GetTradeHistory(ctx context.Context, tradeIndex uint64) (list []types.TradeHistoryEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TradeHistoryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, types.TradeHistoryTradeKey(tradeIndex))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TradeHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllTradeHistory returns the history of all trades
+func (k Keeper) GetAllTradeHistory(ctx context.Context) (list []types.TradeHistoryEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TradeHistoryKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.TradeHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// nextTradeHistorySequence returns the sequence of the next entry of the history of a trade
+func (k Keeper) nextTradeHistorySequence(ctx context.Context, tradeIndex uint64) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TradeHistoryKeyPrefix))
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.TradeHistoryTradeKey(tradeIndex))
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 1
+	}
+
+	var last types.TradeHistoryEntry
+	k.cdc.MustUnmarshal(iterator.Value(), &last)
+	return last.Sequence + 1
+}
+
+// recordTradeTransition appends a transition to the history of a trade, the status of the
+// entry is the status of the trade after the transition
+func (k Keeper) recordTradeTransition(
+	ctx sdk.Context,
+	storedTrade types.StoredTrade,
+	transition types.TradeTransition,
+	actor, reason string,
+) {
+	k.SetTradeHistoryEntry(ctx, types.TradeHistoryEntry{
+		TradeIndex:  storedTrade.TradeIndex,
+		Sequence:    k.nextTradeHistorySequence(ctx, storedTrade.TradeIndex),
+		Transition:  transition,
+		Status:      storedTrade.Status,
+		Actor:       actor,
+		BlockHeight: ctx.BlockHeight(),
+		Time:        ctx.BlockTime(),
+		Reason:      reason,
+	})
+}
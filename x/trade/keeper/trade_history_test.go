@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"github.com/GGEZLabs/vvtxchain/x/trade/testutil"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (suite *KeeperTestSuite) requireTradeHistory(tradeIndex uint64, expected ...types.TradeHistoryEntry) {
+	history := suite.tradeKeeper.GetTradeHistory(suite.ctx, tradeIndex)
+	suite.Require().Len(history, len(expected))
+	for i, entry := range history {
+		suite.Require().Equal(tradeIndex, entry.TradeIndex)
+		suite.Require().Equal(uint64(i+1), entry.Sequence)
+		suite.Require().Equal(expected[i].Transition, entry.Transition)
+		suite.Require().Equal(expected[i].Status, entry.Status)
+		suite.Require().Equal(expected[i].Actor, entry.Actor)
+		suite.Require().Equal(expected[i].Reason, entry.Reason)
+		suite.Require().Equal(suite.ctx.BlockHeight(), entry.BlockHeight)
+		suite.Require().Equal(suite.ctx.BlockTime(), entry.Time)
+	}
+}
+
+func (suite *KeeperTestSuite) TestTradeHistoryCreateAmendConfirm() {
+	indexes := suite.createNTrades(1)
+
+	_, err := suite.msgServer.AmendTrade(suite.ctx, &types.MsgAmendTrade{
+		Creator:           testutil.Alice,
+		TradeIndex:        indexes[0],
+		BankingSystemData: `{"bank":"updated"}`,
+	})
+	suite.Require().NoError(err)
+
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(nil).Times(1)
+	suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	_, err = suite.msgServer.ProcessTrade(suite.ctx, &types.MsgProcessTrade{
+		Creator:          testutil.Bob,
+		ProcessType:      types.ProcessTypeConfirm,
+		TradeIndex:       indexes[0],
+		ExpectedRevision: 1,
+	})
+	suite.Require().NoError(err)
+
+	suite.requireTradeHistory(indexes[0],
+		types.TradeHistoryEntry{Transition: types.TransitionCreated, Status: types.StatusPending, Actor: testutil.Alice, Reason: types.TradeCreatedSuccessfully},
+		types.TradeHistoryEntry{Transition: types.TransitionAmended, Status: types.StatusPending, Actor: testutil.Alice},
+		types.TradeHistoryEntry{Transition: types.TransitionApproved, Status: types.StatusProcessed, Actor: testutil.Bob, Reason: types.TradeProcessedSuccessfully},
+	)
+}
+
+func (suite *KeeperTestSuite) TestTradeHistoryRejectCancelFail() {
+	indexes := suite.createNTrades(3)
+
+	_, err := suite.msgServer.ProcessTrade(suite.ctx, types.NewMsgProcessTrade(testutil.Bob, types.ProcessTypeReject, indexes[0]))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.CancelTrade(suite.ctx, &types.MsgCancelTrade{
+		Creator:    testutil.Alice,
+		TradeIndex: indexes[1],
+		Reason:     "typo in quantity",
+	})
+	suite.Require().NoError(err)
+
+	mintErr := errors.New("mint failed")
+	suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, gomock.Any()).Return(mintErr).Times(1)
+	suite.Require().Equal(types.StatusFailed, suite.confirmTrade(indexes[2]).Status)
+
+	created := types.TradeHistoryEntry{Transition: types.TransitionCreated, Status: types.StatusPending, Actor: testutil.Alice, Reason: types.TradeCreatedSuccessfully}
+	suite.requireTradeHistory(indexes[0], created,
+		types.TradeHistoryEntry{Transition: types.TransitionRejected, Status: types.StatusRejected, Actor: testutil.Bob, Reason: types.TradeProcessedSuccessfully},
+	)
+	suite.requireTradeHistory(indexes[1], created,
+		types.TradeHistoryEntry{Transition: types.TransitionCanceled, Status: types.StatusCanceled, Actor: testutil.Alice, Reason: "typo in quantity"},
+	)
+
+	trade, found := suite.tradeKeeper.GetStoredTrade(suite.ctx, indexes[2])
+	suite.Require().True(found)
+	suite.requireTradeHistory(indexes[2], created,
+		types.TradeHistoryEntry{Transition: types.TransitionFailed, Status: types.StatusFailed, Actor: testutil.Bob, Reason: trade.Result},
+	)
+}
+
+func (suite *KeeperTestSuite) TestTradeHistoryExpired() {
+	indexes := suite.createNTrades(1)
+
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(suite.ctx.BlockTime().Add(types.DefaultPendingTradeTTL))
+	suite.tradeKeeper.CancelExpiredPendingTrades(ctx)
+
+	history := suite.tradeKeeper.GetTradeHistory(ctx, indexes[0])
+	suite.Require().Len(history, 2)
+	suite.Require().Equal(types.TradeHistoryEntry{
+		TradeIndex:  indexes[0],
+		Sequence:    2,
+		Transition:  types.TransitionExpired,
+		Status:      types.StatusCanceled,
+		BlockHeight: 10,
+		Time:        ctx.BlockTime(),
+		Reason:      types.TradeIsCanceled,
+	}, history[1])
+}
+
+func (suite *KeeperTestSuite) TestTradeHistoryQuery() {
+	indexes := suite.createNTrades(2)
+
+	_, err := suite.msgServer.ProcessTrade(suite.ctx, types.NewMsgProcessTrade(testutil.Bob, types.ProcessTypeReject, indexes[1]))
+	suite.Require().NoError(err)
+
+	response, err := suite.queryClient.TradeHistory(suite.ctx, &types.QueryTradeHistoryRequest{TradeIndex: indexes[1]})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.tradeKeeper.GetTradeHistory(suite.ctx, indexes[1]), response.Entries)
+	suite.Require().Len(response.Entries, 2)
+
+	response, err = suite.queryClient.TradeHistory(suite.ctx, &types.QueryTradeHistoryRequest{
+		TradeIndex: indexes[1],
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(response.Entries, 1)
+	suite.Require().Equal(types.TransitionRejected, response.Entries[0].Transition)
+	suite.Require().Equal(uint64(2), response.Pagination.Total)
+
+	_, err = suite.queryClient.TradeHistory(suite.ctx, &types.QueryTradeHistoryRequest{TradeIndex: 100})
+	suite.Require().ErrorIs(err, status.Error(codes.NotFound, "not found"))
+
+	_, err = suite.queryClient.TradeHistory(suite.ctx, nil)
+	suite.Require().Error(err)
+}
@@ -8,6 +8,7 @@ import (
 	acltypes "github.com/GGEZLabs/vvtxchain/x/acl/types"
 	"github.com/GGEZLabs/vvtxchain/x/trade/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // HasPermission checks if the given address has permission
@@ -43,88 +44,146 @@ func (k Keeper) HasPermission(ctx sdk.Context, address string, msgType int32) (b
 	return false, types.ErrModuleNotFound.Wrapf("no permission for module %s", types.ModuleName)
 }
 
-// MintOrBurnCoins processes a trade by minting coins for a 'buy' or burning coins for a 'sell',
-// handling transfers and rollbacks on failure.
+// validateTradePayload validates the payload of a created or amended trade and returns its trade data.
+// The receiver address is required for deposits and withdrawals and must be empty otherwise.
+func validateTradePayload(params types.Params, receiverAddress, tradeData, coinMintingPriceJson, exchangeRateJson string) (types.TradeData, error) {
+	td, err := types.ValidateTradeData(tradeData, params)
+	if err != nil {
+		return td, err
+	}
+
+	err = types.ValidateCoinMintingPriceJson(coinMintingPriceJson)
+	if err != nil {
+		return td, err
+	}
+
+	err = types.ValidateExchangeRateJson(exchangeRateJson)
+	if err != nil {
+		return td, err
+	}
+
+	// Validate receiver address if trade type mints or burns coins
+	if td.TradeInfo.TradeType.IsMintOrBurn() {
+		_, err = sdk.AccAddressFromBech32(receiverAddress)
+		if err != nil {
+			return td, sdkerrors.ErrInvalidAddress.Wrapf("invalid receiver address (%s)", err)
+		}
+
+		if err = params.ValidateDenomEnabled(td.TradeInfo.Quantity.Denom); err != nil {
+			return td, err
+		}
+	} else {
+		if receiverAddress != "" {
+			return td, sdkerrors.ErrInvalidRequest.Wrapf("receiver address must not be set for trade type %s", td.TradeInfo.TradeType.String())
+		}
+
+		// an empty quantity is accepted in the trade data but no amount is stored
+		td.TradeInfo.Quantity = nil
+	}
+
+	return td, nil
+}
+
+// MintOrBurnCoins processes a trade by minting coins for a 'buy' or burning coins for a 'sell'.
+// The trade denom must be an enabled mintable denom and the trade must not exceed the mint and burn
+// limits of the denom. The mint or burn runs in a cache context that is only written when every step
+// succeeds, so a failed trade leaves no partial transfer behind and its error is the first failure.
 func (k Keeper) MintOrBurnCoins(ctx sdk.Context, storedTrade types.StoredTrade) (types.TradeStatus, error) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.mintOrBurnCoins(cacheCtx, storedTrade); err != nil {
+		return types.StatusFailed, err
+	}
+	write()
+	return types.StatusProcessed, nil
+}
+
+// mintOrBurnCoins mints or burns the coins of a trade, the changes of a failed step are
+// discarded by the caller
+func (k Keeper) mintOrBurnCoins(ctx sdk.Context, storedTrade types.StoredTrade) error {
 	receiverAddress, err := sdk.AccAddressFromBech32(storedTrade.ReceiverAddress)
 	if err != nil {
-		return types.StatusFailed, types.ErrInvalidReceiverAddress.Wrap(err.Error())
+		return types.ErrInvalidReceiverAddress.Wrap(err.Error())
+	}
+
+	if storedTrade.Amount == nil {
+		return types.ErrInvalidTradeQuantity
+	}
+
+	if err = k.GetParams(ctx).ValidateDenomEnabled(storedTrade.Amount.Denom); err != nil {
+		return err
+	}
+
+	if err = k.CheckMintBurnLimit(ctx, storedTrade); err != nil {
+		return err
 	}
 
 	coins := sdk.NewCoins(*storedTrade.Amount)
 
 	switch storedTrade.TradeType {
 	case types.TradeTypeFiatDeposit:
+		if err = k.Hooks().BeforeMint(ctx, storedTrade, receiverAddress, coins); err != nil {
+			return err
+		}
+
 		// Mint coins to module account
 		if err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
-			return types.StatusFailed, err
+			return err
 		}
 
 		// Send coins to user
 		if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiverAddress, coins); err != nil {
-			// Rollback: burn minted coins
-			if err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
-				return types.StatusFailed, err
-			}
-			return types.StatusFailed, err
+			return err
 		}
 
-		return types.StatusProcessed, nil
-
 	case types.TradeTypeFiatWithdrawal:
-		// Move coins from user to module
-		if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, receiverAddress, types.ModuleName, coins); err != nil {
-			return types.StatusFailed, err
+		if err = k.Hooks().BeforeBurn(ctx, storedTrade, receiverAddress, coins); err != nil {
+			return err
+		}
+
+		// Withdrawals are escrowed when created, trades created before the escrow
+		// pull the coins from the user when confirmed
+		if _, escrowed := k.GetTradeEscrow(ctx, storedTrade.TradeIndex); escrowed {
+			k.RemoveTradeEscrow(ctx, storedTrade.TradeIndex)
+		} else if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, receiverAddress, types.ModuleName, coins); err != nil {
+			return err
 		}
 
 		// Burn coins from module
 		if err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
-			// Rollback: refund coins to user
-			if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiverAddress, coins); err != nil {
-				return types.StatusFailed, err
-			}
-			return types.StatusFailed, err
+			return err
 		}
 
-		return types.StatusProcessed, nil
-
 	default:
-		return types.StatusFailed, types.ErrInvalidTradeType
+		return types.ErrInvalidTradeType
 	}
+
+	k.recordMintBurn(ctx, storedTrade)
+	return nil
 }
 
-// CancelExpiredPendingTrades automatically cancels pending trades older than 1 day.
+// CancelExpiredPendingTrades automatically cancels pending trades whose expiry time has passed.
+// It only visits the trades that are due, using the expiry index of StoredTempTrade.
 func (k Keeper) CancelExpiredPendingTrades(goCtx context.Context) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	allStoredTempTrade := k.GetAllStoredTempTrade(ctx)
-	var canceledIds []uint64
-
 	currentDate := ctx.BlockTime()
+	expiredIndexes := k.GetExpiredStoredTempTradeIndexes(ctx, currentDate)
+	var canceledIds []uint64
 
-	for i := range allStoredTempTrade {
-		txDate := allStoredTempTrade[i].TxDate
-		formattedTxDate, err := time.Parse(time.RFC3339, txDate)
-		if err != nil {
-			k.logger.Error("an error occurred while canceling expired trades",
-				"trade_index", allStoredTempTrade[i].TradeIndex,
-				"error", err.Error(),
-				"module", types.ModuleName)
-			continue
-		}
-		differenceTime := currentDate.Sub(formattedTxDate)
-		totalDays := int(differenceTime.Hours() / 24)
-
-		if totalDays >= 1 {
-			storedTrade, _ := k.GetStoredTrade(ctx, allStoredTempTrade[i].TradeIndex)
+	for _, tradeIndex := range expiredIndexes {
+		storedTrade, found := k.GetStoredTrade(ctx, tradeIndex)
+		if found {
 			storedTrade.Status = types.StatusCanceled
 			storedTrade.UpdateDate = currentDate.Format(time.RFC3339)
 			storedTrade.Result = types.TradeIsCanceled
 
+			k.refundExpiredTradeEscrow(ctx, storedTrade.TradeIndex)
 			k.SetStoredTrade(ctx, storedTrade)
-			k.RemoveStoredTempTrade(ctx, allStoredTempTrade[i].TradeIndex)
-
-			canceledIds = append(canceledIds, allStoredTempTrade[i].TradeIndex)
+			k.recordTradeTransition(ctx, storedTrade, types.TransitionExpired, "", storedTrade.Result)
+			k.afterExpiredTradeCanceled(ctx, storedTrade)
 		}
+		k.RemoveStoredTempTrade(ctx, tradeIndex)
+
+		canceledIds = append(canceledIds, tradeIndex)
 	}
 
 	if len(canceledIds) > 0 {
@@ -140,5 +199,30 @@ func (k Keeper) CancelExpiredPendingTrades(goCtx context.Context) {
 				attributes...,
 			),
 		)
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventTradesExpired{TradeIndexes: canceledIds}); err != nil {
+			k.Logger().Error("failed to emit expired trades event", "error", err)
+		}
+	}
+}
+
+// refundExpiredTradeEscrow refunds the escrow of an expired trade. The end blocker cannot fail,
+// so the escrow of a failed refund is kept and the error is logged.
+func (k Keeper) refundExpiredTradeEscrow(ctx sdk.Context, tradeIndex uint64) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.refundEscrow(cacheCtx, tradeIndex); err != nil {
+		k.Logger().Error("failed to refund escrow of expired trade", "trade_index", tradeIndex, "error", err)
+		return
+	}
+	write()
+}
+
+// afterExpiredTradeCanceled calls the AfterTradeCanceled hook of an expired trade. The end blocker
+// cannot fail, so the changes of a failed hook are discarded and the error is logged.
+func (k Keeper) afterExpiredTradeCanceled(ctx sdk.Context, storedTrade types.StoredTrade) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.Hooks().AfterTradeCanceled(cacheCtx, storedTrade); err != nil {
+		k.Logger().Error("trade hook failed on expired trade", "trade_index", storedTrade.TradeIndex, "error", err)
+		return
 	}
+	write()
 }
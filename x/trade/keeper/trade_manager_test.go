@@ -10,6 +10,7 @@ import (
 	"github.com/GGEZLabs/vvtxchain/x/trade/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"go.uber.org/mock/gomock"
 )
 
 func (suite *KeeperTestSuite) TestHasPermission() {
@@ -129,19 +130,36 @@ func (suite *KeeperTestSuite) TestMintOrBurnCoins() {
 			TradeType: types.TradeTypeFiatWithdrawal,
 		}
 
-		receiverAddress, err := sdk.AccAddressFromBech32(testutil.Alice)
-		suite.Require().NoErrorf(err, "invalid receiver address: %s", err)
+		status, err := suite.tradeKeeper.MintOrBurnCoins(suite.ctx, tradeData)
+		suite.Require().Equal(status, types.StatusFailed)
+		suite.Require().ErrorIs(err, types.ErrDenomNotRegistered)
+	})
 
-		suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, receiverAddress, types.ModuleName, sdk.Coins{
-			{
-				Denom:  "unknown_denom",
+	suite.Run("disabled denom", func() {
+		params := types.DefaultParams()
+		params.MintableDenoms = append(params.MintableDenoms, types.MintableDenom{
+			Denom:              "ueurv",
+			BaseCurrency:       "EUR",
+			SettlementCurrency: "EUR",
+			Enabled:            false,
+		})
+		suite.Require().NoError(suite.tradeKeeper.SetParams(suite.ctx, params))
+		defer func() {
+			suite.Require().NoError(suite.tradeKeeper.SetParams(suite.ctx, types.DefaultParams()))
+		}()
+
+		tradeData := types.StoredTrade{
+			ReceiverAddress: testutil.Alice,
+			Amount: &sdk.Coin{
+				Denom:  "ueurv",
 				Amount: sdkmath.NewInt(10000000),
 			},
-		}).Return(sdkerrors.ErrInsufficientFunds).Times(1)
+			TradeType: types.TradeTypeFiatDeposit,
+		}
 
 		status, err := suite.tradeKeeper.MintOrBurnCoins(suite.ctx, tradeData)
 		suite.Require().Equal(status, types.StatusFailed)
-		suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+		suite.Require().ErrorIs(err, types.ErrDenomDisabled)
 	})
 
 	suite.Run("mint max amount coins", func() {
@@ -154,7 +172,7 @@ func (suite *KeeperTestSuite) TestMintOrBurnCoins() {
 			TradeType:       types.TradeTypeFiatDeposit,
 		}
 
-		suite.bankKeeper.EXPECT().MintCoins(suite.ctx, types.ModuleName,
+		suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName,
 			sdk.Coins{
 				{
 					Denom:  types.DefaultDenom,
@@ -165,7 +183,7 @@ func (suite *KeeperTestSuite) TestMintOrBurnCoins() {
 		receiverAddress, err := sdk.AccAddressFromBech32(testutil.Alice)
 		suite.Require().NoErrorf(err, "invalid receiver address: %s", err)
 
-		suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName,
+		suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName,
 			receiverAddress,
 			sdk.Coins{
 				{
@@ -202,7 +220,7 @@ func (suite *KeeperTestSuite) TestMintOrBurnCoins() {
 		receiverAddress, err := sdk.AccAddressFromBech32(testutil.Alice)
 		suite.Require().NoErrorf(err, "invalid receiver address: %s", err)
 
-		suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, receiverAddress,
+		suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), receiverAddress,
 			types.ModuleName,
 			sdk.Coins{
 				{
@@ -211,7 +229,7 @@ func (suite *KeeperTestSuite) TestMintOrBurnCoins() {
 				},
 			}).Return(nil).Times(1)
 
-		suite.bankKeeper.EXPECT().BurnCoins(suite.ctx, types.ModuleName,
+		suite.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName,
 			sdk.Coins{
 				{
 					Denom:  types.DefaultDenom,
@@ -244,7 +262,7 @@ func (suite *KeeperTestSuite) TestMintOrBurnCoins() {
 			TradeType:       types.TradeTypeFiatDeposit,
 		}
 
-		suite.bankKeeper.EXPECT().MintCoins(suite.ctx, types.ModuleName,
+		suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName,
 			sdk.Coins{
 				{
 					Denom:  types.DefaultDenom,
@@ -255,7 +273,7 @@ func (suite *KeeperTestSuite) TestMintOrBurnCoins() {
 		receiverAddress, err := sdk.AccAddressFromBech32(testutil.Alice)
 		suite.Require().NoErrorf(err, "invalid receiver address: %s", err)
 
-		suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName,
+		suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName,
 			receiverAddress,
 			sdk.Coins{
 				{
@@ -292,7 +310,7 @@ func (suite *KeeperTestSuite) TestMintOrBurnCoins() {
 		receiverAddress, err := sdk.AccAddressFromBech32(testutil.Alice)
 		suite.Require().NoErrorf(err, "invalid receiver address: %s", err)
 
-		suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, receiverAddress,
+		suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), receiverAddress,
 			types.ModuleName,
 			sdk.Coins{
 				{
@@ -306,6 +324,53 @@ func (suite *KeeperTestSuite) TestMintOrBurnCoins() {
 		suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
 	})
 
+	suite.Run("failed send of minted coins is not compensated by a burn", func() {
+		tradeData := types.StoredTrade{
+			Amount: &sdk.Coin{
+				Denom:  types.DefaultDenom,
+				Amount: sdkmath.NewInt(1000000),
+			},
+			ReceiverAddress: testutil.Alice,
+			TradeType:       types.TradeTypeFiatDeposit,
+		}
+		coins := sdk.NewCoins(*tradeData.Amount)
+
+		// the mint is discarded with the cache context, no coins are burned
+		suite.bankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, coins).Return(nil).Times(1)
+		suite.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, gomock.Any(), coins).
+			Return(sdkerrors.ErrUnauthorized).Times(1)
+
+		status, err := suite.tradeKeeper.MintOrBurnCoins(suite.ctx, tradeData)
+		suite.Require().Equal(status, types.StatusFailed)
+		suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	})
+
+	suite.Run("failed burn keeps the escrow", func() {
+		tradeData := types.StoredTrade{
+			TradeIndex: 10,
+			Amount: &sdk.Coin{
+				Denom:  types.DefaultDenom,
+				Amount: sdkmath.NewInt(1000000),
+			},
+			ReceiverAddress: testutil.Alice,
+			TradeType:       types.TradeTypeFiatWithdrawal,
+		}
+		escrow := types.TradeEscrow{TradeIndex: 10, Holder: testutil.Alice, Amount: *tradeData.Amount}
+		suite.tradeKeeper.SetTradeEscrow(suite.ctx, escrow)
+
+		suite.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(*tradeData.Amount)).
+			Return(sdkerrors.ErrInsufficientFunds).Times(1)
+
+		status, err := suite.tradeKeeper.MintOrBurnCoins(suite.ctx, tradeData)
+		suite.Require().Equal(status, types.StatusFailed)
+		suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+		// the removal of the escrow is discarded with the failed burn
+		tradeEscrow, found := suite.tradeKeeper.GetTradeEscrow(suite.ctx, 10)
+		suite.Require().True(found)
+		suite.Require().Equal(escrow, tradeEscrow)
+	})
+
 	suite.Run("burn coins", func() {
 		tradeData := types.StoredTrade{
 			Amount: &sdk.Coin{
@@ -319,7 +384,7 @@ func (suite *KeeperTestSuite) TestMintOrBurnCoins() {
 		receiverAddress, err := sdk.AccAddressFromBech32(testutil.Alice)
 		suite.Require().NoErrorf(err, "invalid receiver address: %s", err)
 
-		suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(suite.ctx, receiverAddress,
+		suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), receiverAddress,
 			types.ModuleName,
 			sdk.Coins{
 				{
@@ -328,7 +393,7 @@ func (suite *KeeperTestSuite) TestMintOrBurnCoins() {
 				},
 			}).Return(nil).Times(1)
 
-		suite.bankKeeper.EXPECT().BurnCoins(suite.ctx, types.ModuleName,
+		suite.bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName,
 			sdk.Coins{
 				{
 					Denom:  types.DefaultDenom,
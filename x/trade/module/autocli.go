@@ -43,6 +43,83 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query a stored-temp-trade by trade index",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "trade_index"}},
 				},
+				{
+					RpcMethod:      "StoredTradesByStatus",
+					Use:            "stored-trades-by-status [status]",
+					Short:          "Query all stored-trades with the given status",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "status"}},
+				},
+				{
+					RpcMethod:      "StoredTradesByMaker",
+					Use:            "stored-trades-by-maker [maker]",
+					Short:          "Query all stored-trades created by the given maker",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "maker"}},
+				},
+				{
+					RpcMethod:      "StoredTradesByChecker",
+					Use:            "stored-trades-by-checker [checker]",
+					Short:          "Query all stored-trades processed by the given checker",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "checker"}},
+				},
+				{
+					RpcMethod:      "StoredTradesByReceiver",
+					Use:            "stored-trades-by-receiver [receiver-address]",
+					Short:          "Query all stored-trades with the given receiver address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "receiver_address"}},
+				},
+				{
+					RpcMethod: "StoredTradesByDateRange",
+					Use:       "stored-trades-by-date-range [date-field] [from] [to]",
+					Short:     "Query all stored-trades with the selected date between from and to (inclusive)",
+					Example:   "stored-trades-by-date-range TRADE_DATE_FIELD_PROCESS_DATE 2025-01-01T00:00:00Z 2025-01-31T23:59:59Z --status TRADE_STATUS_PROCESSED",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "date_field"},
+						{ProtoField: "from"},
+						{ProtoField: "to"},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"status": {
+							Name:  "status",
+							Usage: "Filter the trades by status. Default is any status",
+						},
+					},
+				},
+				{
+					RpcMethod:      "StoredTradeByExternalReference",
+					Use:            "stored-trade-by-external-reference [external-reference]",
+					Short:          "Query the pending or processed stored-trade with the given external reference",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "external_reference"}},
+				},
+				{
+					RpcMethod:      "MintBurnCapacity",
+					Use:            "mint-burn-capacity [denom] [maker]",
+					Short:          "Query the remaining mint and burn capacity of a denom for a maker and for all makers",
+					Example:        "mint-burn-capacity ugbpv vvtx1...",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "maker"}},
+				},
+				{
+					RpcMethod:      "TradeHistory",
+					Use:            "trade-history [trade-index]",
+					Short:          "Query the state transitions of a stored-trade",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "trade_index"}},
+				},
+				{
+					RpcMethod: "TradeEscrowAll",
+					Use:       "trade-escrows",
+					Short:     "Query all trade-escrows and the escrow balance of the module account",
+				},
+				{
+					RpcMethod:      "TradeEscrow",
+					Use:            "trade-escrow [trade-index]",
+					Short:          "Query the escrowed withdrawal funds of a stored-trade",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "trade_index"}},
+				},
+				{
+					RpcMethod:      "SupplyReconciliation",
+					Use:            "supply-reconciliation [denom]",
+					Short:          "Query the supply of a denom reconciled with the processed trades",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -56,28 +133,73 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod: "CreateTrade",
-					Use:       "create-trade [trade-data] [banking-system-data] [coin-minting-price-json] [exchange-rate-json] [receiver-address]",
-					Short:     "Create the StoredTrade. Must have authority to do so.",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
-						{ProtoField: "trade_data"},
-						{ProtoField: "banking_system_data"},
-						{ProtoField: "coin_minting_price_json"},
-						{ProtoField: "exchange_rate_json"},
-						{ProtoField: "receiver_address", Optional: true},
-					},
-					FlagOptions: map[string]*autocliv1.FlagOptions{
-						"create_date": {
-							Name:         "create-date",
-							Usage:        "Set a create date. Default is current date",
-							DefaultValue: "",
-						},
-					},
+					Skip:      true, // skipped because the custom create-trade command also reads the trade from a file
 				},
 				{
 					RpcMethod:      "ProcessTrade",
 					Use:            "process-trade [trade-index] [process-type]",
 					Short:          "Process the StoredTrade. Must have authority to do so.",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "trade_index"}, {ProtoField: "process_type"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"expected_revision": {
+							Name:         "expected-revision",
+							Usage:        "Set the revision of the trade being processed. Default is 0 (trade never amended)",
+							DefaultValue: "0",
+						},
+					},
+				},
+				{
+					RpcMethod:      "AmendTrade",
+					Use:            "amend-trade [trade-index]",
+					Short:          "Amend the payload of a pending StoredTrade. Must be the maker of the trade.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "trade_index"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"receiver_address":        {Name: "receiver-address", Usage: "Set a new receiver address"},
+						"trade_data":              {Name: "trade-data", Usage: "Set a new trade data json"},
+						"banking_system_data":     {Name: "banking-system-data", Usage: "Set a new banking system data json"},
+						"coin_minting_price_json": {Name: "coin-minting-price-json", Usage: "Set a new coin minting price json"},
+						"exchange_rate_json":      {Name: "exchange-rate-json", Usage: "Set a new exchange rate json"},
+					},
+				},
+				{
+					RpcMethod:      "CancelTrade",
+					Use:            "cancel-trade [trade-index] [reason]",
+					Short:          "Cancel a pending StoredTrade. Must be the maker of the trade or an acl admin.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "trade_index"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod: "CreateTradeV2",
+					Skip:      true, // skipped because the typed payloads are parsed by the custom create-trade-v2 command
+				},
+				{
+					RpcMethod: "CreateTrades",
+					Skip:      true, // skipped because the entries are read from a file by the custom create-trades command
+				},
+				{
+					RpcMethod:      "ProcessTrades",
+					Use:            "process-trades [process-type] [trade-index]...",
+					Short:          "Process a batch of StoredTrades. Must have authority to do so.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "process_type"}, {ProtoField: "trade_indexes", Varargs: true}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"expected_revisions": {
+							Name:  "expected-revisions",
+							Usage: "Set the revisions of the trades being processed, in the order of the trade indexes. Default is 0 for every trade (trades never amended)",
+						},
+						"mode": {
+							Name:         "mode",
+							Usage:        "Set the batch mode, one of: all-or-nothing, best-effort",
+							DefaultValue: "all-or-nothing",
+						},
+					},
+				},
+				{
+					RpcMethod:      "RetryTrade",
+					Use:            "retry-trade [trade-index]",
+					Short:          "Retry the mint or burn of a failed StoredTrade. Must have authority to do so.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "trade_index"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"receiver_address": {Name: "receiver-address", Usage: "Set a corrected receiver address"},
+					},
 				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
@@ -13,6 +13,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		panic(err)
 	}
 
+	// Params are set first, the pending trade ttl is needed to index pending trades
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
 	// Set if defined
 	k.SetTradeIndex(ctx, genState.TradeIndex)
 	// Set all the storedTrade
@@ -23,10 +28,21 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.StoredTempTrades {
 		k.SetStoredTempTrade(ctx, elem)
 	}
-	// this line is used by starport scaffolding # genesis/module/init
-	if err := k.SetParams(ctx, genState.Params); err != nil {
-		panic(err)
+	// Set all the mintBurnRecord
+	for _, elem := range genState.MintBurnRecords {
+		k.SetMintBurnRecord(ctx, elem)
+	}
+	// Set all the tradeHistoryEntry
+	for _, elem := range genState.TradeHistory {
+		k.SetTradeHistoryEntry(ctx, elem)
 	}
+	// Set all the tradeEscrow
+	for _, elem := range genState.TradeEscrows {
+		k.SetTradeEscrow(ctx, elem)
+	}
+	// The totals minted and burned are derived from the processed trades
+	k.RebuildMintBurnTotals(ctx)
+	// this line is used by starport scaffolding # genesis/module/init
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -41,6 +57,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	genesis.StoredTrades = k.GetAllStoredTrade(ctx)
 	genesis.StoredTempTrades = k.GetAllStoredTempTrade(ctx)
+	genesis.MintBurnRecords = k.GetAllMintBurnRecord(ctx)
+	genesis.TradeHistory = k.GetAllTradeHistory(ctx)
+	genesis.TradeEscrows = k.GetAllTradeEscrow(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -2,6 +2,7 @@ package trade_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	keepertest "github.com/GGEZLabs/vvtxchain/testutil/keeper"
@@ -15,10 +16,11 @@ import (
 
 func TestGenesis(t *testing.T) {
 	td := types.GetSampleTradeDataJson(types.TradeTypeFiatDeposit)
+	holder := sample.AccAddress()
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
 		TradeIndex: types.TradeIndex{
-			NextId: 3,
+			NextId: 4,
 		},
 		StoredTrades: []types.StoredTrade{
 			{
@@ -52,7 +54,24 @@ func TestGenesis(t *testing.T) {
 				TxDate:               "2023-05-11T08:44:00Z",
 				UpdateDate:           "2023-05-11T08:44:00Z",
 				ProcessDate:          "2023-05-11T08:44:00Z",
-				TradeData:            td,
+				TradeData:            types.GetSampleTradeDataJson(types.TradeTypeFiatWithdrawal),
+				BankingSystemData:    "{}",
+				CoinMintingPriceJson: types.GetSampleCoinMintingPriceJson(),
+				ExchangeRateJson:     types.GetSampleExchangeRateJson(),
+			},
+			{
+				TradeIndex:           3,
+				TradeType:            types.TradeTypeFiatWithdrawal,
+				Amount:               &sdk.Coin{Denom: types.DefaultDenom, Amount: math.NewInt(1000)},
+				CoinMintingPrice:     "0.01",
+				ReceiverAddress:      holder,
+				Status:               types.StatusPending,
+				Maker:                sample.AccAddress(),
+				CreateDate:           "2023-05-11T08:44:00Z",
+				TxDate:               "2023-05-11T08:44:00Z",
+				UpdateDate:           "2023-05-11T08:44:00Z",
+				ProcessDate:          "2023-05-11T08:44:00Z",
+				TradeData:            types.GetSampleTradeDataJson(types.TradeTypeFiatWithdrawal),
 				BankingSystemData:    "{}",
 				CoinMintingPriceJson: types.GetSampleCoinMintingPriceJson(),
 				ExchangeRateJson:     types.GetSampleExchangeRateJson(),
@@ -68,6 +87,51 @@ func TestGenesis(t *testing.T) {
 				TxDate:     "2023-05-11T08:44:00Z",
 			},
 		},
+		MintBurnRecords: []types.MintBurnRecord{
+			{
+				TradeIndex:  1,
+				TradeType:   types.TradeTypeFiatDeposit,
+				Maker:       sample.AccAddress(),
+				Amount:      sdk.NewInt64Coin(types.DefaultDenom, 100000),
+				ProcessTime: time.Date(2023, 5, 11, 8, 44, 0, 0, time.UTC),
+			},
+			{
+				TradeIndex:  2,
+				TradeType:   types.TradeTypeFiatWithdrawal,
+				Maker:       sample.AccAddress(),
+				Amount:      sdk.NewInt64Coin(types.DefaultDenom, 100000),
+				ProcessTime: time.Date(2023, 5, 11, 8, 44, 0, 0, time.UTC),
+			},
+		},
+		TradeHistory: []types.TradeHistoryEntry{
+			{
+				TradeIndex:  1,
+				Sequence:    1,
+				Transition:  types.TransitionCreated,
+				Status:      types.StatusPending,
+				Actor:       sample.AccAddress(),
+				BlockHeight: 1,
+				Time:        time.Date(2023, 5, 11, 8, 44, 0, 0, time.UTC),
+				Reason:      types.TradeCreatedSuccessfully,
+			},
+			{
+				TradeIndex:  1,
+				Sequence:    2,
+				Transition:  types.TransitionApproved,
+				Status:      types.StatusProcessed,
+				Actor:       sample.AccAddress(),
+				BlockHeight: 2,
+				Time:        time.Date(2023, 5, 11, 8, 45, 0, 0, time.UTC),
+				Reason:      types.TradeProcessedSuccessfully,
+			},
+		},
+		TradeEscrows: []types.TradeEscrow{
+			{
+				TradeIndex: 3,
+				Holder:     holder,
+				Amount:     sdk.NewInt64Coin(types.DefaultDenom, 1000),
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -82,5 +146,14 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.TradeIndex, got.TradeIndex)
 	require.ElementsMatch(t, genesisState.StoredTrades, got.StoredTrades)
 	require.ElementsMatch(t, genesisState.StoredTempTrades, got.StoredTempTrades)
+	require.ElementsMatch(t, genesisState.MintBurnRecords, got.MintBurnRecords)
+	require.ElementsMatch(t, genesisState.TradeHistory, got.TradeHistory)
+	require.ElementsMatch(t, genesisState.TradeEscrows, got.TradeEscrows)
 	// this line is used by starport scaffolding # genesis/test/assert
+
+	// the totals minted and burned are rebuilt from the processed trades
+	deposits, withdrawals, err := k.GetProcessedTradeTotals(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDenom, 100000)), deposits)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDenom, 100000)), withdrawals)
 }
@@ -0,0 +1,39 @@
+package trade_test
+
+import (
+	"context"
+	"testing"
+
+	keepertest "github.com/GGEZLabs/vvtxchain/testutil/keeper"
+	trade "github.com/GGEZLabs/vvtxchain/x/trade/module"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/stretchr/testify/require"
+)
+
+// orderHooks records the name of the module of the hooks called by AfterTradeCreated
+type orderHooks struct {
+	types.MultiTradeHooks
+	name  string
+	calls *[]string
+}
+
+func (h orderHooks) AfterTradeCreated(_ context.Context, _ types.StoredTrade) error {
+	*h.calls = append(*h.calls, h.name)
+	return nil
+}
+
+func TestInvokeSetTradeHooks(t *testing.T) {
+	k, ctx := keepertest.TradeKeeper(t)
+	require.NoError(t, trade.InvokeSetTradeHooks(k, nil))
+
+	var calls []string
+	keeperCopy := k
+	require.NoError(t, trade.InvokeSetTradeHooks(keeperCopy, map[string]types.TradeHooksWrapper{
+		"b": {TradeHooks: orderHooks{name: "b", calls: &calls}},
+		"a": {TradeHooks: orderHooks{name: "a", calls: &calls}},
+	}))
+
+	// the hooks are shared by the copies of the keeper and called in module name order
+	require.NoError(t, k.Hooks().AfterTradeCreated(ctx, types.StoredTrade{}))
+	require.Equal(t, []string{"a", "b"}, calls)
+}
@@ -30,6 +30,30 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgProcessTrade int = 100
 
+	opWeightMsgCancelTrade = "op_weight_msg_cancel_trade"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCancelTrade int = 20
+
+	opWeightMsgAmendTrade = "op_weight_msg_amend_trade"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAmendTrade int = 20
+
+	opWeightMsgCreateTradeV2 = "op_weight_msg_create_trade_v2"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCreateTradeV2 int = 50
+
+	opWeightMsgCreateTrades = "op_weight_msg_create_trades"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCreateTrades int = 20
+
+	opWeightMsgProcessTrades = "op_weight_msg_process_trades"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgProcessTrades int = 20
+
+	opWeightMsgRetryTrade = "op_weight_msg_retry_trade"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRetryTrade int = 20
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -78,6 +102,72 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		tradesimulation.SimulateMsgProcessTrade(am.accountKeeper, am.bankKeeper, am.aclKeeper, am.keeper, simState.TxConfig),
 	))
 
+	var weightMsgCancelTrade int
+	simState.AppParams.GetOrGenerate(opWeightMsgCancelTrade, &weightMsgCancelTrade, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelTrade = defaultWeightMsgCancelTrade
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelTrade,
+		tradesimulation.SimulateMsgCancelTrade(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgAmendTrade int
+	simState.AppParams.GetOrGenerate(opWeightMsgAmendTrade, &weightMsgAmendTrade, nil,
+		func(_ *rand.Rand) {
+			weightMsgAmendTrade = defaultWeightMsgAmendTrade
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAmendTrade,
+		tradesimulation.SimulateMsgAmendTrade(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgCreateTradeV2 int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateTradeV2, &weightMsgCreateTradeV2, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateTradeV2 = defaultWeightMsgCreateTradeV2
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateTradeV2,
+		tradesimulation.SimulateMsgCreateTradeV2(am.accountKeeper, am.bankKeeper, am.aclKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgCreateTrades int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateTrades, &weightMsgCreateTrades, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateTrades = defaultWeightMsgCreateTrades
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateTrades,
+		tradesimulation.SimulateMsgCreateTrades(am.accountKeeper, am.bankKeeper, am.aclKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgProcessTrades int
+	simState.AppParams.GetOrGenerate(opWeightMsgProcessTrades, &weightMsgProcessTrades, nil,
+		func(_ *rand.Rand) {
+			weightMsgProcessTrades = defaultWeightMsgProcessTrades
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgProcessTrades,
+		tradesimulation.SimulateMsgProcessTrades(am.accountKeeper, am.bankKeeper, am.aclKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgRetryTrade int
+	simState.AppParams.GetOrGenerate(opWeightMsgRetryTrade, &weightMsgRetryTrade, nil,
+		func(_ *rand.Rand) {
+			weightMsgRetryTrade = defaultWeightMsgRetryTrade
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRetryTrade,
+		tradesimulation.SimulateMsgRetryTrade(am.accountKeeper, am.bankKeeper, am.aclKeeper, am.keeper, simState.TxConfig),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -102,6 +192,54 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 				return nil
 			},
 		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgCancelTrade,
+			defaultWeightMsgCancelTrade,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				tradesimulation.SimulateMsgCancelTrade(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgAmendTrade,
+			defaultWeightMsgAmendTrade,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				tradesimulation.SimulateMsgAmendTrade(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgCreateTradeV2,
+			defaultWeightMsgCreateTradeV2,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				tradesimulation.SimulateMsgCreateTradeV2(am.accountKeeper, am.bankKeeper, am.aclKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgCreateTrades,
+			defaultWeightMsgCreateTrades,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				tradesimulation.SimulateMsgCreateTrades(am.accountKeeper, am.bankKeeper, am.aclKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgProcessTrades,
+			defaultWeightMsgProcessTrades,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				tradesimulation.SimulateMsgProcessTrades(am.accountKeeper, am.bankKeeper, am.aclKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgRetryTrade,
+			defaultWeightMsgRetryTrade,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				tradesimulation.SimulateMsgRetryTrade(am.accountKeeper, am.bankKeeper, am.aclKeeper, am.keeper, simState.TxConfig)
+				return nil
+			},
+		),
 		// this line is used by starport scaffolding # simapp/module/OpMsg
 	}
 }
@@ -0,0 +1,65 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/GGEZLabs/vvtxchain/x/trade/keeper"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+func SimulateMsgCancelTrade(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var pendingTrades []types.StoredTrade
+		allStoredTrade := k.GetAllStoredTrade(ctx)
+		for _, storedTrade := range allStoredTrade {
+			if storedTrade.Status == types.StatusPending {
+				pendingTrades = append(pendingTrades, storedTrade)
+			}
+		}
+
+		if len(pendingTrades) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, "MsgCancelTrade", "no pending trades available"), nil, nil
+		}
+
+		trade := pendingTrades[r.Intn(len(pendingTrades))]
+
+		// Only the maker can cancel the trade
+		simAccount, found := FindAccount(accs, trade.Maker)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, "MsgCancelTrade", "maker account not found"), nil, nil
+		}
+
+		msg := &types.MsgCancelTrade{
+			Creator:    simAccount.Address.String(),
+			TradeIndex: trade.TradeIndex,
+			Reason:     simtypes.RandStringOfLength(r, 20),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
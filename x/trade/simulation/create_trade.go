@@ -46,7 +46,7 @@ func SimulateMsgCreateTrade(
 			return simtypes.NoOpMsg(types.ModuleName, "MsgCreateTrade", "create date is future date"), nil, nil
 		}
 
-		tradeType := randomTradeType(r)
+		tradeType, escrow := randomFundedTradeType(r, bk.SpendableCoins(ctx, simAccount.Address))
 		receiverAddress := simAccount.Address.String()
 		tradeData := types.GetSampleTradeDataJson(tradeType)
 
@@ -55,8 +55,7 @@ func SimulateMsgCreateTrade(
 			panic(err)
 		}
 
-		if td.TradeInfo.TradeType != types.TradeTypeFiatDeposit &&
-			td.TradeInfo.TradeType != types.TradeTypeFiatWithdrawal {
+		if !td.TradeInfo.TradeType.IsMintOrBurn() {
 			receiverAddress = ""
 		}
 
@@ -79,7 +78,7 @@ func SimulateMsgCreateTrade(
 			Context:         ctx,
 			SimAccount:      simAccount,
 			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
+			CoinsSpentInMsg: escrow,
 			AccountKeeper:   ak,
 			Bankkeeper:      bk,
 		}
@@ -90,11 +89,21 @@ func SimulateMsgCreateTrade(
 
 // randomTradeType Pick a random trade type
 func randomTradeType(r *rand.Rand) types.TradeType {
-	switch r.Intn(2) {
+	switch r.Intn(7) {
 	case 0:
 		return types.TradeTypeFiatDeposit
 	case 1:
 		return types.TradeTypeFiatWithdrawal
+	case 2:
+		return types.TradeTypeSplit
+	case 3:
+		return types.TradeTypeReverseSplit
+	case 4:
+		return types.TradeTypeReinvestment
+	case 5:
+		return types.TradeTypeDividends
+	case 6:
+		return types.TradeTypeDividendsDeduction
 	default:
 		panic("invalid trade type")
 	}
@@ -0,0 +1,79 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+
+	acltypes "github.com/GGEZLabs/vvtxchain/x/acl/types"
+	"github.com/GGEZLabs/vvtxchain/x/trade/keeper"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+func SimulateMsgCreateTradeV2(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	aclk types.AclKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		i := r.Int()
+
+		// Set authority before create trades
+		aclk.SetAclAuthority(ctx, acltypes.AclAuthority{
+			Address: simAccount.Address.String(),
+			Name:    strconv.Itoa(i),
+			AccessDefinitions: []*acltypes.AccessDefinition{
+				{
+					Module:    types.ModuleName,
+					IsMaker:   true,
+					IsChecker: false,
+				},
+			},
+		})
+
+		randomDate := randomDate(r)
+		if isFutureDate(randomDate) {
+			return simtypes.NoOpMsg(types.ModuleName, "MsgCreateTradeV2", "create date is future date"), nil, nil
+		}
+
+		tradeType, escrow := randomFundedTradeType(r, bk.SpendableCoins(ctx, simAccount.Address))
+		receiverAddress := simAccount.Address.String()
+		if !tradeType.IsMintOrBurn() {
+			receiverAddress = ""
+		}
+
+		msg := types.NewMsgCreateTradeV2(
+			simAccount.Address.String(),
+			receiverAddress,
+			types.GetSampleTradeData(tradeType),
+			`{}`,
+			types.GetSampleCoinMintingPrices(),
+			types.GetSampleExchangeRates(),
+		)
+		msg.CreateDate = randomDate
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: escrow,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
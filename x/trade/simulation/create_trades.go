@@ -0,0 +1,103 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+
+	acltypes "github.com/GGEZLabs/vvtxchain/x/acl/types"
+	"github.com/GGEZLabs/vvtxchain/x/trade/keeper"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// maxSimulationBatchSize is the maximum number of trades in a simulated batch
+const maxSimulationBatchSize = 5
+
+func SimulateMsgCreateTrades(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	aclk types.AclKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		i := r.Int()
+
+		// Set authority before create trades
+		aclk.SetAclAuthority(ctx, acltypes.AclAuthority{
+			Address: simAccount.Address.String(),
+			Name:    strconv.Itoa(i),
+			AccessDefinitions: []*acltypes.AccessDefinition{
+				{
+					Module:    types.ModuleName,
+					IsMaker:   true,
+					IsChecker: false,
+				},
+			},
+		})
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		escrow := sdk.NewCoins()
+
+		entries := make([]types.CreateTradeEntry, r.Intn(maxSimulationBatchSize)+1)
+		for j := range entries {
+			tradeType, tradeEscrow := randomFundedTradeType(r, spendable.Sub(escrow...))
+			escrow = escrow.Add(tradeEscrow...)
+			receiverAddress := simAccount.Address.String()
+			if !tradeType.IsMintOrBurn() {
+				receiverAddress = ""
+			}
+
+			createDate := randomDate(r)
+			if isFutureDate(createDate) {
+				// use the current date
+				createDate = ""
+			}
+
+			entries[j] = types.CreateTradeEntry{
+				ReceiverAddress:      receiverAddress,
+				TradeData:            types.GetSampleTradeDataJson(tradeType),
+				BankingSystemData:    `{}`,
+				CoinMintingPriceJson: types.GetSampleCoinMintingPriceJson(),
+				ExchangeRateJson:     types.GetSampleExchangeRateJson(),
+				CreateDate:           createDate,
+			}
+		}
+
+		msg := types.NewMsgCreateTrades(simAccount.Address.String(), entries, randomBatchMode(r))
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: escrow,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomBatchMode Pick a random batch mode
+func randomBatchMode(r *rand.Rand) types.BatchMode {
+	switch r.Intn(2) {
+	case 0:
+		return types.BatchModeAllOrNothing
+	case 1:
+		return types.BatchModeBestEffort
+	default:
+		panic("invalid batch mode")
+	}
+}
@@ -1,6 +1,9 @@
 package simulation
 
 import (
+	"math/rand"
+
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
@@ -13,3 +16,19 @@ func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, boo
 	}
 	return simtypes.FindAccount(accs, creator)
 }
+
+// randomFundedTradeType picks a random trade type. Withdrawals are escrowed when created, so a
+// withdrawal the spendable coins cannot fund is replaced by a deposit. It returns the trade type
+// and the coins escrowed by the trade.
+func randomFundedTradeType(r *rand.Rand, spendable sdk.Coins) (types.TradeType, sdk.Coins) {
+	tradeType := randomTradeType(r)
+	if tradeType != types.TradeTypeFiatWithdrawal {
+		return tradeType, sdk.NewCoins()
+	}
+
+	escrow := sdk.NewCoins(*types.GetSampleTradeData(tradeType).TradeInfo.Quantity)
+	if !spendable.IsAllGTE(escrow) {
+		return types.TradeTypeFiatDeposit, sdk.NewCoins()
+	}
+	return tradeType, escrow
+}
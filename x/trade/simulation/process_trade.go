@@ -61,6 +61,8 @@ func SimulateMsgProcessTrade(
 			Creator:     simAccount.Address.String(),
 			ProcessType: randomProcessType(r),
 			TradeIndex:  tradeIndex,
+			// process the current revision of the trade
+			ExpectedRevision: trade.Revision,
 		}
 
 		txCtx := simulation.OperationInput{
@@ -0,0 +1,79 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+
+	acltypes "github.com/GGEZLabs/vvtxchain/x/acl/types"
+	"github.com/GGEZLabs/vvtxchain/x/trade/keeper"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+func SimulateMsgProcessTrades(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	aclk types.AclKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		i := r.Int()
+
+		// Set authority before process trades
+		aclk.SetAclAuthority(ctx, acltypes.AclAuthority{
+			Address: simAccount.Address.String(),
+			Name:    strconv.Itoa(i),
+			AccessDefinitions: []*acltypes.AccessDefinition{
+				{
+					Module:    types.ModuleName,
+					IsMaker:   false,
+					IsChecker: true,
+				},
+			},
+		})
+
+		var tradeIndexes, expectedRevisions []uint64
+		allStoredTrade := k.GetAllStoredTrade(ctx)
+		for _, storedTrade := range allStoredTrade {
+			if len(tradeIndexes) == maxSimulationBatchSize {
+				break
+			}
+			// the checker must be different than the maker
+			if storedTrade.Status == types.StatusPending && storedTrade.Maker != simAccount.Address.String() {
+				tradeIndexes = append(tradeIndexes, storedTrade.TradeIndex)
+				expectedRevisions = append(expectedRevisions, storedTrade.Revision)
+			}
+		}
+
+		if len(tradeIndexes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, "MsgProcessTrades", "no pending trades available"), nil, nil
+		}
+
+		msg := types.NewMsgProcessTrades(simAccount.Address.String(), randomProcessType(r), tradeIndexes, randomBatchMode(r))
+		// process the current revision of the trades
+		msg.ExpectedRevisions = expectedRevisions
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
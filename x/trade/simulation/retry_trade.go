@@ -0,0 +1,78 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+
+	acltypes "github.com/GGEZLabs/vvtxchain/x/acl/types"
+	"github.com/GGEZLabs/vvtxchain/x/trade/keeper"
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+func SimulateMsgRetryTrade(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	aclk types.AclKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var failedTrades []types.StoredTrade
+		allStoredTrade := k.GetAllStoredTrade(ctx)
+		for _, storedTrade := range allStoredTrade {
+			if storedTrade.Status == types.StatusFailed {
+				failedTrades = append(failedTrades, storedTrade)
+			}
+		}
+
+		if len(failedTrades) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, "MsgRetryTrade", "no failed trades available"), nil, nil
+		}
+
+		trade := failedTrades[r.Intn(len(failedTrades))]
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		if trade.Maker == simAccount.Address.String() {
+			return simtypes.NoOpMsg(types.ModuleName, "MsgRetryTrade", "checker must be different than maker"), nil, nil
+		}
+
+		aclk.SetAclAuthority(ctx, acltypes.AclAuthority{
+			Address: simAccount.Address.String(),
+			Name:    strconv.Itoa(r.Int()),
+			AccessDefinitions: []*acltypes.AccessDefinition{
+				{
+					Module:    types.ModuleName,
+					IsMaker:   false,
+					IsChecker: true,
+				},
+			},
+		})
+
+		msg := &types.MsgRetryTrade{
+			Creator:    simAccount.Address.String(),
+			TradeIndex: trade.TradeIndex,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -217,6 +217,34 @@ func (mr *MockAclKeeperMockRecorder) GetAclAuthority(ctx, address any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAclAuthority", reflect.TypeOf((*MockAclKeeper)(nil).GetAclAuthority), ctx, address)
 }
 
+// IsAdmin mocks base method.
+func (m *MockAclKeeper) IsAdmin(ctx context.Context, address string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAdmin", ctx, address)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAdmin indicates an expected call of IsAdmin.
+func (mr *MockAclKeeperMockRecorder) IsAdmin(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdmin", reflect.TypeOf((*MockAclKeeper)(nil).IsAdmin), ctx, address)
+}
+
+// IsSuperAdmin mocks base method.
+func (m *MockAclKeeper) IsSuperAdmin(ctx context.Context, address string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSuperAdmin", ctx, address)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsSuperAdmin indicates an expected call of IsSuperAdmin.
+func (mr *MockAclKeeperMockRecorder) IsSuperAdmin(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSuperAdmin", reflect.TypeOf((*MockAclKeeper)(nil).IsSuperAdmin), ctx, address)
+}
+
 // SetAclAuthority mocks base method.
 func (m *MockAclKeeper) SetAclAuthority(ctx context.Context, aclAuthority types.AclAuthority) {
 	m.ctrl.T.Helper()
@@ -13,6 +13,24 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgProcessTrade{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCancelTrade{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAmendTrade{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateTradeV2{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateTrades{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgProcessTrades{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRetryTrade{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
@@ -0,0 +1,243 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/math"
+)
+
+// decimalJSONKeys are the keys of the decimal fields of the trade data, exchange rate
+// and coin minting price JSON payloads
+var decimalJSONKeys = map[string]struct{}{
+	"trade_value":        {},
+	"exchange_rate":      {},
+	"number_of_shares":   {},
+	"coin_minting_price": {},
+	"share_price":        {},
+	"trade_fee":          {},
+	"share_net_price":    {},
+	"trade_net_value":    {},
+	"original_amount":    {},
+	"converted_amount":   {},
+	"currency_rate":      {},
+	"minting_price":      {},
+}
+
+// CanonicalizeDecimalJSON rewrites the decimal fields of a JSON payload as canonical decimal strings.
+// Both legacy JSON numbers and decimal strings are accepted. The other values and the order of the
+// object keys are kept as they are, insignificant whitespace is removed.
+func CanonicalizeDecimalJSON(data string) (string, error) {
+	c := decimalCanonicalizer{}
+	return c.canonicalize(data)
+}
+
+// RoundDecimalJSON rewrites the decimal fields of a JSON payload as CanonicalizeDecimalJSON, the
+// decimals with more than 18 decimal places are rounded half to even instead of rejected. It
+// reports whether a decimal was rounded.
+func RoundDecimalJSON(data string) (string, bool, error) {
+	c := decimalCanonicalizer{round: true}
+	canonical, err := c.canonicalize(data)
+	return canonical, c.rounded, err
+}
+
+// unmarshalDecimalJSON unmarshals a JSON payload accepting legacy JSON numbers for the decimal fields
+func unmarshalDecimalJSON(data string, v interface{}) error {
+	canonical, err := CanonicalizeDecimalJSON(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal([]byte(canonical), v)
+}
+
+// decimalCanonicalizer rewrites the decimal fields of a JSON payload, with round the decimals
+// with too much precision are rounded to the LegacyDec precision and rounded is set
+type decimalCanonicalizer struct {
+	round   bool
+	rounded bool
+}
+
+func (c *decimalCanonicalizer) canonicalize(data string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+
+	var buf bytes.Buffer
+	if err := c.canonicalizeValue(decoder, &buf, ""); err != nil {
+		if err == io.EOF {
+			// the payload ended before a complete value was read
+			return "", io.ErrUnexpectedEOF
+		}
+		return "", err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return "", fmt.Errorf("invalid character after top-level value")
+	}
+	return buf.String(), nil
+}
+
+// canonicalizeValue reads the next JSON value of the decoder and writes it to buf,
+// key is the object key of the value, if any
+func (c *decimalCanonicalizer) canonicalizeValue(decoder *json.Decoder, buf *bytes.Buffer, key string) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	switch t := token.(type) {
+	case json.Delim:
+		return c.canonicalizeContainer(decoder, buf, t)
+	case json.Number:
+		if _, ok := decimalJSONKeys[key]; !ok {
+			buf.WriteString(t.String())
+			return nil
+		}
+		dec, err := c.parseDecimalNumber(t)
+		if err != nil {
+			return fmt.Errorf("invalid decimal %s: %w", key, err)
+		}
+		return writeJSONString(buf, dec.String())
+	case string:
+		if _, ok := decimalJSONKeys[key]; !ok {
+			return writeJSONString(buf, t)
+		}
+		dec, err := c.parseDecimalString(t)
+		if err != nil {
+			return fmt.Errorf("invalid decimal %s: %w", key, err)
+		}
+		return writeJSONString(buf, dec.String())
+	default:
+		// null and invalid values are left to the unmarshalling of the payload
+		b, err := json.Marshal(t)
+		if err != nil {
+			return err
+		}
+		buf.Write(b)
+		return nil
+	}
+}
+
+func (c *decimalCanonicalizer) canonicalizeContainer(decoder *json.Decoder, buf *bytes.Buffer, delim json.Delim) error {
+	buf.WriteString(delim.String())
+	for i := 0; decoder.More(); i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		key := ""
+		if delim == '{' {
+			token, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+			key = token.(string)
+			if err := writeJSONString(buf, key); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+		}
+
+		if err := c.canonicalizeValue(decoder, buf, key); err != nil {
+			return err
+		}
+	}
+
+	end, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	buf.WriteString(end.(json.Delim).String())
+	return nil
+}
+
+func writeJSONString(buf *bytes.Buffer, str string) error {
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(str); err != nil {
+		return err
+	}
+	// drop the newline added by the encoder
+	buf.Truncate(buf.Len() - 1)
+	return nil
+}
+
+// parseDecimalString parses a decimal string, a string holding a plain number with too much
+// precision is rounded when rounding is enabled
+func (c *decimalCanonicalizer) parseDecimalString(str string) (math.LegacyDec, error) {
+	dec, err := math.LegacyNewDecFromStr(str)
+	if err == nil || !c.round || strings.ContainsAny(str, "eE") || !json.Valid([]byte(str)) {
+		return dec, err
+	}
+	if _, isNumber := new(big.Rat).SetString(str); !isNumber {
+		return dec, err
+	}
+	return c.roundDecimal(str)
+}
+
+// parseDecimalNumber parses a legacy JSON number without going through a float
+func (c *decimalCanonicalizer) parseDecimalNumber(number json.Number) (math.LegacyDec, error) {
+	str := number.String()
+	if !strings.ContainsAny(str, "eE") {
+		dec, err := math.LegacyNewDecFromStr(str)
+		if err != nil && c.round {
+			return c.roundDecimal(str)
+		}
+		return dec, err
+	}
+
+	rat, ok := new(big.Rat).SetString(str)
+	if !ok {
+		return math.LegacyDec{}, fmt.Errorf("invalid number: %s", str)
+	}
+	scaled := rat.Mul(rat, new(big.Rat).SetInt(math.LegacyOneDec().BigInt()))
+	if !scaled.IsInt() {
+		if c.round {
+			return c.roundDecimal(str)
+		}
+		return math.LegacyDec{}, fmt.Errorf("too much precision, maximum %v, number: %s", math.LegacyPrecision, str)
+	}
+	return math.LegacyNewDecFromBigIntWithPrec(scaled.Num(), math.LegacyPrecision), nil
+}
+
+// roundDecimal rounds a number to the LegacyDec precision, half to even as the LegacyDec
+// arithmetic does
+func (c *decimalCanonicalizer) roundDecimal(str string) (math.LegacyDec, error) {
+	rat, ok := new(big.Rat).SetString(str)
+	if !ok {
+		return math.LegacyDec{}, fmt.Errorf("invalid number: %s", str)
+	}
+	scaled := rat.Mul(rat, new(big.Rat).SetInt(math.LegacyOneDec().BigInt()))
+
+	quo, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	// compare twice the remainder with the denominator to round half to even
+	switch new(big.Int).Abs(new(big.Int).Lsh(rem, 1)).Cmp(scaled.Denom()) {
+	case 1:
+		quo.Add(quo, big.NewInt(int64(rem.Sign())))
+	case 0:
+		if quo.Bit(0) == 1 {
+			quo.Add(quo, big.NewInt(int64(rem.Sign())))
+		}
+	}
+
+	// the decimal is parsed again to apply the LegacyDec size limit
+	dec, err := math.LegacyNewDecFromStr(math.LegacyNewDecFromBigIntWithPrec(quo, math.LegacyPrecision).String())
+	if err != nil {
+		return dec, err
+	}
+	if rem.Sign() != 0 {
+		c.rounded = true
+	}
+	return dec, nil
+}
+
+// zeroNilDecimals sets the decimals missing from a JSON payload to zero
+func zeroNilDecimals(decs ...*math.LegacyDec) {
+	for _, dec := range decs {
+		if dec.IsNil() {
+			*dec = math.LegacyZeroDec()
+		}
+	}
+}
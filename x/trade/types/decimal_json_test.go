@@ -0,0 +1,145 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/GGEZLabs/vvtxchain/x/trade/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalizeDecimalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		expErrMsg string
+	}{
+		{
+			name:     "legacy json numbers",
+			input:    `{"trade_info":{"trade_value":100.5,"trade_fee":5}}`,
+			expected: `{"trade_info":{"trade_value":"100.500000000000000000","trade_fee":"5.000000000000000000"}}`,
+		},
+		{
+			name:     "decimal strings",
+			input:    `{"trade_info":{"trade_value":"100.50","trade_fee":"5"}}`,
+			expected: `{"trade_info":{"trade_value":"100.500000000000000000","trade_fee":"5.000000000000000000"}}`,
+		},
+		{
+			name:     "exponent notation",
+			input:    `[{"minting_price":1.2e-11},{"minting_price":5E2}]`,
+			expected: `[{"minting_price":"0.000000000012000000"},{"minting_price":"500.000000000000000000"}]`,
+		},
+		{
+			name:     "non decimal fields are kept",
+			input:    `{"brokerage": {"name": "a<b", "country": "US"}, "trade_info": {"asset_holder_id": 1, "quantity": {"denom": "uggz", "amount": "10"}, "active": true}}`,
+			expected: `{"brokerage":{"name":"a<b","country":"US"},"trade_info":{"asset_holder_id":1,"quantity":{"denom":"uggz","amount":"10"},"active":true}}`,
+		},
+		{
+			name:     "null decimal is kept",
+			input:    `{"trade_value":null}`,
+			expected: `{"trade_value":null}`,
+		},
+		{
+			name:      "too much precision",
+			input:     `{"trade_value":0.0000000000000000001}`,
+			expErrMsg: "invalid decimal trade_value",
+		},
+		{
+			name:      "too much precision in exponent notation",
+			input:     `{"trade_value":1e-19}`,
+			expErrMsg: "invalid decimal trade_value",
+		},
+		{
+			name:      "invalid decimal string",
+			input:     `{"trade_value":"ten"}`,
+			expErrMsg: "invalid decimal trade_value",
+		},
+		{
+			name:      "trailing data",
+			input:     `{"trade_value":1}{}`,
+			expErrMsg: "invalid character after top-level value",
+		},
+		{
+			name:      "empty payload",
+			input:     "",
+			expErrMsg: "unexpected EOF",
+		},
+		{
+			name:      "invalid json",
+			input:     `{"trade_value":`,
+			expErrMsg: "unexpected EOF",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canonical, err := types.CanonicalizeDecimalJSON(tt.input)
+			if tt.expErrMsg != "" {
+				require.ErrorContains(t, err, tt.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, canonical)
+
+			// canonicalization is idempotent
+			again, err := types.CanonicalizeDecimalJSON(canonical)
+			require.NoError(t, err)
+			require.Equal(t, canonical, again)
+		})
+	}
+}
+
+func TestRoundDecimalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		rounded   bool
+		expErrMsg string
+	}{
+		{
+			name:     "decimals within the precision",
+			input:    `{"trade_value":100.5,"trade_fee":"5"}`,
+			expected: `{"trade_value":"100.500000000000000000","trade_fee":"5.000000000000000000"}`,
+		},
+		{
+			name:     "number with 19 decimal places",
+			input:    `{"trade_value":100.1234567890123456789}`,
+			expected: `{"trade_value":"100.123456789012345679"}`,
+			rounded:  true,
+		},
+		{
+			name:     "string with 19 decimal places",
+			input:    `{"trade_value":"-0.0000000000000000015"}`,
+			expected: `{"trade_value":"-0.000000000000000002"}`,
+			rounded:  true,
+		},
+		{
+			name:     "half rounded to even",
+			input:    `[{"minting_price":0.0000000000000000025},{"minting_price":5e-19}]`,
+			expected: `[{"minting_price":"0.000000000000000002"},{"minting_price":"0.000000000000000000"}]`,
+			rounded:  true,
+		},
+		{
+			name:      "invalid decimal string",
+			input:     `{"trade_value":"1e-19"}`,
+			expErrMsg: "invalid decimal trade_value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canonical, rounded, err := types.RoundDecimalJSON(tt.input)
+			if tt.expErrMsg != "" {
+				require.ErrorContains(t, err, tt.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, canonical)
+			require.Equal(t, tt.rounded, rounded)
+
+			// the rounded payload is canonical
+			again, err := types.CanonicalizeDecimalJSON(canonical)
+			require.NoError(t, err)
+			require.Equal(t, canonical, again)
+		})
+	}
+}
@@ -28,4 +28,17 @@ var (
 	ErrInvalidBankingSystemData    = sdkerrors.Register(ModuleName, 1118, "invalid banking system data json format")
 	ErrInvalidMsgType              = sdkerrors.Register(ModuleName, 1119, "invalid message type")
 	ErrModuleNotFound              = sdkerrors.Register(ModuleName, 1120, "module not found")
+	ErrDenomNotRegistered          = sdkerrors.Register(ModuleName, 1121, "denom is not a registered mintable denom")
+	ErrDenomDisabled               = sdkerrors.Register(ModuleName, 1122, "mintable denom is disabled")
+	ErrInvalidCancelPermission     = sdkerrors.Register(ModuleName, 1123, "only the maker of the trade or an acl admin can cancel it")
+	ErrInvalidCancelReason         = sdkerrors.Register(ModuleName, 1124, "invalid cancel reason")
+	ErrInvalidAmendPermission      = sdkerrors.Register(ModuleName, 1125, "only the maker of the trade can amend it")
+	ErrTradeRevisionMismatch       = sdkerrors.Register(ModuleName, 1126, "trade revision mismatch")
+	ErrDuplicateApproval           = sdkerrors.Register(ModuleName, 1127, "checker has already approved the trade")
+	ErrMintBurnLimitExceeded       = sdkerrors.Register(ModuleName, 1128, "mint burn limit exceeded")
+	ErrInconsistentTradeData       = sdkerrors.Register(ModuleName, 1129, "inconsistent trade data")
+	ErrInvalidExternalReference    = sdkerrors.Register(ModuleName, 1130, "invalid external reference")
+	ErrDuplicateExternalReference  = sdkerrors.Register(ModuleName, 1131, "external reference is already used by another trade")
+	ErrInvalidBatch                = sdkerrors.Register(ModuleName, 1132, "invalid batch")
+	ErrTradeExecutionFailed        = sdkerrors.Register(ModuleName, 1133, "trade execution failed")
 )
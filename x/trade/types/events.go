@@ -1,20 +1,36 @@
 package types
 
-// Trade module event types
+// Trade module event types. The untyped events are deprecated in favor of the typed events of
+// events.proto, both are emitted until the untyped events are removed.
 const (
 	EventTypeCreateTrade                     = "create_trade"
 	EventTypeProcessTrade                    = "process_trade"
+	EventTypeCancelTrade                     = "cancel_trade"
+	EventTypeAmendTrade                      = "amend_trade"
+	EventTypeRetryTrade                      = "retry_trade"
 	EventTypeCanceledTrades                  = "canceled_trades"
 	EventTypeCancelExpiredPendingTradesError = "canceled_trades_error"
+	EventTypeCreateTradeError                = "create_trade_error"
+	EventTypeProcessTradeError               = "process_trade_error"
 
-	AttributeKeyTradeIndex  = "trade_index"
-	AttributeKeyStatus      = "status"
-	AttributeKeyChecker     = "checker"
-	AttributeKeyMaker       = "maker"
-	AttributeKeyTradeData   = "trade_data"
-	AttributeKeyCreateDate  = "create_date"
-	AttributeKeyUpdateDate  = "update_date"
-	AttributeKeyProcessDate = "process_date"
-	AttributeKeyResult      = "result"
-	AttributeKeyError       = "error"
+	AttributeKeyTradeIndex          = "trade_index"
+	AttributeKeyStatus              = "status"
+	AttributeKeyChecker             = "checker"
+	AttributeKeyMaker               = "maker"
+	AttributeKeyTradeData           = "trade_data"
+	AttributeKeyCreateDate          = "create_date"
+	AttributeKeyUpdateDate          = "update_date"
+	AttributeKeyProcessDate         = "process_date"
+	AttributeKeyResult              = "result"
+	AttributeKeyError               = "error"
+	AttributeKeyCanceledBy          = "canceled_by"
+	AttributeKeyReason              = "reason"
+	AttributeKeyRevision            = "revision"
+	AttributeKeyApprovals           = "approvals"
+	AttributeKeyRequiredApprovals   = "required_approvals"
+	AttributeKeyPayloadHash         = "payload_hash"
+	AttributeKeyPreviousPayloadHash = "previous_payload_hash"
+	AttributeKeyEntry               = "entry"
+	AttributeKeyReceiverAddress     = "receiver_address"
+	AttributeKeyAttempts            = "attempts"
 )
@@ -0,0 +1,2924 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: vvtxchain/trade/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventTradeCreated is emitted when a trade is created.
+type EventTradeCreated struct {
+	TradeIndex        uint64      `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Status            TradeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
+	Maker             string      `protobuf:"bytes,3,opt,name=maker,proto3" json:"maker,omitempty"`
+	TradeType         TradeType   `protobuf:"varint,4,opt,name=trade_type,json=tradeType,proto3,enum=vvtxchain.trade.TradeType" json:"trade_type,omitempty"`
+	Amount            *types.Coin `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	ReceiverAddress   string      `protobuf:"bytes,6,opt,name=receiver_address,json=receiverAddress,proto3" json:"receiver_address,omitempty"`
+	ExternalReference string      `protobuf:"bytes,7,opt,name=external_reference,json=externalReference,proto3" json:"external_reference,omitempty"`
+}
+
+func (m *EventTradeCreated) Reset()         { *m = EventTradeCreated{} }
+func (m *EventTradeCreated) String() string { return proto.CompactTextString(m) }
+func (*EventTradeCreated) ProtoMessage()    {}
+func (*EventTradeCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d638b053c03ab83, []int{0}
+}
+func (m *EventTradeCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTradeCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTradeCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTradeCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTradeCreated.Merge(m, src)
+}
+func (m *EventTradeCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTradeCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTradeCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTradeCreated proto.InternalMessageInfo
+
+func (m *EventTradeCreated) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
+	}
+	return 0
+}
+
+func (m *EventTradeCreated) GetStatus() TradeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
+}
+
+func (m *EventTradeCreated) GetMaker() string {
+	if m != nil {
+		return m.Maker
+	}
+	return ""
+}
+
+func (m *EventTradeCreated) GetTradeType() TradeType {
+	if m != nil {
+		return m.TradeType
+	}
+	return TradeType_TRADE_TYPE_UNSPECIFIED
+}
+
+func (m *EventTradeCreated) GetAmount() *types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *EventTradeCreated) GetReceiverAddress() string {
+	if m != nil {
+		return m.ReceiverAddress
+	}
+	return ""
+}
+
+func (m *EventTradeCreated) GetExternalReference() string {
+	if m != nil {
+		return m.ExternalReference
+	}
+	return ""
+}
+
+// EventTradeProcessed is emitted when a trade is confirmed or rejected by a checker.
+type EventTradeProcessed struct {
+	TradeIndex        uint64      `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Status            TradeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
+	Checker           string      `protobuf:"bytes,3,opt,name=checker,proto3" json:"checker,omitempty"`
+	Maker             string      `protobuf:"bytes,4,opt,name=maker,proto3" json:"maker,omitempty"`
+	ProcessDate       string      `protobuf:"bytes,5,opt,name=process_date,json=processDate,proto3" json:"process_date,omitempty"`
+	Result            string      `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+	Revision          uint64      `protobuf:"varint,7,opt,name=revision,proto3" json:"revision,omitempty"`
+	Approvals         uint32      `protobuf:"varint,8,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	RequiredApprovals uint32      `protobuf:"varint,9,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+}
+
+func (m *EventTradeProcessed) Reset()         { *m = EventTradeProcessed{} }
+func (m *EventTradeProcessed) String() string { return proto.CompactTextString(m) }
+func (*EventTradeProcessed) ProtoMessage()    {}
+func (*EventTradeProcessed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d638b053c03ab83, []int{1}
+}
+func (m *EventTradeProcessed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTradeProcessed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTradeProcessed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTradeProcessed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTradeProcessed.Merge(m, src)
+}
+func (m *EventTradeProcessed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTradeProcessed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTradeProcessed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTradeProcessed proto.InternalMessageInfo
+
+func (m *EventTradeProcessed) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
+	}
+	return 0
+}
+
+func (m *EventTradeProcessed) GetStatus() TradeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
+}
+
+func (m *EventTradeProcessed) GetChecker() string {
+	if m != nil {
+		return m.Checker
+	}
+	return ""
+}
+
+func (m *EventTradeProcessed) GetMaker() string {
+	if m != nil {
+		return m.Maker
+	}
+	return ""
+}
+
+func (m *EventTradeProcessed) GetProcessDate() string {
+	if m != nil {
+		return m.ProcessDate
+	}
+	return ""
+}
+
+func (m *EventTradeProcessed) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *EventTradeProcessed) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *EventTradeProcessed) GetApprovals() uint32 {
+	if m != nil {
+		return m.Approvals
+	}
+	return 0
+}
+
+func (m *EventTradeProcessed) GetRequiredApprovals() uint32 {
+	if m != nil {
+		return m.RequiredApprovals
+	}
+	return 0
+}
+
+// EventTradeCanceled is emitted when a pending trade is canceled by MsgCancelTrade.
+type EventTradeCanceled struct {
+	TradeIndex uint64      `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Status     TradeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
+	CanceledBy string      `protobuf:"bytes,3,opt,name=canceled_by,json=canceledBy,proto3" json:"canceled_by,omitempty"`
+	Maker      string      `protobuf:"bytes,4,opt,name=maker,proto3" json:"maker,omitempty"`
+	Reason     string      `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Result     string      `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *EventTradeCanceled) Reset()         { *m = EventTradeCanceled{} }
+func (m *EventTradeCanceled) String() string { return proto.CompactTextString(m) }
+func (*EventTradeCanceled) ProtoMessage()    {}
+func (*EventTradeCanceled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d638b053c03ab83, []int{2}
+}
+func (m *EventTradeCanceled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTradeCanceled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTradeCanceled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTradeCanceled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTradeCanceled.Merge(m, src)
+}
+func (m *EventTradeCanceled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTradeCanceled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTradeCanceled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTradeCanceled proto.InternalMessageInfo
+
+func (m *EventTradeCanceled) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
+	}
+	return 0
+}
+
+func (m *EventTradeCanceled) GetStatus() TradeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
+}
+
+func (m *EventTradeCanceled) GetCanceledBy() string {
+	if m != nil {
+		return m.CanceledBy
+	}
+	return ""
+}
+
+func (m *EventTradeCanceled) GetMaker() string {
+	if m != nil {
+		return m.Maker
+	}
+	return ""
+}
+
+func (m *EventTradeCanceled) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventTradeCanceled) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+// EventTradeAmended is emitted when the payload of a pending trade is amended.
+type EventTradeAmended struct {
+	TradeIndex          uint64 `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Revision            uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Maker               string `protobuf:"bytes,3,opt,name=maker,proto3" json:"maker,omitempty"`
+	PayloadHash         string `protobuf:"bytes,4,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
+	PreviousPayloadHash string `protobuf:"bytes,5,opt,name=previous_payload_hash,json=previousPayloadHash,proto3" json:"previous_payload_hash,omitempty"`
+}
+
+func (m *EventTradeAmended) Reset()         { *m = EventTradeAmended{} }
+func (m *EventTradeAmended) String() string { return proto.CompactTextString(m) }
+func (*EventTradeAmended) ProtoMessage()    {}
+func (*EventTradeAmended) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d638b053c03ab83, []int{3}
+}
+func (m *EventTradeAmended) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTradeAmended) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTradeAmended.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTradeAmended) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTradeAmended.Merge(m, src)
+}
+func (m *EventTradeAmended) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTradeAmended) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTradeAmended.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTradeAmended proto.InternalMessageInfo
+
+func (m *EventTradeAmended) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
+	}
+	return 0
+}
+
+func (m *EventTradeAmended) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *EventTradeAmended) GetMaker() string {
+	if m != nil {
+		return m.Maker
+	}
+	return ""
+}
+
+func (m *EventTradeAmended) GetPayloadHash() string {
+	if m != nil {
+		return m.PayloadHash
+	}
+	return ""
+}
+
+func (m *EventTradeAmended) GetPreviousPayloadHash() string {
+	if m != nil {
+		return m.PreviousPayloadHash
+	}
+	return ""
+}
+
+// EventTradeRetried is emitted when the mint or burn of a failed trade is retried.
+type EventTradeRetried struct {
+	TradeIndex      uint64      `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Status          TradeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
+	Checker         string      `protobuf:"bytes,3,opt,name=checker,proto3" json:"checker,omitempty"`
+	Maker           string      `protobuf:"bytes,4,opt,name=maker,proto3" json:"maker,omitempty"`
+	ReceiverAddress string      `protobuf:"bytes,5,opt,name=receiver_address,json=receiverAddress,proto3" json:"receiver_address,omitempty"`
+	Attempts        uint32      `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Result          string      `protobuf:"bytes,7,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *EventTradeRetried) Reset()         { *m = EventTradeRetried{} }
+func (m *EventTradeRetried) String() string { return proto.CompactTextString(m) }
+func (*EventTradeRetried) ProtoMessage()    {}
+func (*EventTradeRetried) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d638b053c03ab83, []int{4}
+}
+func (m *EventTradeRetried) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTradeRetried) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTradeRetried.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTradeRetried) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTradeRetried.Merge(m, src)
+}
+func (m *EventTradeRetried) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTradeRetried) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTradeRetried.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTradeRetried proto.InternalMessageInfo
+
+func (m *EventTradeRetried) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
+	}
+	return 0
+}
+
+func (m *EventTradeRetried) GetStatus() TradeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
+}
+
+func (m *EventTradeRetried) GetChecker() string {
+	if m != nil {
+		return m.Checker
+	}
+	return ""
+}
+
+func (m *EventTradeRetried) GetMaker() string {
+	if m != nil {
+		return m.Maker
+	}
+	return ""
+}
+
+func (m *EventTradeRetried) GetReceiverAddress() string {
+	if m != nil {
+		return m.ReceiverAddress
+	}
+	return ""
+}
+
+func (m *EventTradeRetried) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *EventTradeRetried) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+// EventTradesExpired is emitted at the end of a block in which pending trades expired.
+type EventTradesExpired struct {
+	TradeIndexes []uint64 `protobuf:"varint,1,rep,packed,name=trade_indexes,json=tradeIndexes,proto3" json:"trade_indexes,omitempty"`
+}
+
+func (m *EventTradesExpired) Reset()         { *m = EventTradesExpired{} }
+func (m *EventTradesExpired) String() string { return proto.CompactTextString(m) }
+func (*EventTradesExpired) ProtoMessage()    {}
+func (*EventTradesExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d638b053c03ab83, []int{5}
+}
+func (m *EventTradesExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTradesExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTradesExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTradesExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTradesExpired.Merge(m, src)
+}
+func (m *EventTradesExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTradesExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTradesExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTradesExpired proto.InternalMessageInfo
+
+func (m *EventTradesExpired) GetTradeIndexes() []uint64 {
+	if m != nil {
+		return m.TradeIndexes
+	}
+	return nil
+}
+
+// EventCreateTradeEntryFailed is emitted for each entry of a MsgCreateTrades that failed in
+// the best effort mode.
+type EventCreateTradeEntryFailed struct {
+	Entry uint32 `protobuf:"varint,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventCreateTradeEntryFailed) Reset()         { *m = EventCreateTradeEntryFailed{} }
+func (m *EventCreateTradeEntryFailed) String() string { return proto.CompactTextString(m) }
+func (*EventCreateTradeEntryFailed) ProtoMessage()    {}
+func (*EventCreateTradeEntryFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d638b053c03ab83, []int{6}
+}
+func (m *EventCreateTradeEntryFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateTradeEntryFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateTradeEntryFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateTradeEntryFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateTradeEntryFailed.Merge(m, src)
+}
+func (m *EventCreateTradeEntryFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateTradeEntryFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateTradeEntryFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateTradeEntryFailed proto.InternalMessageInfo
+
+func (m *EventCreateTradeEntryFailed) GetEntry() uint32 {
+	if m != nil {
+		return m.Entry
+	}
+	return 0
+}
+
+func (m *EventCreateTradeEntryFailed) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// EventProcessTradeEntryFailed is emitted for each trade of a MsgProcessTrades that failed in
+// the best effort mode.
+type EventProcessTradeEntryFailed struct {
+	TradeIndex uint64 `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventProcessTradeEntryFailed) Reset()         { *m = EventProcessTradeEntryFailed{} }
+func (m *EventProcessTradeEntryFailed) String() string { return proto.CompactTextString(m) }
+func (*EventProcessTradeEntryFailed) ProtoMessage()    {}
+func (*EventProcessTradeEntryFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d638b053c03ab83, []int{7}
+}
+func (m *EventProcessTradeEntryFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventProcessTradeEntryFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventProcessTradeEntryFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventProcessTradeEntryFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventProcessTradeEntryFailed.Merge(m, src)
+}
+func (m *EventProcessTradeEntryFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventProcessTradeEntryFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventProcessTradeEntryFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventProcessTradeEntryFailed proto.InternalMessageInfo
+
+func (m *EventProcessTradeEntryFailed) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
+	}
+	return 0
+}
+
+func (m *EventProcessTradeEntryFailed) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventTradeCreated)(nil), "vvtxchain.trade.EventTradeCreated")
+	proto.RegisterType((*EventTradeProcessed)(nil), "vvtxchain.trade.EventTradeProcessed")
+	proto.RegisterType((*EventTradeCanceled)(nil), "vvtxchain.trade.EventTradeCanceled")
+	proto.RegisterType((*EventTradeAmended)(nil), "vvtxchain.trade.EventTradeAmended")
+	proto.RegisterType((*EventTradeRetried)(nil), "vvtxchain.trade.EventTradeRetried")
+	proto.RegisterType((*EventTradesExpired)(nil), "vvtxchain.trade.EventTradesExpired")
+	proto.RegisterType((*EventCreateTradeEntryFailed)(nil), "vvtxchain.trade.EventCreateTradeEntryFailed")
+	proto.RegisterType((*EventProcessTradeEntryFailed)(nil), "vvtxchain.trade.EventProcessTradeEntryFailed")
+}
+
+func init() { proto.RegisterFile("vvtxchain/trade/events.proto", fileDescriptor_0d638b053c03ab83) }
+
+var fileDescriptor_0d638b053c03ab83 = []byte{
+	// 688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x97, 0xae, 0xed, 0x56, 0x77, 0x65, 0xcc, 0x1b, 0x28, 0x74, 0x55, 0x57, 0xc2, 0xa5,
+	0x08, 0x91, 0x68, 0x83, 0xcb, 0x8e, 0xdb, 0x28, 0x63, 0x12, 0x87, 0xc9, 0x8c, 0xcb, 0x2e, 0x91,
+	0x9b, 0x3c, 0x68, 0x44, 0x1b, 0x07, 0xdb, 0x8d, 0x9a, 0x6f, 0xc1, 0x17, 0x42, 0xe2, 0xb8, 0xe3,
+	0xc4, 0x89, 0x23, 0xda, 0x3e, 0x05, 0x37, 0x14, 0xc7, 0x59, 0xb2, 0xd1, 0x09, 0x2e, 0x93, 0xb8,
+	0x4c, 0x7b, 0xff, 0xf7, 0xfc, 0xaf, 0xfd, 0xf3, 0x7b, 0x31, 0xea, 0xc4, 0xb1, 0x9c, 0x79, 0x23,
+	0x1a, 0x84, 0x8e, 0xe4, 0xd4, 0x07, 0x07, 0x62, 0x08, 0xa5, 0xb0, 0x23, 0xce, 0x24, 0xc3, 0xab,
+	0x57, 0x59, 0x5b, 0x65, 0xdb, 0x5d, 0x8f, 0x89, 0x09, 0x13, 0xce, 0x90, 0x0a, 0x70, 0xe2, 0xed,
+	0x21, 0x48, 0xba, 0xed, 0x78, 0x2c, 0x08, 0xb3, 0x05, 0xed, 0xcd, 0x9b, 0x76, 0xea, 0x6f, 0x96,
+	0xb4, 0xce, 0x2a, 0x68, 0x6d, 0x90, 0xda, 0x9f, 0xa4, 0xe2, 0x01, 0x07, 0x2a, 0xc1, 0xc7, 0x5b,
+	0xa8, 0xa9, 0x8a, 0xdc, 0x20, 0xf4, 0x61, 0x66, 0x1a, 0x3d, 0xa3, 0x5f, 0x25, 0x48, 0x49, 0x47,
+	0xa9, 0x82, 0x5f, 0xa2, 0xba, 0x90, 0x54, 0x4e, 0x85, 0x59, 0xe9, 0x19, 0xfd, 0x7b, 0x3b, 0x1d,
+	0xfb, 0xc6, 0xae, 0x6c, 0xe5, 0xf7, 0x4e, 0xd5, 0x10, 0x5d, 0x8b, 0x37, 0x50, 0x6d, 0x42, 0x3f,
+	0x01, 0x37, 0x17, 0x7b, 0x46, 0xbf, 0x41, 0xb2, 0x00, 0xef, 0xa2, 0xcc, 0xd9, 0x95, 0x49, 0x04,
+	0x66, 0x55, 0xf9, 0xb5, 0xe7, 0xfb, 0x9d, 0x24, 0x11, 0x90, 0x86, 0xcc, 0xff, 0xc5, 0xdb, 0xa8,
+	0x4e, 0x27, 0x6c, 0x1a, 0x4a, 0xb3, 0xd6, 0x33, 0xfa, 0xcd, 0x9d, 0x47, 0x76, 0xc6, 0xc2, 0x4e,
+	0x59, 0xd8, 0x9a, 0x85, 0x7d, 0xc0, 0x82, 0x90, 0xe8, 0x42, 0xfc, 0x14, 0xdd, 0xe7, 0xe0, 0x41,
+	0x10, 0x03, 0x77, 0xa9, 0xef, 0x73, 0x10, 0xc2, 0xac, 0xab, 0xed, 0xac, 0xe6, 0xfa, 0x5e, 0x26,
+	0xe3, 0xe7, 0x08, 0xc3, 0x4c, 0x02, 0x0f, 0xe9, 0xd8, 0xe5, 0xf0, 0x01, 0x38, 0x84, 0x1e, 0x98,
+	0x4b, 0xaa, 0x78, 0x2d, 0xcf, 0x90, 0x3c, 0x61, 0x7d, 0xab, 0xa0, 0xf5, 0x02, 0xe5, 0x31, 0x67,
+	0x1e, 0x08, 0x71, 0x77, 0x30, 0x4d, 0xb4, 0xe4, 0x8d, 0xc0, 0x2b, 0x70, 0xe6, 0x61, 0x81, 0xb9,
+	0x5a, 0xc6, 0xfc, 0x18, 0xad, 0x44, 0xd9, 0x9e, 0x5c, 0x9f, 0x4a, 0x50, 0xc4, 0x1a, 0xa4, 0xa9,
+	0xb5, 0x57, 0x54, 0x02, 0x7e, 0x88, 0xea, 0x1c, 0xc4, 0x74, 0x2c, 0x35, 0x11, 0x1d, 0xe1, 0x36,
+	0x5a, 0xe6, 0x10, 0x07, 0x22, 0x60, 0xa1, 0x3a, 0x7e, 0x95, 0x5c, 0xc5, 0xb8, 0x83, 0x1a, 0x34,
+	0x8a, 0x38, 0x8b, 0xe9, 0x58, 0x98, 0xcb, 0x3d, 0xa3, 0xdf, 0x22, 0x85, 0x90, 0x22, 0xe4, 0xf0,
+	0x79, 0x1a, 0x70, 0xf0, 0xdd, 0xa2, 0xac, 0xa1, 0xca, 0xd6, 0xf2, 0xcc, 0x5e, 0x9e, 0xb0, 0xbe,
+	0x1b, 0x08, 0x97, 0xba, 0x91, 0x86, 0x1e, 0x8c, 0xef, 0x8e, 0xe0, 0x16, 0x6a, 0x7a, 0xfa, 0x27,
+	0xdc, 0x61, 0xa2, 0x29, 0xa2, 0x5c, 0xda, 0x4f, 0x6e, 0x01, 0xa9, 0x28, 0x51, 0xc1, 0x42, 0x8d,
+	0x50, 0x47, 0xb7, 0xd1, 0xb3, 0xbe, 0x1a, 0xe5, 0x11, 0xdb, 0x9b, 0x40, 0xe8, 0xff, 0xcb, 0x99,
+	0xca, 0xd0, 0x2b, 0x37, 0xa0, 0xcf, 0x1f, 0xa4, 0xf4, 0x86, 0x69, 0x32, 0x66, 0xd4, 0x77, 0x47,
+	0x54, 0x8c, 0xf4, 0xae, 0x9b, 0x5a, 0x7b, 0x43, 0xc5, 0x08, 0xef, 0xa0, 0x07, 0x51, 0xea, 0xc2,
+	0xa6, 0xc2, 0xbd, 0x56, 0x9b, 0x1d, 0x65, 0x3d, 0x4f, 0x1e, 0x17, 0x6b, 0xac, 0x5f, 0xd7, 0xf6,
+	0x4f, 0x40, 0xf2, 0xe0, 0xff, 0xe9, 0xea, 0x79, 0xe3, 0x5c, 0x9b, 0x3f, 0xce, 0x6d, 0xb4, 0x4c,
+	0xa5, 0x84, 0x49, 0x24, 0xb3, 0x89, 0x6f, 0x91, 0xab, 0xb8, 0x74, 0x77, 0x4b, 0xd7, 0xee, 0x6e,
+	0xb7, 0xdc, 0x8f, 0x62, 0x30, 0x8b, 0xd2, 0x7e, 0xc5, 0x4f, 0x50, 0xab, 0x74, 0x76, 0x10, 0xa6,
+	0xd1, 0x5b, 0xec, 0x57, 0xc9, 0x4a, 0x71, 0x7a, 0x10, 0xd6, 0x11, 0xda, 0x54, 0x4b, 0xb3, 0x6f,
+	0xaa, 0x32, 0x18, 0x84, 0x92, 0x27, 0xaf, 0x69, 0x90, 0xf6, 0xf4, 0x06, 0xaa, 0x41, 0x1a, 0x2a,
+	0x72, 0x2d, 0x92, 0x05, 0x4a, 0xe5, 0x9c, 0x71, 0xc5, 0xac, 0x41, 0xb2, 0xc0, 0x7a, 0x8f, 0x3a,
+	0xca, 0x4a, 0x7f, 0x53, 0xfe, 0xf0, 0xfa, 0xeb, 0x5d, 0xcc, 0xb5, 0xdd, 0x1f, 0x9c, 0x5d, 0x74,
+	0x8d, 0xf3, 0x8b, 0xae, 0xf1, 0xf3, 0xa2, 0x6b, 0x7c, 0xb9, 0xec, 0x2e, 0x9c, 0x5f, 0x76, 0x17,
+	0x7e, 0x5c, 0x76, 0x17, 0x4e, 0x9f, 0x7d, 0x0c, 0xe4, 0x68, 0x3a, 0xb4, 0x3d, 0x36, 0x71, 0x0e,
+	0x0f, 0x07, 0xa7, 0x6f, 0xe9, 0x50, 0x38, 0xc5, 0x33, 0x32, 0xcb, 0x1f, 0x92, 0x24, 0x02, 0x31,
+	0xac, 0xab, 0x97, 0xe4, 0xc5, 0xef, 0x01, 0x00, 0x26, 0xe2, 0x2a, 0x43, 0xb7, 0x06, 0x00, 0x00,
+}
+
+func (m *EventTradeCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradeCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradeCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalReference) > 0 {
+		i -= len(m.ExternalReference)
+		copy(dAtA[i:], m.ExternalReference)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalReference)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ReceiverAddress) > 0 {
+		i -= len(m.ReceiverAddress)
+		copy(dAtA[i:], m.ReceiverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReceiverAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TradeType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TradeType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Maker) > 0 {
+		i -= len(m.Maker)
+		copy(dAtA[i:], m.Maker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Maker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTradeProcessed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradeProcessed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradeProcessed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequiredApprovals != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RequiredApprovals))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Approvals != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Approvals))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Revision != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ProcessDate) > 0 {
+		i -= len(m.ProcessDate)
+		copy(dAtA[i:], m.ProcessDate)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ProcessDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Maker) > 0 {
+		i -= len(m.Maker)
+		copy(dAtA[i:], m.Maker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Maker)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Checker) > 0 {
+		i -= len(m.Checker)
+		copy(dAtA[i:], m.Checker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Checker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTradeCanceled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradeCanceled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradeCanceled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Maker) > 0 {
+		i -= len(m.Maker)
+		copy(dAtA[i:], m.Maker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Maker)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CanceledBy) > 0 {
+		i -= len(m.CanceledBy)
+		copy(dAtA[i:], m.CanceledBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CanceledBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTradeAmended) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradeAmended) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradeAmended) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousPayloadHash) > 0 {
+		i -= len(m.PreviousPayloadHash)
+		copy(dAtA[i:], m.PreviousPayloadHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousPayloadHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PayloadHash) > 0 {
+		i -= len(m.PayloadHash)
+		copy(dAtA[i:], m.PayloadHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PayloadHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Maker) > 0 {
+		i -= len(m.Maker)
+		copy(dAtA[i:], m.Maker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Maker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTradeRetried) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradeRetried) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradeRetried) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ReceiverAddress) > 0 {
+		i -= len(m.ReceiverAddress)
+		copy(dAtA[i:], m.ReceiverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReceiverAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Maker) > 0 {
+		i -= len(m.Maker)
+		copy(dAtA[i:], m.Maker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Maker)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Checker) > 0 {
+		i -= len(m.Checker)
+		copy(dAtA[i:], m.Checker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Checker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTradesExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTradesExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTradesExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TradeIndexes) > 0 {
+		dAtA3 := make([]byte, len(m.TradeIndexes)*10)
+		var j2 int
+		for _, num := range m.TradeIndexes {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintEvents(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreateTradeEntryFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateTradeEntryFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateTradeEntryFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Entry != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Entry))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProcessTradeEntryFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProcessTradeEntryFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProcessTradeEntryFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventTradeCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovEvents(uint64(m.TradeIndex))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	l = len(m.Maker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TradeType != 0 {
+		n += 1 + sovEvents(uint64(m.TradeType))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReceiverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExternalReference)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTradeProcessed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovEvents(uint64(m.TradeIndex))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	l = len(m.Checker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Maker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ProcessDate)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovEvents(uint64(m.Revision))
+	}
+	if m.Approvals != 0 {
+		n += 1 + sovEvents(uint64(m.Approvals))
+	}
+	if m.RequiredApprovals != 0 {
+		n += 1 + sovEvents(uint64(m.RequiredApprovals))
+	}
+	return n
+}
+
+func (m *EventTradeCanceled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovEvents(uint64(m.TradeIndex))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	l = len(m.CanceledBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Maker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTradeAmended) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovEvents(uint64(m.TradeIndex))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovEvents(uint64(m.Revision))
+	}
+	l = len(m.Maker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PayloadHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PreviousPayloadHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTradeRetried) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovEvents(uint64(m.TradeIndex))
+	}
+	if m.Status != 0 {
+		n += 1 + sovEvents(uint64(m.Status))
+	}
+	l = len(m.Checker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Maker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReceiverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovEvents(uint64(m.Attempts))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventTradesExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TradeIndexes) > 0 {
+		l = 0
+		for _, e := range m.TradeIndexes {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EventCreateTradeEntryFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entry != 0 {
+		n += 1 + sovEvents(uint64(m.Entry))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventProcessTradeEntryFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovEvents(uint64(m.TradeIndex))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventTradeCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTradeCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTradeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeType", wireType)
+			}
+			m.TradeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeType |= TradeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTradeProcessed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTradeProcessed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTradeProcessed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			m.Approvals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Approvals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredApprovals", wireType)
+			}
+			m.RequiredApprovals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredApprovals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTradeCanceled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTradeCanceled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTradeCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanceledBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanceledBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTradeAmended) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTradeAmended: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTradeAmended: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPayloadHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousPayloadHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTradeRetried) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTradeRetried: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTradeRetried: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTradesExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTradesExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTradesExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TradeIndexes = append(m.TradeIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TradeIndexes) == 0 {
+					m.TradeIndexes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TradeIndexes = append(m.TradeIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndexes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateTradeEntryFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateTradeEntryFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateTradeEntryFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			m.Entry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Entry |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProcessTradeEntryFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProcessTradeEntryFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProcessTradeEntryFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
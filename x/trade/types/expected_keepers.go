@@ -27,6 +27,8 @@ type BankKeeper interface {
 type AclKeeper interface {
 	GetAclAuthority(ctx context.Context, address string) (val acltypes.AclAuthority, found bool)
 	SetAclAuthority(ctx context.Context, aclAuthority acltypes.AclAuthority)
+	IsAdmin(ctx context.Context, address string) bool
+	IsSuperAdmin(ctx context.Context, address string) bool
 }
 
 // ParamSubspace defines the expected Subspace interface for parameters.
@@ -3,10 +3,10 @@ package types
 import (
 	"encoding/json"
 	"fmt"
-	"strconv"
 	"strings"
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -21,6 +21,9 @@ func DefaultGenesis() *GenesisState {
 		},
 		StoredTrades:     []StoredTrade{},
 		StoredTempTrades: []StoredTempTrade{},
+		MintBurnRecords:  []MintBurnRecord{},
+		TradeHistory:     []TradeHistoryEntry{},
+		TradeEscrows:     []TradeEscrow{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -33,6 +36,10 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("next_id must be more than 0")
 	}
 
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	err := gs.ValidateStoredTrade()
 	if err != nil {
 		return err
@@ -43,13 +50,29 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	err = gs.ValidateMintBurnRecords()
+	if err != nil {
+		return err
+	}
+
+	err = gs.ValidateTradeHistory()
+	if err != nil {
+		return err
+	}
+
+	err = gs.ValidateTradeEscrows()
+	if err != nil {
+		return err
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
-	return gs.Params.Validate()
+	return nil
 }
 
 func (gs GenesisState) ValidateStoredTrade() error {
 	storedTradeIndexMap := make(map[string]struct{})
+	externalReferenceMap := make(map[string]uint64)
 
 	for _, elem := range gs.StoredTrades {
 		if elem.TradeIndex <= 0 {
@@ -67,20 +90,15 @@ func (gs GenesisState) ValidateStoredTrade() error {
 			return fmt.Errorf("invalid trade_type, trade_index: %d", elem.TradeIndex)
 		}
 
-		if elem.TradeType == TradeTypeFiatDeposit ||
-			elem.TradeType == TradeTypeFiatWithdrawal {
-			if !elem.Amount.IsValid() {
-				return fmt.Errorf("invalid amount: %s, trade_index: %d", elem.Amount.String(), elem.TradeIndex)
+		if elem.TradeType.IsMintOrBurn() {
+			if err := elem.Amount.Validate(); err != nil {
+				return fmt.Errorf("invalid amount: %s, error: %s, trade_index: %d", elem.Amount.String(), err, elem.TradeIndex)
 			}
 
 			if elem.Amount.IsZero() {
 				return fmt.Errorf("zero amount not allowed: %s, trade_index: %d", elem.Amount.String(), elem.TradeIndex)
 			}
 
-			if elem.Amount.Denom != DefaultDenom {
-				return fmt.Errorf("invalid denom expected: %s, got: %s, trade_index: %d", DefaultDenom, elem.Amount.Denom, elem.TradeIndex)
-			}
-
 			if _, err := sdk.AccAddressFromBech32(elem.ReceiverAddress); err != nil {
 				return fmt.Errorf("invalid receiver_address for trade_index %d, address %s, error: %w", elem.TradeIndex, elem.ReceiverAddress, err)
 			}
@@ -102,12 +120,12 @@ func (gs GenesisState) ValidateStoredTrade() error {
 			return fmt.Errorf("empty trade price not allowed, trade_index: %d", elem.TradeIndex)
 		}
 
-		coinPrice, err := strconv.ParseFloat(elem.CoinMintingPrice, 64)
+		coinPrice, err := math.LegacyNewDecFromStr(elem.CoinMintingPrice)
 		if err != nil {
 			return fmt.Errorf("invalid trade price err: %s, trade_index: %d", err, elem.TradeIndex)
 		}
 
-		if coinPrice <= 0 {
+		if !coinPrice.IsPositive() {
 			return fmt.Errorf("price must be more than 0, trade_index: %d", elem.TradeIndex)
 		}
 
@@ -119,12 +137,12 @@ func (gs GenesisState) ValidateStoredTrade() error {
 			return fmt.Errorf("invalid maker address for trade_index %d, address %s, error: %w", elem.TradeIndex, elem.Maker, err)
 		}
 
-		if elem.Checker != "" && (elem.Status != StatusPending && elem.Status != StatusCanceled) {
-			if _, err := sdk.AccAddressFromBech32(elem.Checker); err != nil {
-				return fmt.Errorf("invalid checker address for trade_index %d, address %s, error: %w", elem.TradeIndex, elem.Checker, err)
+		if elem.Status == StatusPending || elem.Status == StatusCanceled {
+			if elem.Checker != "" {
+				return fmt.Errorf("checker must not be set for trade status %s, trade_index %d", elem.Status.String(), elem.TradeIndex)
 			}
-		} else {
-			return fmt.Errorf("checker must not be set for trade status %s, trade_index %d", elem.Status.String(), elem.TradeIndex)
+		} else if _, err := sdk.AccAddressFromBech32(elem.Checker); err != nil {
+			return fmt.Errorf("invalid checker address for trade_index %d, address %s, error: %w", elem.TradeIndex, elem.Checker, err)
 		}
 
 		_, err = time.Parse(time.RFC3339, elem.CreateDate)
@@ -147,11 +165,17 @@ func (gs GenesisState) ValidateStoredTrade() error {
 			return fmt.Errorf("invalid process_date format, trade_index: %d", elem.TradeIndex)
 		}
 
-		_, err = ValidateTradeData(elem.TradeData)
+		// the trade data is checked without the params, a stored trade must stay valid when the
+		// mintable denoms or the trade data tolerances change
+		td, err := ValidateTradeDataStructure(elem.TradeData)
 		if err != nil {
 			return fmt.Errorf("invalid trade_data, error: %s, trade_index: %d", err, elem.TradeIndex)
 		}
 
+		if td.TradeInfo.TradeType != elem.TradeType {
+			return fmt.Errorf("trade_type %s does not match the trade_data trade_type %s, trade_index: %d", elem.TradeType.String(), td.TradeInfo.TradeType.String(), elem.TradeIndex)
+		}
+
 		if !json.Valid([]byte(elem.BankingSystemData)) {
 			return fmt.Errorf("invalid banking_system_data json format, trade_index: %d", elem.TradeIndex)
 		}
@@ -165,6 +189,18 @@ func (gs GenesisState) ValidateStoredTrade() error {
 		if err != nil {
 			return fmt.Errorf("invalid exchange_rate_json, error: %s, trade_index: %d", err, elem.TradeIndex)
 		}
+
+		if err = ValidateExternalReference(elem.ExternalReference); err != nil {
+			return fmt.Errorf("invalid external_reference, error: %s, trade_index: %d", err, elem.TradeIndex)
+		}
+
+		// Check for an external reference held by several pending or processed trades
+		if elem.HoldsExternalReference() {
+			if tradeIndex, ok := externalReferenceMap[elem.ExternalReference]; ok {
+				return fmt.Errorf("duplicated external_reference %s, trade_index: %d and %d", elem.ExternalReference, tradeIndex, elem.TradeIndex)
+			}
+			externalReferenceMap[elem.ExternalReference] = elem.TradeIndex
+		}
 	}
 	return nil
 }
@@ -191,3 +227,106 @@ func (gs GenesisState) ValidateStoredTempTrade() error {
 	}
 	return nil
 }
+
+func (gs GenesisState) ValidateMintBurnRecords() error {
+	recordKeyMap := make(map[string]struct{})
+
+	for _, elem := range gs.MintBurnRecords {
+		if elem.TradeIndex <= 0 {
+			return fmt.Errorf("trade_index must be more than 0")
+		}
+
+		direction, ok := MintBurnDirection(elem.TradeType)
+		if !ok {
+			return fmt.Errorf("invalid mint burn record trade_type: %s, trade_index: %d", elem.TradeType.String(), elem.TradeIndex)
+		}
+
+		// Check for duplicated key in mintBurnRecords
+		key := string(MintBurnRecordKey(elem.Amount.Denom, direction, elem.ProcessTime, elem.TradeIndex))
+		if _, ok := recordKeyMap[key]; ok {
+			return fmt.Errorf("duplicated mint burn record, trade_index: %d", elem.TradeIndex)
+		}
+		recordKeyMap[key] = struct{}{}
+
+		if !elem.Amount.IsValid() || elem.Amount.IsZero() {
+			return fmt.Errorf("invalid mint burn record amount: %s, trade_index: %d", elem.Amount.String(), elem.TradeIndex)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Maker); err != nil {
+			return fmt.Errorf("invalid mint burn record maker for trade_index %d, address %s, error: %w", elem.TradeIndex, elem.Maker, err)
+		}
+	}
+	return nil
+}
+
+func (gs GenesisState) ValidateTradeHistory() error {
+	entryKeyMap := make(map[string]struct{})
+
+	for _, elem := range gs.TradeHistory {
+		if elem.TradeIndex <= 0 {
+			return fmt.Errorf("trade_index must be more than 0")
+		}
+
+		// Check for duplicated sequence in the history of a trade
+		key := string(TradeHistoryKey(elem.TradeIndex, elem.Sequence))
+		if _, ok := entryKeyMap[key]; ok {
+			return fmt.Errorf("duplicated trade history sequence: %d, trade_index: %d", elem.Sequence, elem.TradeIndex)
+		}
+		entryKeyMap[key] = struct{}{}
+
+		if !elem.Transition.IsTransitionValid() {
+			return fmt.Errorf("invalid trade history transition: %s, trade_index: %d", elem.Transition.String(), elem.TradeIndex)
+		}
+
+		if !elem.Status.IsStatusValid() {
+			return fmt.Errorf("invalid trade history status: %s, trade_index: %d", elem.Status.String(), elem.TradeIndex)
+		}
+
+		if elem.Actor != "" {
+			if _, err := sdk.AccAddressFromBech32(elem.Actor); err != nil {
+				return fmt.Errorf("invalid trade history actor for trade_index %d, address %s, error: %w", elem.TradeIndex, elem.Actor, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (gs GenesisState) ValidateTradeEscrows() error {
+	pendingWithdrawals := make(map[uint64]StoredTrade)
+	for _, elem := range gs.StoredTrades {
+		if elem.TradeType == TradeTypeFiatWithdrawal && elem.Status == StatusPending {
+			pendingWithdrawals[elem.TradeIndex] = elem
+		}
+	}
+
+	escrowIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.TradeEscrows {
+		if elem.TradeIndex <= 0 {
+			return fmt.Errorf("trade_index must be more than 0")
+		}
+
+		// Check for duplicated index in tradeEscrows
+		if _, ok := escrowIndexMap[elem.TradeIndex]; ok {
+			return fmt.Errorf("duplicated index for tradeEscrow, trade_index: %d", elem.TradeIndex)
+		}
+		escrowIndexMap[elem.TradeIndex] = struct{}{}
+
+		if !elem.Amount.IsValid() || elem.Amount.IsZero() {
+			return fmt.Errorf("invalid trade escrow amount: %s, trade_index: %d", elem.Amount.String(), elem.TradeIndex)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(elem.Holder); err != nil {
+			return fmt.Errorf("invalid trade escrow holder for trade_index %d, address %s, error: %w", elem.TradeIndex, elem.Holder, err)
+		}
+
+		storedTrade, ok := pendingWithdrawals[elem.TradeIndex]
+		if !ok {
+			return fmt.Errorf("trade escrow must belong to a pending withdrawal, trade_index: %d", elem.TradeIndex)
+		}
+
+		if elem.Holder != storedTrade.ReceiverAddress || storedTrade.Amount == nil || !elem.Amount.Equal(*storedTrade.Amount) {
+			return fmt.Errorf("trade escrow does not match the receiver_address and amount of the trade, trade_index: %d", elem.TradeIndex)
+		}
+	}
+	return nil
+}
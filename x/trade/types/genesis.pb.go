@@ -27,10 +27,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the trade module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	TradeIndex       TradeIndex        `protobuf:"bytes,2,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index"`
-	StoredTrades     []StoredTrade     `protobuf:"bytes,3,rep,name=stored_trades,json=storedTrades,proto3" json:"stored_trades"`
-	StoredTempTrades []StoredTempTrade `protobuf:"bytes,4,rep,name=stored_temp_trades,json=storedTempTrades,proto3" json:"stored_temp_trades"`
+	Params           Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TradeIndex       TradeIndex          `protobuf:"bytes,2,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index"`
+	StoredTrades     []StoredTrade       `protobuf:"bytes,3,rep,name=stored_trades,json=storedTrades,proto3" json:"stored_trades"`
+	StoredTempTrades []StoredTempTrade   `protobuf:"bytes,4,rep,name=stored_temp_trades,json=storedTempTrades,proto3" json:"stored_temp_trades"`
+	MintBurnRecords  []MintBurnRecord    `protobuf:"bytes,5,rep,name=mint_burn_records,json=mintBurnRecords,proto3" json:"mint_burn_records"`
+	TradeHistory     []TradeHistoryEntry `protobuf:"bytes,6,rep,name=trade_history,json=tradeHistory,proto3" json:"trade_history"`
+	TradeEscrows     []TradeEscrow       `protobuf:"bytes,7,rep,name=trade_escrows,json=tradeEscrows,proto3" json:"trade_escrows"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -94,6 +97,27 @@ func (m *GenesisState) GetStoredTempTrades() []StoredTempTrade {
 	return nil
 }
 
+func (m *GenesisState) GetMintBurnRecords() []MintBurnRecord {
+	if m != nil {
+		return m.MintBurnRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTradeHistory() []TradeHistoryEntry {
+	if m != nil {
+		return m.TradeHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTradeEscrows() []TradeEscrow {
+	if m != nil {
+		return m.TradeEscrows
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "vvtxchain.trade.GenesisState")
 }
@@ -101,28 +125,35 @@ func init() {
 func init() { proto.RegisterFile("vvtxchain/trade/genesis.proto", fileDescriptor_943e7ffb9d28fa0b) }
 
 var fileDescriptor_943e7ffb9d28fa0b = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0x2b, 0x2b, 0xa9,
-	0x48, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x29, 0x4a, 0x4c, 0x49, 0xd5, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0x4b, 0xeb, 0x81, 0xa5,
-	0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x8d, 0x94, 0x48, 0x7a, 0x7e,
-	0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0x65, 0xd0, 0x0d, 0x2e, 0x48, 0x2c, 0x4a, 0xcc,
-	0x85, 0x9a, 0x2b, 0xa5, 0x88, 0x2e, 0x0b, 0x26, 0xe3, 0x33, 0xf3, 0x52, 0x52, 0x2b, 0xa0, 0x4a,
-	0x94, 0xd0, 0x95, 0x14, 0x97, 0xe4, 0x17, 0xa5, 0xa6, 0xc4, 0x83, 0x39, 0x50, 0x35, 0xea, 0xb8,
-	0xd4, 0xa4, 0xe6, 0x16, 0x20, 0x2b, 0x54, 0xda, 0xc3, 0xc4, 0xc5, 0xe3, 0x0e, 0xf1, 0x59, 0x70,
-	0x49, 0x62, 0x49, 0xaa, 0x90, 0x15, 0x17, 0x1b, 0xc4, 0x41, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc,
-	0x46, 0xe2, 0x7a, 0x68, 0x3e, 0xd5, 0x0b, 0x00, 0x4b, 0x3b, 0x71, 0x9e, 0xb8, 0x27, 0xcf, 0xb0,
-	0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xa8, 0x0e, 0x21, 0x77, 0x2e, 0x6e, 0x24, 0xe7, 0x4a, 0x30,
-	0x81, 0x0d, 0x90, 0xc6, 0x30, 0x20, 0x04, 0x44, 0x7a, 0x82, 0x94, 0x20, 0x1b, 0xc2, 0x55, 0x02,
-	0x17, 0x16, 0xf2, 0xe1, 0xe2, 0x45, 0xf6, 0x54, 0xb1, 0x04, 0xb3, 0x02, 0xb3, 0x06, 0xb7, 0x91,
-	0x0c, 0x86, 0x51, 0xc1, 0x60, 0x55, 0x60, 0x03, 0x91, 0xcd, 0xe2, 0x29, 0x46, 0x88, 0x17, 0x0b,
-	0x45, 0x72, 0x09, 0x61, 0x78, 0xbf, 0x58, 0x82, 0x05, 0x6c, 0xa4, 0x02, 0x2e, 0x23, 0x53, 0x73,
-	0x0b, 0x30, 0x8c, 0x15, 0x28, 0x46, 0x95, 0x2b, 0x76, 0x72, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
-	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc6, 0x63, 0x39, 0x86, 0x28, 0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0x7d, 0x77, 0x77, 0xd7, 0x28, 0x9f, 0xc4, 0xa4, 0x62, 0x7d, 0x44, 0xac, 0x54, 0xc0, 0xa2, 0xb7,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x19, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03,
-	0xa9, 0xab, 0x57, 0x75, 0x02, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4d, 0x6b, 0xd4, 0x40,
+	0x18, 0xc7, 0x13, 0x5b, 0x57, 0x9c, 0x6d, 0xa9, 0x1d, 0x04, 0xc3, 0x5a, 0xd3, 0x75, 0x05, 0x2d,
+	0x0a, 0x09, 0xd4, 0x9b, 0xc7, 0x85, 0x10, 0x85, 0x0a, 0xb2, 0x15, 0xc1, 0x5e, 0x42, 0x5e, 0x86,
+	0xec, 0x1c, 0x32, 0x13, 0xe6, 0x99, 0xad, 0xbb, 0xdf, 0xc2, 0x8f, 0xe1, 0xd1, 0x93, 0x9f, 0xa1,
+	0xc7, 0x1e, 0x3d, 0x89, 0xec, 0x1e, 0xfc, 0x1a, 0x92, 0x27, 0x69, 0x9b, 0x97, 0xcd, 0x65, 0xc8,
+	0x3e, 0xff, 0xdf, 0xfe, 0x18, 0xfe, 0xf3, 0x90, 0x67, 0x97, 0x97, 0x7a, 0x19, 0xcf, 0x43, 0x2e,
+	0x5c, 0xad, 0xc2, 0x84, 0xb9, 0x29, 0x13, 0x0c, 0x38, 0x38, 0xb9, 0x92, 0x5a, 0xd2, 0x83, 0xdb,
+	0xd8, 0xc1, 0x78, 0x74, 0x18, 0x66, 0x5c, 0x48, 0x17, 0xcf, 0x92, 0x19, 0x3d, 0x4e, 0x65, 0x2a,
+	0xf1, 0xd3, 0x2d, 0xbe, 0xaa, 0xe9, 0x51, 0x5b, 0x9c, 0x87, 0x2a, 0xcc, 0x2a, 0xef, 0xe8, 0x79,
+	0x3b, 0xc5, 0x33, 0xe0, 0x22, 0x61, 0xcb, 0x0a, 0x99, 0xb4, 0x11, 0xd0, 0x52, 0xb1, 0x24, 0xc0,
+	0x1f, 0x15, 0xf3, 0xaa, 0x8f, 0x61, 0x59, 0xde, 0x00, 0x5f, 0xb6, 0xc1, 0x8c, 0x0b, 0x1d, 0x44,
+	0x0b, 0x25, 0x02, 0xc5, 0x62, 0xa9, 0x92, 0x8a, 0x7b, 0xb1, 0xfd, 0x5e, 0x73, 0x5e, 0x88, 0x57,
+	0x7d, 0x37, 0x2b, 0x21, 0x06, 0xb1, 0x92, 0xdf, 0x4a, 0x66, 0xf2, 0x6b, 0x97, 0xec, 0xf9, 0x65,
+	0x95, 0xe7, 0x3a, 0xd4, 0x8c, 0xbe, 0x23, 0x83, 0xb2, 0x01, 0xcb, 0x1c, 0x9b, 0x27, 0xc3, 0xd3,
+	0x27, 0x4e, 0xab, 0x5a, 0xe7, 0x13, 0xc6, 0xd3, 0x87, 0x57, 0x7f, 0x8e, 0x8d, 0x1f, 0xff, 0x7e,
+	0xbe, 0x36, 0x67, 0xd5, 0x3f, 0xa8, 0x4f, 0x86, 0xb5, 0x7e, 0xac, 0x7b, 0x28, 0x78, 0xda, 0x11,
+	0x7c, 0x2e, 0xce, 0x0f, 0x05, 0x52, 0x97, 0x10, 0x7d, 0x3b, 0xa6, 0x67, 0x64, 0xbf, 0xde, 0x22,
+	0x58, 0x3b, 0xe3, 0x9d, 0x93, 0xe1, 0xe9, 0x51, 0x47, 0x75, 0x8e, 0x14, 0x0a, 0xeb, 0xae, 0x3d,
+	0xb8, 0x9b, 0x03, 0xfd, 0x4a, 0x68, 0xa7, 0x6f, 0xb0, 0x76, 0x51, 0x39, 0xee, 0x53, 0xb2, 0x2c,
+	0xef, 0x68, 0x1f, 0x41, 0x33, 0x03, 0xfa, 0x85, 0x1c, 0xb6, 0x5f, 0x08, 0xac, 0xfb, 0x68, 0x3e,
+	0xee, 0x98, 0x3f, 0x72, 0xa1, 0xa7, 0x0b, 0x25, 0x66, 0xc8, 0xd5, 0xc5, 0x07, 0x59, 0x23, 0x02,
+	0x3a, 0x23, 0xfb, 0x8d, 0x17, 0xb5, 0x06, 0xe8, 0x9c, 0x6c, 0xef, 0xf2, 0x7d, 0x09, 0x79, 0x42,
+	0xab, 0x55, 0xa3, 0x06, 0x5d, 0x4b, 0x8b, 0x52, 0xeb, 0x0b, 0x00, 0xd6, 0x83, 0x9e, 0x52, 0xd1,
+	0xe9, 0x21, 0xd4, 0xb5, 0x95, 0x73, 0x98, 0x7a, 0x57, 0x6b, 0xdb, 0xbc, 0x5e, 0xdb, 0xe6, 0xdf,
+	0xb5, 0x6d, 0x7e, 0xdf, 0xd8, 0xc6, 0xf5, 0xc6, 0x36, 0x7e, 0x6f, 0x6c, 0xe3, 0xe2, 0x4d, 0xca,
+	0xf5, 0x7c, 0x11, 0x39, 0xb1, 0xcc, 0x5c, 0xdf, 0xf7, 0x2e, 0xce, 0xc2, 0x08, 0xdc, 0xbb, 0x55,
+	0x5c, 0xde, 0x2c, 0xe3, 0x2a, 0x67, 0x10, 0x0d, 0x70, 0x0d, 0xdf, 0xfe, 0x1f, 0x00, 0x48, 0xc6,
+	0xa5, 0x49, 0xe0, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -145,6 +176,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TradeEscrows) > 0 {
+		for iNdEx := len(m.TradeEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradeEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TradeHistory) > 0 {
+		for iNdEx := len(m.TradeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MintBurnRecords) > 0 {
+		for iNdEx := len(m.MintBurnRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintBurnRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.StoredTempTrades) > 0 {
 		for iNdEx := len(m.StoredTempTrades) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -229,6 +302,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.MintBurnRecords) > 0 {
+		for _, e := range m.MintBurnRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TradeHistory) > 0 {
+		for _, e := range m.TradeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TradeEscrows) > 0 {
+		for _, e := range m.TradeEscrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -401,6 +492,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintBurnRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintBurnRecords = append(m.MintBurnRecords, MintBurnRecord{})
+			if err := m.MintBurnRecords[len(m.MintBurnRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeHistory = append(m.TradeHistory, TradeHistoryEntry{})
+			if err := m.TradeHistory[len(m.TradeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeEscrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeEscrows = append(m.TradeEscrows, TradeEscrow{})
+			if err := m.TradeEscrows[len(m.TradeEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/GGEZLabs/vvtxchain/testutil/sample"
@@ -28,6 +30,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -60,9 +63,97 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expErr: false,
 		},
+		{
+			desc: "valid split storedTrade",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TradeIndex: types.TradeIndex{
+					NextId: 2,
+				},
+				StoredTrades: []types.StoredTrade{
+					{
+						TradeIndex:           1,
+						TradeType:            types.TradeTypeSplit,
+						CoinMintingPrice:     "0.01",
+						Status:               types.StatusProcessed,
+						Maker:                sample.AccAddress(),
+						Checker:              sample.AccAddress(),
+						CreateDate:           "2023-05-11T08:44:00Z",
+						TxDate:               "2023-05-11T08:44:00Z",
+						UpdateDate:           "2023-05-11T08:44:00Z",
+						ProcessDate:          "2023-05-11T08:44:00Z",
+						TradeData:            types.GetSampleTradeDataJson(types.TradeTypeSplit),
+						BankingSystemData:    "{}",
+						CoinMintingPriceJson: cmpj,
+						ExchangeRateJson:     erj,
+					},
+				},
+			},
+			expErr: false,
+		},
+		{
+			desc: "split storedTrade with amount",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TradeIndex: types.TradeIndex{
+					NextId: 2,
+				},
+				StoredTrades: []types.StoredTrade{
+					{
+						TradeIndex:           1,
+						TradeType:            types.TradeTypeSplit,
+						Amount:               &sdk.Coin{Denom: types.DefaultDenom, Amount: math.NewInt(100000)},
+						CoinMintingPrice:     "0.01",
+						Status:               types.StatusProcessed,
+						Maker:                sample.AccAddress(),
+						Checker:              sample.AccAddress(),
+						CreateDate:           "2023-05-11T08:44:00Z",
+						TxDate:               "2023-05-11T08:44:00Z",
+						UpdateDate:           "2023-05-11T08:44:00Z",
+						ProcessDate:          "2023-05-11T08:44:00Z",
+						TradeData:            types.GetSampleTradeDataJson(types.TradeTypeSplit),
+						BankingSystemData:    "{}",
+						CoinMintingPriceJson: cmpj,
+						ExchangeRateJson:     erj,
+					},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "amount must not be set for trade type: TRADE_TYPE_SPLIT, trade_index: 1",
+		},
+		{
+			desc: "split storedTrade with deposit trade data",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				TradeIndex: types.TradeIndex{
+					NextId: 2,
+				},
+				StoredTrades: []types.StoredTrade{
+					{
+						TradeIndex:           1,
+						TradeType:            types.TradeTypeSplit,
+						CoinMintingPrice:     "0.01",
+						Status:               types.StatusProcessed,
+						Maker:                sample.AccAddress(),
+						Checker:              sample.AccAddress(),
+						CreateDate:           "2023-05-11T08:44:00Z",
+						TxDate:               "2023-05-11T08:44:00Z",
+						UpdateDate:           "2023-05-11T08:44:00Z",
+						ProcessDate:          "2023-05-11T08:44:00Z",
+						TradeData:            td,
+						BankingSystemData:    "{}",
+						CoinMintingPriceJson: cmpj,
+						ExchangeRateJson:     erj,
+					},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "trade_type TRADE_TYPE_SPLIT does not match the trade_data trade_type TRADE_TYPE_FIAT_DEPOSIT, trade_index: 1",
+		},
 		{
 			desc: "duplicated storedTrade",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 1,
 				},
@@ -111,6 +202,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "duplicated storedTempTrade",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 1,
 				},
@@ -131,6 +223,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid trade_index",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -146,6 +239,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid trade_type",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -162,6 +256,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid amount",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -179,6 +274,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid denom",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -186,7 +282,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					{
 						TradeIndex: 1,
 						TradeType:  types.TradeTypeFiatDeposit,
-						Amount:     &sdk.Coin{Denom: "invalid_denom", Amount: math.NewInt(10)},
+						Amount:     &sdk.Coin{Denom: "1nvalid", Amount: math.NewInt(10)},
 					},
 				},
 			},
@@ -196,6 +292,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid receiver_address",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -216,6 +313,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid price",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -235,6 +333,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid status",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -255,6 +354,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid maker address",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -276,6 +376,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid checker address",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -298,6 +399,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "set checker address with status pending",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -320,6 +422,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid create_date",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -343,6 +446,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid tx_date",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -367,6 +471,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid update_date",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -392,6 +497,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid process_date",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -418,6 +524,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid trade_data",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -445,6 +552,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid banking_system_data",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -473,6 +581,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid coin_minting_price_json",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -502,6 +611,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid exchange_rate_json",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -532,6 +642,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid trade_index (stored temp trade)",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -547,6 +658,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "duplicate trade_index (stored temp trade)",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -567,6 +679,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid create_date (stored temp trade)",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 2,
 				},
@@ -583,6 +696,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "invalid next_id",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				TradeIndex: types.TradeIndex{
 					NextId: 0,
 				},
@@ -590,6 +704,260 @@ func TestGenesisState_Validate(t *testing.T) {
 			expErr:    true,
 			expErrMsg: "next_id must be more than 0",
 		},
+		{
+			desc: "invalid pending trade ttl",
+			genState: &types.GenesisState{
+				TradeIndex: types.TradeIndex{
+					NextId: 1,
+				},
+				Params: types.Params{},
+			},
+			expErr:    true,
+			expErrMsg: "pending trade ttl must be positive",
+		},
+		{
+			desc: "duplicated mintable denom",
+			genState: &types.GenesisState{
+				TradeIndex: types.TradeIndex{
+					NextId: 1,
+				},
+				Params: types.NewParams(types.DefaultPendingTradeTTL, []types.MintableDenom{
+					{Denom: types.DefaultDenom, BaseCurrency: "GBP", SettlementCurrency: "GBP", Enabled: true},
+					{Denom: types.DefaultDenom, BaseCurrency: "EUR", SettlementCurrency: "EUR", Enabled: true},
+				}, types.DefaultApprovalTiers, types.DefaultMintBurnLimits, types.DefaultTradeDataTolerances, types.DefaultSupplyBaselines),
+			},
+			expErr:    true,
+			expErrMsg: "duplicated mintable denom: ugbpv",
+		},
+		{
+			desc: "approval tier of unregistered denom",
+			genState: &types.GenesisState{
+				TradeIndex: types.TradeIndex{
+					NextId: 1,
+				},
+				Params: types.NewParams(types.DefaultPendingTradeTTL, types.DefaultMintableDenoms, []types.ApprovalTier{
+					{Denom: "ueurv", MinAmount: math.NewInt(1000), RequiredApprovals: 2},
+				}, types.DefaultMintBurnLimits, types.DefaultTradeDataTolerances, types.DefaultSupplyBaselines),
+			},
+			expErr:    true,
+			expErrMsg: "approval tier denom ueurv is not a registered mintable denom",
+		},
+		{
+			desc: "approval tier without required approvals",
+			genState: &types.GenesisState{
+				TradeIndex: types.TradeIndex{
+					NextId: 1,
+				},
+				Params: types.NewParams(types.DefaultPendingTradeTTL, types.DefaultMintableDenoms, []types.ApprovalTier{
+					{Denom: types.DefaultDenom, MinAmount: math.NewInt(1000)},
+				}, types.DefaultMintBurnLimits, types.DefaultTradeDataTolerances, types.DefaultSupplyBaselines),
+			},
+			expErr:    true,
+			expErrMsg: "approval tier required_approvals must be at least 1 for denom: ugbpv",
+		},
+		{
+			desc: "mint burn limit without window",
+			genState: &types.GenesisState{
+				TradeIndex: types.TradeIndex{
+					NextId: 1,
+				},
+				Params: types.NewParams(types.DefaultPendingTradeTTL, types.DefaultMintableDenoms, types.DefaultApprovalTiers, []types.MintBurnLimit{
+					{
+						Denom:           types.DefaultDenom,
+						MakerMintLimit:  math.NewInt(1000),
+						MakerBurnLimit:  math.ZeroInt(),
+						GlobalMintLimit: math.ZeroInt(),
+						GlobalBurnLimit: math.ZeroInt(),
+					},
+				}, types.DefaultTradeDataTolerances, types.DefaultSupplyBaselines),
+			},
+			expErr:    true,
+			expErrMsg: "mint burn limit window must be positive for denom: ugbpv",
+		},
+		{
+			desc: "mint burn limit of unregistered denom",
+			genState: &types.GenesisState{
+				TradeIndex: types.TradeIndex{
+					NextId: 1,
+				},
+				Params: types.NewParams(types.DefaultPendingTradeTTL, types.DefaultMintableDenoms, types.DefaultApprovalTiers, []types.MintBurnLimit{
+					types.NewMintBurnLimit("ueurv", time.Hour, math.NewInt(1000), math.ZeroInt(), math.ZeroInt(), math.ZeroInt()),
+				}, types.DefaultTradeDataTolerances, types.DefaultSupplyBaselines),
+			},
+			expErr:    true,
+			expErrMsg: "mint burn limit denom ueurv is not a registered mintable denom",
+		},
+		{
+			desc: "valid mint burn record",
+			genState: &types.GenesisState{
+				TradeIndex: types.TradeIndex{
+					NextId: 2,
+				},
+				Params: types.DefaultParams(),
+				MintBurnRecords: []types.MintBurnRecord{
+					{
+						TradeIndex:  1,
+						TradeType:   types.TradeTypeFiatDeposit,
+						Maker:       sample.AccAddress(),
+						Amount:      sdk.NewInt64Coin(types.DefaultDenom, 1000),
+						ProcessTime: time.Unix(1700000000, 0).UTC(),
+					},
+				},
+			},
+			expErr: false,
+		},
+		{
+			desc: "duplicated mint burn record",
+			genState: &types.GenesisState{
+				TradeIndex: types.TradeIndex{
+					NextId: 2,
+				},
+				Params: types.DefaultParams(),
+				MintBurnRecords: []types.MintBurnRecord{
+					{
+						TradeIndex:  1,
+						TradeType:   types.TradeTypeFiatDeposit,
+						Maker:       sample.AccAddress(),
+						Amount:      sdk.NewInt64Coin(types.DefaultDenom, 1000),
+						ProcessTime: time.Unix(1700000000, 0).UTC(),
+					},
+					{
+						TradeIndex:  1,
+						TradeType:   types.TradeTypeFiatDeposit,
+						Maker:       sample.AccAddress(),
+						Amount:      sdk.NewInt64Coin(types.DefaultDenom, 1000),
+						ProcessTime: time.Unix(1700000000, 0).UTC(),
+					},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "duplicated mint burn record, trade_index: 1",
+		},
+		{
+			desc: "mint burn record of non mint trade type",
+			genState: &types.GenesisState{
+				TradeIndex: types.TradeIndex{
+					NextId: 2,
+				},
+				Params: types.DefaultParams(),
+				MintBurnRecords: []types.MintBurnRecord{
+					{
+						TradeIndex:  1,
+						TradeType:   types.TradeTypeNil,
+						Maker:       sample.AccAddress(),
+						Amount:      sdk.NewInt64Coin(types.DefaultDenom, 1000),
+						ProcessTime: time.Unix(1700000000, 0).UTC(),
+					},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "invalid mint burn record trade_type",
+		},
+		{
+			desc: "valid trade history",
+			genState: &types.GenesisState{
+				TradeIndex: types.TradeIndex{
+					NextId: 2,
+				},
+				Params: types.DefaultParams(),
+				TradeHistory: []types.TradeHistoryEntry{
+					{
+						TradeIndex:  1,
+						Sequence:    1,
+						Transition:  types.TransitionCreated,
+						Status:      types.StatusPending,
+						Actor:       sample.AccAddress(),
+						BlockHeight: 10,
+						Time:        time.Unix(1700000000, 0).UTC(),
+					},
+					{
+						TradeIndex:  1,
+						Sequence:    2,
+						Transition:  types.TransitionExpired,
+						Status:      types.StatusCanceled,
+						Actor:       "",
+						BlockHeight: 10,
+						Time:        time.Unix(1700000000, 0).UTC(),
+					},
+				},
+			},
+			expErr: false,
+		},
+		{
+			desc: "duplicated trade history sequence",
+			genState: &types.GenesisState{
+				TradeIndex: types.TradeIndex{
+					NextId: 2,
+				},
+				Params: types.DefaultParams(),
+				TradeHistory: []types.TradeHistoryEntry{
+					{
+						TradeIndex:  1,
+						Sequence:    1,
+						Transition:  types.TransitionCreated,
+						Status:      types.StatusPending,
+						Actor:       sample.AccAddress(),
+						BlockHeight: 10,
+						Time:        time.Unix(1700000000, 0).UTC(),
+					},
+					{
+						TradeIndex:  1,
+						Sequence:    1,
+						Transition:  types.TransitionAmended,
+						Status:      types.StatusPending,
+						Actor:       sample.AccAddress(),
+						BlockHeight: 10,
+						Time:        time.Unix(1700000000, 0).UTC(),
+					},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "duplicated trade history sequence: 1, trade_index: 1",
+		},
+		{
+			desc: "invalid trade history transition",
+			genState: &types.GenesisState{
+				TradeIndex: types.TradeIndex{
+					NextId: 2,
+				},
+				Params: types.DefaultParams(),
+				TradeHistory: []types.TradeHistoryEntry{
+					{
+						TradeIndex:  1,
+						Sequence:    1,
+						Transition:  types.TransitionNil,
+						Status:      types.StatusPending,
+						Actor:       sample.AccAddress(),
+						BlockHeight: 10,
+						Time:        time.Unix(1700000000, 0).UTC(),
+					},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "invalid trade history transition",
+		},
+		{
+			desc: "invalid trade history actor",
+			genState: &types.GenesisState{
+				TradeIndex: types.TradeIndex{
+					NextId: 2,
+				},
+				Params: types.DefaultParams(),
+				TradeHistory: []types.TradeHistoryEntry{
+					{
+						TradeIndex:  1,
+						Sequence:    1,
+						Transition:  types.TransitionCreated,
+						Status:      types.StatusPending,
+						Actor:       "invalid_address",
+						BlockHeight: 10,
+						Time:        time.Unix(1700000000, 0).UTC(),
+					},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "invalid trade history actor",
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -616,6 +984,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "valid storedTrade list",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:           1,
@@ -642,6 +1011,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "duplicated storedTrade",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:           1,
@@ -686,6 +1056,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid trade_index",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex: 0,
@@ -698,6 +1069,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid trade_type",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex: 1,
@@ -711,6 +1083,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid amount",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex: 1,
@@ -725,11 +1098,12 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid denom",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex: 1,
 						TradeType:  types.TradeTypeFiatDeposit,
-						Amount:     &sdk.Coin{Denom: "invalid_denom", Amount: math.NewInt(10)},
+						Amount:     &sdk.Coin{Denom: "1nvalid", Amount: math.NewInt(10)},
 					},
 				},
 			},
@@ -739,6 +1113,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid receiver_address",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:       1,
@@ -756,6 +1131,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid price",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:       1,
@@ -772,6 +1148,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid status",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:       1,
@@ -789,6 +1166,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid maker address",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:       1,
@@ -807,6 +1185,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid checker address",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:       1,
@@ -826,6 +1205,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "set checker address with status pending",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:       1,
@@ -845,6 +1225,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid create_date",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:       1,
@@ -865,6 +1246,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid tx_date",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:       1,
@@ -886,6 +1268,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid update_date",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:       1,
@@ -908,6 +1291,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid process_date",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:       1,
@@ -931,6 +1315,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid trade_data",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:       1,
@@ -955,6 +1340,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid banking_system_data",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:        1,
@@ -980,6 +1366,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid coin_minting_price_json",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:           1,
@@ -1006,6 +1393,7 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 		{
 			desc: "invalid exchange_rate_json",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 				StoredTrades: []types.StoredTrade{
 					{
 						TradeIndex:           1,
@@ -1030,6 +1418,130 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 			expErr:    true,
 			expErrMsg: "invalid exchange_rate_json",
 		},
+		{
+			desc: "invalid external_reference",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				StoredTrades: []types.StoredTrade{
+					{
+						TradeIndex:           1,
+						TradeType:            types.TradeTypeFiatDeposit,
+						Amount:               &sdk.Coin{Denom: types.DefaultDenom, Amount: math.NewInt(100000)},
+						CoinMintingPrice:     "0.01",
+						ReceiverAddress:      sample.AccAddress(),
+						Status:               types.StatusProcessed,
+						Maker:                sample.AccAddress(),
+						Checker:              sample.AccAddress(),
+						CreateDate:           "2023-05-11T08:44:00Z",
+						TxDate:               "2023-05-11T08:44:00Z",
+						UpdateDate:           "2023-05-11T08:44:00Z",
+						ProcessDate:          "2023-05-11T08:44:00Z",
+						TradeData:            td,
+						BankingSystemData:    "{}",
+						CoinMintingPriceJson: types.GetSampleCoinMintingPriceJson(),
+						ExchangeRateJson:     types.GetSampleExchangeRateJson(),
+						ExternalReference:    " WIRE-1",
+					},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "invalid external_reference",
+		},
+		{
+			desc: "duplicated external_reference",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				StoredTrades: []types.StoredTrade{
+					{
+						TradeIndex:           1,
+						TradeType:            types.TradeTypeFiatDeposit,
+						Amount:               &sdk.Coin{Denom: types.DefaultDenom, Amount: math.NewInt(100000)},
+						CoinMintingPrice:     "0.01",
+						ReceiverAddress:      sample.AccAddress(),
+						Status:               types.StatusProcessed,
+						Maker:                sample.AccAddress(),
+						Checker:              sample.AccAddress(),
+						CreateDate:           "2023-05-11T08:44:00Z",
+						TxDate:               "2023-05-11T08:44:00Z",
+						UpdateDate:           "2023-05-11T08:44:00Z",
+						ProcessDate:          "2023-05-11T08:44:00Z",
+						TradeData:            td,
+						BankingSystemData:    "{}",
+						CoinMintingPriceJson: types.GetSampleCoinMintingPriceJson(),
+						ExchangeRateJson:     types.GetSampleExchangeRateJson(),
+						ExternalReference:    "WIRE-1",
+					},
+					{
+						TradeIndex:           2,
+						TradeType:            types.TradeTypeFiatDeposit,
+						Amount:               &sdk.Coin{Denom: types.DefaultDenom, Amount: math.NewInt(100000)},
+						CoinMintingPrice:     "0.01",
+						ReceiverAddress:      sample.AccAddress(),
+						Status:               types.StatusProcessed,
+						Maker:                sample.AccAddress(),
+						Checker:              sample.AccAddress(),
+						CreateDate:           "2023-05-11T08:44:00Z",
+						TxDate:               "2023-05-11T08:44:00Z",
+						UpdateDate:           "2023-05-11T08:44:00Z",
+						ProcessDate:          "2023-05-11T08:44:00Z",
+						TradeData:            td,
+						BankingSystemData:    "{}",
+						CoinMintingPriceJson: types.GetSampleCoinMintingPriceJson(),
+						ExchangeRateJson:     types.GetSampleExchangeRateJson(),
+						ExternalReference:    "WIRE-1",
+					},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "duplicated external_reference WIRE-1, trade_index: 1 and 2",
+		},
+		{
+			desc: "external_reference of a rejected storedTrade reused",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				StoredTrades: []types.StoredTrade{
+					{
+						TradeIndex:           1,
+						TradeType:            types.TradeTypeFiatDeposit,
+						Amount:               &sdk.Coin{Denom: types.DefaultDenom, Amount: math.NewInt(100000)},
+						CoinMintingPrice:     "0.01",
+						ReceiverAddress:      sample.AccAddress(),
+						Status:               types.StatusRejected,
+						Maker:                sample.AccAddress(),
+						Checker:              sample.AccAddress(),
+						CreateDate:           "2023-05-11T08:44:00Z",
+						TxDate:               "2023-05-11T08:44:00Z",
+						UpdateDate:           "2023-05-11T08:44:00Z",
+						ProcessDate:          "2023-05-11T08:44:00Z",
+						TradeData:            td,
+						BankingSystemData:    "{}",
+						CoinMintingPriceJson: types.GetSampleCoinMintingPriceJson(),
+						ExchangeRateJson:     types.GetSampleExchangeRateJson(),
+						ExternalReference:    "WIRE-1",
+					},
+					{
+						TradeIndex:           2,
+						TradeType:            types.TradeTypeFiatDeposit,
+						Amount:               &sdk.Coin{Denom: types.DefaultDenom, Amount: math.NewInt(100000)},
+						CoinMintingPrice:     "0.01",
+						ReceiverAddress:      sample.AccAddress(),
+						Status:               types.StatusProcessed,
+						Maker:                sample.AccAddress(),
+						Checker:              sample.AccAddress(),
+						CreateDate:           "2023-05-11T08:44:00Z",
+						TxDate:               "2023-05-11T08:44:00Z",
+						UpdateDate:           "2023-05-11T08:44:00Z",
+						ProcessDate:          "2023-05-11T08:44:00Z",
+						TradeData:            td,
+						BankingSystemData:    "{}",
+						CoinMintingPriceJson: types.GetSampleCoinMintingPriceJson(),
+						ExchangeRateJson:     types.GetSampleExchangeRateJson(),
+						ExternalReference:    "WIRE-1",
+					},
+				},
+			},
+			expErr: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -1044,6 +1556,37 @@ func TestGenesisState_ValidateStoredTrade(t *testing.T) {
 	}
 }
 
+func TestGenesisState_ValidateStoredTradeWithChangedParams(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("vvtx", "vvtx")
+
+	// the trade value of a processed trade no longer matches its shares within the tolerances
+	var td types.TradeData
+	require.NoError(t, json.Unmarshal([]byte(types.GetSampleTradeDataJson(types.TradeTypeFiatDeposit)), &td))
+	td.TradeInfo.TradeValue = td.TradeInfo.TradeValue.MulInt64(2)
+	tdBytes, err := json.Marshal(td)
+	require.NoError(t, err)
+
+	storedTrade := types.GetSampleStoredTradeConfirmed(1)
+	storedTrade.TradeData = string(tdBytes)
+
+	params := types.DefaultParams()
+	_, err = types.ValidateTradeData(storedTrade.TradeData, params)
+	require.ErrorIs(t, err, types.ErrInconsistentTradeData)
+
+	genState := types.GenesisState{
+		Params:       params,
+		TradeIndex:   types.TradeIndex{NextId: 2},
+		StoredTrades: []types.StoredTrade{storedTrade},
+	}
+	require.NoError(t, genState.Validate())
+
+	// the denom of the trade is no longer registered
+	genState.Params.MintableDenoms = []types.MintableDenom{
+		{Denom: "ueurv", BaseCurrency: "EUR", SettlementCurrency: "EUR", Enabled: true},
+	}
+	require.NoError(t, genState.Validate())
+}
+
 func TestGenesisState_ValidateStoredTempTrade(t *testing.T) {
 	tests := []struct {
 		desc      string
@@ -1123,13 +1666,99 @@ func TestGenesisState_ValidateStoredTempTrade(t *testing.T) {
 	}
 }
 
+func TestGenesisState_ValidateTradeEscrows(t *testing.T) {
+	holder := sample.AccAddress()
+	amount := sdk.NewInt64Coin(types.DefaultDenom, 1000)
+	pendingWithdrawal := types.StoredTrade{
+		TradeIndex:      1,
+		TradeType:       types.TradeTypeFiatWithdrawal,
+		Status:          types.StatusPending,
+		ReceiverAddress: holder,
+		Amount:          &amount,
+	}
+	processedWithdrawal := pendingWithdrawal
+	processedWithdrawal.TradeIndex = 2
+	processedWithdrawal.Status = types.StatusProcessed
+
+	tests := []struct {
+		desc      string
+		escrows   []types.TradeEscrow
+		expErrMsg string
+	}{
+		{
+			desc:    "valid tradeEscrow list",
+			escrows: []types.TradeEscrow{{TradeIndex: 1, Holder: holder, Amount: amount}},
+		},
+		{
+			desc:      "invalid trade_index",
+			escrows:   []types.TradeEscrow{{TradeIndex: 0, Holder: holder, Amount: amount}},
+			expErrMsg: "trade_index must be more than 0",
+		},
+		{
+			desc: "duplicate trade_index",
+			escrows: []types.TradeEscrow{
+				{TradeIndex: 1, Holder: holder, Amount: amount},
+				{TradeIndex: 1, Holder: holder, Amount: amount},
+			},
+			expErrMsg: "duplicated index for tradeEscrow",
+		},
+		{
+			desc:      "zero amount",
+			escrows:   []types.TradeEscrow{{TradeIndex: 1, Holder: holder, Amount: sdk.NewInt64Coin(types.DefaultDenom, 0)}},
+			expErrMsg: "invalid trade escrow amount",
+		},
+		{
+			desc:      "invalid holder",
+			escrows:   []types.TradeEscrow{{TradeIndex: 1, Holder: "invalid", Amount: amount}},
+			expErrMsg: "invalid trade escrow holder",
+		},
+		{
+			desc:      "trade is not pending",
+			escrows:   []types.TradeEscrow{{TradeIndex: 2, Holder: holder, Amount: amount}},
+			expErrMsg: "trade escrow must belong to a pending withdrawal",
+		},
+		{
+			desc:      "trade does not exist",
+			escrows:   []types.TradeEscrow{{TradeIndex: 3, Holder: holder, Amount: amount}},
+			expErrMsg: "trade escrow must belong to a pending withdrawal",
+		},
+		{
+			desc:      "amount does not match the trade",
+			escrows:   []types.TradeEscrow{{TradeIndex: 1, Holder: holder, Amount: sdk.NewInt64Coin(types.DefaultDenom, 10)}},
+			expErrMsg: "trade escrow does not match",
+		},
+		{
+			desc:      "holder does not match the trade",
+			escrows:   []types.TradeEscrow{{TradeIndex: 1, Holder: sample.AccAddress(), Amount: amount}},
+			expErrMsg: "trade escrow does not match",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := types.GenesisState{
+				StoredTrades: []types.StoredTrade{pendingWithdrawal, processedWithdrawal},
+				TradeEscrows: tc.escrows,
+			}
+			err := genState.ValidateTradeEscrows()
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestDefaultGenesisState_ExpectedInitialNextId(t *testing.T) {
 	require.Equal(t,
 		&types.GenesisState{
 			StoredTrades:     []types.StoredTrade{},
 			TradeIndex:       types.TradeIndex{NextId: 1},
-			Params:           types.Params{},
+			Params:           types.DefaultParams(),
 			StoredTempTrades: []types.StoredTempTrade{},
+			MintBurnRecords:  []types.MintBurnRecord{},
+			TradeHistory:     []types.TradeHistoryEntry{},
+			TradeEscrows:     []types.TradeEscrow{},
 		},
 		types.DefaultGenesis())
 }
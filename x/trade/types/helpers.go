@@ -1,11 +1,10 @@
 package types
 
 import (
-	"encoding/json"
-	"fmt"
 	"strings"
 	"time"
 
+	"cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -26,33 +25,52 @@ func ValidateDate(blockTime time.Time, dateStr string) error {
 	return nil
 }
 
-// FormatPrice convert a float to a decimal string
-func FormatPrice(price float64) string {
-	str := fmt.Sprintf("%.12f", price)
+// FormatPrice convert a decimal to a decimal string without trailing zeros
+func FormatPrice(price math.LegacyDec) string {
+	str := price.String()
 	return strings.TrimRight(strings.TrimRight(str, "0"), ".")
 }
 
 // ValidateExchangeRateJson
 func ValidateExchangeRateJson(exchangeRateJson string) error {
+	exchangeRates, err := ParseExchangeRateJson(exchangeRateJson)
+	if err != nil {
+		return err
+	}
+	return ValidateExchangeRates(exchangeRates)
+}
+
+// ParseExchangeRateJson unmarshals the exchange rate JSON, missing decimals are set to zero
+func ParseExchangeRateJson(exchangeRateJson string) ([]ExchangeRateJson, error) {
 	var exchangeRates []ExchangeRateJson
-	if err := json.Unmarshal([]byte(exchangeRateJson), &exchangeRates); err != nil {
-		return ErrInvalidExchangeRateJson.Wrap(err.Error())
+	if err := unmarshalDecimalJSON(exchangeRateJson, &exchangeRates); err != nil {
+		return nil, ErrInvalidExchangeRateJson.Wrap(err.Error())
 	}
+	for i := range exchangeRates {
+		rate := &exchangeRates[i]
+		zeroNilDecimals(&rate.OriginalAmount, &rate.ConvertedAmount, &rate.CurrencyRate)
+	}
+	return exchangeRates, nil
+}
+
+// ValidateExchangeRates validates the exchange rates of a trade
+func ValidateExchangeRates(exchangeRates []ExchangeRateJson) error {
 	for i, rate := range exchangeRates {
+		zeroNilDecimals(&rate.OriginalAmount, &rate.ConvertedAmount, &rate.CurrencyRate)
 		if strings.TrimSpace(rate.FromCurrency) == "" {
 			return ErrInvalidExchangeRateJson.Wrapf("from_currency must not be empty or whitespace at index: %d", i)
 		}
 		if strings.TrimSpace(rate.ToCurrency) == "" {
 			return ErrInvalidExchangeRateJson.Wrapf("to_currency must not be empty or whitespace at index: %d", i)
 		}
-		if rate.OriginalAmount < 0 {
-			return ErrInvalidExchangeRateJson.Wrapf("original_amount must be a non-negative number, got: %f, at index: %d", rate.OriginalAmount, i)
+		if rate.OriginalAmount.IsNegative() {
+			return ErrInvalidExchangeRateJson.Wrapf("original_amount must be a non-negative number, got: %s, at index: %d", rate.OriginalAmount, i)
 		}
-		if rate.ConvertedAmount < 0 {
-			return ErrInvalidExchangeRateJson.Wrapf("converted_amount must be a non-negative number, got: %f, at index: %d", rate.ConvertedAmount, i)
+		if rate.ConvertedAmount.IsNegative() {
+			return ErrInvalidExchangeRateJson.Wrapf("converted_amount must be a non-negative number, got: %s, at index: %d", rate.ConvertedAmount, i)
 		}
-		if rate.CurrencyRate <= 0 {
-			return ErrInvalidExchangeRateJson.Wrapf("currency_rate must be greater than 0, got: %f, at index: %d", rate.CurrencyRate, i)
+		if !rate.CurrencyRate.IsPositive() {
+			return ErrInvalidExchangeRateJson.Wrapf("currency_rate must be greater than 0, got: %s, at index: %d", rate.CurrencyRate, i)
 		}
 		_, err := time.Parse(time.RFC3339, rate.Timestamp)
 		if err != nil {
@@ -64,16 +82,34 @@ func ValidateExchangeRateJson(exchangeRateJson string) error {
 
 // ValidateCoinMintingPriceJson
 func ValidateCoinMintingPriceJson(coinMintingPriceJson string) error {
+	coinMintingPrices, err := ParseCoinMintingPriceJson(coinMintingPriceJson)
+	if err != nil {
+		return err
+	}
+	return ValidateCoinMintingPrices(coinMintingPrices)
+}
+
+// ParseCoinMintingPriceJson unmarshals the coin minting price JSON, missing decimals are set to zero
+func ParseCoinMintingPriceJson(coinMintingPriceJson string) ([]CoinMintingPriceJson, error) {
 	var coinMintingPrices []CoinMintingPriceJson
-	if err := json.Unmarshal([]byte(coinMintingPriceJson), &coinMintingPrices); err != nil {
-		return ErrInvalidCoinMintingPriceJson.Wrap(err.Error())
+	if err := unmarshalDecimalJSON(coinMintingPriceJson, &coinMintingPrices); err != nil {
+		return nil, ErrInvalidCoinMintingPriceJson.Wrap(err.Error())
 	}
+	for i := range coinMintingPrices {
+		zeroNilDecimals(&coinMintingPrices[i].MintingPrice)
+	}
+	return coinMintingPrices, nil
+}
+
+// ValidateCoinMintingPrices validates the coin minting prices of a trade
+func ValidateCoinMintingPrices(coinMintingPrices []CoinMintingPriceJson) error {
 	for i, mintingPrice := range coinMintingPrices {
+		zeroNilDecimals(&mintingPrice.MintingPrice)
 		if strings.TrimSpace(mintingPrice.CurrencyCode) == "" {
 			return ErrInvalidCoinMintingPriceJson.Wrapf("currency_code must not be empty or whitespace at index: %d", i)
 		}
-		if mintingPrice.MintingPrice <= 0 {
-			return ErrInvalidCoinMintingPriceJson.Wrapf("minting_price must be greater than 0, got: %f, at index: %d", mintingPrice.MintingPrice, i)
+		if !mintingPrice.MintingPrice.IsPositive() {
+			return ErrInvalidCoinMintingPriceJson.Wrapf("minting_price must be greater than 0, got: %s, at index: %d", mintingPrice.MintingPrice, i)
 		}
 	}
 	return nil
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
 	"github.com/GGEZLabs/vvtxchain/x/trade/types"
 	"github.com/stretchr/testify/require"
 )
@@ -51,22 +52,24 @@ func TestValidateDate(t *testing.T) {
 
 func TestFormatPrice(t *testing.T) {
 	tests := []struct {
-		input    float64
+		input    string
 		expected string
 	}{
-		{1.2e-11, "0.000000000012"},
-		{5.4e-11, "0.000000000054"},
-		{0.000000000001, "0.000000000001"},
-		{0.0001, "0.0001"},
-		{123.456, "123.456"},
-		{123.456000, "123.456"},
-		{123.000000000000, "123"},
-		{1e-13, "0"},
+		{"0.000000000012", "0.000000000012"},
+		{"0.000000000054", "0.000000000054"},
+		{"0.000000000001", "0.000000000001"},
+		{"0.0001", "0.0001"},
+		{"123.456", "123.456"},
+		{"123.456000", "123.456"},
+		{"123.000000000000", "123"},
+		{"0.0000000000001", "0.0000000000001"},
+		{"0.000000000000000001", "0.000000000000000001"},
+		{"0", "0"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.expected, func(t *testing.T) {
-			price := types.FormatPrice(tt.input)
+			price := types.FormatPrice(math.LegacyMustNewDecFromStr(tt.input))
 			require.Equal(t, tt.expected, price)
 		})
 	}
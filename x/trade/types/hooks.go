@@ -0,0 +1,85 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TradeHooks are called by the trade module on the lifecycle of a trade. An error returned by
+// a hook fails the transaction, except in the end blocker where the error is logged.
+type TradeHooks interface {
+	// AfterTradeCreated is called after a trade is created
+	AfterTradeCreated(ctx context.Context, trade StoredTrade) error
+	// AfterTradeProcessed is called after a trade is processed, confirmed, rejected or failed,
+	// and after a failed trade is retried. It is not called for a confirmation of a trade
+	// awaiting approvals, nor for a retry that corrects the receiver and sets the trade pending.
+	AfterTradeProcessed(ctx context.Context, trade StoredTrade) error
+	// AfterTradeCanceled is called after a trade is canceled by its maker, an admin or on expiry
+	AfterTradeCanceled(ctx context.Context, trade StoredTrade) error
+	// BeforeMint is called before the coins of a deposit are minted, an error fails the trade
+	BeforeMint(ctx context.Context, trade StoredTrade, receiver sdk.AccAddress, amount sdk.Coins) error
+	// BeforeBurn is called before the coins of a withdrawal are burned, an error fails the trade
+	BeforeBurn(ctx context.Context, trade StoredTrade, holder sdk.AccAddress, amount sdk.Coins) error
+}
+
+// TradeHooksWrapper is a wrapper for modules to inject TradeHooks using depinject.
+type TradeHooksWrapper struct{ TradeHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (TradeHooksWrapper) IsOnePerModuleType() {}
+
+var _ TradeHooks = MultiTradeHooks{}
+
+// MultiTradeHooks combines multiple trade hooks, all hook functions are run in array sequence
+// and the first error is returned
+type MultiTradeHooks []TradeHooks
+
+func NewMultiTradeHooks(hooks ...TradeHooks) MultiTradeHooks {
+	return hooks
+}
+
+func (h MultiTradeHooks) AfterTradeCreated(ctx context.Context, trade StoredTrade) error {
+	for i := range h {
+		if err := h[i].AfterTradeCreated(ctx, trade); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTradeHooks) AfterTradeProcessed(ctx context.Context, trade StoredTrade) error {
+	for i := range h {
+		if err := h[i].AfterTradeProcessed(ctx, trade); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTradeHooks) AfterTradeCanceled(ctx context.Context, trade StoredTrade) error {
+	for i := range h {
+		if err := h[i].AfterTradeCanceled(ctx, trade); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTradeHooks) BeforeMint(ctx context.Context, trade StoredTrade, receiver sdk.AccAddress, amount sdk.Coins) error {
+	for i := range h {
+		if err := h[i].BeforeMint(ctx, trade, receiver, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTradeHooks) BeforeBurn(ctx context.Context, trade StoredTrade, holder sdk.AccAddress, amount sdk.Coins) error {
+	for i := range h {
+		if err := h[i].BeforeBurn(ctx, trade, holder, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
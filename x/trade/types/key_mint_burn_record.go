@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// MintBurnRecordKeyPrefix is the prefix to retrieve all MintBurnRecord
+	MintBurnRecordKeyPrefix = "MintBurnRecord/value/"
+
+	// MintDirection is the direction of the records of deposits
+	MintDirection = "mint"
+	// BurnDirection is the direction of the records of withdrawals
+	BurnDirection = "burn"
+)
+
+// MintBurnDirection returns the direction of the records of a trade type, or false if
+// the trade type does not mint nor burn coins
+func MintBurnDirection(tradeType TradeType) (string, bool) {
+	switch tradeType {
+	case TradeTypeFiatDeposit:
+		return MintDirection, true
+	case TradeTypeFiatWithdrawal:
+		return BurnDirection, true
+	default:
+		return "", false
+	}
+}
+
+// MintBurnRecordDirectionKey returns the key prefix of all MintBurnRecord of a denom and direction.
+// The denom is length prefixed, a denom may hold a slash and must not be a prefix of another denom.
+func MintBurnRecordDirectionKey(denom, direction string) []byte {
+	key := address.MustLengthPrefix([]byte(denom))
+	key = append(key, []byte(direction)...)
+	return append(key, []byte("/")...)
+}
+
+// MintBurnRecordTimeKey returns the key prefix of the MintBurnRecord of a denom and direction
+// processed at the given time. The keys are ordered by time.
+func MintBurnRecordTimeKey(denom, direction string, processTime time.Time) []byte {
+	return append(MintBurnRecordDirectionKey(denom, direction), sdk.FormatTimeBytes(processTime)...)
+}
+
+// MintBurnRecordKey returns the store key of a MintBurnRecord
+func MintBurnRecordKey(denom, direction string, processTime time.Time, tradeIndex uint64) []byte {
+	key := MintBurnRecordTimeKey(denom, direction, processTime)
+
+	tradeIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(tradeIndexBytes, tradeIndex)
+	return append(key, tradeIndexBytes...)
+}
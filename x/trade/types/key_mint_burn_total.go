@@ -0,0 +1,13 @@
+package types
+
+const (
+	// MintBurnTotalKeyPrefix is the prefix to retrieve the totals minted and burned by the processed trades
+	MintBurnTotalKeyPrefix = "MintBurnTotal/value/"
+)
+
+// MintBurnTotalKey returns the store key of the total minted or burned of a denom
+func MintBurnTotalKey(denom, direction string) []byte {
+	key := append([]byte(denom), []byte("/")...)
+	key = append(key, []byte(direction)...)
+	return append(key, []byte("/")...)
+}
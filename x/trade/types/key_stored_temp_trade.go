@@ -1,12 +1,20 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 var _ binary.ByteOrder
 
 const (
 	// StoredTempTradeKeyPrefix is the prefix to retrieve all StoredTempTrade
 	StoredTempTradeKeyPrefix = "StoredTempTrade/value/"
+
+	// StoredTempTradeExpiryKeyPrefix is the prefix to retrieve pending trades ordered by expiry time
+	StoredTempTradeExpiryKeyPrefix = "StoredTempTradeExpiry/value/"
 )
 
 // StoredTempTradeKey returns the store key to retrieve a StoredTempTrade from the index fields
@@ -22,3 +30,22 @@ func StoredTempTradeKey(
 
 	return key
 }
+
+// StoredTempTradeExpiryTimeKey returns the expiry index prefix of all pending trades expiring at the given time
+func StoredTempTradeExpiryTimeKey(expiry time.Time) []byte {
+	return sdk.FormatTimeBytes(expiry)
+}
+
+// StoredTempTradeExpiryKey returns the expiry index key of a pending trade
+func StoredTempTradeExpiryKey(
+	expiry time.Time,
+	tradeIndex uint64,
+) []byte {
+	key := StoredTempTradeExpiryTimeKey(expiry)
+
+	tradeIndexBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(tradeIndexBytes, tradeIndex)
+	key = append(key, tradeIndexBytes...)
+
+	return key
+}
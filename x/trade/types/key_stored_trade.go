@@ -1,12 +1,33 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 var _ binary.ByteOrder
 
 const (
 	// StoredTradeKeyPrefix is the prefix to retrieve all StoredTrade
 	StoredTradeKeyPrefix = "StoredTrade/value/"
+	// StoredTradeByStatusKeyPrefix is the prefix of the StoredTrade index by status
+	StoredTradeByStatusKeyPrefix = "StoredTradeByStatus/value/"
+	// StoredTradeByMakerKeyPrefix is the prefix of the StoredTrade index by maker
+	StoredTradeByMakerKeyPrefix = "StoredTradeByMaker/value/"
+	// StoredTradeByCheckerKeyPrefix is the prefix of the StoredTrade index by checker
+	StoredTradeByCheckerKeyPrefix = "StoredTradeByChecker/value/"
+	// StoredTradeByReceiverKeyPrefix is the prefix of the StoredTrade index by receiver address
+	StoredTradeByReceiverKeyPrefix = "StoredTradeByReceiver/value/"
+	// StoredTradeByCreateDateKeyPrefix is the prefix of the StoredTrade index by create date
+	StoredTradeByCreateDateKeyPrefix = "StoredTradeByCreateDate/value/"
+	// StoredTradeByTxDateKeyPrefix is the prefix of the StoredTrade index by tx date
+	StoredTradeByTxDateKeyPrefix = "StoredTradeByTxDate/value/"
+	// StoredTradeByProcessDateKeyPrefix is the prefix of the StoredTrade index by process date
+	StoredTradeByProcessDateKeyPrefix = "StoredTradeByProcessDate/value/"
+	// StoredTradeByExternalReferenceKeyPrefix is the prefix of the unique StoredTrade index by external reference
+	StoredTradeByExternalReferenceKeyPrefix = "StoredTradeByExternalReference/value/"
 )
 
 // StoredTradeKey returns the store key to retrieve a StoredTrade from the index fields
@@ -22,3 +43,49 @@ func StoredTradeKey(
 
 	return key
 }
+
+// StoredTradeStatusKey returns the key prefix of all StoredTrade with the given status
+func StoredTradeStatusKey(status TradeStatus) []byte {
+	statusBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(statusBytes, uint32(status))
+	return append(statusBytes, []byte("/")...)
+}
+
+// StoredTradeAddressKey returns the key prefix of all StoredTrade with the given address
+// in one of the address indexes (maker, checker or receiver)
+func StoredTradeAddressKey(address string) []byte {
+	return append([]byte(address), []byte("/")...)
+}
+
+// StoredTradeExternalReferenceKey returns the key of an external reference in the unique
+// external reference index
+func StoredTradeExternalReferenceKey(externalReference string) []byte {
+	return append([]byte(externalReference), []byte("/")...)
+}
+
+// StoredTradeDateKey returns the key of a date in one of the date indexes
+// (create date, tx date or process date). The keys are ordered by date.
+func StoredTradeDateKey(date time.Time) []byte {
+	return append(sdk.FormatTimeBytes(date), []byte("/")...)
+}
+
+// StoredTradeDateKeyPrefix returns the prefix of the date index of the given date field
+func StoredTradeDateKeyPrefix(dateField TradeDateField) (string, bool) {
+	switch dateField {
+	case TradeDateFieldCreateDate:
+		return StoredTradeByCreateDateKeyPrefix, true
+	case TradeDateFieldTxDate:
+		return StoredTradeByTxDateKeyPrefix, true
+	case TradeDateFieldProcessDate:
+		return StoredTradeByProcessDateKeyPrefix, true
+	default:
+		return "", false
+	}
+}
+
+// StoredTradeSecondaryIndexKey returns the secondary index key of a StoredTrade
+// made of the indexed field key and the trade index
+func StoredTradeSecondaryIndexKey(fieldKey []byte, tradeIndex uint64) []byte {
+	key := append([]byte{}, fieldKey...)
+	return append(key, StoredTradeKey(tradeIndex)...)
+}
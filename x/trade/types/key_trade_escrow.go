@@ -0,0 +1,15 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// TradeEscrowKeyPrefix is the prefix to retrieve all TradeEscrow
+	TradeEscrowKeyPrefix = "TradeEscrow/value/"
+)
+
+// TradeEscrowKey returns the store key to retrieve a TradeEscrow from the index fields
+func TradeEscrowKey(tradeIndex uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, tradeIndex)
+	return append(key, []byte("/")...)
+}
@@ -0,0 +1,22 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// TradeHistoryKeyPrefix is the prefix to retrieve all TradeHistoryEntry
+	TradeHistoryKeyPrefix = "TradeHistory/value/"
+)
+
+// TradeHistoryTradeKey returns the key prefix of all TradeHistoryEntry of a trade
+func TradeHistoryTradeKey(tradeIndex uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, tradeIndex)
+	return append(key, []byte("/")...)
+}
+
+// TradeHistoryKey returns the store key of a TradeHistoryEntry. The keys of a trade are ordered by sequence.
+func TradeHistoryKey(tradeIndex, sequence uint64) []byte {
+	sequenceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	return append(TradeHistoryTradeKey(tradeIndex), sequenceBytes...)
+}
@@ -26,6 +26,7 @@ const (
 	TradeCreatedSuccessfully   = "trade created successfully"
 	TradeProcessedSuccessfully = "trade processed successfully"
 	TradeIsCanceled            = "trade is canceled"
+	TradeAwaitingApprovals     = "trade is awaiting approvals"
 )
 
 const (
@@ -44,14 +45,39 @@ const (
 )
 
 const (
-	TradeTypeNil            = TradeType_TRADE_TYPE_UNSPECIFIED
-	TradeTypeFiatDeposit    = TradeType_TRADE_TYPE_FIAT_DEPOSIT
-	TradeTypeFiatWithdrawal = TradeType_TRADE_TYPE_FIAT_WITHDRAWAL
-	// TradeTypeSplit              = TradeType_TRADE_TYPE_SPLIT
-	// TradeTypeReverseSplit       = TradeType_TRADE_TYPE_REVERSE_SPLIT
-	// TradeTypeReinvestment       = TradeType_TRADE_TYPE_REINVESTMENT
-	// TradeTypeDividends          = TradeType_TRADE_TYPE_DIVIDENDS
-	// TradeTypeDividendsDeduction = TradeType_TRADE_TYPE_DIVIDEND_DEDUCTION
+	TradeTypeNil                = TradeType_TRADE_TYPE_UNSPECIFIED
+	TradeTypeFiatDeposit        = TradeType_TRADE_TYPE_FIAT_DEPOSIT
+	TradeTypeFiatWithdrawal     = TradeType_TRADE_TYPE_FIAT_WITHDRAWAL
+	TradeTypeSplit              = TradeType_TRADE_TYPE_SPLIT
+	TradeTypeReverseSplit       = TradeType_TRADE_TYPE_REVERSE_SPLIT
+	TradeTypeReinvestment       = TradeType_TRADE_TYPE_REINVESTMENT
+	TradeTypeDividends          = TradeType_TRADE_TYPE_DIVIDENDS
+	TradeTypeDividendsDeduction = TradeType_TRADE_TYPE_DIVIDEND_DEDUCTION
+)
+
+const (
+	TradeDateFieldNil         = TradeDateField_TRADE_DATE_FIELD_UNSPECIFIED
+	TradeDateFieldCreateDate  = TradeDateField_TRADE_DATE_FIELD_CREATE_DATE
+	TradeDateFieldTxDate      = TradeDateField_TRADE_DATE_FIELD_TX_DATE
+	TradeDateFieldProcessDate = TradeDateField_TRADE_DATE_FIELD_PROCESS_DATE
+)
+
+const (
+	BatchModeNil          = BatchMode_BATCH_MODE_UNSPECIFIED
+	BatchModeAllOrNothing = BatchMode_BATCH_MODE_ALL_OR_NOTHING
+	BatchModeBestEffort   = BatchMode_BATCH_MODE_BEST_EFFORT
+)
+
+const (
+	TransitionNil      = TradeTransition_TRADE_TRANSITION_UNSPECIFIED
+	TransitionCreated  = TradeTransition_TRADE_TRANSITION_CREATED
+	TransitionAmended  = TradeTransition_TRADE_TRANSITION_AMENDED
+	TransitionApproved = TradeTransition_TRADE_TRANSITION_APPROVED
+	TransitionRejected = TradeTransition_TRADE_TRANSITION_REJECTED
+	TransitionCanceled = TradeTransition_TRADE_TRANSITION_CANCELED
+	TransitionExpired  = TradeTransition_TRADE_TRANSITION_EXPIRED
+	TransitionFailed   = TradeTransition_TRADE_TRANSITION_FAILED
+	TransitionRetried  = TradeTransition_TRADE_TRANSITION_RETRIED
 )
 
 const (
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAmendTrade{}
+
+func NewMsgAmendTrade(creator string, tradeIndex uint64, receiverAddress string, tradeData string, bankingSystemData string, coinMintingPriceJson string, exchangeRateJson string) *MsgAmendTrade {
+	return &MsgAmendTrade{
+		Creator:              creator,
+		TradeIndex:           tradeIndex,
+		ReceiverAddress:      receiverAddress,
+		TradeData:            tradeData,
+		BankingSystemData:    bankingSystemData,
+		CoinMintingPriceJson: coinMintingPriceJson,
+		ExchangeRateJson:     exchangeRateJson,
+	}
+}
+
+func (msg *MsgAmendTrade) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address (%s)", err)
+	}
+
+	if msg.TradeIndex <= 0 {
+		return ErrInvalidTradeIndex
+	}
+
+	if msg.ReceiverAddress == "" &&
+		msg.TradeData == "" &&
+		msg.BankingSystemData == "" &&
+		msg.CoinMintingPriceJson == "" &&
+		msg.ExchangeRateJson == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("at least one field of the trade must be amended")
+	}
+
+	// Validate receiver address if it does not empty
+	if msg.ReceiverAddress != "" {
+		_, err = sdk.AccAddressFromBech32(msg.ReceiverAddress)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid receiver address (%s)", err)
+		}
+	}
+
+	// Validate trade data if it does not empty
+	if msg.TradeData != "" && !json.Valid([]byte(msg.TradeData)) {
+		return ErrInvalidTradeData
+	}
+
+	// Validate banking system data if it does not empty
+	if msg.BankingSystemData != "" && !json.Valid([]byte(msg.BankingSystemData)) {
+		return ErrInvalidBankingSystemData
+	}
+
+	// Validate CoinMintingPriceJson if it does not empty
+	if msg.CoinMintingPriceJson != "" && !json.Valid([]byte(msg.CoinMintingPriceJson)) {
+		return ErrInvalidCoinMintingPriceJson
+	}
+
+	// Validate ExchangeRateJson if it does not empty
+	if msg.ExchangeRateJson != "" && !json.Valid([]byte(msg.ExchangeRateJson)) {
+		return ErrInvalidExchangeRateJson
+	}
+
+	return nil
+}
+
+// Apply returns the stored trade payload with the amended fields of the message. The receiver
+// address of a trade amended into a trade type that does not mint nor burn coins is cleared,
+// unless the message sets one.
+func (msg *MsgAmendTrade) Apply(st StoredTrade) StoredTrade {
+	if msg.ReceiverAddress != "" {
+		st.ReceiverAddress = msg.ReceiverAddress
+	}
+	if msg.TradeData != "" {
+		st.TradeData = msg.TradeData
+
+		// an invalid trade data is rejected by the validation of the amended payload
+		td, err := ParseTradeData(msg.TradeData)
+		if err == nil && td.TradeInfo != nil && !td.TradeInfo.TradeType.IsMintOrBurn() && msg.ReceiverAddress == "" {
+			st.ReceiverAddress = ""
+		}
+	}
+	if msg.BankingSystemData != "" {
+		st.BankingSystemData = msg.BankingSystemData
+	}
+	if msg.CoinMintingPriceJson != "" {
+		st.CoinMintingPriceJson = msg.CoinMintingPriceJson
+	}
+	if msg.ExchangeRateJson != "" {
+		st.ExchangeRateJson = msg.ExchangeRateJson
+	}
+	return st
+}
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/GGEZLabs/vvtxchain/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAmendTrade_ValidateBasic(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("vvtx", "vvtx")
+
+	tests := []struct {
+		name string
+		msg  MsgAmendTrade
+		err  error
+	}{
+		{
+			name: "amend trade with valid data",
+			msg: MsgAmendTrade{
+				Creator:           sample.AccAddress(),
+				TradeIndex:        1,
+				BankingSystemData: "{}",
+			},
+		},
+		{
+			name: "amend trade with invalid address",
+			msg: MsgAmendTrade{
+				Creator:           "xxxx1uuyxga4x50h43yucgtn8ddxd5au5nvh0dlf3fl",
+				TradeIndex:        1,
+				BankingSystemData: "{}",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "amend trade with invalid trade index",
+			msg: MsgAmendTrade{
+				Creator:           sample.AccAddress(),
+				BankingSystemData: "{}",
+			},
+			err: ErrInvalidTradeIndex,
+		},
+		{
+			name: "amend trade without amended field",
+			msg: MsgAmendTrade{
+				Creator:    sample.AccAddress(),
+				TradeIndex: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "amend trade with invalid receiver address",
+			msg: MsgAmendTrade{
+				Creator:         sample.AccAddress(),
+				TradeIndex:      1,
+				ReceiverAddress: "invalid",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "amend trade with invalid trade data",
+			msg: MsgAmendTrade{
+				Creator:    sample.AccAddress(),
+				TradeIndex: 1,
+				TradeData:  "{",
+			},
+			err: ErrInvalidTradeData,
+		},
+		{
+			name: "amend trade with invalid banking system data",
+			msg: MsgAmendTrade{
+				Creator:           sample.AccAddress(),
+				TradeIndex:        1,
+				BankingSystemData: "{",
+			},
+			err: ErrInvalidBankingSystemData,
+		},
+		{
+			name: "amend trade with invalid coin minting price json",
+			msg: MsgAmendTrade{
+				Creator:              sample.AccAddress(),
+				TradeIndex:           1,
+				CoinMintingPriceJson: "{",
+			},
+			err: ErrInvalidCoinMintingPriceJson,
+		},
+		{
+			name: "amend trade with invalid exchange rate json",
+			msg: MsgAmendTrade{
+				Creator:          sample.AccAddress(),
+				TradeIndex:       1,
+				ExchangeRateJson: "{",
+			},
+			err: ErrInvalidExchangeRateJson,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxCancelReasonLength is the maximum length of the reason of a canceled trade
+const MaxCancelReasonLength = 256
+
+var _ sdk.Msg = &MsgCancelTrade{}
+
+func NewMsgCancelTrade(creator string, tradeIndex uint64, reason string) *MsgCancelTrade {
+	return &MsgCancelTrade{
+		Creator:    creator,
+		TradeIndex: tradeIndex,
+		Reason:     reason,
+	}
+}
+
+func (msg *MsgCancelTrade) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address (%s)", err)
+	}
+
+	if msg.TradeIndex <= 0 {
+		return ErrInvalidTradeIndex
+	}
+
+	if strings.TrimSpace(msg.Reason) == "" {
+		return ErrInvalidCancelReason.Wrap("reason cannot be empty")
+	}
+
+	if len(msg.Reason) > MaxCancelReasonLength {
+		return ErrInvalidCancelReason.Wrapf("reason length %d exceeds the maximum length %d", len(msg.Reason), MaxCancelReasonLength)
+	}
+
+	return nil
+}
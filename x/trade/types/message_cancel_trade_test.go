@@ -0,0 +1,76 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/GGEZLabs/vvtxchain/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCancelTrade_ValidateBasic(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("vvtx", "vvtx")
+
+	tests := []struct {
+		name string
+		msg  MsgCancelTrade
+		err  error
+	}{
+		{
+			name: "cancel trade with valid data",
+			msg: MsgCancelTrade{
+				Creator:    sample.AccAddress(),
+				TradeIndex: 1,
+				Reason:     "typo in quantity",
+			},
+		},
+		{
+			name: "cancel trade with invalid address",
+			msg: MsgCancelTrade{
+				Creator:    "xxxx1uuyxga4x50h43yucgtn8ddxd5au5nvh0dlf3fl",
+				TradeIndex: 1,
+				Reason:     "typo in quantity",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "cancel trade with invalid trade index",
+			msg: MsgCancelTrade{
+				Creator:    sample.AccAddress(),
+				TradeIndex: 0,
+				Reason:     "typo in quantity",
+			},
+			err: ErrInvalidTradeIndex,
+		},
+		{
+			name: "cancel trade with empty reason",
+			msg: MsgCancelTrade{
+				Creator:    sample.AccAddress(),
+				TradeIndex: 1,
+				Reason:     "  ",
+			},
+			err: ErrInvalidCancelReason,
+		},
+		{
+			name: "cancel trade with too long reason",
+			msg: MsgCancelTrade{
+				Creator:    sample.AccAddress(),
+				TradeIndex: 1,
+				Reason:     strings.Repeat("a", MaxCancelReasonLength+1),
+			},
+			err: ErrInvalidCancelReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
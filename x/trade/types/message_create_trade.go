@@ -2,12 +2,16 @@ package types
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// MaxExternalReferenceLength is the maximum length of the external reference of a trade
+const MaxExternalReferenceLength = 128
+
 var _ sdk.Msg = &MsgCreateTrade{}
 
 func NewMsgCreateTrade(creator string, receiverAddress string, tradeData string, bankingSystemData string, coinMintingPriceJson string, exchangeRateJson string) *MsgCreateTrade {
@@ -55,5 +59,19 @@ func (msg *MsgCreateTrade) ValidateBasic() error {
 		}
 	}
 
+	return ValidateExternalReference(msg.ExternalReference)
+}
+
+// ValidateExternalReference validates the optional external reference of a trade
+func ValidateExternalReference(externalReference string) error {
+	if externalReference == "" {
+		return nil
+	}
+	if strings.TrimSpace(externalReference) != externalReference {
+		return ErrInvalidExternalReference.Wrap("external reference must not be whitespace or have leading or trailing whitespace")
+	}
+	if len(externalReference) > MaxExternalReferenceLength {
+		return ErrInvalidExternalReference.Wrapf("external reference length %d exceeds the maximum length %d", len(externalReference), MaxExternalReferenceLength)
+	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/GGEZLabs/vvtxchain/testutil/sample"
@@ -118,6 +119,44 @@ func TestMsgCreateTrade_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidRequest,
 		},
+		{
+			name: "create trade with external reference",
+			msg: MsgCreateTrade{
+				Creator:              sample.AccAddress(),
+				ReceiverAddress:      sample.AccAddress(),
+				TradeData:            td,
+				BankingSystemData:    "{}",
+				CoinMintingPriceJson: "{}",
+				ExchangeRateJson:     "{}",
+				ExternalReference:    "WIRE-2023-0001",
+			},
+		},
+		{
+			name: "create trade with whitespace external reference",
+			msg: MsgCreateTrade{
+				Creator:              sample.AccAddress(),
+				ReceiverAddress:      sample.AccAddress(),
+				TradeData:            td,
+				BankingSystemData:    "{}",
+				CoinMintingPriceJson: "{}",
+				ExchangeRateJson:     "{}",
+				ExternalReference:    " WIRE-2023-0001",
+			},
+			err: ErrInvalidExternalReference,
+		},
+		{
+			name: "create trade with too long external reference",
+			msg: MsgCreateTrade{
+				Creator:              sample.AccAddress(),
+				ReceiverAddress:      sample.AccAddress(),
+				TradeData:            td,
+				BankingSystemData:    "{}",
+				CoinMintingPriceJson: "{}",
+				ExchangeRateJson:     "{}",
+				ExternalReference:    strings.Repeat("a", MaxExternalReferenceLength+1),
+			},
+			err: ErrInvalidExternalReference,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateTradeV2{}
+
+func NewMsgCreateTradeV2(creator string, receiverAddress string, tradeData TradeData, bankingSystemData string, coinMintingPrices []CoinMintingPriceJson, exchangeRates []ExchangeRateJson) *MsgCreateTradeV2 {
+	return &MsgCreateTradeV2{
+		Creator:           creator,
+		ReceiverAddress:   receiverAddress,
+		TradeData:         tradeData,
+		BankingSystemData: bankingSystemData,
+		CoinMintingPrices: coinMintingPrices,
+		ExchangeRates:     exchangeRates,
+	}
+}
+
+func (msg *MsgCreateTradeV2) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address (%s)", err)
+	}
+
+	// Validate trade data, the trade info is validated against the params by the keeper
+	if msg.TradeData.TradeInfo == nil || msg.TradeData.Brokerage == nil {
+		return ErrInvalidTradeData
+	}
+
+	// Validate banking system data
+	if !json.Valid([]byte(msg.BankingSystemData)) {
+		return ErrInvalidBankingSystemData
+	}
+
+	if err = ValidateCoinMintingPrices(msg.CoinMintingPrices); err != nil {
+		return err
+	}
+
+	if err = ValidateExchangeRates(msg.ExchangeRates); err != nil {
+		return err
+	}
+
+	// Validate CreateDate if it does not empty
+	if msg.CreateDate != "" {
+		_, err = time.Parse(time.RFC3339, msg.CreateDate)
+		if err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid create_date format: %s, date format should be like: %s", msg.CreateDate, time.RFC3339)
+		}
+	}
+
+	return ValidateExternalReference(msg.ExternalReference)
+}
+
+// ToMsgCreateTrade returns the equivalent MsgCreateTrade, with the typed payloads
+// encoded as JSON strings with canonical decimals
+func (msg *MsgCreateTradeV2) ToMsgCreateTrade() (*MsgCreateTrade, error) {
+	tradeData, err := json.Marshal(msg.TradeData)
+	if err != nil {
+		return nil, ErrInvalidTradeData.Wrap(err.Error())
+	}
+
+	coinMintingPrices := msg.CoinMintingPrices
+	if coinMintingPrices == nil {
+		coinMintingPrices = []CoinMintingPriceJson{}
+	}
+	coinMintingPriceJson, err := json.Marshal(coinMintingPrices)
+	if err != nil {
+		return nil, ErrInvalidCoinMintingPriceJson.Wrap(err.Error())
+	}
+
+	exchangeRates := msg.ExchangeRates
+	if exchangeRates == nil {
+		exchangeRates = []ExchangeRateJson{}
+	}
+	exchangeRateJson, err := json.Marshal(exchangeRates)
+	if err != nil {
+		return nil, ErrInvalidExchangeRateJson.Wrap(err.Error())
+	}
+
+	return &MsgCreateTrade{
+		Creator:              msg.Creator,
+		ReceiverAddress:      msg.ReceiverAddress,
+		TradeData:            string(tradeData),
+		BankingSystemData:    msg.BankingSystemData,
+		CoinMintingPriceJson: string(coinMintingPriceJson),
+		ExchangeRateJson:     string(exchangeRateJson),
+		CreateDate:           msg.CreateDate,
+		ExternalReference:    msg.ExternalReference,
+	}, nil
+}
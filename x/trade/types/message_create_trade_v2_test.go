@@ -0,0 +1,136 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/GGEZLabs/vvtxchain/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateTradeV2_ValidateBasic(t *testing.T) {
+	validMsg := func() MsgCreateTradeV2 {
+		return MsgCreateTradeV2{
+			Creator:           sample.AccAddress(),
+			ReceiverAddress:   sample.AccAddress(),
+			TradeData:         GetSampleTradeData(TradeTypeFiatDeposit),
+			BankingSystemData: "{}",
+			CoinMintingPrices: GetSampleCoinMintingPrices(),
+			ExchangeRates:     GetSampleExchangeRates(),
+		}
+	}
+	tests := []struct {
+		name   string
+		update func(*MsgCreateTradeV2)
+		err    error
+	}{
+		{
+			name:   "valid message",
+			update: func(*MsgCreateTradeV2) {},
+		},
+		{
+			name:   "valid message without prices and rates",
+			update: func(msg *MsgCreateTradeV2) { msg.CoinMintingPrices, msg.ExchangeRates = nil, nil },
+		},
+		{
+			name:   "valid create date",
+			update: func(msg *MsgCreateTradeV2) { msg.CreateDate = "2023-05-11T08:44:00Z" },
+		},
+		{
+			name:   "invalid creator address",
+			update: func(msg *MsgCreateTradeV2) { msg.Creator = "invalid_address" },
+			err:    sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:   "missing trade info",
+			update: func(msg *MsgCreateTradeV2) { msg.TradeData.TradeInfo = nil },
+			err:    ErrInvalidTradeData,
+		},
+		{
+			name:   "missing brokerage",
+			update: func(msg *MsgCreateTradeV2) { msg.TradeData.Brokerage = nil },
+			err:    ErrInvalidTradeData,
+		},
+		{
+			name:   "invalid banking system data",
+			update: func(msg *MsgCreateTradeV2) { msg.BankingSystemData = "" },
+			err:    ErrInvalidBankingSystemData,
+		},
+		{
+			name:   "invalid coin minting price",
+			update: func(msg *MsgCreateTradeV2) { msg.CoinMintingPrices[0].MintingPrice = math.LegacyZeroDec() },
+			err:    ErrInvalidCoinMintingPriceJson,
+		},
+		{
+			name:   "unset coin minting price",
+			update: func(msg *MsgCreateTradeV2) { msg.CoinMintingPrices[0].MintingPrice = math.LegacyDec{} },
+			err:    ErrInvalidCoinMintingPriceJson,
+		},
+		{
+			name:   "invalid exchange rate",
+			update: func(msg *MsgCreateTradeV2) { msg.ExchangeRates[0].Timestamp = "2025-07-08" },
+			err:    ErrInvalidExchangeRateJson,
+		},
+		{
+			name:   "invalid create date",
+			update: func(msg *MsgCreateTradeV2) { msg.CreateDate = "2023-05-11" },
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "invalid external reference",
+			update: func(msg *MsgCreateTradeV2) { msg.ExternalReference = " " },
+			err:    ErrInvalidExternalReference,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := validMsg()
+			tt.update(&msg)
+			err := msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCreateTradeV2_ToMsgCreateTrade(t *testing.T) {
+	msg := NewMsgCreateTradeV2(
+		sample.AccAddress(),
+		sample.AccAddress(),
+		GetSampleTradeData(TradeTypeFiatDeposit),
+		"{}",
+		GetSampleCoinMintingPrices(),
+		nil,
+	)
+	msg.CreateDate = "2023-05-11T08:44:00Z"
+	msg.ExternalReference = "WIRE-2023-0001"
+
+	legacyMsg, err := msg.ToMsgCreateTrade()
+	require.NoError(t, err)
+	require.Equal(t, msg.Creator, legacyMsg.Creator)
+	require.Equal(t, msg.ReceiverAddress, legacyMsg.ReceiverAddress)
+	require.Equal(t, msg.BankingSystemData, legacyMsg.BankingSystemData)
+	require.Equal(t, msg.CreateDate, legacyMsg.CreateDate)
+	require.Equal(t, msg.ExternalReference, legacyMsg.ExternalReference)
+	require.Equal(t, `[{"currency_code":"GBP","minting_price":"0.001000000000000000"}]`, legacyMsg.CoinMintingPriceJson)
+	require.Equal(t, "[]", legacyMsg.ExchangeRateJson)
+	require.NoError(t, legacyMsg.ValidateBasic())
+
+	// the JSON trade data is already canonical and decodes back to the typed trade data
+	canonical, err := CanonicalizeDecimalJSON(legacyMsg.TradeData)
+	require.NoError(t, err)
+	require.Equal(t, canonical, legacyMsg.TradeData)
+
+	td, err := ParseTradeData(legacyMsg.TradeData)
+	require.NoError(t, err)
+	expected, err := json.Marshal(msg.TradeData)
+	require.NoError(t, err)
+	actual, err := json.Marshal(td)
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), string(actual))
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxBatchSize is the maximum number of entries of a batch message
+const MaxBatchSize = 500
+
+var _ sdk.Msg = &MsgCreateTrades{}
+
+func NewMsgCreateTrades(creator string, entries []CreateTradeEntry, mode BatchMode) *MsgCreateTrades {
+	return &MsgCreateTrades{
+		Creator: creator,
+		Entries: entries,
+		Mode:    mode,
+	}
+}
+
+func (msg *MsgCreateTrades) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address (%s)", err)
+	}
+
+	if !msg.Mode.IsModeValid() {
+		return ErrInvalidBatch.Wrapf("invalid batch mode: %s", msg.Mode.String())
+	}
+
+	if len(msg.Entries) == 0 {
+		return ErrInvalidBatch.Wrap("entries cannot be empty")
+	}
+
+	if len(msg.Entries) > MaxBatchSize {
+		return ErrInvalidBatch.Wrapf("number of entries %d exceeds the maximum batch size %d", len(msg.Entries), MaxBatchSize)
+	}
+
+	for i, entry := range msg.Entries {
+		if err := entry.ToMsgCreateTrade(msg.Creator).ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "entry %d", i)
+		}
+	}
+
+	return nil
+}
+
+// ToMsgCreateTrade returns the MsgCreateTrade of the entry signed by the given creator
+func (entry CreateTradeEntry) ToMsgCreateTrade(creator string) *MsgCreateTrade {
+	return &MsgCreateTrade{
+		Creator:              creator,
+		ReceiverAddress:      entry.ReceiverAddress,
+		TradeData:            entry.TradeData,
+		BankingSystemData:    entry.BankingSystemData,
+		CoinMintingPriceJson: entry.CoinMintingPriceJson,
+		ExchangeRateJson:     entry.ExchangeRateJson,
+		CreateDate:           entry.CreateDate,
+		ExternalReference:    entry.ExternalReference,
+	}
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/GGEZLabs/vvtxchain/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateTrades_ValidateBasic(t *testing.T) {
+	entry := CreateTradeEntry{
+		ReceiverAddress:      sample.AccAddress(),
+		TradeData:            GetSampleTradeDataJson(TradeTypeFiatDeposit),
+		BankingSystemData:    "{}",
+		CoinMintingPriceJson: GetSampleCoinMintingPriceJson(),
+		ExchangeRateJson:     GetSampleExchangeRateJson(),
+	}
+	invalidEntry := entry
+	invalidEntry.BankingSystemData = ""
+
+	tooManyEntries := make([]CreateTradeEntry, MaxBatchSize+1)
+	for i := range tooManyEntries {
+		tooManyEntries[i] = entry
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgCreateTrades
+		err  error
+	}{
+		{
+			name: "create trades with valid data (all or nothing)",
+			msg: MsgCreateTrades{
+				Creator: sample.AccAddress(),
+				Entries: []CreateTradeEntry{entry, entry},
+				Mode:    BatchModeAllOrNothing,
+			},
+		},
+		{
+			name: "create trades with valid data (best effort)",
+			msg: MsgCreateTrades{
+				Creator: sample.AccAddress(),
+				Entries: []CreateTradeEntry{entry},
+				Mode:    BatchModeBestEffort,
+			},
+		},
+		{
+			name: "create trades with invalid address",
+			msg: MsgCreateTrades{
+				Creator: "invalid_address",
+				Entries: []CreateTradeEntry{entry},
+				Mode:    BatchModeAllOrNothing,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "create trades with invalid mode",
+			msg: MsgCreateTrades{
+				Creator: sample.AccAddress(),
+				Entries: []CreateTradeEntry{entry},
+				Mode:    BatchModeNil,
+			},
+			err: ErrInvalidBatch,
+		},
+		{
+			name: "create trades without entries",
+			msg: MsgCreateTrades{
+				Creator: sample.AccAddress(),
+				Mode:    BatchModeAllOrNothing,
+			},
+			err: ErrInvalidBatch,
+		},
+		{
+			name: "create trades with too many entries",
+			msg: MsgCreateTrades{
+				Creator: sample.AccAddress(),
+				Entries: tooManyEntries,
+				Mode:    BatchModeAllOrNothing,
+			},
+			err: ErrInvalidBatch,
+		},
+		{
+			name: "create trades with invalid entry",
+			msg: MsgCreateTrades{
+				Creator: sample.AccAddress(),
+				Entries: []CreateTradeEntry{entry, invalidEntry},
+				Mode:    BatchModeBestEffort,
+			},
+			err: ErrInvalidBankingSystemData,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
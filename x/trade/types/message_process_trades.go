@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgProcessTrades{}
+
+func NewMsgProcessTrades(creator string, processType ProcessType, tradeIndexes []uint64, mode BatchMode) *MsgProcessTrades {
+	return &MsgProcessTrades{
+		Creator:      creator,
+		ProcessType:  processType,
+		TradeIndexes: tradeIndexes,
+		Mode:         mode,
+	}
+}
+
+func (msg *MsgProcessTrades) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address (%s)", err)
+	}
+
+	if msg.ProcessType != ProcessTypeConfirm &&
+		msg.ProcessType != ProcessTypeReject {
+		return ErrInvalidProcessType
+	}
+
+	if !msg.Mode.IsModeValid() {
+		return ErrInvalidBatch.Wrapf("invalid batch mode: %s", msg.Mode.String())
+	}
+
+	if len(msg.TradeIndexes) == 0 {
+		return ErrInvalidBatch.Wrap("trade indexes cannot be empty")
+	}
+
+	if len(msg.TradeIndexes) > MaxBatchSize {
+		return ErrInvalidBatch.Wrapf("number of trade indexes %d exceeds the maximum batch size %d", len(msg.TradeIndexes), MaxBatchSize)
+	}
+
+	if len(msg.ExpectedRevisions) != 0 && len(msg.ExpectedRevisions) != len(msg.TradeIndexes) {
+		return ErrInvalidBatch.Wrapf("number of expected revisions %d does not match the number of trade indexes %d", len(msg.ExpectedRevisions), len(msg.TradeIndexes))
+	}
+
+	tradeIndexes := make(map[uint64]struct{}, len(msg.TradeIndexes))
+	for _, tradeIndex := range msg.TradeIndexes {
+		if tradeIndex <= 0 {
+			return ErrInvalidTradeIndex
+		}
+		if _, ok := tradeIndexes[tradeIndex]; ok {
+			return ErrInvalidBatch.Wrapf("duplicated trade index: %d", tradeIndex)
+		}
+		tradeIndexes[tradeIndex] = struct{}{}
+	}
+
+	return nil
+}
+
+// ToMsgProcessTrade returns the MsgProcessTrade of the trade at position i of the batch
+func (msg *MsgProcessTrades) ToMsgProcessTrade(i int) *MsgProcessTrade {
+	processMsg := NewMsgProcessTrade(msg.Creator, msg.ProcessType, msg.TradeIndexes[i])
+	if len(msg.ExpectedRevisions) != 0 {
+		processMsg.ExpectedRevision = msg.ExpectedRevisions[i]
+	}
+	return processMsg
+}
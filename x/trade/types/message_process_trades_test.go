@@ -0,0 +1,132 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/GGEZLabs/vvtxchain/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgProcessTrades_ValidateBasic(t *testing.T) {
+	tooManyIndexes := make([]uint64, MaxBatchSize+1)
+	for i := range tooManyIndexes {
+		tooManyIndexes[i] = uint64(i + 1)
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgProcessTrades
+		err  error
+	}{
+		{
+			name: "process trades with valid data (confirm)",
+			msg: MsgProcessTrades{
+				Creator:      sample.AccAddress(),
+				ProcessType:  ProcessTypeConfirm,
+				TradeIndexes: []uint64{1, 2},
+				Mode:         BatchModeAllOrNothing,
+			},
+		},
+		{
+			name: "process trades with valid data (reject with expected revisions)",
+			msg: MsgProcessTrades{
+				Creator:           sample.AccAddress(),
+				ProcessType:       ProcessTypeReject,
+				TradeIndexes:      []uint64{1, 2},
+				ExpectedRevisions: []uint64{0, 3},
+				Mode:              BatchModeBestEffort,
+			},
+		},
+		{
+			name: "process trades with invalid address",
+			msg: MsgProcessTrades{
+				Creator:      "invalid_address",
+				ProcessType:  ProcessTypeConfirm,
+				TradeIndexes: []uint64{1},
+				Mode:         BatchModeAllOrNothing,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "process trades with invalid process type",
+			msg: MsgProcessTrades{
+				Creator:      sample.AccAddress(),
+				ProcessType:  ProcessTypeNil,
+				TradeIndexes: []uint64{1},
+				Mode:         BatchModeAllOrNothing,
+			},
+			err: ErrInvalidProcessType,
+		},
+		{
+			name: "process trades with invalid mode",
+			msg: MsgProcessTrades{
+				Creator:      sample.AccAddress(),
+				ProcessType:  ProcessTypeConfirm,
+				TradeIndexes: []uint64{1},
+				Mode:         BatchModeNil,
+			},
+			err: ErrInvalidBatch,
+		},
+		{
+			name: "process trades without trade indexes",
+			msg: MsgProcessTrades{
+				Creator:     sample.AccAddress(),
+				ProcessType: ProcessTypeConfirm,
+				Mode:        BatchModeAllOrNothing,
+			},
+			err: ErrInvalidBatch,
+		},
+		{
+			name: "process trades with too many trade indexes",
+			msg: MsgProcessTrades{
+				Creator:      sample.AccAddress(),
+				ProcessType:  ProcessTypeConfirm,
+				TradeIndexes: tooManyIndexes,
+				Mode:         BatchModeAllOrNothing,
+			},
+			err: ErrInvalidBatch,
+		},
+		{
+			name: "process trades with mismatched expected revisions",
+			msg: MsgProcessTrades{
+				Creator:           sample.AccAddress(),
+				ProcessType:       ProcessTypeConfirm,
+				TradeIndexes:      []uint64{1, 2},
+				ExpectedRevisions: []uint64{1},
+				Mode:              BatchModeAllOrNothing,
+			},
+			err: ErrInvalidBatch,
+		},
+		{
+			name: "process trades with zero trade index",
+			msg: MsgProcessTrades{
+				Creator:      sample.AccAddress(),
+				ProcessType:  ProcessTypeConfirm,
+				TradeIndexes: []uint64{1, 0},
+				Mode:         BatchModeAllOrNothing,
+			},
+			err: ErrInvalidTradeIndex,
+		},
+		{
+			name: "process trades with duplicated trade index",
+			msg: MsgProcessTrades{
+				Creator:      sample.AccAddress(),
+				ProcessType:  ProcessTypeConfirm,
+				TradeIndexes: []uint64{1, 2, 1},
+				Mode:         BatchModeAllOrNothing,
+			},
+			err: ErrInvalidBatch,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRetryTrade{}
+
+func NewMsgRetryTrade(creator string, tradeIndex uint64, receiverAddress string) *MsgRetryTrade {
+	return &MsgRetryTrade{
+		Creator:         creator,
+		TradeIndex:      tradeIndex,
+		ReceiverAddress: receiverAddress,
+	}
+}
+
+func (msg *MsgRetryTrade) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid creator address (%s)", err)
+	}
+
+	if msg.TradeIndex <= 0 {
+		return ErrInvalidTradeIndex
+	}
+
+	if msg.ReceiverAddress != "" {
+		if _, err = sdk.AccAddressFromBech32(msg.ReceiverAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid receiver address (%s)", err)
+		}
+	}
+
+	return nil
+}
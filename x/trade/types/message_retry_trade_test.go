@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/GGEZLabs/vvtxchain/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRetryTrade_ValidateBasic(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("vvtx", "vvtx")
+
+	tests := []struct {
+		name string
+		msg  MsgRetryTrade
+		err  error
+	}{
+		{
+			name: "retry trade with valid data",
+			msg: MsgRetryTrade{
+				Creator:    sample.AccAddress(),
+				TradeIndex: 1,
+			},
+		},
+		{
+			name: "retry trade with corrected receiver address",
+			msg: MsgRetryTrade{
+				Creator:         sample.AccAddress(),
+				TradeIndex:      1,
+				ReceiverAddress: sample.AccAddress(),
+			},
+		},
+		{
+			name: "retry trade with invalid address",
+			msg: MsgRetryTrade{
+				Creator:    "xxxx1uuyxga4x50h43yucgtn8ddxd5au5nvh0dlf3fl",
+				TradeIndex: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "retry trade with invalid trade index",
+			msg: MsgRetryTrade{
+				Creator:    sample.AccAddress(),
+				TradeIndex: 0,
+			},
+			err: ErrInvalidTradeIndex,
+		},
+		{
+			name: "retry trade with invalid receiver address",
+			msg: MsgRetryTrade{
+				Creator:         sample.AccAddress(),
+				TradeIndex:      1,
+				ReceiverAddress: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,514 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: vvtxchain/trade/mint_burn_record.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintBurnRecord is the volume minted or burned by a processed trade. The
+// records within the window of the mint_burn_limits are used to enforce them.
+type MintBurnRecord struct {
+	TradeIndex  uint64     `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	TradeType   TradeType  `protobuf:"varint,2,opt,name=trade_type,json=tradeType,proto3,enum=vvtxchain.trade.TradeType" json:"trade_type,omitempty"`
+	Maker       string     `protobuf:"bytes,3,opt,name=maker,proto3" json:"maker,omitempty"`
+	Amount      types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	ProcessTime time.Time  `protobuf:"bytes,5,opt,name=process_time,json=processTime,proto3,stdtime" json:"process_time"`
+}
+
+func (m *MintBurnRecord) Reset()         { *m = MintBurnRecord{} }
+func (m *MintBurnRecord) String() string { return proto.CompactTextString(m) }
+func (*MintBurnRecord) ProtoMessage()    {}
+func (*MintBurnRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a3be8a66d5dec74, []int{0}
+}
+func (m *MintBurnRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintBurnRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintBurnRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintBurnRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintBurnRecord.Merge(m, src)
+}
+func (m *MintBurnRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintBurnRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintBurnRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintBurnRecord proto.InternalMessageInfo
+
+func (m *MintBurnRecord) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
+	}
+	return 0
+}
+
+func (m *MintBurnRecord) GetTradeType() TradeType {
+	if m != nil {
+		return m.TradeType
+	}
+	return TradeType_TRADE_TYPE_UNSPECIFIED
+}
+
+func (m *MintBurnRecord) GetMaker() string {
+	if m != nil {
+		return m.Maker
+	}
+	return ""
+}
+
+func (m *MintBurnRecord) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MintBurnRecord) GetProcessTime() time.Time {
+	if m != nil {
+		return m.ProcessTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*MintBurnRecord)(nil), "vvtxchain.trade.MintBurnRecord")
+}
+
+func init() {
+	proto.RegisterFile("vvtxchain/trade/mint_burn_record.proto", fileDescriptor_1a3be8a66d5dec74)
+}
+
+var fileDescriptor_1a3be8a66d5dec74 = []byte{
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xb1, 0xce, 0xd3, 0x30,
+	0x10, 0xc7, 0xe3, 0x8f, 0xaf, 0x15, 0x75, 0x51, 0x11, 0x51, 0x87, 0x10, 0xa4, 0x24, 0x62, 0x40,
+	0x11, 0x48, 0xb6, 0x5a, 0x26, 0x24, 0xa6, 0xa0, 0xaa, 0x42, 0x82, 0x25, 0xea, 0xd4, 0x25, 0x72,
+	0x52, 0x93, 0x5a, 0x60, 0x3b, 0xb2, 0x9d, 0xaa, 0x7d, 0x04, 0xb6, 0x3e, 0x06, 0x23, 0x8f, 0xd1,
+	0xb1, 0x23, 0x13, 0xa0, 0x76, 0xe0, 0x35, 0x50, 0x9c, 0x04, 0xa4, 0x2e, 0xa7, 0xbb, 0xfb, 0xff,
+	0x7d, 0xbe, 0xfb, 0xc1, 0x17, 0xbb, 0x9d, 0xd9, 0x17, 0x5b, 0xc2, 0x04, 0x36, 0x8a, 0x6c, 0x28,
+	0xe6, 0x4c, 0x98, 0x2c, 0xaf, 0x95, 0xc8, 0x14, 0x2d, 0xa4, 0xda, 0xa0, 0x4a, 0x49, 0x23, 0xdd,
+	0xc7, 0xff, 0x7c, 0xc8, 0xfa, 0xfc, 0x27, 0x84, 0x33, 0x21, 0xb1, 0x8d, 0xad, 0xc7, 0x0f, 0x0a,
+	0xa9, 0xb9, 0xd4, 0x38, 0x27, 0x9a, 0xe2, 0xdd, 0x2c, 0xa7, 0x86, 0xcc, 0x70, 0x21, 0x99, 0xe8,
+	0xf4, 0x69, 0x29, 0x4b, 0x69, 0x53, 0xdc, 0x64, 0x5d, 0x37, 0x2c, 0xa5, 0x2c, 0xbf, 0x50, 0x6c,
+	0xab, 0xbc, 0xfe, 0x84, 0x0d, 0xe3, 0x54, 0x1b, 0xc2, 0xab, 0xce, 0xf0, 0xec, 0x76, 0x45, 0x1b,
+	0x5b, 0xf1, 0xf9, 0xd7, 0x3b, 0x38, 0xf9, 0xc8, 0x84, 0x49, 0x6a, 0x25, 0x52, 0xbb, 0xb0, 0x1b,
+	0xc2, 0xb1, 0x75, 0x64, 0x4c, 0x6c, 0xe8, 0xde, 0x03, 0x11, 0x88, 0xef, 0x53, 0x68, 0x5b, 0xef,
+	0x9b, 0x8e, 0xfb, 0x06, 0xb6, 0x55, 0x66, 0x0e, 0x15, 0xf5, 0xee, 0x22, 0x10, 0x4f, 0xe6, 0x3e,
+	0xba, 0x39, 0x10, 0xad, 0x9a, 0xb8, 0x3a, 0x54, 0x34, 0x1d, 0x99, 0x3e, 0x75, 0xa7, 0x70, 0xc0,
+	0xc9, 0x67, 0xaa, 0xbc, 0x07, 0x11, 0x88, 0x47, 0x69, 0x5b, 0xb8, 0x6f, 0xe1, 0x90, 0x70, 0x59,
+	0x0b, 0xe3, 0xdd, 0x47, 0x20, 0x1e, 0xcf, 0x9f, 0xa2, 0x96, 0x04, 0x6a, 0x48, 0xa0, 0x8e, 0x04,
+	0x7a, 0x27, 0x99, 0x48, 0x46, 0xa7, 0x9f, 0xa1, 0xf3, 0xed, 0xcf, 0xf7, 0x97, 0x20, 0xed, 0xde,
+	0xb8, 0x4b, 0xf8, 0xa8, 0x52, 0xb2, 0xa0, 0x5a, 0x67, 0xcd, 0xe9, 0xde, 0xc0, 0xce, 0xf0, 0x51,
+	0xcb, 0x05, 0xf5, 0x5c, 0xd0, 0xaa, 0xe7, 0x92, 0x3c, 0x6c, 0x86, 0x1c, 0x7f, 0x85, 0x20, 0x1d,
+	0x77, 0x2f, 0x1b, 0x2d, 0x59, 0x9c, 0x2e, 0x01, 0x38, 0x5f, 0x02, 0xf0, 0xfb, 0x12, 0x80, 0xe3,
+	0x35, 0x70, 0xce, 0xd7, 0xc0, 0xf9, 0x71, 0x0d, 0x9c, 0xf5, 0xab, 0x92, 0x99, 0x6d, 0x9d, 0xa3,
+	0x42, 0x72, 0xbc, 0x5c, 0x2e, 0xd6, 0x1f, 0x48, 0xae, 0xf1, 0x7f, 0xac, 0xfb, 0x1e, 0xec, 0xa1,
+	0xa2, 0x3a, 0x1f, 0xda, 0x1f, 0x5f, 0xff, 0x1d, 0x00, 0xbb, 0xc4, 0xd6, 0x20, 0x1b, 0x02, 0x00,
+	0x00,
+}
+
+func (m *MintBurnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintBurnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintBurnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ProcessTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ProcessTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMintBurnRecord(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMintBurnRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Maker) > 0 {
+		i -= len(m.Maker)
+		copy(dAtA[i:], m.Maker)
+		i = encodeVarintMintBurnRecord(dAtA, i, uint64(len(m.Maker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TradeType != 0 {
+		i = encodeVarintMintBurnRecord(dAtA, i, uint64(m.TradeType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintMintBurnRecord(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintBurnRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintBurnRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintBurnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovMintBurnRecord(uint64(m.TradeIndex))
+	}
+	if m.TradeType != 0 {
+		n += 1 + sovMintBurnRecord(uint64(m.TradeType))
+	}
+	l = len(m.Maker)
+	if l > 0 {
+		n += 1 + l + sovMintBurnRecord(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMintBurnRecord(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ProcessTime)
+	n += 1 + l + sovMintBurnRecord(uint64(l))
+	return n
+}
+
+func sovMintBurnRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintBurnRecord(x uint64) (n int) {
+	return sovMintBurnRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintBurnRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintBurnRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintBurnRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintBurnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintBurnRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeType", wireType)
+			}
+			m.TradeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintBurnRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeType |= TradeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintBurnRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintBurnRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintBurnRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintBurnRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintBurnRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintBurnRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintBurnRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintBurnRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintBurnRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ProcessTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintBurnRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintBurnRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMintBurnRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMintBurnRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintBurnRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintBurnRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMintBurnRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMintBurnRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMintBurnRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMintBurnRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMintBurnRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMintBurnRecord = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,32 +1,355 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyPendingTradeTTL = []byte("PendingTradeTTL")
+	// DefaultPendingTradeTTL is the default duration after which a pending trade is canceled
+	DefaultPendingTradeTTL = 24 * time.Hour
+
+	KeyMintableDenoms = []byte("MintableDenoms")
+	// DefaultMintableDenoms is the default registry of mintable denoms
+	DefaultMintableDenoms = []MintableDenom{
+		{
+			Denom:              DefaultDenom,
+			BaseCurrency:       "GBP",
+			SettlementCurrency: "GBP",
+			Enabled:            true,
+		},
+	}
+
+	KeyApprovalTiers = []byte("ApprovalTiers")
+	// DefaultApprovalTiers is the default list of approval tiers, every trade needs a single checker
+	DefaultApprovalTiers []ApprovalTier
+
+	KeyMintBurnLimits = []byte("MintBurnLimits")
+	// DefaultMintBurnLimits is the default list of mint and burn limits, the volumes are not capped
+	DefaultMintBurnLimits []MintBurnLimit
+
+	KeyTradeDataTolerances = []byte("TradeDataTolerances")
+	// DefaultTradeDataTolerance is the default relative tolerance of the trade data consistency checks
+	DefaultTradeDataTolerance = math.LegacyNewDecWithPrec(1, 3)
+	// DefaultTradeDataTolerances are the default tolerances of the trade data consistency checks
+	DefaultTradeDataTolerances = NewTradeDataTolerances(true, DefaultTradeDataTolerance, DefaultTradeDataTolerance, DefaultTradeDataTolerance)
+
+	KeySupplyBaselines = []byte("SupplyBaselines")
+	// DefaultSupplyBaselines is the default supply baselines, every coin is minted by trades
+	DefaultSupplyBaselines sdk.Coins
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	pendingTradeTTL time.Duration,
+	mintableDenoms []MintableDenom,
+	approvalTiers []ApprovalTier,
+	mintBurnLimits []MintBurnLimit,
+	tradeDataTolerances TradeDataTolerances,
+	supplyBaselines sdk.Coins,
+) Params {
+	return Params{
+		PendingTradeTtl:     pendingTradeTTL,
+		MintableDenoms:      mintableDenoms,
+		ApprovalTiers:       approvalTiers,
+		MintBurnLimits:      mintBurnLimits,
+		TradeDataTolerances: tradeDataTolerances,
+		SupplyBaselines:     supplyBaselines,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultPendingTradeTTL, DefaultMintableDenoms, DefaultApprovalTiers, DefaultMintBurnLimits, DefaultTradeDataTolerances, DefaultSupplyBaselines)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyPendingTradeTTL, &p.PendingTradeTtl, validatePendingTradeTTL),
+		paramtypes.NewParamSetPair(KeyMintableDenoms, &p.MintableDenoms, validateMintableDenoms),
+		paramtypes.NewParamSetPair(KeyApprovalTiers, &p.ApprovalTiers, validateApprovalTiers),
+		paramtypes.NewParamSetPair(KeyMintBurnLimits, &p.MintBurnLimits, validateMintBurnLimits),
+		paramtypes.NewParamSetPair(KeyTradeDataTolerances, &p.TradeDataTolerances, validateTradeDataTolerances),
+		paramtypes.NewParamSetPair(KeySupplyBaselines, &p.SupplyBaselines, validateSupplyBaselines),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validatePendingTradeTTL(p.PendingTradeTtl); err != nil {
+		return err
+	}
+
+	if err := validateMintableDenoms(p.MintableDenoms); err != nil {
+		return err
+	}
+
+	if err := validateApprovalTiers(p.ApprovalTiers); err != nil {
+		return err
+	}
+
+	if err := validateMintBurnLimits(p.MintBurnLimits); err != nil {
+		return err
+	}
+
+	if err := validateTradeDataTolerances(p.TradeDataTolerances); err != nil {
+		return err
+	}
+
+	if err := validateSupplyBaselines(p.SupplyBaselines); err != nil {
+		return err
+	}
+
+	// approval tiers and mint and burn limits apply to registered mintable denoms only
+	for _, tier := range p.ApprovalTiers {
+		if _, found := p.GetMintableDenom(tier.Denom); !found {
+			return fmt.Errorf("approval tier denom %s is not a registered mintable denom", tier.Denom)
+		}
+	}
+	for _, limit := range p.MintBurnLimits {
+		if _, found := p.GetMintableDenom(limit.Denom); !found {
+			return fmt.Errorf("mint burn limit denom %s is not a registered mintable denom", limit.Denom)
+		}
+	}
+
+	return nil
+}
+
+// GetMintableDenom returns the registry entry of a denom
+func (p Params) GetMintableDenom(denom string) (MintableDenom, bool) {
+	for _, md := range p.MintableDenoms {
+		if md.Denom == denom {
+			return md, true
+		}
+	}
+	return MintableDenom{}, false
+}
+
+// FindMintableDenom returns the registry entry of a base and settlement currency pair
+func (p Params) FindMintableDenom(baseCurrency, settlementCurrency string) (MintableDenom, bool) {
+	for _, md := range p.MintableDenoms {
+		if md.BaseCurrency == baseCurrency && md.SettlementCurrency == settlementCurrency {
+			return md, true
+		}
+	}
+	return MintableDenom{}, false
+}
+
+// ValidateDenomEnabled checks that a denom is registered and enabled for minting and burning
+func (p Params) ValidateDenomEnabled(denom string) error {
+	md, found := p.GetMintableDenom(denom)
+	if !found {
+		return ErrDenomNotRegistered.Wrapf("denom: %s", denom)
+	}
+	if !md.Enabled {
+		return ErrDenomDisabled.Wrapf("denom: %s", denom)
+	}
+	return nil
+}
+
+// RequiredApprovals returns the number of distinct checkers that must confirm a trade of the given
+// amount. It is the highest number of approvals of the tiers reached by the amount, or 1 if none is reached.
+func (p Params) RequiredApprovals(amount sdk.Coin) uint32 {
+	requiredApprovals := uint32(1)
+	for _, tier := range p.ApprovalTiers {
+		if tier.Denom == amount.Denom && amount.Amount.GTE(tier.MinAmount) && tier.RequiredApprovals > requiredApprovals {
+			requiredApprovals = tier.RequiredApprovals
+		}
+	}
+	return requiredApprovals
+}
+
+// NewMintBurnLimit creates a new MintBurnLimit instance
+func NewMintBurnLimit(
+	denom string,
+	window time.Duration,
+	makerMintLimit, makerBurnLimit, globalMintLimit, globalBurnLimit math.Int,
+) MintBurnLimit {
+	return MintBurnLimit{
+		Denom:           denom,
+		Window:          window,
+		MakerMintLimit:  makerMintLimit,
+		MakerBurnLimit:  makerBurnLimit,
+		GlobalMintLimit: globalMintLimit,
+		GlobalBurnLimit: globalBurnLimit,
+	}
+}
+
+// GetMintBurnLimit returns the mint and burn limit of a denom
+func (p Params) GetMintBurnLimit(denom string) (MintBurnLimit, bool) {
+	for _, limit := range p.MintBurnLimits {
+		if limit.Denom == denom {
+			return limit, true
+		}
+	}
+	return MintBurnLimit{}, false
+}
+
+// NewTradeDataTolerances creates a new TradeDataTolerances instance
+func NewTradeDataTolerances(enabled bool, tradeValue, tradeNetValue, quantity math.LegacyDec) TradeDataTolerances {
+	return TradeDataTolerances{
+		Enabled:       enabled,
+		TradeValue:    tradeValue,
+		TradeNetValue: tradeNetValue,
+		Quantity:      quantity,
+	}
+}
+
+func validatePendingTradeTTL(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("pending trade ttl must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMintableDenoms(i interface{}) error {
+	v, ok := i.([]MintableDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]struct{})
+	currencies := make(map[string]struct{})
+	for i, md := range v {
+		if err := sdk.ValidateDenom(md.Denom); err != nil {
+			return fmt.Errorf("invalid mintable denom at index %d: %w", i, err)
+		}
+		if strings.TrimSpace(md.BaseCurrency) == "" {
+			return fmt.Errorf("base_currency must not be empty or whitespace for denom: %s", md.Denom)
+		}
+		if strings.TrimSpace(md.SettlementCurrency) == "" {
+			return fmt.Errorf("settlement_currency must not be empty or whitespace for denom: %s", md.Denom)
+		}
+
+		if _, found := denoms[md.Denom]; found {
+			return fmt.Errorf("duplicated mintable denom: %s", md.Denom)
+		}
+		denoms[md.Denom] = struct{}{}
+
+		pair := md.BaseCurrency + "/" + md.SettlementCurrency
+		if _, found := currencies[pair]; found {
+			return fmt.Errorf("duplicated mintable denom currencies: %s", pair)
+		}
+		currencies[pair] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateApprovalTiers(i interface{}) error {
+	v, ok := i.([]ApprovalTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	tiers := make(map[string]struct{})
+	for i, tier := range v {
+		if err := sdk.ValidateDenom(tier.Denom); err != nil {
+			return fmt.Errorf("invalid approval tier denom at index %d: %w", i, err)
+		}
+		if tier.MinAmount.IsNil() || !tier.MinAmount.IsPositive() {
+			return fmt.Errorf("approval tier min_amount must be positive for denom: %s", tier.Denom)
+		}
+		if tier.RequiredApprovals < 1 {
+			return fmt.Errorf("approval tier required_approvals must be at least 1 for denom: %s", tier.Denom)
+		}
+
+		key := tier.Denom + "/" + tier.MinAmount.String()
+		if _, found := tiers[key]; found {
+			return fmt.Errorf("duplicated approval tier: %s", key)
+		}
+		tiers[key] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateMintBurnLimits(i interface{}) error {
+	v, ok := i.([]MintBurnLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := make(map[string]struct{})
+	for i, limit := range v {
+		if err := sdk.ValidateDenom(limit.Denom); err != nil {
+			return fmt.Errorf("invalid mint burn limit denom at index %d: %w", i, err)
+		}
+		if limit.Window <= 0 {
+			return fmt.Errorf("mint burn limit window must be positive for denom: %s", limit.Denom)
+		}
+
+		for name, amount := range map[string]math.Int{
+			"maker_mint_limit":  limit.MakerMintLimit,
+			"maker_burn_limit":  limit.MakerBurnLimit,
+			"global_mint_limit": limit.GlobalMintLimit,
+			"global_burn_limit": limit.GlobalBurnLimit,
+		} {
+			if amount.IsNil() || amount.IsNegative() {
+				return fmt.Errorf("mint burn limit %s must not be negative for denom: %s", name, limit.Denom)
+			}
+		}
+
+		if _, found := denoms[limit.Denom]; found {
+			return fmt.Errorf("duplicated mint burn limit: %s", limit.Denom)
+		}
+		denoms[limit.Denom] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateTradeDataTolerances(i interface{}) error {
+	v, ok := i.(TradeDataTolerances)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for _, tolerance := range []struct {
+		name  string
+		value math.LegacyDec
+	}{
+		{"trade_value", v.TradeValue},
+		{"trade_net_value", v.TradeNetValue},
+		{"quantity", v.Quantity},
+	} {
+		if tolerance.value.IsNil() || tolerance.value.IsNegative() || tolerance.value.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("trade data tolerance %s must be between 0 and 1", tolerance.name)
+		}
+	}
+
+	return nil
+}
+
+func validateSupplyBaselines(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid supply baselines: %w", err)
+	}
+
 	return nil
 }
@@ -4,19 +4,27 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +34,27 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// pending_trade_ttl is the duration after which a pending trade
+	// is canceled if it has not been processed.
+	PendingTradeTtl time.Duration `protobuf:"bytes,1,opt,name=pending_trade_ttl,json=pendingTradeTtl,proto3,stdduration" json:"pending_trade_ttl"`
+	// mintable_denoms is the registry of denoms that can be minted and burned
+	// by trades, keyed by the trade base and settlement currencies.
+	MintableDenoms []MintableDenom `protobuf:"bytes,2,rep,name=mintable_denoms,json=mintableDenoms,proto3" json:"mintable_denoms"`
+	// approval_tiers defines the number of distinct checkers that must confirm
+	// a trade of a denom from a minimum amount. Trades below every tier need a
+	// single checker.
+	ApprovalTiers []ApprovalTier `protobuf:"bytes,3,rep,name=approval_tiers,json=approvalTiers,proto3" json:"approval_tiers"`
+	// mint_burn_limits caps the volume of a denom that can be minted and burned
+	// over a rolling window, by each maker and by all makers together.
+	MintBurnLimits []MintBurnLimit `protobuf:"bytes,4,rep,name=mint_burn_limits,json=mintBurnLimits,proto3" json:"mint_burn_limits"`
+	// trade_data_tolerances are the tolerances of the arithmetic consistency
+	// checks between the fields of the deposit and withdrawal trade data.
+	TradeDataTolerances TradeDataTolerances `protobuf:"bytes,5,opt,name=trade_data_tolerances,json=tradeDataTolerances,proto3" json:"trade_data_tolerances"`
+	// supply_baselines is the supply of each denom that was not minted by
+	// trades, such as the balances of the genesis or a supply adjusted for IBC.
+	// The supply invariant expects the supply of a denom to be its baseline plus
+	// the processed deposits minus the processed withdrawals.
+	SupplyBaselines github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=supply_baselines,json=supplyBaselines,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply_baselines"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,25 +90,359 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetPendingTradeTtl() time.Duration {
+	if m != nil {
+		return m.PendingTradeTtl
+	}
+	return 0
+}
+
+func (m *Params) GetMintableDenoms() []MintableDenom {
+	if m != nil {
+		return m.MintableDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetApprovalTiers() []ApprovalTier {
+	if m != nil {
+		return m.ApprovalTiers
+	}
+	return nil
+}
+
+func (m *Params) GetMintBurnLimits() []MintBurnLimit {
+	if m != nil {
+		return m.MintBurnLimits
+	}
+	return nil
+}
+
+func (m *Params) GetTradeDataTolerances() TradeDataTolerances {
+	if m != nil {
+		return m.TradeDataTolerances
+	}
+	return TradeDataTolerances{}
+}
+
+func (m *Params) GetSupplyBaselines() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SupplyBaselines
+	}
+	return nil
+}
+
+// MintableDenom maps a trade base/settlement currency pair to the denom
+// minted on deposit and burned on withdrawal.
+type MintableDenom struct {
+	Denom              string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	BaseCurrency       string `protobuf:"bytes,2,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	SettlementCurrency string `protobuf:"bytes,3,opt,name=settlement_currency,json=settlementCurrency,proto3" json:"settlement_currency,omitempty"`
+	// enabled allows new trades to be created and processed for the denom.
+	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MintableDenom) Reset()         { *m = MintableDenom{} }
+func (m *MintableDenom) String() string { return proto.CompactTextString(m) }
+func (*MintableDenom) ProtoMessage()    {}
+func (*MintableDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca45ab034519844a, []int{1}
+}
+func (m *MintableDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintableDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintableDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintableDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintableDenom.Merge(m, src)
+}
+func (m *MintableDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintableDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintableDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintableDenom proto.InternalMessageInfo
+
+func (m *MintableDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintableDenom) GetBaseCurrency() string {
+	if m != nil {
+		return m.BaseCurrency
+	}
+	return ""
+}
+
+func (m *MintableDenom) GetSettlementCurrency() string {
+	if m != nil {
+		return m.SettlementCurrency
+	}
+	return ""
+}
+
+func (m *MintableDenom) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// ApprovalTier requires required_approvals distinct checkers to confirm the
+// trades of denom with an amount greater than or equal to min_amount.
+type ApprovalTier struct {
+	Denom             string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MinAmount         cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=min_amount,json=minAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount"`
+	RequiredApprovals uint32                `protobuf:"varint,3,opt,name=required_approvals,json=requiredApprovals,proto3" json:"required_approvals,omitempty"`
+}
+
+func (m *ApprovalTier) Reset()         { *m = ApprovalTier{} }
+func (m *ApprovalTier) String() string { return proto.CompactTextString(m) }
+func (*ApprovalTier) ProtoMessage()    {}
+func (*ApprovalTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca45ab034519844a, []int{2}
+}
+func (m *ApprovalTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApprovalTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApprovalTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApprovalTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovalTier.Merge(m, src)
+}
+func (m *ApprovalTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApprovalTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovalTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApprovalTier proto.InternalMessageInfo
+
+func (m *ApprovalTier) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ApprovalTier) GetRequiredApprovals() uint32 {
+	if m != nil {
+		return m.RequiredApprovals
+	}
+	return 0
+}
+
+// MintBurnLimit caps the volume of denom minted by deposits and burned by
+// withdrawals over the rolling window. A zero limit disables the cap.
+type MintBurnLimit struct {
+	Denom  string        `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Window time.Duration `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
+	// maker_mint_limit is the volume that each maker can mint in the window.
+	MakerMintLimit cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=maker_mint_limit,json=makerMintLimit,proto3,customtype=cosmossdk.io/math.Int" json:"maker_mint_limit"`
+	// maker_burn_limit is the volume that each maker can burn in the window.
+	MakerBurnLimit cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=maker_burn_limit,json=makerBurnLimit,proto3,customtype=cosmossdk.io/math.Int" json:"maker_burn_limit"`
+	// global_mint_limit is the volume that all makers can mint in the window.
+	GlobalMintLimit cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=global_mint_limit,json=globalMintLimit,proto3,customtype=cosmossdk.io/math.Int" json:"global_mint_limit"`
+	// global_burn_limit is the volume that all makers can burn in the window.
+	GlobalBurnLimit cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=global_burn_limit,json=globalBurnLimit,proto3,customtype=cosmossdk.io/math.Int" json:"global_burn_limit"`
+}
+
+func (m *MintBurnLimit) Reset()         { *m = MintBurnLimit{} }
+func (m *MintBurnLimit) String() string { return proto.CompactTextString(m) }
+func (*MintBurnLimit) ProtoMessage()    {}
+func (*MintBurnLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca45ab034519844a, []int{3}
+}
+func (m *MintBurnLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintBurnLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintBurnLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintBurnLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintBurnLimit.Merge(m, src)
+}
+func (m *MintBurnLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintBurnLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintBurnLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintBurnLimit proto.InternalMessageInfo
+
+func (m *MintBurnLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintBurnLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// TradeDataTolerances are the relative tolerances of the arithmetic consistency
+// checks of the trade data. A field passes a check if it differs from the value
+// computed from the other fields by at most the tolerance times the computed value.
+type TradeDataTolerances struct {
+	// enabled turns the consistency checks on.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// trade_value is the tolerance between trade_value and
+	// number_of_shares * share_price.
+	TradeValue cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=trade_value,json=tradeValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trade_value"`
+	// trade_net_value is the tolerance between trade_net_value and trade_value
+	// plus trade_fee for a deposit, or trade_value minus trade_fee for a withdrawal.
+	TradeNetValue cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=trade_net_value,json=tradeNetValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trade_net_value"`
+	// quantity is the tolerance between the quantity amount and
+	// trade_net_value * exchange_rate / coin_minting_price. The quantity may also
+	// differ by one unit of the denom for rounding.
+	Quantity cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=quantity,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"quantity"`
+}
+
+func (m *TradeDataTolerances) Reset()         { *m = TradeDataTolerances{} }
+func (m *TradeDataTolerances) String() string { return proto.CompactTextString(m) }
+func (*TradeDataTolerances) ProtoMessage()    {}
+func (*TradeDataTolerances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca45ab034519844a, []int{4}
+}
+func (m *TradeDataTolerances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradeDataTolerances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradeDataTolerances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradeDataTolerances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeDataTolerances.Merge(m, src)
+}
+func (m *TradeDataTolerances) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradeDataTolerances) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeDataTolerances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeDataTolerances proto.InternalMessageInfo
+
+func (m *TradeDataTolerances) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "vvtxchain.trade.Params")
+	proto.RegisterType((*MintableDenom)(nil), "vvtxchain.trade.MintableDenom")
+	proto.RegisterType((*ApprovalTier)(nil), "vvtxchain.trade.ApprovalTier")
+	proto.RegisterType((*MintBurnLimit)(nil), "vvtxchain.trade.MintBurnLimit")
+	proto.RegisterType((*TradeDataTolerances)(nil), "vvtxchain.trade.TradeDataTolerances")
 }
 
 func init() { proto.RegisterFile("vvtxchain/trade/params.proto", fileDescriptor_ca45ab034519844a) }
 
 var fileDescriptor_ca45ab034519844a = []byte{
-	// 173 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x2b, 0x2b, 0xa9,
-	0x48, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x29, 0x4a, 0x4c, 0x49, 0xd5, 0x2f, 0x48, 0x2c, 0x4a,
-	0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0xcb, 0xea, 0x81, 0x65, 0xa5,
-	0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x8d, 0x94, 0x48, 0x7a, 0x7e, 0x7a,
-	0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0xb4, 0xb9, 0xd8, 0x02, 0xc0, 0x26, 0x59, 0x29,
-	0xbe, 0x58, 0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x04, 0xc2, 0xaa, 0x0a, 0xa8, 0x65, 0x10,
-	0x25, 0x4e, 0xae, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3,
-	0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9d, 0x9e,
-	0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0xef, 0xee, 0xee, 0x1a, 0xe5, 0x93, 0x98,
-	0x54, 0xac, 0x8f, 0x69, 0x4e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x6a, 0x63, 0x40,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xb0, 0xdd, 0xbb, 0x47, 0xd4, 0x00, 0x00, 0x00,
+	// 848 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x6f, 0x1b, 0x45,
+	0x18, 0xc6, 0xbd, 0xb1, 0x63, 0x9a, 0x49, 0x1d, 0xc7, 0x93, 0x56, 0xda, 0x14, 0x58, 0x87, 0xc0,
+	0xc1, 0x2a, 0xca, 0x2e, 0x2d, 0x82, 0x43, 0x4f, 0xd4, 0x75, 0x55, 0x55, 0x0a, 0x14, 0x19, 0x0b,
+	0xa4, 0x08, 0xb1, 0x9a, 0xdd, 0x1d, 0x36, 0xa3, 0xec, 0xce, 0x6c, 0x77, 0x66, 0xdd, 0x5a, 0x7c,
+	0x03, 0x4e, 0x1c, 0x91, 0xb8, 0x70, 0x44, 0x3d, 0xf5, 0xc0, 0x27, 0xe0, 0x94, 0x63, 0xc4, 0x09,
+	0x21, 0x91, 0x40, 0x72, 0x08, 0x1f, 0x03, 0xcd, 0x1f, 0xaf, 0x37, 0x98, 0x20, 0x35, 0xbe, 0x24,
+	0x9e, 0x79, 0xde, 0xf9, 0xcd, 0xb3, 0xef, 0x3c, 0x33, 0xe0, 0x8d, 0xf1, 0x58, 0x3c, 0x0f, 0xf7,
+	0x11, 0xa1, 0x9e, 0xc8, 0x51, 0x84, 0xbd, 0x0c, 0xe5, 0x28, 0xe5, 0x6e, 0x96, 0x33, 0xc1, 0x60,
+	0xbb, 0x54, 0x5d, 0xa5, 0xde, 0xea, 0xa0, 0x94, 0x50, 0xe6, 0xa9, 0xbf, 0xba, 0xe6, 0x96, 0x13,
+	0x32, 0x9e, 0x32, 0xee, 0x05, 0x88, 0x63, 0x6f, 0x7c, 0x27, 0xc0, 0x02, 0xdd, 0xf1, 0x42, 0x46,
+	0xa8, 0xd1, 0x37, 0xb5, 0xee, 0xab, 0x91, 0xa7, 0x07, 0x46, 0xba, 0x11, 0xb3, 0x98, 0xe9, 0x79,
+	0xf9, 0x6b, 0x0a, 0x8c, 0x19, 0x8b, 0x13, 0xec, 0xa9, 0x51, 0x50, 0x7c, 0xed, 0x45, 0x45, 0x8e,
+	0x04, 0x61, 0x06, 0xb8, 0xfd, 0x47, 0x03, 0x34, 0x3f, 0x55, 0x2e, 0xe1, 0x08, 0x74, 0x32, 0x4c,
+	0x23, 0x42, 0x63, 0x5f, 0xf9, 0xf3, 0x85, 0x48, 0x6c, 0x6b, 0xcb, 0xea, 0xad, 0xde, 0xdd, 0x74,
+	0x35, 0xc6, 0x9d, 0x62, 0xdc, 0x81, 0xc1, 0xf4, 0x5b, 0x87, 0xc7, 0xdd, 0xda, 0xf7, 0x27, 0x5d,
+	0xeb, 0xa7, 0xf3, 0x97, 0xb7, 0xad, 0x61, 0xdb, 0x20, 0x46, 0x92, 0x30, 0x12, 0x09, 0x1c, 0x82,
+	0x76, 0x4a, 0xa8, 0x40, 0x41, 0x82, 0xfd, 0x08, 0x53, 0x96, 0x72, 0x7b, 0x69, 0xab, 0xde, 0x5b,
+	0xbd, 0xeb, 0xb8, 0xff, 0xea, 0x87, 0xfb, 0xb1, 0xa9, 0x1b, 0xc8, 0xb2, 0xfe, 0x8a, 0x04, 0x6b,
+	0xe8, 0x5a, 0x5a, 0x55, 0x38, 0x7c, 0x02, 0xd6, 0x50, 0x96, 0xe5, 0x6c, 0x8c, 0x12, 0x5f, 0x10,
+	0x9c, 0x73, 0xbb, 0xae, 0x90, 0x6f, 0xce, 0x21, 0xef, 0x9b, 0xb2, 0x11, 0xc1, 0x79, 0x95, 0xd8,
+	0x42, 0x15, 0x81, 0xc3, 0xcf, 0xc0, 0xba, 0xdc, 0xc2, 0x0f, 0x8a, 0x9c, 0xfa, 0x09, 0x49, 0x89,
+	0xe0, 0x76, 0xe3, 0x7f, 0x5c, 0xf6, 0x8b, 0x9c, 0xee, 0xca, 0xb2, 0x39, 0x97, 0xa5, 0xc2, 0x61,
+	0x08, 0x6e, 0xea, 0x3e, 0x46, 0x48, 0x20, 0x5f, 0xb0, 0x04, 0xe7, 0x88, 0x86, 0x98, 0xdb, 0xcb,
+	0xaa, 0xa7, 0xef, 0xcc, 0x91, 0x55, 0xcf, 0x06, 0x48, 0xa0, 0x51, 0x59, 0x5b, 0xe5, 0x6f, 0x88,
+	0x79, 0x1d, 0x7e, 0x03, 0xd6, 0x79, 0x91, 0x65, 0xc9, 0xc4, 0x97, 0x91, 0x49, 0x08, 0xc5, 0xdc,
+	0x6e, 0x2a, 0xe7, 0x9b, 0xae, 0x89, 0x87, 0x14, 0x5c, 0x93, 0x25, 0xf7, 0x01, 0x23, 0xb4, 0xff,
+	0x81, 0x84, 0xbe, 0x38, 0xe9, 0xf6, 0x62, 0x22, 0xf6, 0x8b, 0xc0, 0x0d, 0x59, 0x6a, 0xb2, 0x64,
+	0xfe, 0xed, 0xf0, 0xe8, 0xc0, 0x13, 0x93, 0x0c, 0x73, 0xb5, 0x80, 0x9b, 0xb3, 0xd5, 0x3b, 0xf5,
+	0xa7, 0x1b, 0xdd, 0x7b, 0xeb, 0xef, 0x1f, 0xbb, 0xd6, 0xb7, 0xe7, 0x2f, 0x6f, 0xdb, 0xb3, 0xe0,
+	0x3f, 0x37, 0xd1, 0xd7, 0xa1, 0xda, 0xfe, 0xc1, 0x02, 0xad, 0x0b, 0xe7, 0x0a, 0x6f, 0x80, 0x65,
+	0x95, 0x03, 0x15, 0xad, 0x95, 0xa1, 0x1e, 0xc0, 0xb7, 0x41, 0x4b, 0xfa, 0xf4, 0xc3, 0x22, 0xcf,
+	0x31, 0x0d, 0x27, 0xf6, 0x92, 0x52, 0xaf, 0xcb, 0xc9, 0x07, 0x66, 0x0e, 0x7a, 0x60, 0x83, 0x63,
+	0x21, 0x12, 0x9c, 0x62, 0x2a, 0x66, 0xa5, 0x75, 0x55, 0x0a, 0x67, 0x52, 0xb9, 0xc0, 0x06, 0xaf,
+	0x61, 0x2a, 0xb7, 0x8e, 0xec, 0xc6, 0x96, 0xd5, 0xbb, 0x36, 0x9c, 0x0e, 0xef, 0x35, 0xa4, 0xf5,
+	0xed, 0x17, 0x16, 0xb8, 0x5e, 0x8d, 0xc8, 0x25, 0xe6, 0x9e, 0x00, 0x90, 0x12, 0xea, 0xa3, 0x94,
+	0x15, 0x54, 0x68, 0x67, 0xfd, 0xf7, 0x64, 0x0f, 0x7f, 0x3f, 0xee, 0xde, 0xd4, 0x1d, 0xe3, 0xd1,
+	0x81, 0x4b, 0x98, 0x97, 0x22, 0xb1, 0xef, 0x3e, 0xa6, 0xe2, 0xd7, 0x9f, 0x77, 0x80, 0x69, 0xff,
+	0x63, 0x2a, 0x74, 0xfb, 0x56, 0x52, 0x42, 0xef, 0x2b, 0x04, 0xdc, 0x01, 0x30, 0xc7, 0x4f, 0x0b,
+	0x92, 0xe3, 0xc8, 0x9f, 0x26, 0x91, 0xab, 0xef, 0x68, 0x0d, 0x3b, 0x53, 0x65, 0x6a, 0x8c, 0x1b,
+	0xb3, 0x7f, 0xd5, 0x75, 0x2b, 0xcb, 0x88, 0x5d, 0xe2, 0xf6, 0x23, 0xd0, 0x7c, 0x46, 0x68, 0xc4,
+	0x9e, 0x29, 0xa7, 0xaf, 0x72, 0x79, 0xcd, 0x3a, 0xb8, 0x07, 0xd6, 0x53, 0x74, 0x80, 0x73, 0x5f,
+	0x5d, 0x0a, 0x75, 0x1f, 0xec, 0xfa, 0x15, 0xbf, 0x7a, 0x4d, 0x91, 0xa4, 0x6f, 0xed, 0xb9, 0x64,
+	0xcf, 0xee, 0x9a, 0xdd, 0x58, 0x88, 0x3d, 0xeb, 0xc7, 0x97, 0xa0, 0x13, 0x27, 0x2c, 0x40, 0x49,
+	0xd5, 0xf8, 0xf2, 0x15, 0xe1, 0x6d, 0x8d, 0x9a, 0x39, 0x9f, 0xd1, 0x2b, 0xd6, 0x9b, 0x8b, 0xd1,
+	0x4b, 0xef, 0xe6, 0x8c, 0x7f, 0x59, 0x02, 0x1b, 0xff, 0xf1, 0x0c, 0x54, 0x83, 0x6c, 0x5d, 0x08,
+	0x32, 0xfc, 0x02, 0xac, 0xea, 0x57, 0x66, 0x8c, 0x92, 0x02, 0x9b, 0x70, 0x7e, 0x68, 0xfc, 0xbc,
+	0x3e, 0xef, 0x67, 0x17, 0xc7, 0x28, 0x9c, 0x0c, 0x70, 0x58, 0x71, 0x35, 0xc0, 0xa1, 0x76, 0x05,
+	0x14, 0xea, 0x73, 0x49, 0x82, 0x5f, 0x81, 0xb6, 0x06, 0x53, 0x2c, 0x0c, 0xbc, 0xbe, 0x10, 0xbc,
+	0xa5, 0x70, 0x9f, 0x60, 0xa1, 0xf9, 0x43, 0x70, 0xed, 0x69, 0x81, 0xa8, 0x20, 0x62, 0x62, 0x37,
+	0x16, 0x02, 0x97, 0x1c, 0xdd, 0xc4, 0xfe, 0xc3, 0xc3, 0x53, 0xc7, 0x3a, 0x3a, 0x75, 0xac, 0x3f,
+	0x4f, 0x1d, 0xeb, 0xbb, 0x33, 0xa7, 0x76, 0x74, 0xe6, 0xd4, 0x7e, 0x3b, 0x73, 0x6a, 0x7b, 0xef,
+	0x56, 0x1e, 0xbc, 0x47, 0x8f, 0x1e, 0xee, 0xed, 0xa2, 0x80, 0x7b, 0xf3, 0x6f, 0x97, 0x7a, 0xf9,
+	0x82, 0xa6, 0xba, 0x2f, 0xef, 0xff, 0x33, 0x00, 0x20, 0x43, 0xef, 0x9e, 0xd6, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -101,69 +464,1220 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.PendingTradeTtl != that1.PendingTradeTtl {
+		return false
+	}
+	if len(this.MintableDenoms) != len(that1.MintableDenoms) {
+		return false
+	}
+	for i := range this.MintableDenoms {
+		if !this.MintableDenoms[i].Equal(&that1.MintableDenoms[i]) {
+			return false
+		}
+	}
+	if len(this.ApprovalTiers) != len(that1.ApprovalTiers) {
+		return false
+	}
+	for i := range this.ApprovalTiers {
+		if !this.ApprovalTiers[i].Equal(&that1.ApprovalTiers[i]) {
+			return false
+		}
+	}
+	if len(this.MintBurnLimits) != len(that1.MintBurnLimits) {
+		return false
+	}
+	for i := range this.MintBurnLimits {
+		if !this.MintBurnLimits[i].Equal(&that1.MintBurnLimits[i]) {
+			return false
+		}
+	}
+	if !this.TradeDataTolerances.Equal(&that1.TradeDataTolerances) {
+		return false
+	}
+	if len(this.SupplyBaselines) != len(that1.SupplyBaselines) {
+		return false
+	}
+	for i := range this.SupplyBaselines {
+		if !this.SupplyBaselines[i].Equal(&that1.SupplyBaselines[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MintableDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	that1, ok := that.(*MintableDenom)
+	if !ok {
+		that2, ok := that.(MintableDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.BaseCurrency != that1.BaseCurrency {
+		return false
+	}
+	if this.SettlementCurrency != that1.SettlementCurrency {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
 }
+func (this *ApprovalTier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*ApprovalTier)
+	if !ok {
+		that2, ok := that.(ApprovalTier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	return n
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.MinAmount.Equal(that1.MinAmount) {
+		return false
+	}
+	if this.RequiredApprovals != that1.RequiredApprovals {
+		return false
+	}
+	return true
 }
+func (this *MintBurnLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+	that1, ok := that.(*MintBurnLimit)
+	if !ok {
+		that2, ok := that.(MintBurnLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if !this.MakerMintLimit.Equal(that1.MakerMintLimit) {
+		return false
+	}
+	if !this.MakerBurnLimit.Equal(that1.MakerBurnLimit) {
+		return false
+	}
+	if !this.GlobalMintLimit.Equal(that1.GlobalMintLimit) {
+		return false
+	}
+	if !this.GlobalBurnLimit.Equal(that1.GlobalBurnLimit) {
+		return false
+	}
+	return true
+}
+func (this *TradeDataTolerances) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TradeDataTolerances)
+	if !ok {
+		that2, ok := that.(TradeDataTolerances)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if !this.TradeValue.Equal(that1.TradeValue) {
+		return false
+	}
+	if !this.TradeNetValue.Equal(that1.TradeNetValue) {
+		return false
+	}
+	if !this.Quantity.Equal(that1.Quantity) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SupplyBaselines) > 0 {
+		for iNdEx := len(m.SupplyBaselines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyBaselines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.TradeDataTolerances.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.MintBurnLimits) > 0 {
+		for iNdEx := len(m.MintBurnLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintBurnLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ApprovalTiers) > 0 {
+		for iNdEx := len(m.ApprovalTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApprovalTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MintableDenoms) > 0 {
+		for iNdEx := len(m.MintableDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintableDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PendingTradeTtl, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PendingTradeTtl):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MintableDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintableDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintableDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SettlementCurrency) > 0 {
+		i -= len(m.SettlementCurrency)
+		copy(dAtA[i:], m.SettlementCurrency)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SettlementCurrency)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseCurrency) > 0 {
+		i -= len(m.BaseCurrency)
+		copy(dAtA[i:], m.BaseCurrency)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BaseCurrency)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApprovalTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApprovalTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApprovalTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequiredApprovals != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RequiredApprovals))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintBurnLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintBurnLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintBurnLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GlobalBurnLimit.Size()
+		i -= size
+		if _, err := m.GlobalBurnLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.GlobalMintLimit.Size()
+		i -= size
+		if _, err := m.GlobalMintLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MakerBurnLimit.Size()
+		i -= size
+		if _, err := m.MakerBurnLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MakerMintLimit.Size()
+		i -= size
+		if _, err := m.MakerMintLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradeDataTolerances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradeDataTolerances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradeDataTolerances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TradeNetValue.Size()
+		i -= size
+		if _, err := m.TradeNetValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TradeValue.Size()
+		i -= size
+		if _, err := m.TradeValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PendingTradeTtl)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.MintableDenoms) > 0 {
+		for _, e := range m.MintableDenoms {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ApprovalTiers) > 0 {
+		for _, e := range m.ApprovalTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.MintBurnLimits) > 0 {
+		for _, e := range m.MintBurnLimits {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.TradeDataTolerances.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.SupplyBaselines) > 0 {
+		for _, e := range m.SupplyBaselines {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintableDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.BaseCurrency)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.SettlementCurrency)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *ApprovalTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MinAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.RequiredApprovals != 0 {
+		n += 1 + sovParams(uint64(m.RequiredApprovals))
+	}
+	return n
+}
+
+func (m *MintBurnLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MakerMintLimit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MakerBurnLimit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.GlobalMintLimit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.GlobalBurnLimit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *TradeDataTolerances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.TradeValue.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TradeNetValue.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTradeTtl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PendingTradeTtl, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintableDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintableDenoms = append(m.MintableDenoms, MintableDenom{})
+			if err := m.MintableDenoms[len(m.MintableDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalTiers = append(m.ApprovalTiers, ApprovalTier{})
+			if err := m.ApprovalTiers[len(m.ApprovalTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintBurnLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintBurnLimits = append(m.MintBurnLimits, MintBurnLimit{})
+			if err := m.MintBurnLimits[len(m.MintBurnLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeDataTolerances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradeDataTolerances.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyBaselines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyBaselines = append(m.SupplyBaselines, types.Coin{})
+			if err := m.SupplyBaselines[len(m.SupplyBaselines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintableDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintableDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintableDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseCurrency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseCurrency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementCurrency", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementCurrency = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApprovalTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApprovalTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApprovalTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredApprovals", wireType)
+			}
+			m.RequiredApprovals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredApprovals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintBurnLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -175,12 +1689,385 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintBurnLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintBurnLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerMintLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerMintLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerBurnLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerBurnLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalMintLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalMintLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalBurnLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalBurnLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradeDataTolerances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradeDataTolerances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradeDataTolerances: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeNetValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradeNetValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,173 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsRequiredApprovals(t *testing.T) {
+	params := DefaultParams()
+	params.MintableDenoms = append(params.MintableDenoms, MintableDenom{
+		Denom:              "ueurv",
+		BaseCurrency:       "EUR",
+		SettlementCurrency: "EUR",
+		Enabled:            true,
+	})
+	params.ApprovalTiers = []ApprovalTier{
+		{Denom: DefaultDenom, MinAmount: math.NewInt(1000), RequiredApprovals: 2},
+		{Denom: DefaultDenom, MinAmount: math.NewInt(10000), RequiredApprovals: 3},
+		{Denom: "ueurv", MinAmount: math.NewInt(500), RequiredApprovals: 2},
+	}
+	require.NoError(t, params.Validate())
+
+	tests := []struct {
+		name     string
+		amount   sdk.Coin
+		expected uint32
+	}{
+		{name: "below every tier", amount: sdk.NewInt64Coin(DefaultDenom, 999), expected: 1},
+		{name: "first tier reached", amount: sdk.NewInt64Coin(DefaultDenom, 1000), expected: 2},
+		{name: "highest tier reached", amount: sdk.NewInt64Coin(DefaultDenom, 20000), expected: 3},
+		{name: "tier of another denom", amount: sdk.NewInt64Coin("ueurv", 600), expected: 2},
+		{name: "denom without tier", amount: sdk.NewInt64Coin("uusdv", 1000000), expected: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, params.RequiredApprovals(tt.amount))
+		})
+	}
+}
+
+func TestParamsValidateApprovalTiers(t *testing.T) {
+	tests := []struct {
+		name   string
+		tiers  []ApprovalTier
+		errMsg string
+	}{
+		{
+			name:  "valid tier",
+			tiers: []ApprovalTier{{Denom: DefaultDenom, MinAmount: math.NewInt(1000), RequiredApprovals: 2}},
+		},
+		{
+			name:   "non positive min amount",
+			tiers:  []ApprovalTier{{Denom: DefaultDenom, MinAmount: math.ZeroInt(), RequiredApprovals: 2}},
+			errMsg: "approval tier min_amount must be positive for denom: ugbpv",
+		},
+		{
+			name:   "nil min amount",
+			tiers:  []ApprovalTier{{Denom: DefaultDenom, RequiredApprovals: 2}},
+			errMsg: "approval tier min_amount must be positive for denom: ugbpv",
+		},
+		{
+			name: "duplicated tier",
+			tiers: []ApprovalTier{
+				{Denom: DefaultDenom, MinAmount: math.NewInt(1000), RequiredApprovals: 2},
+				{Denom: DefaultDenom, MinAmount: math.NewInt(1000), RequiredApprovals: 3},
+			},
+			errMsg: "duplicated approval tier: ugbpv/1000",
+		},
+		{
+			name:   "invalid denom",
+			tiers:  []ApprovalTier{{Denom: "1", MinAmount: math.NewInt(1000), RequiredApprovals: 2}},
+			errMsg: "invalid approval tier denom at index 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.ApprovalTiers = tt.tiers
+			err := params.Validate()
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsValidateTradeDataTolerances(t *testing.T) {
+	tests := []struct {
+		name       string
+		tolerances TradeDataTolerances
+		errMsg     string
+	}{
+		{
+			name:       "default tolerances",
+			tolerances: DefaultTradeDataTolerances,
+		},
+		{
+			name:       "zero and full tolerances",
+			tolerances: NewTradeDataTolerances(true, math.LegacyZeroDec(), math.LegacyOneDec(), math.LegacyZeroDec()),
+		},
+		{
+			name:       "negative tolerance",
+			tolerances: NewTradeDataTolerances(true, math.LegacyNewDec(-1), math.LegacyZeroDec(), math.LegacyZeroDec()),
+			errMsg:     "trade data tolerance trade_value must be between 0 and 1",
+		},
+		{
+			name:       "tolerance above one",
+			tolerances: NewTradeDataTolerances(true, math.LegacyZeroDec(), math.LegacyNewDec(2), math.LegacyZeroDec()),
+			errMsg:     "trade data tolerance trade_net_value must be between 0 and 1",
+		},
+		{
+			name:       "nil tolerance",
+			tolerances: TradeDataTolerances{Enabled: true, TradeValue: math.LegacyZeroDec(), TradeNetValue: math.LegacyZeroDec()},
+			errMsg:     "trade data tolerance quantity must be between 0 and 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.TradeDataTolerances = tt.tolerances
+			err := params.Validate()
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsValidateSupplyBaselines(t *testing.T) {
+	tests := []struct {
+		name      string
+		baselines sdk.Coins
+		errMsg    string
+	}{
+		{
+			name:      "default baselines",
+			baselines: DefaultSupplyBaselines,
+		},
+		{
+			name:      "valid baselines",
+			baselines: sdk.NewCoins(sdk.NewInt64Coin(DefaultDenom, 1000), sdk.NewInt64Coin("ueurv", 500)),
+		},
+		{
+			name:      "zero baseline",
+			baselines: sdk.Coins{sdk.NewInt64Coin(DefaultDenom, 0)},
+			errMsg:    "invalid supply baselines",
+		},
+		{
+			name:      "unsorted baselines",
+			baselines: sdk.Coins{sdk.NewInt64Coin(DefaultDenom, 1000), sdk.NewInt64Coin("ueurv", 500)},
+			errMsg:    "invalid supply baselines",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.SupplyBaselines = tt.baselines
+			err := params.Validate()
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
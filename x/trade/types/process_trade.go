@@ -14,7 +14,14 @@ func (msg *MsgProcessTrade) validateStatus(status TradeStatus) (err error) {
 	return nil
 }
 
-func (msg *MsgProcessTrade) Validate(status TradeStatus, maker string) error {
+func (msg *MsgProcessTrade) validateRevision(revision uint64) (err error) {
+	if msg.ExpectedRevision != revision {
+		return ErrTradeRevisionMismatch.Wrapf("expected revision %d, got revision %d; the trade has been amended", msg.ExpectedRevision, revision)
+	}
+	return nil
+}
+
+func (msg *MsgProcessTrade) Validate(status TradeStatus, maker string, revision uint64) error {
 	err := msg.validateCheckerIsNotMaker(maker)
 	if err != nil {
 		return err
@@ -23,5 +30,9 @@ func (msg *MsgProcessTrade) Validate(status TradeStatus, maker string) error {
 	if err != nil {
 		return err
 	}
+	err = msg.validateRevision(revision)
+	if err != nil {
+		return err
+	}
 	return nil
 }
@@ -87,11 +87,12 @@ func TestValidate(t *testing.T) {
 	makerAdd := sample.AccAddress()
 
 	tests := []struct {
-		name   string
-		msg    MsgProcessTrade
-		maker  string
-		status TradeStatus
-		err    error
+		name     string
+		msg      MsgProcessTrade
+		maker    string
+		status   TradeStatus
+		revision uint64
+		err      error
 	}{
 		{
 			name:   "process trade with valid checker address",
@@ -146,11 +147,26 @@ func TestValidate(t *testing.T) {
 			maker:  makerAdd,
 			status: StatusPending,
 		},
+		{
+			name:     "process trade with expected revision",
+			msg:      MsgProcessTrade{Creator: checkerAdd, ExpectedRevision: 2},
+			maker:    makerAdd,
+			status:   StatusPending,
+			revision: 2,
+		},
+		{
+			name:     "process trade amended after the expected revision",
+			msg:      MsgProcessTrade{Creator: checkerAdd, ExpectedRevision: 1},
+			maker:    makerAdd,
+			status:   StatusPending,
+			revision: 2,
+			err:      ErrTradeRevisionMismatch,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := tt.msg.Validate(tt.status, tt.maker)
+			err := tt.msg.Validate(tt.status, tt.maker, tt.revision)
 			if tt.err != nil {
 				require.ErrorIs(t, err, tt.err)
 				return
@@ -5,25 +5,34 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -562,912 +571,6636 @@ func (m *QueryAllStoredTempTradeResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "vvtxchain.trade.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "vvtxchain.trade.QueryParamsResponse")
-	proto.RegisterType((*QueryGetTradeIndexRequest)(nil), "vvtxchain.trade.QueryGetTradeIndexRequest")
-	proto.RegisterType((*QueryGetTradeIndexResponse)(nil), "vvtxchain.trade.QueryGetTradeIndexResponse")
-	proto.RegisterType((*QueryGetStoredTradeRequest)(nil), "vvtxchain.trade.QueryGetStoredTradeRequest")
-	proto.RegisterType((*QueryGetStoredTradeResponse)(nil), "vvtxchain.trade.QueryGetStoredTradeResponse")
-	proto.RegisterType((*QueryAllStoredTradeRequest)(nil), "vvtxchain.trade.QueryAllStoredTradeRequest")
-	proto.RegisterType((*QueryAllStoredTradeResponse)(nil), "vvtxchain.trade.QueryAllStoredTradeResponse")
-	proto.RegisterType((*QueryGetStoredTempTradeRequest)(nil), "vvtxchain.trade.QueryGetStoredTempTradeRequest")
-	proto.RegisterType((*QueryGetStoredTempTradeResponse)(nil), "vvtxchain.trade.QueryGetStoredTempTradeResponse")
-	proto.RegisterType((*QueryAllStoredTempTradeRequest)(nil), "vvtxchain.trade.QueryAllStoredTempTradeRequest")
-	proto.RegisterType((*QueryAllStoredTempTradeResponse)(nil), "vvtxchain.trade.QueryAllStoredTempTradeResponse")
+type QueryStoredTradesByStatusRequest struct {
+	Status     TradeStatus        `protobuf:"varint,1,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("vvtxchain/trade/query.proto", fileDescriptor_330c65892d0e2c34) }
-
-var fileDescriptor_330c65892d0e2c34 = []byte{
-	// 725 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0x3b, 0xc0, 0x8f, 0x5f, 0x9c, 0x1a, 0x09, 0x23, 0x89, 0xba, 0x25, 0x0b, 0x8e, 0x0a,
-	0x04, 0x64, 0x47, 0xc0, 0x98, 0xf8, 0xef, 0xd0, 0x26, 0xd8, 0x98, 0x78, 0x80, 0xea, 0x89, 0x0b,
-	0x4e, 0xe9, 0x64, 0xd9, 0xa4, 0xdd, 0x59, 0x3a, 0x5b, 0x02, 0x31, 0x5c, 0x7c, 0x05, 0x26, 0x7a,
-	0xf3, 0x6e, 0x3c, 0x68, 0xe2, 0xcd, 0x9b, 0x67, 0x2e, 0x26, 0x24, 0x5e, 0x3c, 0x19, 0x03, 0x26,
-	0xbe, 0x0d, 0xb3, 0xb3, 0x4f, 0xe9, 0x76, 0xff, 0xd0, 0xa2, 0x5c, 0x36, 0xd3, 0x99, 0xe7, 0x79,
-	0xbe, 0x9f, 0xe7, 0x99, 0x67, 0x9e, 0x14, 0x17, 0xb6, 0xb7, 0xfd, 0x9d, 0x8d, 0x4d, 0xee, 0xb8,
-	0xcc, 0x6f, 0xf2, 0x9a, 0x60, 0x5b, 0x2d, 0xd1, 0xdc, 0xb5, 0xbc, 0xa6, 0xf4, 0x25, 0x19, 0x39,
-	0x3e, 0xb4, 0xf4, 0xa1, 0x31, 0xca, 0x1b, 0x8e, 0x2b, 0x99, 0xfe, 0x86, 0x36, 0xc6, 0x98, 0x2d,
-	0x6d, 0xa9, 0x97, 0x2c, 0x58, 0xc1, 0xee, 0xb8, 0x2d, 0xa5, 0x5d, 0x17, 0x8c, 0x7b, 0x0e, 0xe3,
-	0xae, 0x2b, 0x7d, 0xee, 0x3b, 0xd2, 0x55, 0x70, 0x3a, 0xbb, 0x21, 0x55, 0x43, 0x2a, 0x56, 0xe5,
-	0x0a, 0x04, 0xd9, 0xf6, 0x42, 0x55, 0xf8, 0x7c, 0x81, 0x79, 0xdc, 0x76, 0x5c, 0x6d, 0xdc, 0x8e,
-	0x14, 0x07, 0xf4, 0x78, 0x93, 0x37, 0xda, 0x91, 0xae, 0xc6, 0x4f, 0xf5, 0x77, 0xdd, 0x71, 0x6b,
-	0x62, 0x07, 0x4c, 0x68, 0xdc, 0x44, 0xf9, 0xb2, 0x29, 0x6a, 0xeb, 0xfa, 0x07, 0xd8, 0x4c, 0x67,
-	0xd9, 0x88, 0x86, 0x17, 0x35, 0xa4, 0x63, 0x98, 0xac, 0x06, 0xbc, 0x2b, 0x1a, 0xa2, 0x22, 0xb6,
-	0x5a, 0x42, 0xf9, 0x74, 0x15, 0x5f, 0xec, 0xda, 0x55, 0x9e, 0x74, 0x95, 0x20, 0xf7, 0xf0, 0x70,
-	0x08, 0x7b, 0x19, 0x4d, 0xa2, 0x99, 0xfc, 0xe2, 0x25, 0x2b, 0x56, 0x4f, 0x2b, 0x74, 0x28, 0x9d,
-	0xdb, 0xff, 0x31, 0x91, 0x7b, 0xff, 0xfb, 0xd3, 0x2c, 0xaa, 0x80, 0x07, 0x2d, 0xe0, 0x2b, 0x3a,
-	0x64, 0x59, 0xf8, 0xcf, 0x02, 0xd3, 0xc7, 0x41, 0x46, 0x6d, 0xbd, 0xe7, 0xd8, 0x48, 0x3b, 0x04,
-	0xd9, 0x12, 0xce, 0x47, 0xaa, 0x00, 0xda, 0x85, 0x84, 0x76, 0xc7, 0xb3, 0x34, 0x14, 0xe8, 0x57,
-	0xb0, 0x7f, 0xbc, 0x43, 0x1f, 0x76, 0x14, 0x9e, 0xea, 0x52, 0x68, 0x6b, 0xd0, 0x27, 0x13, 0x49,
-	0x85, 0xa1, 0x2e, 0xf7, 0x1a, 0x2e, 0xa4, 0xba, 0x03, 0xe1, 0x32, 0x3e, 0x1f, 0xbd, 0x04, 0x40,
-	0x1c, 0x4f, 0x20, 0x46, 0x7c, 0x81, 0x31, 0xaf, 0x3a, 0x5b, 0xb4, 0x06, 0x90, 0xc5, 0x7a, 0x3d,
-	0x05, 0xf2, 0x11, 0xc6, 0x9d, 0x66, 0x02, 0x89, 0x29, 0x2b, 0xec, 0x3c, 0x2b, 0xe8, 0x3c, 0x2b,
-	0x6c, 0x75, 0xe8, 0x3c, 0x6b, 0x85, 0xdb, 0x6d, 0xdf, 0x4a, 0xc4, 0x93, 0x7e, 0x44, 0x90, 0x4c,
-	0x5c, 0x26, 0x33, 0x99, 0xc1, 0xbf, 0x48, 0x86, 0x94, 0xbb, 0x70, 0x07, 0x34, 0xee, 0x74, 0x4f,
-	0xdc, 0x90, 0xa1, 0x8b, 0xb7, 0x88, 0xcd, 0x58, 0xed, 0x45, 0xc3, 0x3b, 0xdd, 0xf5, 0xb5, 0xf0,
-	0x44, 0x66, 0x08, 0xc8, 0xba, 0x82, 0x47, 0x13, 0x6f, 0x04, 0x8a, 0x3c, 0x99, 0x95, 0x7a, 0x3b,
-	0x08, 0xa4, 0x3f, 0xa2, 0xba, 0xb7, 0xe9, 0x26, 0x90, 0x77, 0x0a, 0x1d, 0x27, 0x3f, 0xab, 0x3b,
-	0xfd, 0x82, 0x20, 0xc3, 0x34, 0xa9, 0x93, 0x33, 0x1c, 0xfc, 0x87, 0x0c, 0xcf, 0xec, 0x92, 0x17,
-	0xbf, 0xfe, 0x8f, 0xff, 0xd3, 0x09, 0x90, 0x3d, 0x3c, 0x1c, 0x4e, 0x11, 0x72, 0x2d, 0x41, 0x95,
-	0x1c, 0x55, 0xc6, 0xf5, 0x93, 0x8d, 0x42, 0x29, 0x3a, 0xf3, 0xf2, 0xdb, 0xaf, 0xd7, 0x03, 0x94,
-	0x4c, 0xb2, 0x72, 0x79, 0x79, 0xed, 0x09, 0xaf, 0x2a, 0x96, 0x3e, 0x86, 0xc9, 0x1b, 0x84, 0x71,
-	0x67, 0x92, 0x90, 0xd9, 0xf4, 0xf0, 0x69, 0x53, 0xcc, 0x98, 0xeb, 0xcb, 0x16, 0x88, 0xe6, 0x35,
-	0xd1, 0x34, 0xb9, 0x91, 0x4d, 0x14, 0xe9, 0x69, 0xf2, 0x0e, 0xe1, 0x7c, 0xe4, 0xc1, 0x91, 0x6c,
-	0xad, 0xe4, 0xe4, 0x30, 0x6e, 0xf6, 0x67, 0x0c, 0x64, 0x0f, 0x34, 0xd9, 0x1d, 0x72, 0x3b, 0x9b,
-	0x2c, 0x3a, 0x1f, 0xd8, 0x8b, 0x08, 0xe7, 0x1e, 0x79, 0x8b, 0xf0, 0x85, 0x48, 0xd4, 0x62, 0xbd,
-	0x9e, 0xc5, 0x9a, 0x3a, 0xe5, 0xb2, 0x58, 0xd3, 0x67, 0x15, 0xb5, 0x34, 0xeb, 0x0c, 0x99, 0xea,
-	0x8f, 0x95, 0x7c, 0x46, 0x78, 0x24, 0xd6, 0xda, 0x84, 0xf5, 0xaa, 0x4e, 0xec, 0xd1, 0x1a, 0xb7,
-	0xfa, 0x77, 0x00, 0xcc, 0xa2, 0xc6, 0xbc, 0x4f, 0xee, 0xf6, 0xc6, 0x3c, 0x7e, 0x9a, 0xb1, 0xba,
-	0x7e, 0x40, 0x98, 0xc4, 0xc2, 0x07, 0xb5, 0x65, 0xbd, 0xca, 0xd5, 0x27, 0x7c, 0xf6, 0xdc, 0xa0,
-	0x4b, 0x1a, 0x7e, 0x9e, 0xcc, 0x9d, 0x02, 0xbe, 0xb4, 0xbc, 0x7f, 0x68, 0xa2, 0x83, 0x43, 0x13,
-	0xfd, 0x3c, 0x34, 0xd1, 0xab, 0x23, 0x33, 0x77, 0x70, 0x64, 0xe6, 0xbe, 0x1f, 0x99, 0xb9, 0xb5,
-	0x39, 0xdb, 0xf1, 0x37, 0x5b, 0x55, 0x6b, 0x43, 0x36, 0xd2, 0x02, 0xee, 0xb4, 0x9b, 0x7f, 0xd7,
-	0x13, 0xaa, 0x3a, 0xac, 0xff, 0xa5, 0x2c, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x7e, 0x77, 0x0e,
-	0xc6, 0xd6, 0x09, 0x00, 0x00,
+func (m *QueryStoredTradesByStatusRequest) Reset()         { *m = QueryStoredTradesByStatusRequest{} }
+func (m *QueryStoredTradesByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStoredTradesByStatusRequest) ProtoMessage()    {}
+func (*QueryStoredTradesByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{12}
+}
+func (m *QueryStoredTradesByStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoredTradesByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredTradesByStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStoredTradesByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredTradesByStatusRequest.Merge(m, src)
+}
+func (m *QueryStoredTradesByStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoredTradesByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredTradesByStatusRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryStoredTradesByStatusRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a TradeIndex by index.
-	TradeIndex(ctx context.Context, in *QueryGetTradeIndexRequest, opts ...grpc.CallOption) (*QueryGetTradeIndexResponse, error)
-	// Queries a list of StoredTrade items.
-	StoredTrade(ctx context.Context, in *QueryGetStoredTradeRequest, opts ...grpc.CallOption) (*QueryGetStoredTradeResponse, error)
-	StoredTradeAll(ctx context.Context, in *QueryAllStoredTradeRequest, opts ...grpc.CallOption) (*QueryAllStoredTradeResponse, error)
-	// Queries a list of StoredTempTrade items.
-	StoredTempTrade(ctx context.Context, in *QueryGetStoredTempTradeRequest, opts ...grpc.CallOption) (*QueryGetStoredTempTradeResponse, error)
-	StoredTempTradeAll(ctx context.Context, in *QueryAllStoredTempTradeRequest, opts ...grpc.CallOption) (*QueryAllStoredTempTradeResponse, error)
+func (m *QueryStoredTradesByStatusRequest) GetStatus() TradeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryStoredTradesByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryStoredTradesByStatusResponse struct {
+	StoredTrade []StoredTrade       `protobuf:"bytes,1,rep,name=stored_trade,json=storedTrade,proto3" json:"stored_trade"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStoredTradesByStatusResponse) Reset()         { *m = QueryStoredTradesByStatusResponse{} }
+func (m *QueryStoredTradesByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStoredTradesByStatusResponse) ProtoMessage()    {}
+func (*QueryStoredTradesByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{13}
+}
+func (m *QueryStoredTradesByStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoredTradesByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredTradesByStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryStoredTradesByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredTradesByStatusResponse.Merge(m, src)
+}
+func (m *QueryStoredTradesByStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoredTradesByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredTradesByStatusResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TradeIndex(ctx context.Context, in *QueryGetTradeIndexRequest, opts ...grpc.CallOption) (*QueryGetTradeIndexResponse, error) {
-	out := new(QueryGetTradeIndexResponse)
-	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/TradeIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStoredTradesByStatusResponse proto.InternalMessageInfo
+
+func (m *QueryStoredTradesByStatusResponse) GetStoredTrade() []StoredTrade {
+	if m != nil {
+		return m.StoredTrade
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) StoredTrade(ctx context.Context, in *QueryGetStoredTradeRequest, opts ...grpc.CallOption) (*QueryGetStoredTradeResponse, error) {
-	out := new(QueryGetStoredTradeResponse)
-	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/StoredTrade", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStoredTradesByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) StoredTradeAll(ctx context.Context, in *QueryAllStoredTradeRequest, opts ...grpc.CallOption) (*QueryAllStoredTradeResponse, error) {
-	out := new(QueryAllStoredTradeResponse)
-	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/StoredTradeAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryStoredTradesByMakerRequest struct {
+	Maker      string             `protobuf:"bytes,1,opt,name=maker,proto3" json:"maker,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) StoredTempTrade(ctx context.Context, in *QueryGetStoredTempTradeRequest, opts ...grpc.CallOption) (*QueryGetStoredTempTradeResponse, error) {
-	out := new(QueryGetStoredTempTradeResponse)
-	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/StoredTempTrade", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStoredTradesByMakerRequest) Reset()         { *m = QueryStoredTradesByMakerRequest{} }
+func (m *QueryStoredTradesByMakerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStoredTradesByMakerRequest) ProtoMessage()    {}
+func (*QueryStoredTradesByMakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{14}
+}
+func (m *QueryStoredTradesByMakerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoredTradesByMakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredTradesByMakerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryStoredTradesByMakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredTradesByMakerRequest.Merge(m, src)
+}
+func (m *QueryStoredTradesByMakerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoredTradesByMakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredTradesByMakerRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) StoredTempTradeAll(ctx context.Context, in *QueryAllStoredTempTradeRequest, opts ...grpc.CallOption) (*QueryAllStoredTempTradeResponse, error) {
-	out := new(QueryAllStoredTempTradeResponse)
-	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/StoredTempTradeAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStoredTradesByMakerRequest proto.InternalMessageInfo
+
+func (m *QueryStoredTradesByMakerRequest) GetMaker() string {
+	if m != nil {
+		return m.Maker
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a TradeIndex by index.
-	TradeIndex(context.Context, *QueryGetTradeIndexRequest) (*QueryGetTradeIndexResponse, error)
-	// Queries a list of StoredTrade items.
-	StoredTrade(context.Context, *QueryGetStoredTradeRequest) (*QueryGetStoredTradeResponse, error)
-	StoredTradeAll(context.Context, *QueryAllStoredTradeRequest) (*QueryAllStoredTradeResponse, error)
-	// Queries a list of StoredTempTrade items.
-	StoredTempTrade(context.Context, *QueryGetStoredTempTradeRequest) (*QueryGetStoredTempTradeResponse, error)
-	StoredTempTradeAll(context.Context, *QueryAllStoredTempTradeRequest) (*QueryAllStoredTempTradeResponse, error)
+func (m *QueryStoredTradesByMakerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryStoredTradesByMakerResponse struct {
+	StoredTrade []StoredTrade       `protobuf:"bytes,1,rep,name=stored_trade,json=storedTrade,proto3" json:"stored_trade"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryStoredTradesByMakerResponse) Reset()         { *m = QueryStoredTradesByMakerResponse{} }
+func (m *QueryStoredTradesByMakerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStoredTradesByMakerResponse) ProtoMessage()    {}
+func (*QueryStoredTradesByMakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{15}
 }
-func (*UnimplementedQueryServer) TradeIndex(ctx context.Context, req *QueryGetTradeIndexRequest) (*QueryGetTradeIndexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TradeIndex not implemented")
+func (m *QueryStoredTradesByMakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) StoredTrade(ctx context.Context, req *QueryGetStoredTradeRequest) (*QueryGetStoredTradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoredTrade not implemented")
+func (m *QueryStoredTradesByMakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredTradesByMakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) StoredTradeAll(ctx context.Context, req *QueryAllStoredTradeRequest) (*QueryAllStoredTradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoredTradeAll not implemented")
+func (m *QueryStoredTradesByMakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredTradesByMakerResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) StoredTempTrade(ctx context.Context, req *QueryGetStoredTempTradeRequest) (*QueryGetStoredTempTradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoredTempTrade not implemented")
+func (m *QueryStoredTradesByMakerResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) StoredTempTradeAll(ctx context.Context, req *QueryAllStoredTempTradeRequest) (*QueryAllStoredTempTradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StoredTempTradeAll not implemented")
+func (m *QueryStoredTradesByMakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredTradesByMakerResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryStoredTradesByMakerResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vvtxchain.trade.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryStoredTradesByMakerResponse) GetStoredTrade() []StoredTrade {
+	if m != nil {
+		return m.StoredTrade
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TradeIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTradeIndexRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TradeIndex(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vvtxchain.trade.Query/TradeIndex",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TradeIndex(ctx, req.(*QueryGetTradeIndexRequest))
+func (m *QueryStoredTradesByMakerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_StoredTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetStoredTradeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StoredTrade(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vvtxchain.trade.Query/StoredTrade",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StoredTrade(ctx, req.(*QueryGetStoredTradeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryStoredTradesByCheckerRequest struct {
+	Checker    string             `protobuf:"bytes,1,opt,name=checker,proto3" json:"checker,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_StoredTradeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllStoredTradeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StoredTradeAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vvtxchain.trade.Query/StoredTradeAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StoredTradeAll(ctx, req.(*QueryAllStoredTradeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryStoredTradesByCheckerRequest) Reset()         { *m = QueryStoredTradesByCheckerRequest{} }
+func (m *QueryStoredTradesByCheckerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStoredTradesByCheckerRequest) ProtoMessage()    {}
+func (*QueryStoredTradesByCheckerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{16}
 }
-
-func _Query_StoredTempTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetStoredTempTradeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StoredTempTrade(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vvtxchain.trade.Query/StoredTempTrade",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StoredTempTrade(ctx, req.(*QueryGetStoredTempTradeRequest))
+func (m *QueryStoredTradesByCheckerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoredTradesByCheckerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredTradesByCheckerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryStoredTradesByCheckerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredTradesByCheckerRequest.Merge(m, src)
+}
+func (m *QueryStoredTradesByCheckerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoredTradesByCheckerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredTradesByCheckerRequest.DiscardUnknown(m)
 }
 
-func _Query_StoredTempTradeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllStoredTempTradeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StoredTempTradeAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vvtxchain.trade.Query/StoredTempTradeAll",
+var xxx_messageInfo_QueryStoredTradesByCheckerRequest proto.InternalMessageInfo
+
+func (m *QueryStoredTradesByCheckerRequest) GetChecker() string {
+	if m != nil {
+		return m.Checker
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StoredTempTradeAll(ctx, req.(*QueryAllStoredTempTradeRequest))
+	return ""
+}
+
+func (m *QueryStoredTradesByCheckerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "vvtxchain.trade.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "TradeIndex",
-			Handler:    _Query_TradeIndex_Handler,
-		},
-		{
-			MethodName: "StoredTrade",
-			Handler:    _Query_StoredTrade_Handler,
-		},
-		{
-			MethodName: "StoredTradeAll",
-			Handler:    _Query_StoredTradeAll_Handler,
-		},
-		{
-			MethodName: "StoredTempTrade",
-			Handler:    _Query_StoredTempTrade_Handler,
-		},
-		{
-			MethodName: "StoredTempTradeAll",
-			Handler:    _Query_StoredTempTradeAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "vvtxchain/trade/query.proto",
+type QueryStoredTradesByCheckerResponse struct {
+	StoredTrade []StoredTrade       `protobuf:"bytes,1,rep,name=stored_trade,json=storedTrade,proto3" json:"stored_trade"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStoredTradesByCheckerResponse) Reset()         { *m = QueryStoredTradesByCheckerResponse{} }
+func (m *QueryStoredTradesByCheckerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStoredTradesByCheckerResponse) ProtoMessage()    {}
+func (*QueryStoredTradesByCheckerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{17}
+}
+func (m *QueryStoredTradesByCheckerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoredTradesByCheckerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredTradesByCheckerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStoredTradesByCheckerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredTradesByCheckerResponse.Merge(m, src)
+}
+func (m *QueryStoredTradesByCheckerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoredTradesByCheckerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredTradesByCheckerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryStoredTradesByCheckerResponse proto.InternalMessageInfo
+
+func (m *QueryStoredTradesByCheckerResponse) GetStoredTrade() []StoredTrade {
+	if m != nil {
+		return m.StoredTrade
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStoredTradesByCheckerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStoredTradesByReceiverRequest struct {
+	ReceiverAddress string             `protobuf:"bytes,1,opt,name=receiver_address,json=receiverAddress,proto3" json:"receiver_address,omitempty"`
+	Pagination      *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryStoredTradesByReceiverRequest) Reset()         { *m = QueryStoredTradesByReceiverRequest{} }
+func (m *QueryStoredTradesByReceiverRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStoredTradesByReceiverRequest) ProtoMessage()    {}
+func (*QueryStoredTradesByReceiverRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{18}
+}
+func (m *QueryStoredTradesByReceiverRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoredTradesByReceiverRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredTradesByReceiverRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetTradeIndexRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryStoredTradesByReceiverRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredTradesByReceiverRequest.Merge(m, src)
 }
-
-func (m *QueryGetTradeIndexRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStoredTradesByReceiverRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoredTradesByReceiverRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredTradesByReceiverRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetTradeIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryStoredTradesByReceiverRequest proto.InternalMessageInfo
+
+func (m *QueryStoredTradesByReceiverRequest) GetReceiverAddress() string {
+	if m != nil {
+		return m.ReceiverAddress
+	}
+	return ""
 }
 
-func (m *QueryGetTradeIndexResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStoredTradesByReceiverRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetTradeIndexResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStoredTradesByReceiverResponse struct {
+	StoredTrade []StoredTrade       `protobuf:"bytes,1,rep,name=stored_trade,json=storedTrade,proto3" json:"stored_trade"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetTradeIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TradeIndex.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryStoredTradesByReceiverResponse) Reset()         { *m = QueryStoredTradesByReceiverResponse{} }
+func (m *QueryStoredTradesByReceiverResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStoredTradesByReceiverResponse) ProtoMessage()    {}
+func (*QueryStoredTradesByReceiverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{19}
+}
+func (m *QueryStoredTradesByReceiverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoredTradesByReceiverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredTradesByReceiverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetStoredTradeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryStoredTradesByReceiverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredTradesByReceiverResponse.Merge(m, src)
 }
-
-func (m *QueryGetStoredTradeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStoredTradesByReceiverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoredTradesByReceiverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredTradesByReceiverResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetStoredTradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TradeIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TradeIndex))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryStoredTradesByReceiverResponse proto.InternalMessageInfo
+
+func (m *QueryStoredTradesByReceiverResponse) GetStoredTrade() []StoredTrade {
+	if m != nil {
+		return m.StoredTrade
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetStoredTradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStoredTradesByReceiverResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetStoredTradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStoredTradesByDateRangeRequest struct {
+	DateField TradeDateField `protobuf:"varint,1,opt,name=date_field,json=dateField,proto3,enum=vvtxchain.trade.TradeDateField" json:"date_field,omitempty"`
+	From      time.Time      `protobuf:"bytes,2,opt,name=from,proto3,stdtime" json:"from"`
+	To        time.Time      `protobuf:"bytes,3,opt,name=to,proto3,stdtime" json:"to"`
+	// status filters the trades by status, TRADE_STATUS_UNSPECIFIED returns trades of any status.
+	Status     TradeStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetStoredTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.StoredTrade.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryStoredTradesByDateRangeRequest) Reset()         { *m = QueryStoredTradesByDateRangeRequest{} }
+func (m *QueryStoredTradesByDateRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStoredTradesByDateRangeRequest) ProtoMessage()    {}
+func (*QueryStoredTradesByDateRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{20}
+}
+func (m *QueryStoredTradesByDateRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoredTradesByDateRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredTradesByDateRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryStoredTradesByDateRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredTradesByDateRangeRequest.Merge(m, src)
+}
+func (m *QueryStoredTradesByDateRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoredTradesByDateRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredTradesByDateRangeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllStoredTradeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStoredTradesByDateRangeRequest proto.InternalMessageInfo
+
+func (m *QueryStoredTradesByDateRangeRequest) GetDateField() TradeDateField {
+	if m != nil {
+		return m.DateField
 	}
-	return dAtA[:n], nil
+	return TradeDateField_TRADE_DATE_FIELD_UNSPECIFIED
 }
 
-func (m *QueryAllStoredTradeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStoredTradesByDateRangeRequest) GetFrom() time.Time {
+	if m != nil {
+		return m.From
+	}
+	return time.Time{}
 }
 
-func (m *QueryAllStoredTradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryStoredTradesByDateRangeRequest) GetTo() time.Time {
+	if m != nil {
+		return m.To
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *QueryAllStoredTradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStoredTradesByDateRangeRequest) GetStatus() TradeStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
 }
 
-func (m *QueryAllStoredTradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStoredTradesByDateRangeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllStoredTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StoredTrade) > 0 {
-		for iNdEx := len(m.StoredTrade) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StoredTrade[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type QueryStoredTradesByDateRangeResponse struct {
+	StoredTrade []StoredTrade       `protobuf:"bytes,1,rep,name=stored_trade,json=storedTrade,proto3" json:"stored_trade"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetStoredTempTradeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStoredTradesByDateRangeResponse) Reset()         { *m = QueryStoredTradesByDateRangeResponse{} }
+func (m *QueryStoredTradesByDateRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStoredTradesByDateRangeResponse) ProtoMessage()    {}
+func (*QueryStoredTradesByDateRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{21}
+}
+func (m *QueryStoredTradesByDateRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoredTradesByDateRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredTradesByDateRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetStoredTempTradeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStoredTradesByDateRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredTradesByDateRangeResponse.Merge(m, src)
+}
+func (m *QueryStoredTradesByDateRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoredTradesByDateRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredTradesByDateRangeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetStoredTempTradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TradeIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TradeIndex))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryStoredTradesByDateRangeResponse proto.InternalMessageInfo
+
+func (m *QueryStoredTradesByDateRangeResponse) GetStoredTrade() []StoredTrade {
+	if m != nil {
+		return m.StoredTrade
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetStoredTempTradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStoredTradesByDateRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetStoredTempTradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStoredTradeByExternalReferenceRequest struct {
+	ExternalReference string `protobuf:"bytes,1,opt,name=external_reference,json=externalReference,proto3" json:"external_reference,omitempty"`
 }
 
-func (m *QueryGetStoredTempTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.StoredTempTrade.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryStoredTradeByExternalReferenceRequest) Reset() {
+	*m = QueryStoredTradeByExternalReferenceRequest{}
+}
+func (m *QueryStoredTradeByExternalReferenceRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryStoredTradeByExternalReferenceRequest) ProtoMessage() {}
+func (*QueryStoredTradeByExternalReferenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{22}
+}
+func (m *QueryStoredTradeByExternalReferenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoredTradeByExternalReferenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredTradeByExternalReferenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryStoredTradeByExternalReferenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredTradeByExternalReferenceRequest.Merge(m, src)
+}
+func (m *QueryStoredTradeByExternalReferenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoredTradeByExternalReferenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredTradeByExternalReferenceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllStoredTempTradeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStoredTradeByExternalReferenceRequest proto.InternalMessageInfo
+
+func (m *QueryStoredTradeByExternalReferenceRequest) GetExternalReference() string {
+	if m != nil {
+		return m.ExternalReference
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllStoredTempTradeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStoredTradeByExternalReferenceResponse struct {
+	StoredTrade StoredTrade `protobuf:"bytes,1,opt,name=stored_trade,json=storedTrade,proto3" json:"stored_trade"`
 }
 
-func (m *QueryAllStoredTempTradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryStoredTradeByExternalReferenceResponse) Reset() {
+	*m = QueryStoredTradeByExternalReferenceResponse{}
+}
+func (m *QueryStoredTradeByExternalReferenceResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryStoredTradeByExternalReferenceResponse) ProtoMessage() {}
+func (*QueryStoredTradeByExternalReferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{23}
+}
+func (m *QueryStoredTradeByExternalReferenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStoredTradeByExternalReferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStoredTradeByExternalReferenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryStoredTradeByExternalReferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStoredTradeByExternalReferenceResponse.Merge(m, src)
+}
+func (m *QueryStoredTradeByExternalReferenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStoredTradeByExternalReferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStoredTradeByExternalReferenceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllStoredTempTradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStoredTradeByExternalReferenceResponse proto.InternalMessageInfo
+
+func (m *QueryStoredTradeByExternalReferenceResponse) GetStoredTrade() StoredTrade {
+	if m != nil {
+		return m.StoredTrade
 	}
-	return dAtA[:n], nil
+	return StoredTrade{}
 }
 
-func (m *QueryAllStoredTempTradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryMintBurnCapacityRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Maker string `protobuf:"bytes,2,opt,name=maker,proto3" json:"maker,omitempty"`
 }
 
-func (m *QueryAllStoredTempTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StoredTempTrade) > 0 {
-		for iNdEx := len(m.StoredTempTrade) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StoredTempTrade[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMintBurnCapacityRequest) Reset()         { *m = QueryMintBurnCapacityRequest{} }
+func (m *QueryMintBurnCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintBurnCapacityRequest) ProtoMessage()    {}
+func (*QueryMintBurnCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{24}
+}
+func (m *QueryMintBurnCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintBurnCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintBurnCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMintBurnCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintBurnCapacityRequest.Merge(m, src)
+}
+func (m *QueryMintBurnCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintBurnCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintBurnCapacityRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryMintBurnCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryMintBurnCapacityRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryMintBurnCapacityRequest) GetMaker() string {
+	if m != nil {
+		return m.Maker
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// MintBurnCapacity is the volume used and remaining in the window of a mint or burn limit.
+type MintBurnCapacity struct {
+	// limit is zero when the volume is not capped.
+	Limit cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+	Used  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=used,proto3,customtype=cosmossdk.io/math.Int" json:"used"`
+	// remaining is zero when the volume is not capped.
+	Remaining cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remaining,proto3,customtype=cosmossdk.io/math.Int" json:"remaining"`
 }
 
-func (m *QueryGetTradeIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MintBurnCapacity) Reset()         { *m = MintBurnCapacity{} }
+func (m *MintBurnCapacity) String() string { return proto.CompactTextString(m) }
+func (*MintBurnCapacity) ProtoMessage()    {}
+func (*MintBurnCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{25}
+}
+func (m *MintBurnCapacity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintBurnCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintBurnCapacity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MintBurnCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintBurnCapacity.Merge(m, src)
+}
+func (m *MintBurnCapacity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintBurnCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintBurnCapacity.DiscardUnknown(m)
 }
 
-func (m *QueryGetTradeIndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MintBurnCapacity proto.InternalMessageInfo
+
+type QueryMintBurnCapacityResponse struct {
+	Denom      string           `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Window     time.Duration    `protobuf:"bytes,2,opt,name=window,proto3,stdduration" json:"window"`
+	MakerMint  MintBurnCapacity `protobuf:"bytes,3,opt,name=maker_mint,json=makerMint,proto3" json:"maker_mint"`
+	MakerBurn  MintBurnCapacity `protobuf:"bytes,4,opt,name=maker_burn,json=makerBurn,proto3" json:"maker_burn"`
+	GlobalMint MintBurnCapacity `protobuf:"bytes,5,opt,name=global_mint,json=globalMint,proto3" json:"global_mint"`
+	GlobalBurn MintBurnCapacity `protobuf:"bytes,6,opt,name=global_burn,json=globalBurn,proto3" json:"global_burn"`
+}
+
+func (m *QueryMintBurnCapacityResponse) Reset()         { *m = QueryMintBurnCapacityResponse{} }
+func (m *QueryMintBurnCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintBurnCapacityResponse) ProtoMessage()    {}
+func (*QueryMintBurnCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{26}
+}
+func (m *QueryMintBurnCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintBurnCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintBurnCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.TradeIndex.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryMintBurnCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintBurnCapacityResponse.Merge(m, src)
+}
+func (m *QueryMintBurnCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintBurnCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintBurnCapacityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetStoredTradeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryMintBurnCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryMintBurnCapacityResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	if m.TradeIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TradeIndex))
+	return ""
+}
+
+func (m *QueryMintBurnCapacityResponse) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
 	}
-	return n
+	return 0
 }
 
-func (m *QueryGetStoredTradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMintBurnCapacityResponse) GetMakerMint() MintBurnCapacity {
+	if m != nil {
+		return m.MakerMint
 	}
-	var l int
-	_ = l
-	l = m.StoredTrade.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return MintBurnCapacity{}
 }
 
-func (m *QueryAllStoredTradeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMintBurnCapacityResponse) GetMakerBurn() MintBurnCapacity {
+	if m != nil {
+		return m.MakerBurn
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return MintBurnCapacity{}
+}
+
+func (m *QueryMintBurnCapacityResponse) GetGlobalMint() MintBurnCapacity {
+	if m != nil {
+		return m.GlobalMint
 	}
-	return n
+	return MintBurnCapacity{}
 }
 
-func (m *QueryAllStoredTradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMintBurnCapacityResponse) GetGlobalBurn() MintBurnCapacity {
+	if m != nil {
+		return m.GlobalBurn
 	}
-	var l int
-	_ = l
-	if len(m.StoredTrade) > 0 {
-		for _, e := range m.StoredTrade {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return MintBurnCapacity{}
+}
+
+type QueryTradeHistoryRequest struct {
+	TradeIndex uint64             `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTradeHistoryRequest) Reset()         { *m = QueryTradeHistoryRequest{} }
+func (m *QueryTradeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTradeHistoryRequest) ProtoMessage()    {}
+func (*QueryTradeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{27}
+}
+func (m *QueryTradeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryTradeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradeHistoryRequest.Merge(m, src)
+}
+func (m *QueryTradeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradeHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetStoredTempTradeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TradeIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TradeIndex))
+var xxx_messageInfo_QueryTradeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryTradeHistoryRequest) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
 	}
-	return n
+	return 0
 }
 
-func (m *QueryGetStoredTempTradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTradeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.StoredTempTrade.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllStoredTempTradeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryTradeHistoryResponse struct {
+	Entries    []TradeHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllStoredTempTradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.StoredTempTrade) > 0 {
-		for _, e := range m.StoredTempTrade {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTradeHistoryResponse) Reset()         { *m = QueryTradeHistoryResponse{} }
+func (m *QueryTradeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTradeHistoryResponse) ProtoMessage()    {}
+func (*QueryTradeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{28}
+}
+func (m *QueryTradeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryTradeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradeHistoryResponse.Merge(m, src)
+}
+func (m *QueryTradeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTradeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryTradeHistoryResponse) GetEntries() []TradeHistoryEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return n
+	return nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryTradeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+type QueryGetTradeEscrowRequest struct {
+	TradeIndex uint64 `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+}
+
+func (m *QueryGetTradeEscrowRequest) Reset()         { *m = QueryGetTradeEscrowRequest{} }
+func (m *QueryGetTradeEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTradeEscrowRequest) ProtoMessage()    {}
+func (*QueryGetTradeEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{29}
+}
+func (m *QueryGetTradeEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTradeEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTradeEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTradeEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTradeEscrowRequest.Merge(m, src)
+}
+func (m *QueryGetTradeEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTradeEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTradeEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTradeEscrowRequest proto.InternalMessageInfo
+
+func (m *QueryGetTradeEscrowRequest) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
+	}
+	return 0
+}
+
+type QueryGetTradeEscrowResponse struct {
+	TradeEscrow TradeEscrow `protobuf:"bytes,1,opt,name=trade_escrow,json=tradeEscrow,proto3" json:"trade_escrow"`
+}
+
+func (m *QueryGetTradeEscrowResponse) Reset()         { *m = QueryGetTradeEscrowResponse{} }
+func (m *QueryGetTradeEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTradeEscrowResponse) ProtoMessage()    {}
+func (*QueryGetTradeEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{30}
+}
+func (m *QueryGetTradeEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTradeEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTradeEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTradeEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTradeEscrowResponse.Merge(m, src)
+}
+func (m *QueryGetTradeEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTradeEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTradeEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTradeEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryGetTradeEscrowResponse) GetTradeEscrow() TradeEscrow {
+	if m != nil {
+		return m.TradeEscrow
+	}
+	return TradeEscrow{}
+}
+
+type QueryAllTradeEscrowRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTradeEscrowRequest) Reset()         { *m = QueryAllTradeEscrowRequest{} }
+func (m *QueryAllTradeEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTradeEscrowRequest) ProtoMessage()    {}
+func (*QueryAllTradeEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{31}
+}
+func (m *QueryAllTradeEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTradeEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTradeEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTradeEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTradeEscrowRequest.Merge(m, src)
+}
+func (m *QueryAllTradeEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTradeEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTradeEscrowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTradeEscrowRequest proto.InternalMessageInfo
+
+func (m *QueryAllTradeEscrowRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllTradeEscrowResponse struct {
+	TradeEscrow []TradeEscrow       `protobuf:"bytes,1,rep,name=trade_escrow,json=tradeEscrow,proto3" json:"trade_escrow"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// balance is the balance held in escrow by the module account.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *QueryAllTradeEscrowResponse) Reset()         { *m = QueryAllTradeEscrowResponse{} }
+func (m *QueryAllTradeEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTradeEscrowResponse) ProtoMessage()    {}
+func (*QueryAllTradeEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{32}
+}
+func (m *QueryAllTradeEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTradeEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTradeEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTradeEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTradeEscrowResponse.Merge(m, src)
+}
+func (m *QueryAllTradeEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTradeEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTradeEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTradeEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryAllTradeEscrowResponse) GetTradeEscrow() []TradeEscrow {
+	if m != nil {
+		return m.TradeEscrow
+	}
+	return nil
+}
+
+func (m *QueryAllTradeEscrowResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryAllTradeEscrowResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+type QuerySupplyReconciliationRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QuerySupplyReconciliationRequest) Reset()         { *m = QuerySupplyReconciliationRequest{} }
+func (m *QuerySupplyReconciliationRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyReconciliationRequest) ProtoMessage()    {}
+func (*QuerySupplyReconciliationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{33}
+}
+func (m *QuerySupplyReconciliationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyReconciliationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyReconciliationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyReconciliationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyReconciliationRequest.Merge(m, src)
+}
+func (m *QuerySupplyReconciliationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyReconciliationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyReconciliationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyReconciliationRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyReconciliationRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QuerySupplyReconciliationResponse compares the supply expected from the
+// processed trades with the supply of the bank module.
+type QuerySupplyReconciliationResponse struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// baseline is the supply_baselines parameter of the denom.
+	Baseline cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=baseline,proto3,customtype=cosmossdk.io/math.Int" json:"baseline"`
+	// processed_deposits is the amount minted by the processed deposits.
+	ProcessedDeposits cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=processed_deposits,json=processedDeposits,proto3,customtype=cosmossdk.io/math.Int" json:"processed_deposits"`
+	// processed_withdrawals is the amount burned by the processed withdrawals.
+	ProcessedWithdrawals cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=processed_withdrawals,json=processedWithdrawals,proto3,customtype=cosmossdk.io/math.Int" json:"processed_withdrawals"`
+	// expected_supply is the baseline plus the processed deposits minus the
+	// processed withdrawals.
+	ExpectedSupply cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=expected_supply,json=expectedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"expected_supply"`
+	ActualSupply   cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=actual_supply,json=actualSupply,proto3,customtype=cosmossdk.io/math.Int" json:"actual_supply"`
+	// reconciled is true when the actual supply is the expected supply.
+	Reconciled bool `protobuf:"varint,7,opt,name=reconciled,proto3" json:"reconciled,omitempty"`
+}
+
+func (m *QuerySupplyReconciliationResponse) Reset()         { *m = QuerySupplyReconciliationResponse{} }
+func (m *QuerySupplyReconciliationResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyReconciliationResponse) ProtoMessage()    {}
+func (*QuerySupplyReconciliationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_330c65892d0e2c34, []int{34}
+}
+func (m *QuerySupplyReconciliationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyReconciliationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyReconciliationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyReconciliationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyReconciliationResponse.Merge(m, src)
+}
+func (m *QuerySupplyReconciliationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyReconciliationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyReconciliationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyReconciliationResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyReconciliationResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QuerySupplyReconciliationResponse) GetReconciled() bool {
+	if m != nil {
+		return m.Reconciled
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "vvtxchain.trade.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "vvtxchain.trade.QueryParamsResponse")
+	proto.RegisterType((*QueryGetTradeIndexRequest)(nil), "vvtxchain.trade.QueryGetTradeIndexRequest")
+	proto.RegisterType((*QueryGetTradeIndexResponse)(nil), "vvtxchain.trade.QueryGetTradeIndexResponse")
+	proto.RegisterType((*QueryGetStoredTradeRequest)(nil), "vvtxchain.trade.QueryGetStoredTradeRequest")
+	proto.RegisterType((*QueryGetStoredTradeResponse)(nil), "vvtxchain.trade.QueryGetStoredTradeResponse")
+	proto.RegisterType((*QueryAllStoredTradeRequest)(nil), "vvtxchain.trade.QueryAllStoredTradeRequest")
+	proto.RegisterType((*QueryAllStoredTradeResponse)(nil), "vvtxchain.trade.QueryAllStoredTradeResponse")
+	proto.RegisterType((*QueryGetStoredTempTradeRequest)(nil), "vvtxchain.trade.QueryGetStoredTempTradeRequest")
+	proto.RegisterType((*QueryGetStoredTempTradeResponse)(nil), "vvtxchain.trade.QueryGetStoredTempTradeResponse")
+	proto.RegisterType((*QueryAllStoredTempTradeRequest)(nil), "vvtxchain.trade.QueryAllStoredTempTradeRequest")
+	proto.RegisterType((*QueryAllStoredTempTradeResponse)(nil), "vvtxchain.trade.QueryAllStoredTempTradeResponse")
+	proto.RegisterType((*QueryStoredTradesByStatusRequest)(nil), "vvtxchain.trade.QueryStoredTradesByStatusRequest")
+	proto.RegisterType((*QueryStoredTradesByStatusResponse)(nil), "vvtxchain.trade.QueryStoredTradesByStatusResponse")
+	proto.RegisterType((*QueryStoredTradesByMakerRequest)(nil), "vvtxchain.trade.QueryStoredTradesByMakerRequest")
+	proto.RegisterType((*QueryStoredTradesByMakerResponse)(nil), "vvtxchain.trade.QueryStoredTradesByMakerResponse")
+	proto.RegisterType((*QueryStoredTradesByCheckerRequest)(nil), "vvtxchain.trade.QueryStoredTradesByCheckerRequest")
+	proto.RegisterType((*QueryStoredTradesByCheckerResponse)(nil), "vvtxchain.trade.QueryStoredTradesByCheckerResponse")
+	proto.RegisterType((*QueryStoredTradesByReceiverRequest)(nil), "vvtxchain.trade.QueryStoredTradesByReceiverRequest")
+	proto.RegisterType((*QueryStoredTradesByReceiverResponse)(nil), "vvtxchain.trade.QueryStoredTradesByReceiverResponse")
+	proto.RegisterType((*QueryStoredTradesByDateRangeRequest)(nil), "vvtxchain.trade.QueryStoredTradesByDateRangeRequest")
+	proto.RegisterType((*QueryStoredTradesByDateRangeResponse)(nil), "vvtxchain.trade.QueryStoredTradesByDateRangeResponse")
+	proto.RegisterType((*QueryStoredTradeByExternalReferenceRequest)(nil), "vvtxchain.trade.QueryStoredTradeByExternalReferenceRequest")
+	proto.RegisterType((*QueryStoredTradeByExternalReferenceResponse)(nil), "vvtxchain.trade.QueryStoredTradeByExternalReferenceResponse")
+	proto.RegisterType((*QueryMintBurnCapacityRequest)(nil), "vvtxchain.trade.QueryMintBurnCapacityRequest")
+	proto.RegisterType((*MintBurnCapacity)(nil), "vvtxchain.trade.MintBurnCapacity")
+	proto.RegisterType((*QueryMintBurnCapacityResponse)(nil), "vvtxchain.trade.QueryMintBurnCapacityResponse")
+	proto.RegisterType((*QueryTradeHistoryRequest)(nil), "vvtxchain.trade.QueryTradeHistoryRequest")
+	proto.RegisterType((*QueryTradeHistoryResponse)(nil), "vvtxchain.trade.QueryTradeHistoryResponse")
+	proto.RegisterType((*QueryGetTradeEscrowRequest)(nil), "vvtxchain.trade.QueryGetTradeEscrowRequest")
+	proto.RegisterType((*QueryGetTradeEscrowResponse)(nil), "vvtxchain.trade.QueryGetTradeEscrowResponse")
+	proto.RegisterType((*QueryAllTradeEscrowRequest)(nil), "vvtxchain.trade.QueryAllTradeEscrowRequest")
+	proto.RegisterType((*QueryAllTradeEscrowResponse)(nil), "vvtxchain.trade.QueryAllTradeEscrowResponse")
+	proto.RegisterType((*QuerySupplyReconciliationRequest)(nil), "vvtxchain.trade.QuerySupplyReconciliationRequest")
+	proto.RegisterType((*QuerySupplyReconciliationResponse)(nil), "vvtxchain.trade.QuerySupplyReconciliationResponse")
+}
+
+func init() { proto.RegisterFile("vvtxchain/trade/query.proto", fileDescriptor_330c65892d0e2c34) }
+
+var fileDescriptor_330c65892d0e2c34 = []byte{
+	// 1972 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xcf, 0xf8, 0x2b, 0xf1, 0x71, 0x88, 0x9b, 0x5b, 0x87, 0xae, 0xc7, 0x61, 0xd7, 0x99, 0x94,
+	0xc6, 0xb5, 0xe3, 0x9d, 0xe6, 0x8b, 0xb6, 0x24, 0x69, 0xe5, 0x75, 0x1c, 0xd7, 0x88, 0x48, 0xc9,
+	0x26, 0x12, 0x22, 0x3c, 0x6c, 0xef, 0xee, 0xdc, 0xac, 0x87, 0xcc, 0xce, 0x6c, 0x67, 0xee, 0xfa,
+	0x43, 0xd6, 0x0a, 0x09, 0x84, 0xc4, 0x63, 0x25, 0x10, 0x1f, 0xe2, 0x05, 0x24, 0x84, 0x2a, 0x04,
+	0x12, 0x82, 0x42, 0x1f, 0x90, 0x2a, 0x5e, 0x90, 0xfa, 0x58, 0xda, 0x17, 0xc4, 0x43, 0x03, 0x09,
+	0x12, 0xff, 0x01, 0xcf, 0x68, 0xee, 0x9c, 0xd9, 0x9d, 0x9d, 0xbd, 0xb3, 0x9e, 0xd9, 0xee, 0x83,
+	0x5f, 0xe2, 0x9d, 0x7b, 0xcf, 0xef, 0xdc, 0xdf, 0xf9, 0xdd, 0xcf, 0x73, 0x02, 0x0b, 0x3b, 0x3b,
+	0x7c, 0xaf, 0xb6, 0x4d, 0x4d, 0x5b, 0xe7, 0x2e, 0x35, 0x98, 0xfe, 0x4e, 0x8b, 0xb9, 0xfb, 0xc5,
+	0xa6, 0xeb, 0x70, 0x87, 0xcc, 0x76, 0x3a, 0x8b, 0xa2, 0x53, 0x3d, 0x4d, 0x1b, 0xa6, 0xed, 0xe8,
+	0xe2, 0xdf, 0xc0, 0x46, 0x9d, 0xab, 0x3b, 0x75, 0x47, 0xfc, 0xd4, 0xfd, 0x5f, 0xd8, 0x7a, 0xb6,
+	0xee, 0x38, 0x75, 0x8b, 0xe9, 0xb4, 0x69, 0xea, 0xd4, 0xb6, 0x1d, 0x4e, 0xb9, 0xe9, 0xd8, 0x1e,
+	0xf6, 0xce, 0xd7, 0x1c, 0xaf, 0xe1, 0x78, 0x95, 0x00, 0x16, 0x7c, 0x60, 0x57, 0x1e, 0x81, 0xe2,
+	0xab, 0xda, 0x7a, 0xa4, 0x1b, 0x2d, 0x57, 0x60, 0xb1, 0xbf, 0x10, 0xef, 0xe7, 0x66, 0x83, 0x79,
+	0x9c, 0x36, 0x9a, 0x68, 0xb0, 0x1c, 0xb8, 0xd3, 0xab, 0xd4, 0xc3, 0x60, 0xf4, 0x9d, 0x4b, 0x55,
+	0xc6, 0xe9, 0x25, 0xbd, 0x49, 0xeb, 0xa6, 0x1d, 0x75, 0x76, 0x36, 0x1e, 0x7c, 0x93, 0xba, 0xb4,
+	0x11, 0x52, 0x39, 0x17, 0xef, 0x15, 0xff, 0x56, 0x4c, 0xdb, 0x60, 0x7b, 0x68, 0xa2, 0xc5, 0x4d,
+	0x3c, 0xee, 0xb8, 0xcc, 0xa8, 0x88, 0x0f, 0xb4, 0xb9, 0x90, 0x64, 0xc3, 0x1a, 0xcd, 0x1e, 0xc3,
+	0x05, 0xe9, 0x78, 0xd8, 0x79, 0x5e, 0x4e, 0x66, 0xdb, 0xf4, 0xbd, 0xed, 0x27, 0xd1, 0x09, 0x8c,
+	0x98, 0x57, 0x73, 0x9d, 0xdd, 0x50, 0xe0, 0xa8, 0x3e, 0xa1, 0x32, 0x35, 0xc7, 0x44, 0x4d, 0xb4,
+	0x39, 0x20, 0xf7, 0x7c, 0xd5, 0xee, 0x0a, 0x29, 0xca, 0xec, 0x9d, 0x16, 0xf3, 0xb8, 0x76, 0x0f,
+	0x9e, 0xef, 0x69, 0xf5, 0x9a, 0x8e, 0xed, 0x31, 0xf2, 0x55, 0x98, 0x0a, 0x24, 0xcb, 0x29, 0x8b,
+	0xca, 0xd2, 0xcc, 0xe5, 0x17, 0x8a, 0xb1, 0x15, 0x53, 0x0c, 0x00, 0xa5, 0xe9, 0x8f, 0x3e, 0x2b,
+	0x1c, 0x7b, 0xef, 0xbf, 0xbf, 0x5f, 0x56, 0xca, 0x88, 0xd0, 0x16, 0x60, 0x5e, 0xb8, 0xdc, 0x64,
+	0xfc, 0x81, 0x6f, 0xba, 0xe5, 0xeb, 0x1a, 0x8e, 0xf7, 0x36, 0xa8, 0xb2, 0x4e, 0x1c, 0xb6, 0x04,
+	0x33, 0x91, 0xb9, 0xc0, 0xb1, 0x17, 0xfa, 0xc6, 0xee, 0x22, 0x4b, 0x13, 0xfe, 0xf8, 0x65, 0xe0,
+	0x9d, 0x16, 0xed, 0x66, 0x77, 0x84, 0xfb, 0x62, 0x42, 0x84, 0x35, 0x8e, 0x4f, 0x0a, 0xfd, 0x23,
+	0x4c, 0xf4, 0xc0, 0x0d, 0x58, 0x90, 0xc2, 0x91, 0xe1, 0x06, 0x9c, 0x8c, 0x2e, 0x05, 0xa4, 0x78,
+	0xb6, 0x8f, 0x62, 0x04, 0x8b, 0x1c, 0x67, 0xbc, 0x6e, 0x93, 0x66, 0x20, 0xc9, 0x35, 0xcb, 0x92,
+	0x90, 0xbc, 0x0d, 0xd0, 0x5d, 0xd2, 0x38, 0xc4, 0x4b, 0x45, 0xdc, 0x4e, 0xfe, 0xfc, 0x16, 0x83,
+	0xcd, 0x8c, 0xb3, 0x5c, 0xbc, 0x4b, 0xeb, 0x21, 0xb6, 0x1c, 0x41, 0x6a, 0xbf, 0x53, 0x60, 0x41,
+	0x3a, 0x4c, 0x62, 0x30, 0xe3, 0x43, 0x04, 0x43, 0x36, 0x7b, 0xe8, 0x8e, 0x09, 0xba, 0x17, 0x0e,
+	0xa5, 0x1b, 0x70, 0xe8, 0xe1, 0xbb, 0x06, 0xf9, 0x98, 0xf6, 0xac, 0xd1, 0xcc, 0x36, 0x7d, 0x2d,
+	0x28, 0x24, 0xba, 0xc0, 0xa8, 0xcb, 0x70, 0xba, 0x6f, 0xa7, 0xa2, 0xc8, 0x8b, 0x49, 0xa1, 0x87,
+	0x4e, 0x30, 0xfc, 0x59, 0xaf, 0xb7, 0x59, 0xdb, 0x86, 0x7c, 0x4c, 0xe8, 0x38, 0xf3, 0x51, 0xcd,
+	0xe9, 0x87, 0x0a, 0x14, 0x12, 0x87, 0x1a, 0x1c, 0xe1, 0xf8, 0xe7, 0x88, 0x70, 0x74, 0x93, 0xfc,
+	0x0b, 0x05, 0x16, 0x45, 0x00, 0x91, 0x55, 0xe5, 0x95, 0xf6, 0xef, 0x73, 0xca, 0x5b, 0xe1, 0xb1,
+	0x44, 0xae, 0xc2, 0x94, 0x27, 0x1a, 0x84, 0x52, 0xa7, 0x24, 0x6b, 0x52, 0xe0, 0x10, 0x84, 0xb6,
+	0xe4, 0xb6, 0x84, 0xe3, 0x30, 0x1a, 0xff, 0x51, 0x81, 0x73, 0x03, 0x28, 0x1e, 0xd1, 0xdd, 0xf3,
+	0x1d, 0x5c, 0x18, 0xbd, 0xa4, 0xef, 0xd0, 0xc7, 0xcc, 0x0d, 0x65, 0x9d, 0x83, 0xc9, 0x86, 0xff,
+	0x2d, 0x54, 0x9d, 0x2e, 0x07, 0x1f, 0x23, 0x93, 0xed, 0x0f, 0xf2, 0x99, 0x45, 0x06, 0x47, 0x54,
+	0xb5, 0xef, 0xcb, 0xe7, 0x7a, 0x7d, 0x9b, 0xd5, 0x22, 0xc2, 0xe5, 0xe0, 0x78, 0x2d, 0x68, 0x41,
+	0xe9, 0xc2, 0xcf, 0x91, 0x89, 0xf7, 0xbe, 0x02, 0xda, 0x20, 0x1e, 0x47, 0x54, 0xbe, 0x9f, 0xc8,
+	0x69, 0x97, 0x59, 0x8d, 0x99, 0x3b, 0x5d, 0xfd, 0x5e, 0x86, 0xe7, 0x5c, 0x6c, 0xaa, 0x50, 0xc3,
+	0x70, 0x99, 0xe7, 0xa1, 0x90, 0xb3, 0x61, 0xfb, 0x5a, 0xd0, 0x3c, 0x32, 0x41, 0xff, 0xa4, 0xc0,
+	0xf9, 0x81, 0xcc, 0x8e, 0xa8, 0xa2, 0x9f, 0x8e, 0x49, 0x79, 0xdf, 0xa2, 0x9c, 0x95, 0xa9, 0xdd,
+	0x89, 0x95, 0xbc, 0x01, 0x60, 0x50, 0xce, 0x2a, 0x8f, 0x4c, 0x66, 0x19, 0x78, 0x4c, 0x16, 0xe4,
+	0xc7, 0xa4, 0x8f, 0xbd, 0xed, 0x9b, 0x95, 0xa7, 0x8d, 0xf0, 0x27, 0x79, 0x0d, 0x26, 0x1e, 0xb9,
+	0x4e, 0x03, 0xa9, 0xaa, 0xc5, 0xe0, 0xfd, 0x5d, 0x0c, 0xdf, 0xdf, 0xc5, 0x07, 0xe1, 0xfb, 0xbb,
+	0x74, 0xc2, 0x8f, 0xf6, 0xdd, 0x27, 0x05, 0xa5, 0x2c, 0x10, 0xe4, 0x2a, 0x8c, 0x71, 0x27, 0x37,
+	0x9e, 0x01, 0x37, 0xc6, 0x9d, 0xc8, 0x91, 0x3e, 0x31, 0xf4, 0x91, 0x3e, 0x39, 0xf4, 0x6a, 0xf8,
+	0xb3, 0x02, 0x2f, 0x0e, 0x56, 0xf5, 0x88, 0x2e, 0x87, 0x6f, 0xc1, 0x72, 0x9c, 0x77, 0x69, 0x7f,
+	0x63, 0x8f, 0x33, 0xd7, 0xa6, 0x56, 0x99, 0x3d, 0x62, 0x2e, 0xb3, 0x6b, 0x9d, 0x45, 0xb1, 0x0a,
+	0x84, 0x61, 0x5f, 0xc5, 0x0d, 0x3b, 0x71, 0xa7, 0x9d, 0x66, 0x71, 0x94, 0xc6, 0x61, 0x25, 0x95,
+	0xf3, 0xd1, 0x3e, 0x7e, 0xbf, 0x06, 0x67, 0xc5, 0xa8, 0x77, 0x4c, 0x9b, 0x97, 0x5a, 0xae, 0xbd,
+	0x4e, 0x9b, 0xb4, 0x66, 0xf2, 0xfd, 0xc8, 0x2d, 0x65, 0x30, 0xdb, 0x69, 0x84, 0xb7, 0x94, 0xf8,
+	0xe8, 0xde, 0x5d, 0x63, 0x91, 0xbb, 0x4b, 0xfb, 0xb7, 0x02, 0xcf, 0xc5, 0xfd, 0x90, 0x35, 0x98,
+	0xb4, 0xcc, 0x86, 0xc9, 0x03, 0x07, 0xa5, 0x15, 0x9f, 0xc2, 0x3f, 0x3f, 0x2b, 0x9c, 0x09, 0xe4,
+	0xf7, 0x8c, 0xc7, 0x45, 0xd3, 0xd1, 0x1b, 0x94, 0x6f, 0x17, 0xb7, 0x6c, 0xfe, 0xc9, 0xfb, 0xab,
+	0x80, 0xf3, 0xb2, 0x65, 0xf3, 0x72, 0x80, 0x24, 0x6f, 0xc2, 0x44, 0xcb, 0x63, 0x46, 0x6e, 0x2c,
+	0xbb, 0x07, 0x01, 0x24, 0x5b, 0x30, 0xed, 0xb2, 0x06, 0x35, 0x6d, 0xd3, 0xae, 0xe7, 0xc6, 0xb3,
+	0x7b, 0xe9, 0xa2, 0xb5, 0x1f, 0x8f, 0xc3, 0x97, 0x12, 0x04, 0xc3, 0x89, 0x91, 0x2b, 0x76, 0x1d,
+	0xa6, 0x76, 0x4d, 0xdb, 0x70, 0x76, 0x71, 0xfd, 0xcd, 0xf7, 0xed, 0xd5, 0x5b, 0x98, 0x83, 0x07,
+	0x5b, 0xf5, 0xa7, 0xfe, 0x56, 0x45, 0x88, 0xbf, 0xf1, 0x84, 0xc2, 0x95, 0x86, 0x69, 0x73, 0xdc,
+	0xec, 0xe7, 0xfa, 0x66, 0x3a, 0xce, 0x08, 0xa7, 0x7b, 0x5a, 0x40, 0xfd, 0xce, 0xae, 0x9f, 0x6a,
+	0xcb, 0xb5, 0x73, 0x13, 0xc3, 0xf8, 0xf1, 0x3b, 0xc8, 0x5b, 0x30, 0x53, 0xb7, 0x9c, 0x2a, 0xb5,
+	0x02, 0x42, 0x93, 0xd9, 0x1c, 0x41, 0x80, 0x15, 0x8c, 0xba, 0x9e, 0x04, 0xa5, 0xa9, 0xa1, 0x3c,
+	0xf9, 0x3d, 0xda, 0xf7, 0x14, 0xc8, 0x89, 0x89, 0x11, 0xeb, 0xfa, 0xad, 0x20, 0x65, 0x4f, 0x9b,
+	0xaa, 0x8c, 0xec, 0xa2, 0x7b, 0x4f, 0x81, 0x79, 0x09, 0x8b, 0x4e, 0x4a, 0x7d, 0x9c, 0xd9, 0xdc,
+	0x35, 0x99, 0x87, 0x47, 0x99, 0x26, 0x3f, 0x77, 0x11, 0xb7, 0x61, 0x73, 0x37, 0x0c, 0x35, 0x04,
+	0x8e, 0xee, 0x30, 0xbb, 0x19, 0xcb, 0xfe, 0x37, 0x44, 0x01, 0x63, 0x98, 0xdc, 0xbc, 0x07, 0xde,
+	0x3d, 0x9e, 0xa2, 0x75, 0x91, 0xc4, 0xe3, 0x29, 0x82, 0x0d, 0x8f, 0x27, 0xde, 0x6d, 0x8a, 0xe6,
+	0xe6, 0x12, 0x92, 0xa3, 0xca, 0xe3, 0x7e, 0x36, 0x06, 0x0b, 0xd2, 0x61, 0x12, 0x83, 0x19, 0x1f,
+	0x22, 0x98, 0x91, 0x4d, 0x1d, 0xf9, 0x36, 0x1c, 0xaf, 0x52, 0x8b, 0xfa, 0xd7, 0xc9, 0xb8, 0xa0,
+	0x32, 0xdf, 0xe3, 0x25, 0xc4, 0xaf, 0x3b, 0xa6, 0x5d, 0xba, 0xe6, 0xf3, 0xf8, 0xcd, 0x93, 0xc2,
+	0x52, 0xdd, 0xe4, 0xdb, 0xad, 0x6a, 0xb1, 0xe6, 0x34, 0xb0, 0x18, 0x88, 0x7f, 0x56, 0x3d, 0xe3,
+	0xb1, 0xce, 0xf7, 0x9b, 0xcc, 0x13, 0x00, 0x2f, 0x28, 0x20, 0x85, 0x03, 0x68, 0xaf, 0x85, 0x79,
+	0x44, 0xab, 0xd9, 0xb4, 0xfc, 0x17, 0x9b, 0x63, 0xd7, 0x4c, 0xcb, 0x14, 0x44, 0x06, 0x5e, 0x12,
+	0xda, 0x0f, 0x26, 0xe0, 0xdc, 0x00, 0xe8, 0xc0, 0xe3, 0x72, 0x13, 0x4e, 0xf8, 0xa1, 0x58, 0xa6,
+	0xcd, 0x86, 0x39, 0xf6, 0x3b, 0x60, 0xf2, 0x10, 0x48, 0xd3, 0x75, 0x6a, 0xcc, 0xf3, 0x98, 0x51,
+	0x31, 0x58, 0xd3, 0xf1, 0x4c, 0xee, 0x0d, 0x73, 0x07, 0x9c, 0xee, 0xb8, 0xb9, 0x85, 0x5e, 0xc8,
+	0xdb, 0x70, 0xa6, 0xeb, 0x7b, 0xd7, 0xe4, 0xdb, 0x86, 0x4b, 0x77, 0xa9, 0x15, 0x3c, 0xaa, 0x32,
+	0xba, 0x9f, 0xeb, 0x78, 0xfa, 0x46, 0xd7, 0x11, 0x79, 0x00, 0xb3, 0x6c, 0xaf, 0xc9, 0x6a, 0x9c,
+	0x19, 0x15, 0x4f, 0xa8, 0x98, 0x9b, 0xcc, 0xee, 0xfb, 0x54, 0xe8, 0x23, 0x98, 0x08, 0x72, 0x17,
+	0xbe, 0x40, 0x6b, 0xbc, 0x45, 0xad, 0xd0, 0xe7, 0x54, 0x76, 0x9f, 0x27, 0x03, 0x0f, 0xe8, 0x31,
+	0x0f, 0xe0, 0xe2, 0xf4, 0x32, 0x23, 0x77, 0x7c, 0x51, 0x59, 0x3a, 0x51, 0x8e, 0xb4, 0x5c, 0xfe,
+	0x65, 0x0e, 0x26, 0xc5, 0x52, 0x20, 0x6d, 0x98, 0x0a, 0xaa, 0x95, 0xe4, 0x7c, 0xdf, 0xf6, 0xe9,
+	0x2f, 0x89, 0xaa, 0x2f, 0x0e, 0x36, 0x0a, 0xd6, 0x90, 0xb6, 0xf4, 0xdd, 0x4f, 0xff, 0xf3, 0xc3,
+	0x31, 0x8d, 0x2c, 0xea, 0x9b, 0x9b, 0x1b, 0x0f, 0xbf, 0x4e, 0xab, 0x9e, 0x2e, 0x2f, 0x3a, 0x93,
+	0x1f, 0x29, 0x00, 0xdd, 0x8a, 0x25, 0x59, 0x96, 0xbb, 0x97, 0x55, 0x4b, 0xd5, 0x95, 0x54, 0xb6,
+	0xc8, 0x68, 0x55, 0x30, 0xba, 0x40, 0xbe, 0x9c, 0xcc, 0x28, 0x72, 0xbc, 0x92, 0x5f, 0x2b, 0x30,
+	0x13, 0x79, 0xa8, 0x91, 0xe4, 0xb1, 0xfa, 0x2b, 0x94, 0xea, 0xc5, 0x74, 0xc6, 0xc8, 0xec, 0x86,
+	0x60, 0xf6, 0x15, 0x72, 0x35, 0x99, 0x59, 0xf4, 0x5d, 0xa9, 0x1f, 0x44, 0x78, 0xb6, 0xc9, 0xcf,
+	0x15, 0x38, 0x15, 0xf1, 0xba, 0x66, 0x59, 0x49, 0x5c, 0xa5, 0xd5, 0x54, 0xf5, 0x62, 0x3a, 0x63,
+	0xe4, 0x5a, 0x14, 0x5c, 0x97, 0xc8, 0x4b, 0xe9, 0xb8, 0x92, 0x0f, 0x14, 0x98, 0x8d, 0x95, 0xd0,
+	0x88, 0x7e, 0x98, 0x3a, 0xb1, 0xe2, 0xa0, 0xfa, 0x4a, 0x7a, 0x00, 0xd2, 0x5c, 0x13, 0x34, 0xaf,
+	0x93, 0xd7, 0x0f, 0xa7, 0xd9, 0x29, 0x01, 0xc6, 0x74, 0xfd, 0xad, 0x02, 0x24, 0xe6, 0xde, 0xd7,
+	0x56, 0x3f, 0x4c, 0xae, 0x94, 0xe4, 0x93, 0xeb, 0x93, 0xda, 0x15, 0x41, 0x7e, 0x95, 0xac, 0x64,
+	0x20, 0x4f, 0xfe, 0xaa, 0xc0, 0x9c, 0xac, 0x1e, 0x47, 0x2e, 0xc9, 0xc7, 0x1f, 0x50, 0x5e, 0x54,
+	0x2f, 0x67, 0x81, 0x64, 0x57, 0x5c, 0x7c, 0x54, 0xf7, 0x2b, 0x41, 0x0e, 0xab, 0x1f, 0x04, 0x7f,
+	0xdb, 0xe4, 0x2f, 0x0a, 0x3c, 0x2f, 0xa9, 0x8d, 0x91, 0x57, 0xd2, 0xd0, 0x89, 0x16, 0xf2, 0xd4,
+	0x4b, 0x19, 0x10, 0xc8, 0xff, 0x4d, 0xc1, 0xff, 0x75, 0xf2, 0x6a, 0x7a, 0xfe, 0xe2, 0xf5, 0xad,
+	0x1f, 0x88, 0x3f, 0x6d, 0xf2, 0x37, 0x05, 0xce, 0x48, 0x8b, 0x53, 0x24, 0x95, 0x9c, 0xbd, 0x15,
+	0x35, 0xf5, 0x4a, 0x26, 0x0c, 0xc6, 0xb0, 0x2e, 0x62, 0xb8, 0x49, 0xae, 0xa7, 0x8f, 0x01, 0xeb,
+	0x74, 0xfa, 0x01, 0xfe, 0x68, 0x93, 0x4f, 0x14, 0xf8, 0xa2, 0xbc, 0x26, 0x44, 0x52, 0x91, 0x8a,
+	0xd5, 0xb6, 0xd4, 0xab, 0xd9, 0x40, 0x18, 0xca, 0x1d, 0x11, 0xca, 0x26, 0xd9, 0x48, 0x1f, 0x4a,
+	0x58, 0x29, 0xd3, 0x0f, 0xe2, 0xb5, 0xb4, 0x36, 0xf9, 0xbb, 0x02, 0x2f, 0x24, 0x94, 0x36, 0x48,
+	0x2a, 0x82, 0xf1, 0xfa, 0x92, 0x7a, 0x2d, 0x23, 0x0a, 0xe3, 0xda, 0x12, 0x71, 0xad, 0x93, 0xb5,
+	0xf4, 0x71, 0x89, 0x32, 0x96, 0xeb, 0x7b, 0xd1, 0x0f, 0xba, 0x25, 0xad, 0x36, 0xf9, 0x9f, 0x02,
+	0xf9, 0xc1, 0x95, 0x09, 0x72, 0xfd, 0x50, 0x92, 0xc9, 0xc5, 0x12, 0xf5, 0xc6, 0x70, 0x60, 0x0c,
+	0xf4, 0x9b, 0x22, 0xd0, 0xfb, 0xe4, 0x5e, 0xfa, 0x40, 0xfb, 0x4b, 0x33, 0xfa, 0x41, 0x7f, 0x5b,
+	0x9b, 0x7c, 0x20, 0x2b, 0x6a, 0xac, 0xca, 0xd9, 0x26, 0x14, 0x51, 0xd4, 0x62, 0x5a, 0x73, 0x0c,
+	0xe7, 0x96, 0x08, 0xe7, 0x0d, 0x72, 0x23, 0x39, 0x1c, 0x3f, 0xf1, 0x16, 0x39, 0x73, 0xa5, 0x86,
+	0x68, 0xfd, 0x40, 0x3c, 0x9d, 0xdb, 0x9d, 0x33, 0xe2, 0x57, 0x0a, 0x9c, 0x8c, 0xa6, 0x93, 0xe4,
+	0x65, 0x39, 0x0d, 0x49, 0xc2, 0xac, 0x2e, 0xa7, 0x31, 0x45, 0xb6, 0x37, 0x05, 0xdb, 0x57, 0xc9,
+	0xb5, 0xc3, 0xde, 0x3a, 0xf8, 0xff, 0xe8, 0xb1, 0xab, 0xcf, 0x7f, 0xfb, 0x44, 0x12, 0x27, 0x72,
+	0xc8, 0x3b, 0xab, 0x27, 0x03, 0x54, 0x2f, 0xa6, 0x33, 0x4e, 0xff, 0xf6, 0x89, 0xe6, 0x79, 0x92,
+	0xb7, 0x4f, 0xc4, 0xeb, 0xe0, 0xb7, 0x4f, 0x7a, 0xae, 0xf2, 0x9c, 0x33, 0xcd, 0xdb, 0x27, 0xca,
+	0x95, 0x7c, 0xe8, 0x5f, 0xc9, 0x92, 0x44, 0x2b, 0xf1, 0x4a, 0x4e, 0xce, 0xe7, 0xd4, 0xcb, 0x59,
+	0x20, 0x19, 0xae, 0x34, 0x81, 0xaf, 0xb8, 0x3d, 0x0e, 0xc2, 0x65, 0x5b, 0xda, 0xf8, 0xe8, 0x69,
+	0x5e, 0xf9, 0xf8, 0x69, 0x5e, 0xf9, 0xd7, 0xd3, 0xbc, 0xf2, 0xee, 0xb3, 0xfc, 0xb1, 0x8f, 0x9f,
+	0xe5, 0x8f, 0xfd, 0xe3, 0x59, 0xfe, 0xd8, 0xc3, 0x95, 0x48, 0xea, 0x2a, 0x71, 0xbe, 0x17, 0xca,
+	0xe1, 0xe7, 0xb0, 0xd5, 0x29, 0x51, 0x50, 0xbb, 0xf2, 0xff, 0x01, 0x00, 0x3e, 0xaf, 0x5f, 0x61,
+	0x74, 0x23, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a TradeIndex by index.
+	TradeIndex(ctx context.Context, in *QueryGetTradeIndexRequest, opts ...grpc.CallOption) (*QueryGetTradeIndexResponse, error)
+	// Queries a list of StoredTrade items.
+	StoredTrade(ctx context.Context, in *QueryGetStoredTradeRequest, opts ...grpc.CallOption) (*QueryGetStoredTradeResponse, error)
+	StoredTradeAll(ctx context.Context, in *QueryAllStoredTradeRequest, opts ...grpc.CallOption) (*QueryAllStoredTradeResponse, error)
+	// Queries a list of StoredTempTrade items.
+	StoredTempTrade(ctx context.Context, in *QueryGetStoredTempTradeRequest, opts ...grpc.CallOption) (*QueryGetStoredTempTradeResponse, error)
+	StoredTempTradeAll(ctx context.Context, in *QueryAllStoredTempTradeRequest, opts ...grpc.CallOption) (*QueryAllStoredTempTradeResponse, error)
+	// Queries a list of StoredTrade items by status.
+	StoredTradesByStatus(ctx context.Context, in *QueryStoredTradesByStatusRequest, opts ...grpc.CallOption) (*QueryStoredTradesByStatusResponse, error)
+	// Queries a list of StoredTrade items by maker.
+	StoredTradesByMaker(ctx context.Context, in *QueryStoredTradesByMakerRequest, opts ...grpc.CallOption) (*QueryStoredTradesByMakerResponse, error)
+	// Queries a list of StoredTrade items by checker.
+	StoredTradesByChecker(ctx context.Context, in *QueryStoredTradesByCheckerRequest, opts ...grpc.CallOption) (*QueryStoredTradesByCheckerResponse, error)
+	// Queries a list of StoredTrade items by receiver address.
+	StoredTradesByReceiver(ctx context.Context, in *QueryStoredTradesByReceiverRequest, opts ...grpc.CallOption) (*QueryStoredTradesByReceiverResponse, error)
+	// Queries a list of StoredTrade items with the selected date between from and to (inclusive).
+	StoredTradesByDateRange(ctx context.Context, in *QueryStoredTradesByDateRangeRequest, opts ...grpc.CallOption) (*QueryStoredTradesByDateRangeResponse, error)
+	// Queries the pending or processed StoredTrade with the given external reference.
+	StoredTradeByExternalReference(ctx context.Context, in *QueryStoredTradeByExternalReferenceRequest, opts ...grpc.CallOption) (*QueryStoredTradeByExternalReferenceResponse, error)
+	// Queries the remaining mint and burn capacity of a denom for a maker and for all makers.
+	MintBurnCapacity(ctx context.Context, in *QueryMintBurnCapacityRequest, opts ...grpc.CallOption) (*QueryMintBurnCapacityResponse, error)
+	// Queries the transitions of a trade, ordered by sequence.
+	TradeHistory(ctx context.Context, in *QueryTradeHistoryRequest, opts ...grpc.CallOption) (*QueryTradeHistoryResponse, error)
+	// Queries the escrow of a pending withdrawal.
+	TradeEscrow(ctx context.Context, in *QueryGetTradeEscrowRequest, opts ...grpc.CallOption) (*QueryGetTradeEscrowResponse, error)
+	// Queries the escrows of all pending withdrawals and the escrow balance of the module account.
+	TradeEscrowAll(ctx context.Context, in *QueryAllTradeEscrowRequest, opts ...grpc.CallOption) (*QueryAllTradeEscrowResponse, error)
+	// Queries the supply of a denom reconciled with the processed trades.
+	SupplyReconciliation(ctx context.Context, in *QuerySupplyReconciliationRequest, opts ...grpc.CallOption) (*QuerySupplyReconciliationResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradeIndex(ctx context.Context, in *QueryGetTradeIndexRequest, opts ...grpc.CallOption) (*QueryGetTradeIndexResponse, error) {
+	out := new(QueryGetTradeIndexResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/TradeIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTrade(ctx context.Context, in *QueryGetStoredTradeRequest, opts ...grpc.CallOption) (*QueryGetStoredTradeResponse, error) {
+	out := new(QueryGetStoredTradeResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/StoredTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTradeAll(ctx context.Context, in *QueryAllStoredTradeRequest, opts ...grpc.CallOption) (*QueryAllStoredTradeResponse, error) {
+	out := new(QueryAllStoredTradeResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/StoredTradeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTempTrade(ctx context.Context, in *QueryGetStoredTempTradeRequest, opts ...grpc.CallOption) (*QueryGetStoredTempTradeResponse, error) {
+	out := new(QueryGetStoredTempTradeResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/StoredTempTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTempTradeAll(ctx context.Context, in *QueryAllStoredTempTradeRequest, opts ...grpc.CallOption) (*QueryAllStoredTempTradeResponse, error) {
+	out := new(QueryAllStoredTempTradeResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/StoredTempTradeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTradesByStatus(ctx context.Context, in *QueryStoredTradesByStatusRequest, opts ...grpc.CallOption) (*QueryStoredTradesByStatusResponse, error) {
+	out := new(QueryStoredTradesByStatusResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/StoredTradesByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTradesByMaker(ctx context.Context, in *QueryStoredTradesByMakerRequest, opts ...grpc.CallOption) (*QueryStoredTradesByMakerResponse, error) {
+	out := new(QueryStoredTradesByMakerResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/StoredTradesByMaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTradesByChecker(ctx context.Context, in *QueryStoredTradesByCheckerRequest, opts ...grpc.CallOption) (*QueryStoredTradesByCheckerResponse, error) {
+	out := new(QueryStoredTradesByCheckerResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/StoredTradesByChecker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTradesByReceiver(ctx context.Context, in *QueryStoredTradesByReceiverRequest, opts ...grpc.CallOption) (*QueryStoredTradesByReceiverResponse, error) {
+	out := new(QueryStoredTradesByReceiverResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/StoredTradesByReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTradesByDateRange(ctx context.Context, in *QueryStoredTradesByDateRangeRequest, opts ...grpc.CallOption) (*QueryStoredTradesByDateRangeResponse, error) {
+	out := new(QueryStoredTradesByDateRangeResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/StoredTradesByDateRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StoredTradeByExternalReference(ctx context.Context, in *QueryStoredTradeByExternalReferenceRequest, opts ...grpc.CallOption) (*QueryStoredTradeByExternalReferenceResponse, error) {
+	out := new(QueryStoredTradeByExternalReferenceResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/StoredTradeByExternalReference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintBurnCapacity(ctx context.Context, in *QueryMintBurnCapacityRequest, opts ...grpc.CallOption) (*QueryMintBurnCapacityResponse, error) {
+	out := new(QueryMintBurnCapacityResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/MintBurnCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradeHistory(ctx context.Context, in *QueryTradeHistoryRequest, opts ...grpc.CallOption) (*QueryTradeHistoryResponse, error) {
+	out := new(QueryTradeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/TradeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradeEscrow(ctx context.Context, in *QueryGetTradeEscrowRequest, opts ...grpc.CallOption) (*QueryGetTradeEscrowResponse, error) {
+	out := new(QueryGetTradeEscrowResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/TradeEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradeEscrowAll(ctx context.Context, in *QueryAllTradeEscrowRequest, opts ...grpc.CallOption) (*QueryAllTradeEscrowResponse, error) {
+	out := new(QueryAllTradeEscrowResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/TradeEscrowAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyReconciliation(ctx context.Context, in *QuerySupplyReconciliationRequest, opts ...grpc.CallOption) (*QuerySupplyReconciliationResponse, error) {
+	out := new(QuerySupplyReconciliationResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Query/SupplyReconciliation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a TradeIndex by index.
+	TradeIndex(context.Context, *QueryGetTradeIndexRequest) (*QueryGetTradeIndexResponse, error)
+	// Queries a list of StoredTrade items.
+	StoredTrade(context.Context, *QueryGetStoredTradeRequest) (*QueryGetStoredTradeResponse, error)
+	StoredTradeAll(context.Context, *QueryAllStoredTradeRequest) (*QueryAllStoredTradeResponse, error)
+	// Queries a list of StoredTempTrade items.
+	StoredTempTrade(context.Context, *QueryGetStoredTempTradeRequest) (*QueryGetStoredTempTradeResponse, error)
+	StoredTempTradeAll(context.Context, *QueryAllStoredTempTradeRequest) (*QueryAllStoredTempTradeResponse, error)
+	// Queries a list of StoredTrade items by status.
+	StoredTradesByStatus(context.Context, *QueryStoredTradesByStatusRequest) (*QueryStoredTradesByStatusResponse, error)
+	// Queries a list of StoredTrade items by maker.
+	StoredTradesByMaker(context.Context, *QueryStoredTradesByMakerRequest) (*QueryStoredTradesByMakerResponse, error)
+	// Queries a list of StoredTrade items by checker.
+	StoredTradesByChecker(context.Context, *QueryStoredTradesByCheckerRequest) (*QueryStoredTradesByCheckerResponse, error)
+	// Queries a list of StoredTrade items by receiver address.
+	StoredTradesByReceiver(context.Context, *QueryStoredTradesByReceiverRequest) (*QueryStoredTradesByReceiverResponse, error)
+	// Queries a list of StoredTrade items with the selected date between from and to (inclusive).
+	StoredTradesByDateRange(context.Context, *QueryStoredTradesByDateRangeRequest) (*QueryStoredTradesByDateRangeResponse, error)
+	// Queries the pending or processed StoredTrade with the given external reference.
+	StoredTradeByExternalReference(context.Context, *QueryStoredTradeByExternalReferenceRequest) (*QueryStoredTradeByExternalReferenceResponse, error)
+	// Queries the remaining mint and burn capacity of a denom for a maker and for all makers.
+	MintBurnCapacity(context.Context, *QueryMintBurnCapacityRequest) (*QueryMintBurnCapacityResponse, error)
+	// Queries the transitions of a trade, ordered by sequence.
+	TradeHistory(context.Context, *QueryTradeHistoryRequest) (*QueryTradeHistoryResponse, error)
+	// Queries the escrow of a pending withdrawal.
+	TradeEscrow(context.Context, *QueryGetTradeEscrowRequest) (*QueryGetTradeEscrowResponse, error)
+	// Queries the escrows of all pending withdrawals and the escrow balance of the module account.
+	TradeEscrowAll(context.Context, *QueryAllTradeEscrowRequest) (*QueryAllTradeEscrowResponse, error)
+	// Queries the supply of a denom reconciled with the processed trades.
+	SupplyReconciliation(context.Context, *QuerySupplyReconciliationRequest) (*QuerySupplyReconciliationResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) TradeIndex(ctx context.Context, req *QueryGetTradeIndexRequest) (*QueryGetTradeIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradeIndex not implemented")
+}
+func (*UnimplementedQueryServer) StoredTrade(ctx context.Context, req *QueryGetStoredTradeRequest) (*QueryGetStoredTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTrade not implemented")
+}
+func (*UnimplementedQueryServer) StoredTradeAll(ctx context.Context, req *QueryAllStoredTradeRequest) (*QueryAllStoredTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTradeAll not implemented")
+}
+func (*UnimplementedQueryServer) StoredTempTrade(ctx context.Context, req *QueryGetStoredTempTradeRequest) (*QueryGetStoredTempTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTempTrade not implemented")
+}
+func (*UnimplementedQueryServer) StoredTempTradeAll(ctx context.Context, req *QueryAllStoredTempTradeRequest) (*QueryAllStoredTempTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTempTradeAll not implemented")
+}
+func (*UnimplementedQueryServer) StoredTradesByStatus(ctx context.Context, req *QueryStoredTradesByStatusRequest) (*QueryStoredTradesByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTradesByStatus not implemented")
+}
+func (*UnimplementedQueryServer) StoredTradesByMaker(ctx context.Context, req *QueryStoredTradesByMakerRequest) (*QueryStoredTradesByMakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTradesByMaker not implemented")
+}
+func (*UnimplementedQueryServer) StoredTradesByChecker(ctx context.Context, req *QueryStoredTradesByCheckerRequest) (*QueryStoredTradesByCheckerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTradesByChecker not implemented")
+}
+func (*UnimplementedQueryServer) StoredTradesByReceiver(ctx context.Context, req *QueryStoredTradesByReceiverRequest) (*QueryStoredTradesByReceiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTradesByReceiver not implemented")
+}
+func (*UnimplementedQueryServer) StoredTradesByDateRange(ctx context.Context, req *QueryStoredTradesByDateRangeRequest) (*QueryStoredTradesByDateRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTradesByDateRange not implemented")
+}
+func (*UnimplementedQueryServer) StoredTradeByExternalReference(ctx context.Context, req *QueryStoredTradeByExternalReferenceRequest) (*QueryStoredTradeByExternalReferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoredTradeByExternalReference not implemented")
+}
+func (*UnimplementedQueryServer) MintBurnCapacity(ctx context.Context, req *QueryMintBurnCapacityRequest) (*QueryMintBurnCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintBurnCapacity not implemented")
+}
+func (*UnimplementedQueryServer) TradeHistory(ctx context.Context, req *QueryTradeHistoryRequest) (*QueryTradeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradeHistory not implemented")
+}
+func (*UnimplementedQueryServer) TradeEscrow(ctx context.Context, req *QueryGetTradeEscrowRequest) (*QueryGetTradeEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradeEscrow not implemented")
+}
+func (*UnimplementedQueryServer) TradeEscrowAll(ctx context.Context, req *QueryAllTradeEscrowRequest) (*QueryAllTradeEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradeEscrowAll not implemented")
+}
+func (*UnimplementedQueryServer) SupplyReconciliation(ctx context.Context, req *QuerySupplyReconciliationRequest) (*QuerySupplyReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyReconciliation not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradeIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTradeIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradeIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/TradeIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradeIndex(ctx, req.(*QueryGetTradeIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStoredTradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/StoredTrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTrade(ctx, req.(*QueryGetStoredTradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTradeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllStoredTradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTradeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/StoredTradeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTradeAll(ctx, req.(*QueryAllStoredTradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTempTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetStoredTempTradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTempTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/StoredTempTrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTempTrade(ctx, req.(*QueryGetStoredTempTradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTempTradeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllStoredTempTradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTempTradeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/StoredTempTradeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTempTradeAll(ctx, req.(*QueryAllStoredTempTradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTradesByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoredTradesByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTradesByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/StoredTradesByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTradesByStatus(ctx, req.(*QueryStoredTradesByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTradesByMaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoredTradesByMakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTradesByMaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/StoredTradesByMaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTradesByMaker(ctx, req.(*QueryStoredTradesByMakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTradesByChecker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoredTradesByCheckerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTradesByChecker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/StoredTradesByChecker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTradesByChecker(ctx, req.(*QueryStoredTradesByCheckerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTradesByReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoredTradesByReceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTradesByReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/StoredTradesByReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTradesByReceiver(ctx, req.(*QueryStoredTradesByReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTradesByDateRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoredTradesByDateRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTradesByDateRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/StoredTradesByDateRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTradesByDateRange(ctx, req.(*QueryStoredTradesByDateRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StoredTradeByExternalReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStoredTradeByExternalReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StoredTradeByExternalReference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/StoredTradeByExternalReference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StoredTradeByExternalReference(ctx, req.(*QueryStoredTradeByExternalReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintBurnCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintBurnCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintBurnCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/MintBurnCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintBurnCapacity(ctx, req.(*QueryMintBurnCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/TradeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradeHistory(ctx, req.(*QueryTradeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradeEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTradeEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradeEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/TradeEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradeEscrow(ctx, req.(*QueryGetTradeEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradeEscrowAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTradeEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradeEscrowAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/TradeEscrowAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradeEscrowAll(ctx, req.(*QueryAllTradeEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Query/SupplyReconciliation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyReconciliation(ctx, req.(*QuerySupplyReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "vvtxchain.trade.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "TradeIndex",
+			Handler:    _Query_TradeIndex_Handler,
+		},
+		{
+			MethodName: "StoredTrade",
+			Handler:    _Query_StoredTrade_Handler,
+		},
+		{
+			MethodName: "StoredTradeAll",
+			Handler:    _Query_StoredTradeAll_Handler,
+		},
+		{
+			MethodName: "StoredTempTrade",
+			Handler:    _Query_StoredTempTrade_Handler,
+		},
+		{
+			MethodName: "StoredTempTradeAll",
+			Handler:    _Query_StoredTempTradeAll_Handler,
+		},
+		{
+			MethodName: "StoredTradesByStatus",
+			Handler:    _Query_StoredTradesByStatus_Handler,
+		},
+		{
+			MethodName: "StoredTradesByMaker",
+			Handler:    _Query_StoredTradesByMaker_Handler,
+		},
+		{
+			MethodName: "StoredTradesByChecker",
+			Handler:    _Query_StoredTradesByChecker_Handler,
+		},
+		{
+			MethodName: "StoredTradesByReceiver",
+			Handler:    _Query_StoredTradesByReceiver_Handler,
+		},
+		{
+			MethodName: "StoredTradesByDateRange",
+			Handler:    _Query_StoredTradesByDateRange_Handler,
+		},
+		{
+			MethodName: "StoredTradeByExternalReference",
+			Handler:    _Query_StoredTradeByExternalReference_Handler,
+		},
+		{
+			MethodName: "MintBurnCapacity",
+			Handler:    _Query_MintBurnCapacity_Handler,
+		},
+		{
+			MethodName: "TradeHistory",
+			Handler:    _Query_TradeHistory_Handler,
+		},
+		{
+			MethodName: "TradeEscrow",
+			Handler:    _Query_TradeEscrow_Handler,
+		},
+		{
+			MethodName: "TradeEscrowAll",
+			Handler:    _Query_TradeEscrowAll_Handler,
+		},
+		{
+			MethodName: "SupplyReconciliation",
+			Handler:    _Query_SupplyReconciliation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vvtxchain/trade/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTradeIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTradeIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTradeIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTradeIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTradeIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTradeIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TradeIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStoredTradeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStoredTradeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStoredTradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStoredTradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStoredTradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStoredTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StoredTrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStoredTradeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStoredTradeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStoredTradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStoredTradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStoredTradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStoredTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoredTrade) > 0 {
+		for iNdEx := len(m.StoredTrade) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoredTrade[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStoredTempTradeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStoredTempTradeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStoredTempTradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetStoredTempTradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetStoredTempTradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetStoredTempTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StoredTempTrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStoredTempTradeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStoredTempTradeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStoredTempTradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllStoredTempTradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllStoredTempTradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllStoredTempTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoredTempTrade) > 0 {
+		for iNdEx := len(m.StoredTempTrade) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoredTempTrade[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredTradesByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredTradesByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredTradesByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredTradesByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredTradesByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredTradesByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoredTrade) > 0 {
+		for iNdEx := len(m.StoredTrade) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoredTrade[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredTradesByMakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredTradesByMakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredTradesByMakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Maker) > 0 {
+		i -= len(m.Maker)
+		copy(dAtA[i:], m.Maker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Maker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredTradesByMakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredTradesByMakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredTradesByMakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoredTrade) > 0 {
+		for iNdEx := len(m.StoredTrade) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoredTrade[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredTradesByCheckerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredTradesByCheckerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredTradesByCheckerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checker) > 0 {
+		i -= len(m.Checker)
+		copy(dAtA[i:], m.Checker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredTradesByCheckerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredTradesByCheckerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredTradesByCheckerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoredTrade) > 0 {
+		for iNdEx := len(m.StoredTrade) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoredTrade[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredTradesByReceiverRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredTradesByReceiverRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredTradesByReceiverRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReceiverAddress) > 0 {
+		i -= len(m.ReceiverAddress)
+		copy(dAtA[i:], m.ReceiverAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReceiverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredTradesByReceiverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredTradesByReceiverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredTradesByReceiverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoredTrade) > 0 {
+		for iNdEx := len(m.StoredTrade) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoredTrade[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredTradesByDateRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredTradesByDateRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredTradesByDateRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.To, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.To):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintQuery(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x1a
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.From, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.From):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintQuery(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x12
+	if m.DateField != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DateField))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredTradesByDateRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredTradesByDateRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredTradesByDateRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StoredTrade) > 0 {
+		for iNdEx := len(m.StoredTrade) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoredTrade[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredTradeByExternalReferenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredTradeByExternalReferenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredTradeByExternalReferenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalReference) > 0 {
+		i -= len(m.ExternalReference)
+		copy(dAtA[i:], m.ExternalReference)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalReference)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStoredTradeByExternalReferenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStoredTradeByExternalReferenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStoredTradeByExternalReferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StoredTrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintBurnCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintBurnCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintBurnCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Maker) > 0 {
+		i -= len(m.Maker)
+		copy(dAtA[i:], m.Maker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Maker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintBurnCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintBurnCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintBurnCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Used.Size()
+		i -= size
+		if _, err := m.Used.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintBurnCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintBurnCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintBurnCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GlobalBurn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.GlobalMint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.MakerBurn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MakerMint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n26, err26 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err26 != nil {
+		return 0, err26
+	}
+	i -= n26
+	i = encodeVarintQuery(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTradeEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTradeEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTradeEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTradeEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTradeEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTradeEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TradeEscrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTradeEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTradeEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTradeEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTradeEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTradeEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTradeEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TradeEscrow) > 0 {
+		for iNdEx := len(m.TradeEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradeEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyReconciliationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyReconciliationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyReconciliationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyReconciliationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyReconciliationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyReconciliationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reconciled {
+		i--
+		if m.Reconciled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.ActualSupply.Size()
+		i -= size
+		if _, err := m.ActualSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ExpectedSupply.Size()
+		i -= size
+		if _, err := m.ExpectedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ProcessedWithdrawals.Size()
+		i -= size
+		if _, err := m.ProcessedWithdrawals.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ProcessedDeposits.Size()
+		i -= size
+		if _, err := m.ProcessedDeposits.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Baseline.Size()
+		i -= size
+		if _, err := m.Baseline.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTradeIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetTradeIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TradeIndex.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetStoredTradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TradeIndex))
+	}
+	return n
+}
+
+func (m *QueryGetStoredTradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StoredTrade.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllStoredTradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllStoredTradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoredTrade) > 0 {
+		for _, e := range m.StoredTrade {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetStoredTempTradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TradeIndex))
+	}
+	return n
+}
+
+func (m *QueryGetStoredTempTradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StoredTempTrade.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllStoredTempTradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllStoredTempTradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoredTempTrade) > 0 {
+		for _, e := range m.StoredTempTrade {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredTradesByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredTradesByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoredTrade) > 0 {
+		for _, e := range m.StoredTrade {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredTradesByMakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Maker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredTradesByMakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoredTrade) > 0 {
+		for _, e := range m.StoredTrade {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredTradesByCheckerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredTradesByCheckerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoredTrade) > 0 {
+		for _, e := range m.StoredTrade {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredTradesByReceiverRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReceiverAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredTradesByReceiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoredTrade) > 0 {
+		for _, e := range m.StoredTrade {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredTradesByDateRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DateField != 0 {
+		n += 1 + sovQuery(uint64(m.DateField))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.From)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.To)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredTradesByDateRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoredTrade) > 0 {
+		for _, e := range m.StoredTrade {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredTradeByExternalReferenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExternalReference)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStoredTradeByExternalReferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StoredTrade.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintBurnCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Maker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MintBurnCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Used.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintBurnCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MakerMint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MakerBurn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.GlobalMint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.GlobalBurn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTradeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TradeIndex))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTradeEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TradeIndex))
+	}
+	return n
+}
+
+func (m *QueryGetTradeEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TradeEscrow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTradeEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTradeEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TradeEscrow) > 0 {
+		for _, e := range m.TradeEscrow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySupplyReconciliationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyReconciliationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Baseline.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProcessedDeposits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProcessedWithdrawals.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExpectedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ActualSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Reconciled {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTradeIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTradeIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTradeIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTradeIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTradeIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTradeIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradeIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStoredTradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStoredTradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStoredTradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStoredTradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStoredTradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStoredTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredTrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StoredTrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStoredTradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStoredTradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStoredTradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStoredTradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStoredTradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStoredTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredTrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredTrade = append(m.StoredTrade, StoredTrade{})
+			if err := m.StoredTrade[len(m.StoredTrade)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStoredTempTradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStoredTempTradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStoredTempTradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetStoredTempTradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetStoredTempTradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetStoredTempTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredTempTrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StoredTempTrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStoredTempTradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStoredTempTradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStoredTempTradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllStoredTempTradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllStoredTempTradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllStoredTempTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredTempTrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredTempTrade = append(m.StoredTempTrade, StoredTempTrade{})
+			if err := m.StoredTempTrade[len(m.StoredTempTrade)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredTradesByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredTradesByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredTradesByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredTradesByStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredTradesByStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredTradesByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredTrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredTrade = append(m.StoredTrade, StoredTrade{})
+			if err := m.StoredTrade[len(m.StoredTrade)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredTradesByMakerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredTradesByMakerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredTradesByMakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredTradesByMakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredTradesByMakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredTradesByMakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredTrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredTrade = append(m.StoredTrade, StoredTrade{})
+			if err := m.StoredTrade[len(m.StoredTrade)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredTradesByCheckerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredTradesByCheckerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredTradesByCheckerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredTradesByCheckerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredTradesByCheckerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredTradesByCheckerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredTrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredTrade = append(m.StoredTrade, StoredTrade{})
+			if err := m.StoredTrade[len(m.StoredTrade)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredTradesByReceiverRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredTradesByReceiverRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredTradesByReceiverRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredTradesByReceiverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredTradesByReceiverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredTradesByReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredTrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredTrade = append(m.StoredTrade, StoredTrade{})
+			if err := m.StoredTrade[len(m.StoredTrade)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredTradesByDateRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredTradesByDateRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredTradesByDateRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateField", wireType)
+			}
+			m.DateField = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DateField |= TradeDateField(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.From, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.To, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredTradesByDateRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredTradesByDateRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredTradesByDateRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredTrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoredTrade = append(m.StoredTrade, StoredTrade{})
+			if err := m.StoredTrade[len(m.StoredTrade)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredTradeByExternalReferenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredTradeByExternalReferenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredTradeByExternalReferenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStoredTradeByExternalReferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStoredTradeByExternalReferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStoredTradeByExternalReferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredTrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StoredTrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintBurnCapacityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintBurnCapacityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintBurnCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Maker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintBurnCapacity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1486,16 +7219,118 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintBurnCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintBurnCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1517,7 +7352,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintBurnCapacityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1540,15 +7375,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintBurnCapacityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintBurnCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1575,7 +7442,139 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerMint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerMint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerBurn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerBurn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalMint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalMint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalBurn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalBurn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1600,7 +7599,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTradeIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTradeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1623,12 +7622,67 @@ func (m *QueryGetTradeIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTradeIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTradeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTradeIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTradeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1650,7 +7704,7 @@ func (m *QueryGetTradeIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTradeIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTradeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1673,15 +7727,49 @@ func (m *QueryGetTradeIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTradeIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTradeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTradeIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTradeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, TradeHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1708,7 +7796,10 @@ func (m *QueryGetTradeIndexResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TradeIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1733,7 +7824,7 @@ func (m *QueryGetTradeIndexResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStoredTradeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTradeEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1756,10 +7847,10 @@ func (m *QueryGetStoredTradeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStoredTradeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTradeEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStoredTradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTradeEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1802,7 +7893,7 @@ func (m *QueryGetStoredTradeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStoredTradeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTradeEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1825,15 +7916,15 @@ func (m *QueryGetStoredTradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStoredTradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTradeEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStoredTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTradeEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredTrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeEscrow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1860,7 +7951,7 @@ func (m *QueryGetStoredTradeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StoredTrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TradeEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1885,7 +7976,7 @@ func (m *QueryGetStoredTradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStoredTradeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTradeEscrowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1908,10 +7999,10 @@ func (m *QueryAllStoredTradeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStoredTradeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllTradeEscrowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStoredTradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTradeEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1971,7 +8062,7 @@ func (m *QueryAllStoredTradeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStoredTradeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTradeEscrowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1994,15 +8085,15 @@ func (m *QueryAllStoredTradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStoredTradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllTradeEscrowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStoredTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTradeEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredTrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeEscrow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2029,8 +8120,8 @@ func (m *QueryAllStoredTradeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StoredTrade = append(m.StoredTrade, StoredTrade{})
-			if err := m.StoredTrade[len(m.StoredTrade)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TradeEscrow = append(m.TradeEscrow, TradeEscrow{})
+			if err := m.TradeEscrow[len(m.TradeEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2070,6 +8161,40 @@ func (m *QueryAllStoredTradeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2091,7 +8216,7 @@ func (m *QueryAllStoredTradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStoredTempTradeRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyReconciliationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2114,17 +8239,17 @@ func (m *QueryGetStoredTempTradeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStoredTempTradeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyReconciliationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStoredTempTradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyReconciliationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.TradeIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2134,11 +8259,24 @@ func (m *QueryGetStoredTempTradeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TradeIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2160,7 +8298,7 @@ func (m *QueryGetStoredTempTradeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetStoredTempTradeResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyReconciliationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2183,17 +8321,17 @@ func (m *QueryGetStoredTempTradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetStoredTempTradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyReconciliationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetStoredTempTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyReconciliationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredTempTrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2203,80 +8341,63 @@ func (m *QueryGetStoredTempTradeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StoredTempTrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Baseline", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllStoredTempTradeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Baseline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStoredTempTradeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStoredTempTradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedDeposits", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2286,83 +8407,65 @@ func (m *QueryAllStoredTempTradeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProcessedDeposits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedWithdrawals", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllStoredTempTradeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ProcessedWithdrawals.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStoredTempTradeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStoredTempTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StoredTempTrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedSupply", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2372,31 +8475,31 @@ func (m *QueryAllStoredTempTradeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StoredTempTrade = append(m.StoredTempTrade, StoredTempTrade{})
-			if err := m.StoredTempTrade[len(m.StoredTempTrade)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpectedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualSupply", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2406,28 +8509,46 @@ func (m *QueryAllStoredTempTradeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ActualSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconciled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reconciled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
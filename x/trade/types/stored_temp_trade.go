@@ -0,0 +1,12 @@
+package types
+
+import "time"
+
+// ExpiryTime returns the time at which a pending trade expires for the given ttl
+func (stt StoredTempTrade) ExpiryTime(ttl time.Duration) (time.Time, error) {
+	txDate, err := time.Parse(time.RFC3339, stt.TxDate)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return txDate.Add(ttl), nil
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// PayloadHash returns the hex encoded sha256 hash of the payload of the trade,
+// made of the fields that can be amended by the maker
+func (st StoredTrade) PayloadHash() string {
+	hasher := sha256.New()
+	for _, field := range []string{
+		st.ReceiverAddress,
+		st.TradeData,
+		st.BankingSystemData,
+		st.CoinMintingPriceJson,
+		st.ExchangeRateJson,
+	} {
+		// length prefix each field so the hash is not ambiguous
+		lengthBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(lengthBytes, uint64(len(field)))
+		hasher.Write(lengthBytes)
+		hasher.Write([]byte(field))
+	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// HoldsExternalReference reports whether the trade holds its external reference in the unique
+// external reference index, a reference is held while the trade is pending or processed
+func (st StoredTrade) HoldsExternalReference() bool {
+	return st.ExternalReference != "" && (st.Status == StatusPending || st.Status == StatusProcessed)
+}
+
+// SetFailure records the error of a failed mint or burn in the result and the failure code of
+// the trade. Errors without a registered code are recorded as ErrTradeExecutionFailed.
+func (st *StoredTrade) SetFailure(err error) {
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	if codespace == errorsmod.UndefinedCodespace {
+		codespace, code = ErrTradeExecutionFailed.Codespace(), ErrTradeExecutionFailed.ABCICode()
+	}
+
+	st.Result = err.Error()
+	st.FailureCodespace = codespace
+	st.FailureCode = code
+}
+
+// ClearFailure removes the failure code of a trade whose mint or burn succeeded when retried
+func (st *StoredTrade) ClearFailure() {
+	st.FailureCodespace = ""
+	st.FailureCode = 0
+}
+
+// CanonicalizeDecimals rewrites the decimal fields of the trade data, coin minting price and
+// exchange rate JSON payloads of the trade as canonical decimal strings. Empty payloads are kept.
+func (st *StoredTrade) CanonicalizeDecimals() error {
+	return st.canonicalizeDecimals(CanonicalizeDecimalJSON)
+}
+
+// RoundDecimals rewrites the decimal fields of the payloads as CanonicalizeDecimals, the decimals
+// with more than 18 decimal places are rounded half to even. It reports whether a decimal was rounded.
+func (st *StoredTrade) RoundDecimals() (bool, error) {
+	rounded := false
+	err := st.canonicalizeDecimals(func(data string) (string, error) {
+		canonical, r, err := RoundDecimalJSON(data)
+		rounded = rounded || r
+		return canonical, err
+	})
+	return rounded, err
+}
+
+func (st *StoredTrade) canonicalizeDecimals(canonicalize func(string) (string, error)) error {
+	for _, field := range []*string{
+		&st.TradeData,
+		&st.CoinMintingPriceJson,
+		&st.ExchangeRateJson,
+	} {
+		if *field == "" {
+			continue
+		}
+		canonical, err := canonicalize(*field)
+		if err != nil {
+			return err
+		}
+		*field = canonical
+	}
+	return nil
+}
+
+// DecodePayload sets the typed trade data, coin minting prices and exchange rates of the
+// trade from its JSON payloads. Empty payloads leave the typed fields empty.
+func (st *StoredTrade) DecodePayload() error {
+	st.TypedTradeData = nil
+	st.CoinMintingPrices = nil
+	st.ExchangeRates = nil
+
+	if st.TradeData != "" {
+		td, err := ParseTradeData(st.TradeData)
+		if err != nil {
+			return err
+		}
+		st.TypedTradeData = &td
+	}
+
+	if st.CoinMintingPriceJson != "" {
+		coinMintingPrices, err := ParseCoinMintingPriceJson(st.CoinMintingPriceJson)
+		if err != nil {
+			return err
+		}
+		st.CoinMintingPrices = coinMintingPrices
+	}
+
+	if st.ExchangeRateJson != "" {
+		exchangeRates, err := ParseExchangeRateJson(st.ExchangeRateJson)
+		if err != nil {
+			return err
+		}
+		st.ExchangeRates = exchangeRates
+	}
+	return nil
+}
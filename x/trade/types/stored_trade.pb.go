@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -41,6 +42,27 @@ type StoredTrade struct {
 	ExchangeRateJson     string      `protobuf:"bytes,15,opt,name=exchange_rate_json,json=exchangeRateJson,proto3" json:"exchange_rate_json,omitempty"`
 	BankingSystemData    string      `protobuf:"bytes,16,opt,name=banking_system_data,json=bankingSystemData,proto3" json:"banking_system_data,omitempty"`
 	Result               string      `protobuf:"bytes,17,opt,name=result,proto3" json:"result,omitempty"`
+	// revision is incremented each time the trade is amended by its maker.
+	Revision uint64 `protobuf:"varint,18,opt,name=revision,proto3" json:"revision,omitempty"`
+	// previous_payload_hash is the hash of the trade payload before the last amendment.
+	PreviousPayloadHash string `protobuf:"bytes,19,opt,name=previous_payload_hash,json=previousPayloadHash,proto3" json:"previous_payload_hash,omitempty"`
+	// approvals are the checkers that have confirmed the current revision of the trade.
+	Approvals []string `protobuf:"bytes,20,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// typed_trade_data is the typed form of trade_data.
+	TypedTradeData *TradeData `protobuf:"bytes,21,opt,name=typed_trade_data,json=typedTradeData,proto3" json:"typed_trade_data,omitempty"`
+	// coin_minting_prices is the typed form of coin_minting_price_json.
+	CoinMintingPrices []CoinMintingPriceJson `protobuf:"bytes,22,rep,name=coin_minting_prices,json=coinMintingPrices,proto3" json:"coin_minting_prices"`
+	// exchange_rates is the typed form of exchange_rate_json.
+	ExchangeRates []ExchangeRateJson `protobuf:"bytes,23,rep,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates"`
+	// external_reference is the optional reference of the trade in the banking system.
+	ExternalReference string `protobuf:"bytes,24,opt,name=external_reference,json=externalReference,proto3" json:"external_reference,omitempty"`
+	// failure_codespace and failure_code are the registered error of the mint or
+	// burn of a failed trade, result holds its message.
+	FailureCodespace string `protobuf:"bytes,25,opt,name=failure_codespace,json=failureCodespace,proto3" json:"failure_codespace,omitempty"`
+	FailureCode      uint32 `protobuf:"varint,26,opt,name=failure_code,json=failureCode,proto3" json:"failure_code,omitempty"`
+	// attempts is the number of times the mint or burn of the trade has been executed,
+	// it is incremented when the trade is confirmed and each time it is retried.
+	Attempts uint32 `protobuf:"varint,27,opt,name=attempts,proto3" json:"attempts,omitempty"`
 }
 
 func (m *StoredTrade) Reset()         { *m = StoredTrade{} }
@@ -195,6 +217,76 @@ func (m *StoredTrade) GetResult() string {
 	return ""
 }
 
+func (m *StoredTrade) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *StoredTrade) GetPreviousPayloadHash() string {
+	if m != nil {
+		return m.PreviousPayloadHash
+	}
+	return ""
+}
+
+func (m *StoredTrade) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *StoredTrade) GetTypedTradeData() *TradeData {
+	if m != nil {
+		return m.TypedTradeData
+	}
+	return nil
+}
+
+func (m *StoredTrade) GetCoinMintingPrices() []CoinMintingPriceJson {
+	if m != nil {
+		return m.CoinMintingPrices
+	}
+	return nil
+}
+
+func (m *StoredTrade) GetExchangeRates() []ExchangeRateJson {
+	if m != nil {
+		return m.ExchangeRates
+	}
+	return nil
+}
+
+func (m *StoredTrade) GetExternalReference() string {
+	if m != nil {
+		return m.ExternalReference
+	}
+	return ""
+}
+
+func (m *StoredTrade) GetFailureCodespace() string {
+	if m != nil {
+		return m.FailureCodespace
+	}
+	return ""
+}
+
+func (m *StoredTrade) GetFailureCode() uint32 {
+	if m != nil {
+		return m.FailureCode
+	}
+	return 0
+}
+
+func (m *StoredTrade) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StoredTrade)(nil), "vvtxchain.trade.StoredTrade")
 }
@@ -204,40 +296,54 @@ func init() {
 }
 
 var fileDescriptor_ff10d2b3ebdcd65b = []byte{
-	// 514 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x63, 0x68, 0x1c, 0xb2, 0x29, 0x4d, 0xba, 0x54, 0x74, 0x09, 0x60, 0x42, 0x4f, 0x41,
-	0x20, 0x5b, 0x29, 0x70, 0xe0, 0x08, 0xb4, 0xaa, 0x40, 0x20, 0x21, 0xa7, 0xa7, 0x5e, 0xac, 0xb5,
-	0x3d, 0x4a, 0x4c, 0xb1, 0xd7, 0xda, 0x9d, 0x44, 0xc9, 0x5b, 0xf0, 0x12, 0xbc, 0x0b, 0xc7, 0x1e,
-	0x39, 0xa2, 0xe4, 0x45, 0x90, 0x67, 0xe3, 0x82, 0x42, 0x6f, 0x9e, 0xff, 0xff, 0x76, 0xfc, 0xef,
-	0xcc, 0xb2, 0xa3, 0xf9, 0x1c, 0x17, 0xc9, 0x54, 0x66, 0x45, 0x80, 0x5a, 0xa6, 0x10, 0x18, 0x54,
-	0x1a, 0xd2, 0x88, 0x0a, 0xbf, 0xd4, 0x0a, 0x15, 0xef, 0x5e, 0x33, 0x3e, 0xc9, 0x7d, 0x2f, 0x51,
-	0x26, 0x57, 0x26, 0x88, 0xa5, 0x81, 0x60, 0x3e, 0x8a, 0x01, 0xe5, 0x28, 0x48, 0x54, 0x56, 0xd8,
-	0x03, 0xfd, 0x87, 0xdb, 0x4d, 0xff, 0xe9, 0x76, 0xf4, 0xa3, 0xc9, 0x3a, 0x63, 0xfa, 0xc9, 0x79,
-	0xa5, 0xf2, 0x27, 0xac, 0x43, 0x76, 0x94, 0x15, 0x29, 0x2c, 0x84, 0x33, 0x70, 0x86, 0x3b, 0x21,
-	0x23, 0xe9, 0x43, 0xa5, 0xf0, 0x37, 0xcc, 0x56, 0x11, 0x2e, 0x4b, 0x10, 0xb7, 0x06, 0xce, 0x70,
-	0xef, 0xb8, 0xef, 0x6f, 0x65, 0xf2, 0xa9, 0xd9, 0xf9, 0xb2, 0x84, 0xb0, 0x8d, 0xf5, 0x27, 0x1f,
-	0x31, 0x57, 0xe6, 0x6a, 0x56, 0xa0, 0xb8, 0x3d, 0x70, 0x86, 0x9d, 0xe3, 0x07, 0xbe, 0x4d, 0xee,
-	0x57, 0xc9, 0xfd, 0x4d, 0x72, 0xff, 0xbd, 0xca, 0x8a, 0x70, 0x03, 0xf2, 0x17, 0x8c, 0x57, 0x37,
-	0x89, 0xf2, 0xac, 0xc0, 0xac, 0x98, 0x44, 0xa5, 0xce, 0x12, 0x10, 0x3b, 0x03, 0x67, 0xd8, 0x0e,
-	0x7b, 0x95, 0xf3, 0xd9, 0x1a, 0x5f, 0x2a, 0x9d, 0x3f, 0x63, 0x3d, 0x0d, 0x09, 0x64, 0x73, 0xd0,
-	0x91, 0x4c, 0x53, 0x0d, 0xc6, 0x88, 0x26, 0xb1, 0xdd, 0x5a, 0x7f, 0x6b, 0x65, 0xfe, 0x8a, 0xb9,
-	0x06, 0x25, 0xce, 0x8c, 0x70, 0xe9, 0x0a, 0x8f, 0x6e, 0xbe, 0xc2, 0x98, 0x98, 0x70, 0xc3, 0xf2,
-	0x03, 0xd6, 0xcc, 0xe5, 0x25, 0x68, 0xd1, 0xa2, 0xae, 0xb6, 0xe0, 0x82, 0xb5, 0x92, 0x29, 0x24,
-	0x95, 0x7e, 0x87, 0xf4, 0xba, 0xe4, 0x87, 0xac, 0x85, 0x8b, 0x28, 0x95, 0x08, 0xa2, 0x4d, 0x8e,
-	0x8b, 0x8b, 0x13, 0x89, 0x34, 0xe6, 0x44, 0x83, 0x44, 0xb0, 0x26, 0x23, 0x93, 0x59, 0xa9, 0x06,
-	0x66, 0x65, 0x7a, 0x0d, 0x74, 0x2c, 0x60, 0x25, 0x02, 0x9e, 0xb2, 0xdd, 0x52, 0xab, 0x04, 0x8c,
-	0xb1, 0xc4, 0x2e, 0x11, 0x9d, 0x8d, 0x46, 0xc8, 0xe3, 0x7a, 0x55, 0xa9, 0x44, 0x29, 0xee, 0x12,
-	0x60, 0xd7, 0x71, 0x22, 0x51, 0xf2, 0xd7, 0xec, 0xf0, 0xff, 0xd9, 0x46, 0x5f, 0x8d, 0x2a, 0xc4,
-	0x1e, 0xb1, 0x07, 0xdb, 0x03, 0xfe, 0x68, 0x54, 0x51, 0xad, 0x04, 0xaa, 0x41, 0x15, 0x13, 0x88,
-	0x74, 0x15, 0x90, 0x4e, 0x74, 0xed, 0x4a, 0x6a, 0x27, 0x94, 0x68, 0x69, 0x9f, 0xdd, 0x8b, 0x65,
-	0x71, 0x59, 0xf5, 0x37, 0x4b, 0x83, 0x90, 0xdb, 0x30, 0x3d, 0xc2, 0xf7, 0x37, 0xd6, 0x98, 0x1c,
-	0x0a, 0x75, 0x9f, 0xb9, 0x1a, 0xcc, 0xec, 0x1b, 0x8a, 0x7d, 0x3b, 0x30, 0x5b, 0xbd, 0x3b, 0xfd,
-	0xb9, 0xf2, 0x9c, 0xab, 0x95, 0xe7, 0xfc, 0x5e, 0x79, 0xce, 0xf7, 0xb5, 0xd7, 0xb8, 0x5a, 0x7b,
-	0x8d, 0x5f, 0x6b, 0xaf, 0x71, 0xf1, 0x7c, 0x92, 0xe1, 0x74, 0x16, 0xfb, 0x89, 0xca, 0x83, 0xb3,
-	0xb3, 0xd3, 0x8b, 0x4f, 0x32, 0x36, 0xc1, 0xdf, 0x27, 0xbf, 0xa8, 0x1f, 0xfd, 0xb2, 0x04, 0x13,
-	0xbb, 0xf4, 0xea, 0x5f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x59, 0x22, 0x04, 0xb9, 0x69, 0x03,
-	0x00, 0x00,
+	// 740 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x2f, 0x10, 0xc8, 0x04, 0x42, 0x32, 0x09, 0x30, 0x04, 0x6e, 0x30, 0x48, 0x57, 0xca,
+	0x15, 0xf7, 0xda, 0x22, 0x6d, 0x17, 0x5d, 0x96, 0x1f, 0xd1, 0x56, 0x6d, 0x85, 0x0c, 0x2b, 0xba,
+	0xb0, 0x26, 0xf6, 0x21, 0x71, 0x49, 0x3c, 0xd6, 0xcc, 0x24, 0x4a, 0xde, 0xa2, 0xaf, 0xd0, 0xb7,
+	0x61, 0xc9, 0xb2, 0xab, 0xaa, 0x82, 0x17, 0xa9, 0x66, 0xc6, 0x0e, 0x69, 0x42, 0x77, 0x73, 0xbe,
+	0xef, 0x9b, 0xe3, 0x73, 0xce, 0x77, 0x3c, 0xe8, 0x60, 0x38, 0x94, 0xa3, 0xa0, 0x4b, 0xa3, 0xd8,
+	0x95, 0x9c, 0x86, 0xe0, 0x0a, 0xc9, 0x38, 0x84, 0xbe, 0x0e, 0x9c, 0x84, 0x33, 0xc9, 0xf0, 0xfa,
+	0x44, 0xe3, 0x68, 0xb8, 0xde, 0x08, 0x98, 0xe8, 0x33, 0xe1, 0xb6, 0xa9, 0x00, 0x77, 0x78, 0xd4,
+	0x06, 0x49, 0x8f, 0xdc, 0x80, 0x45, 0xb1, 0xb9, 0x50, 0xaf, 0x75, 0x58, 0x87, 0xe9, 0xa3, 0xab,
+	0x4e, 0x29, 0xba, 0x33, 0xfb, 0xa9, 0xa9, 0x6f, 0xd4, 0xed, 0x67, 0x49, 0x3f, 0xa4, 0x92, 0x1a,
+	0xc5, 0xc1, 0xb7, 0x02, 0x2a, 0x5e, 0xea, 0xe2, 0xae, 0x14, 0x85, 0xf7, 0x50, 0xd1, 0x68, 0xa2,
+	0x38, 0x84, 0x11, 0xb1, 0x6c, 0xab, 0xb9, 0xe8, 0x21, 0x0d, 0xbd, 0x53, 0x08, 0x7e, 0x8d, 0x4c,
+	0xe4, 0xcb, 0x71, 0x02, 0xe4, 0x2f, 0xdb, 0x6a, 0x96, 0x5a, 0x75, 0x67, 0xa6, 0x17, 0x47, 0x27,
+	0xbb, 0x1a, 0x27, 0xe0, 0x15, 0x64, 0x76, 0xc4, 0x47, 0x28, 0x4f, 0xfb, 0x6c, 0x10, 0x4b, 0xb2,
+	0x60, 0x5b, 0xcd, 0x62, 0x6b, 0xdb, 0x31, 0x1d, 0x3b, 0xaa, 0x63, 0x27, 0xed, 0xd8, 0x39, 0x61,
+	0x51, 0xec, 0xa5, 0x42, 0xfc, 0x1f, 0xc2, 0x6a, 0x02, 0x7e, 0x3f, 0x8a, 0x65, 0x14, 0x77, 0xfc,
+	0x84, 0x47, 0x01, 0x90, 0x45, 0xdb, 0x6a, 0x16, 0xbc, 0xb2, 0x62, 0x3e, 0x1a, 0xe2, 0x42, 0xe1,
+	0xf8, 0x5f, 0x54, 0xe6, 0x10, 0x40, 0x34, 0x04, 0xee, 0xd3, 0x30, 0xe4, 0x20, 0x04, 0x59, 0xd2,
+	0xda, 0xf5, 0x0c, 0x7f, 0x63, 0x60, 0xfc, 0x12, 0xe5, 0x85, 0xa4, 0x72, 0x20, 0x48, 0x5e, 0xb7,
+	0xb0, 0xfb, 0x7c, 0x0b, 0x97, 0x5a, 0xe3, 0xa5, 0x5a, 0x5c, 0x43, 0x4b, 0x7d, 0x7a, 0x0b, 0x9c,
+	0x2c, 0xeb, 0xac, 0x26, 0xc0, 0x04, 0x2d, 0x07, 0x5d, 0x08, 0x14, 0xbe, 0xa2, 0xf1, 0x2c, 0xc4,
+	0x5b, 0x68, 0x59, 0x8e, 0xd4, 0xb8, 0x81, 0x14, 0x34, 0x93, 0x97, 0xa3, 0x53, 0x2a, 0xf5, 0x98,
+	0x03, 0x0e, 0x54, 0x82, 0x21, 0x91, 0x26, 0x91, 0x81, 0x32, 0xc1, 0x20, 0x09, 0x27, 0x82, 0xa2,
+	0x11, 0x18, 0x48, 0x0b, 0xf6, 0xd1, 0x6a, 0xc2, 0x59, 0x00, 0x42, 0x18, 0xc5, 0xaa, 0x56, 0x14,
+	0x53, 0x4c, 0x4b, 0xfe, 0x46, 0xe8, 0xc9, 0x6f, 0xb2, 0xa6, 0x05, 0xc6, 0x8e, 0x53, 0x2a, 0x29,
+	0x7e, 0x85, 0xb6, 0xe6, 0x67, 0xeb, 0x7f, 0x11, 0x2c, 0x26, 0x25, 0xad, 0xad, 0xcd, 0x0e, 0xf8,
+	0xbd, 0x60, 0xb1, 0xb2, 0x04, 0xd4, 0xa0, 0xe2, 0x0e, 0xf8, 0x9c, 0xca, 0xf4, 0xc6, 0xba, 0xb1,
+	0x24, 0x63, 0x3c, 0x2a, 0x8d, 0xda, 0x41, 0xd5, 0x36, 0x8d, 0x6f, 0x55, 0x7e, 0x31, 0x16, 0x12,
+	0xfa, 0xa6, 0x98, 0xb2, 0x96, 0x57, 0x52, 0xea, 0x52, 0x33, 0xba, 0xa8, 0x4d, 0x94, 0xe7, 0x20,
+	0x06, 0x3d, 0x49, 0x2a, 0x66, 0x60, 0x26, 0xc2, 0x75, 0xb4, 0xc2, 0x61, 0x18, 0x89, 0x88, 0xc5,
+	0x04, 0xeb, 0xa5, 0x9c, 0xc4, 0xb8, 0x85, 0x36, 0x12, 0x15, 0xb0, 0x81, 0xf0, 0x13, 0x3a, 0xee,
+	0x31, 0x1a, 0xfa, 0x5d, 0x2a, 0xba, 0xa4, 0xaa, 0x53, 0x54, 0x33, 0xf2, 0xc2, 0x70, 0x6f, 0xa9,
+	0xe8, 0xe2, 0x5d, 0x54, 0xa0, 0x49, 0xc2, 0xd9, 0x90, 0xf6, 0x04, 0xa9, 0xd9, 0x0b, 0x6a, 0x34,
+	0x13, 0x00, 0x9f, 0xa2, 0xb2, 0x5a, 0xef, 0xf4, 0x87, 0x35, 0x25, 0x6f, 0xe8, 0x9d, 0xfd, 0xc3,
+	0xaa, 0xab, 0xda, 0xbd, 0x92, 0xbe, 0x33, 0x89, 0xf1, 0x67, 0x54, 0x9d, 0x1f, 0xb0, 0x20, 0x9b,
+	0xf6, 0x42, 0xb3, 0xd8, 0xfa, 0x67, 0x2e, 0xd1, 0xc9, 0x33, 0xd3, 0x3e, 0x5e, 0xbc, 0xfb, 0xb1,
+	0x97, 0xf3, 0x2a, 0xb3, 0x4e, 0x08, 0xfc, 0x09, 0x95, 0x7e, 0xb3, 0x41, 0x90, 0x2d, 0x9d, 0x77,
+	0x7f, 0x2e, 0xef, 0xd9, 0x8c, 0x27, 0x69, 0xce, 0xb5, 0x69, 0xaf, 0x04, 0xfe, 0x5f, 0xd9, 0x2a,
+	0x81, 0xc7, 0xb4, 0xe7, 0x73, 0xb8, 0x01, 0x0e, 0x71, 0x00, 0x84, 0x18, 0x9f, 0x32, 0xc6, 0xcb,
+	0x08, 0x7c, 0x88, 0x2a, 0x37, 0x34, 0xea, 0x0d, 0x38, 0xf8, 0x01, 0x0b, 0x41, 0x24, 0x34, 0x00,
+	0xb2, 0x6d, 0x96, 0x20, 0x25, 0x4e, 0x32, 0x5c, 0xed, 0xea, 0xb4, 0x98, 0xd4, 0x6d, 0xab, 0xb9,
+	0xe6, 0x15, 0xa7, 0x74, 0xca, 0x5f, 0x2a, 0x25, 0xf4, 0x13, 0x29, 0xc8, 0x8e, 0xa6, 0x27, 0xf1,
+	0xf1, 0xd9, 0xdd, 0x43, 0xc3, 0xba, 0x7f, 0x68, 0x58, 0x3f, 0x1f, 0x1a, 0xd6, 0xd7, 0xc7, 0x46,
+	0xee, 0xfe, 0xb1, 0x91, 0xfb, 0xfe, 0xd8, 0xc8, 0x5d, 0x1f, 0x76, 0x22, 0xd9, 0x1d, 0xb4, 0x9d,
+	0x80, 0xf5, 0xdd, 0xf3, 0xf3, 0xb3, 0xeb, 0x0f, 0xb4, 0x2d, 0xdc, 0xa7, 0x37, 0x6f, 0x94, 0xbd,
+	0x7a, 0xe3, 0x04, 0x44, 0x3b, 0xaf, 0x5f, 0xbc, 0x17, 0xbf, 0x06, 0x00, 0xd6, 0xae, 0xb4, 0x96,
+	0x9d, 0x05, 0x00, 0x00,
 }
 
 func (m *StoredTrade) Marshal() (dAtA []byte, err error) {
@@ -260,6 +366,111 @@ func (m *StoredTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintStoredTrade(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.FailureCode != 0 {
+		i = encodeVarintStoredTrade(dAtA, i, uint64(m.FailureCode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.FailureCodespace) > 0 {
+		i -= len(m.FailureCodespace)
+		copy(dAtA[i:], m.FailureCodespace)
+		i = encodeVarintStoredTrade(dAtA, i, uint64(len(m.FailureCodespace)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.ExternalReference) > 0 {
+		i -= len(m.ExternalReference)
+		copy(dAtA[i:], m.ExternalReference)
+		i = encodeVarintStoredTrade(dAtA, i, uint64(len(m.ExternalReference)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStoredTrade(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.CoinMintingPrices) > 0 {
+		for iNdEx := len(m.CoinMintingPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinMintingPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStoredTrade(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.TypedTradeData != nil {
+		{
+			size, err := m.TypedTradeData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStoredTrade(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintStoredTrade(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.PreviousPayloadHash) > 0 {
+		i -= len(m.PreviousPayloadHash)
+		copy(dAtA[i:], m.PreviousPayloadHash)
+		i = encodeVarintStoredTrade(dAtA, i, uint64(len(m.PreviousPayloadHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintStoredTrade(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
 	if len(m.Result) > 0 {
 		i -= len(m.Result)
 		copy(dAtA[i:], m.Result)
@@ -467,6 +678,49 @@ func (m *StoredTrade) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovStoredTrade(uint64(l))
 	}
+	if m.Revision != 0 {
+		n += 2 + sovStoredTrade(uint64(m.Revision))
+	}
+	l = len(m.PreviousPayloadHash)
+	if l > 0 {
+		n += 2 + l + sovStoredTrade(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 2 + l + sovStoredTrade(uint64(l))
+		}
+	}
+	if m.TypedTradeData != nil {
+		l = m.TypedTradeData.Size()
+		n += 2 + l + sovStoredTrade(uint64(l))
+	}
+	if len(m.CoinMintingPrices) > 0 {
+		for _, e := range m.CoinMintingPrices {
+			l = e.Size()
+			n += 2 + l + sovStoredTrade(uint64(l))
+		}
+	}
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 2 + l + sovStoredTrade(uint64(l))
+		}
+	}
+	l = len(m.ExternalReference)
+	if l > 0 {
+		n += 2 + l + sovStoredTrade(uint64(l))
+	}
+	l = len(m.FailureCodespace)
+	if l > 0 {
+		n += 2 + l + sovStoredTrade(uint64(l))
+	}
+	if m.FailureCode != 0 {
+		n += 2 + sovStoredTrade(uint64(m.FailureCode))
+	}
+	if m.Attempts != 0 {
+		n += 2 + sovStoredTrade(uint64(m.Attempts))
+	}
 	return n
 }
 
@@ -1014,6 +1268,295 @@ func (m *StoredTrade) Unmarshal(dAtA []byte) error {
 			}
 			m.Result = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPayloadHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousPayloadHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypedTradeData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredTrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TypedTradeData == nil {
+				m.TypedTradeData = &TradeData{}
+			}
+			if err := m.TypedTradeData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinMintingPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredTrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinMintingPrices = append(m.CoinMintingPrices, CoinMintingPriceJson{})
+			if err := m.CoinMintingPrices[len(m.CoinMintingPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredTrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, ExchangeRateJson{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCodespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureCodespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCode", wireType)
+			}
+			m.FailureCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStoredTrade(dAtA[iNdEx:])
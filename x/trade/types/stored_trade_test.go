@@ -0,0 +1,76 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoredTradePayloadHash(t *testing.T) {
+	st := StoredTrade{
+		TradeData:            `{"trade_info":{}}`,
+		BankingSystemData:    "{}",
+		CoinMintingPriceJson: "[]",
+		ExchangeRateJson:     "[]",
+	}
+	hash := st.PayloadHash()
+	require.Len(t, hash, 64)
+
+	// fields that are not part of the payload do not change the hash
+	other := st
+	other.Revision = 1
+	other.Status = StatusCanceled
+	require.Equal(t, hash, other.PayloadHash())
+
+	// moving bytes between fields changes the hash
+	other = st
+	other.BankingSystemData = "{}[]"
+	other.CoinMintingPriceJson = ""
+	require.NotEqual(t, hash, other.PayloadHash())
+
+	other = st
+	other.ExchangeRateJson = `[{"from_currency":"USD"}]`
+	require.NotEqual(t, hash, other.PayloadHash())
+}
+
+func TestStoredTradeSetFailure(t *testing.T) {
+	for _, tc := range []struct {
+		desc         string
+		err          error
+		expCodespace string
+		expCode      uint32
+	}{
+		{
+			desc:         "trade error",
+			err:          ErrMintBurnLimitExceeded.Wrap("maker mint limit"),
+			expCodespace: ModuleName,
+			expCode:      ErrMintBurnLimitExceeded.ABCICode(),
+		},
+		{
+			desc:         "sdk error",
+			err:          sdkerrors.ErrInsufficientFunds.Wrap("spendable balance"),
+			expCodespace: sdkerrors.ErrInsufficientFunds.Codespace(),
+			expCode:      sdkerrors.ErrInsufficientFunds.ABCICode(),
+		},
+		{
+			desc:         "unregistered error",
+			err:          errors.New("vetoed"),
+			expCodespace: ModuleName,
+			expCode:      ErrTradeExecutionFailed.ABCICode(),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			var st StoredTrade
+			st.SetFailure(tc.err)
+			require.Equal(t, tc.err.Error(), st.Result)
+			require.Equal(t, tc.expCodespace, st.FailureCodespace)
+			require.Equal(t, tc.expCode, st.FailureCode)
+
+			st.ClearFailure()
+			require.Empty(t, st.FailureCodespace)
+			require.Zero(t, st.FailureCode)
+		})
+	}
+}
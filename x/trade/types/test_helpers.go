@@ -9,11 +9,18 @@ import (
 )
 
 func GetSampleTradeDataJson(tradeType TradeType) string {
-	tradeValue := 100.50
-	tradeNetValue := 495.00
-	numberOfShares := 1000.0
-	sharePrice := 49.50
-	shareNetPrice := 500.00
+	// the values are consistent, a quantity of 100000 is worth a trade net value of 100
+	// at the coin minting price of 0.001
+	tradeValue := math.LegacyMustNewDecFromStr("95.00")
+	tradeNetValue := math.LegacyMustNewDecFromStr("100.00")
+	numberOfShares := math.LegacyMustNewDecFromStr("1000.0")
+	sharePrice := math.LegacyMustNewDecFromStr("0.095")
+	shareNetPrice := math.LegacyMustNewDecFromStr("0.10")
+	if tradeType == TradeTypeFiatWithdrawal {
+		// the fee is deducted from the trade value of a withdrawal
+		tradeValue = math.LegacyMustNewDecFromStr("105.00")
+		sharePrice = math.LegacyMustNewDecFromStr("0.105")
+	}
 
 	var quantity *sdk.Coin
 	if tradeType == TradeTypeFiatDeposit || tradeType == TradeTypeFiatWithdrawal {
@@ -23,18 +30,18 @@ func GetSampleTradeDataJson(tradeType TradeType) string {
 		}
 	}
 
-	// switch tradeType {
-	// case TradeTypeSplit, TradeTypeReverseSplit:
-	// 	tradeValue = 0
-	// 	tradeNetValue = 0
-	// 	sharePrice = 0
-	// 	shareNetPrice = 0
+	switch tradeType {
+	case TradeTypeSplit, TradeTypeReverseSplit:
+		tradeValue = math.LegacyZeroDec()
+		tradeNetValue = math.LegacyZeroDec()
+		sharePrice = math.LegacyZeroDec()
+		shareNetPrice = math.LegacyZeroDec()
 
-	// case TradeTypeDividends, TradeTypeDividendsDeduction:
-	// 	numberOfShares = 0
-	// 	sharePrice = 0
-	// 	shareNetPrice = 0
-	// }
+	case TradeTypeDividends, TradeTypeDividendsDeduction:
+		numberOfShares = math.LegacyZeroDec()
+		sharePrice = math.LegacyZeroDec()
+		shareNetPrice = math.LegacyZeroDec()
+	}
 
 	tradeData := TradeData{
 		TradeInfo: &TradeInfo{
@@ -44,17 +51,17 @@ func GetSampleTradeDataJson(tradeType TradeType) string {
 			TradeValue:         tradeValue,
 			BaseCurrency:       "GBP",
 			SettlementCurrency: "GBP",
-			ExchangeRate:       1,
+			ExchangeRate:       math.LegacyOneDec(),
 			Exchange:           "US",
 			FundName:           "TechFund",
 			Issuer:             "CompanyA",
 			NumberOfShares:     numberOfShares,
-			CoinMintingPrice:   0.001,
+			CoinMintingPrice:   math.LegacyMustNewDecFromStr("0.001"),
 			Quantity:           quantity,
 			Segment:            "Technology",
 			SharePrice:         sharePrice,
 			Ticker:             "TECH",
-			TradeFee:           5.00,
+			TradeFee:           math.LegacyMustNewDecFromStr("5.00"),
 			ShareNetPrice:      shareNetPrice,
 			TradeNetValue:      tradeNetValue,
 		},
@@ -73,11 +80,18 @@ func GetSampleTradeDataJson(tradeType TradeType) string {
 }
 
 func GetSampleTradeData(tradeType TradeType) TradeData {
-	tradeValue := 100.50
-	tradeNetValue := 495.00
-	numberOfShares := 1000.0
-	sharePrice := 49.50
-	shareNetPrice := 500.00
+	// the values are consistent, a quantity of 100000 is worth a trade net value of 100
+	// at the coin minting price of 0.001
+	tradeValue := math.LegacyMustNewDecFromStr("95.00")
+	tradeNetValue := math.LegacyMustNewDecFromStr("100.00")
+	numberOfShares := math.LegacyMustNewDecFromStr("1000.0")
+	sharePrice := math.LegacyMustNewDecFromStr("0.095")
+	shareNetPrice := math.LegacyMustNewDecFromStr("0.10")
+	if tradeType == TradeTypeFiatWithdrawal {
+		// the fee is deducted from the trade value of a withdrawal
+		tradeValue = math.LegacyMustNewDecFromStr("105.00")
+		sharePrice = math.LegacyMustNewDecFromStr("0.105")
+	}
 
 	var quantity *sdk.Coin
 	if tradeType == TradeTypeFiatDeposit || tradeType == TradeTypeFiatWithdrawal {
@@ -87,6 +101,19 @@ func GetSampleTradeData(tradeType TradeType) TradeData {
 		}
 	}
 
+	switch tradeType {
+	case TradeTypeSplit, TradeTypeReverseSplit:
+		tradeValue = math.LegacyZeroDec()
+		tradeNetValue = math.LegacyZeroDec()
+		sharePrice = math.LegacyZeroDec()
+		shareNetPrice = math.LegacyZeroDec()
+
+	case TradeTypeDividends, TradeTypeDividendsDeduction:
+		numberOfShares = math.LegacyZeroDec()
+		sharePrice = math.LegacyZeroDec()
+		shareNetPrice = math.LegacyZeroDec()
+	}
+
 	tradeData := TradeData{
 		TradeInfo: &TradeInfo{
 			AssetHolderId:      2,
@@ -95,17 +122,17 @@ func GetSampleTradeData(tradeType TradeType) TradeData {
 			TradeValue:         tradeValue,
 			BaseCurrency:       "GBP",
 			SettlementCurrency: "GBP",
-			ExchangeRate:       1,
+			ExchangeRate:       math.LegacyOneDec(),
 			Exchange:           "NYSE",
 			FundName:           "TechFund",
 			Issuer:             "CompanyA",
 			NumberOfShares:     numberOfShares,
-			CoinMintingPrice:   0.001,
+			CoinMintingPrice:   math.LegacyMustNewDecFromStr("0.001"),
 			Quantity:           quantity,
 			Segment:            "Technology",
 			SharePrice:         sharePrice,
 			Ticker:             "TECH",
-			TradeFee:           5.00,
+			TradeFee:           math.LegacyMustNewDecFromStr("5.00"),
 			ShareNetPrice:      shareNetPrice,
 			TradeNetValue:      tradeNetValue,
 		},
@@ -118,34 +145,38 @@ func GetSampleTradeData(tradeType TradeType) TradeData {
 	return tradeData
 }
 
-func GetSampleExchangeRateJson() string {
-	exchangeRate := []ExchangeRateJson{
+func GetSampleExchangeRates() []ExchangeRateJson {
+	return []ExchangeRateJson{
 		{
 			FromCurrency:    "GBP",
 			ToCurrency:      "EUR",
-			OriginalAmount:  1,
-			ConvertedAmount: 0.85,
-			CurrencyRate:    0.85,
+			OriginalAmount:  math.LegacyOneDec(),
+			ConvertedAmount: math.LegacyMustNewDecFromStr("0.85"),
+			CurrencyRate:    math.LegacyMustNewDecFromStr("0.85"),
 			Timestamp:       "2025-07-08T00:00:00Z",
 		},
 	}
+}
 
-	exchangeRateBytes, err := json.Marshal(exchangeRate)
+func GetSampleExchangeRateJson() string {
+	exchangeRateBytes, err := json.Marshal(GetSampleExchangeRates())
 	if err != nil {
 		panic(err)
 	}
 	return string(exchangeRateBytes)
 }
 
-func GetSampleCoinMintingPriceJson() string {
-	coinMintingPrice := []CoinMintingPriceJson{
+func GetSampleCoinMintingPrices() []CoinMintingPriceJson {
+	return []CoinMintingPriceJson{
 		{
 			CurrencyCode: "GBP",
-			MintingPrice: 0.001,
+			MintingPrice: math.LegacyMustNewDecFromStr("0.001"),
 		},
 	}
+}
 
-	coinMintingPriceBytes, err := json.Marshal(coinMintingPrice)
+func GetSampleCoinMintingPriceJson() string {
+	coinMintingPriceBytes, err := json.Marshal(GetSampleCoinMintingPrices())
 	if err != nil {
 		panic(err)
 	}
@@ -153,6 +184,10 @@ func GetSampleCoinMintingPriceJson() string {
 }
 
 func GetBaseStoredTrade() StoredTrade {
+	typedTradeData, err := ParseTradeData(GetSampleTradeDataJson(TradeTypeFiatDeposit))
+	if err != nil {
+		panic(err)
+	}
 	return StoredTrade{
 		TradeType: TradeTypeFiatDeposit,
 		Amount: &sdk.Coin{
@@ -166,6 +201,9 @@ func GetBaseStoredTrade() StoredTrade {
 		BankingSystemData:    "{}",
 		ExchangeRateJson:     GetSampleExchangeRateJson(),
 		CoinMintingPriceJson: GetSampleCoinMintingPriceJson(),
+		TypedTradeData:       &typedTradeData,
+		CoinMintingPrices:    GetSampleCoinMintingPrices(),
+		ExchangeRates:        GetSampleExchangeRates(),
 		TxDate:               "0001-01-01T00:00:00Z",
 		CreateDate:           "0001-01-01T00:00:00Z",
 		ProcessDate:          "0001-01-01T00:00:00Z",
@@ -185,6 +223,18 @@ func GetSampleMsgCreateTrade() *MsgCreateTrade {
 	)
 }
 
+// GetSampleMsgCreateTradeV2 get sample create trade message with typed payloads
+func GetSampleMsgCreateTradeV2() *MsgCreateTradeV2 {
+	return NewMsgCreateTradeV2(
+		testutil.Alice,
+		testutil.Alice,
+		GetSampleTradeData(TradeTypeFiatDeposit),
+		"{}",
+		GetSampleCoinMintingPrices(),
+		GetSampleExchangeRates(),
+	)
+}
+
 // GetMsgCreateTradeWithTypeAndAmount get sample create trade message specified with trade type and amount
 func GetMsgCreateTradeWithTypeAndAmount(tradeType TradeType, amount int64) *MsgCreateTrade {
 	tdStr := GetSampleTradeDataJson(TradeTypeFiatDeposit)
@@ -195,6 +245,7 @@ func GetMsgCreateTradeWithTypeAndAmount(tradeType TradeType, amount int64) *MsgC
 
 	td.TradeInfo.TradeType = tradeType
 	td.TradeInfo.Quantity.Amount = math.NewInt(amount)
+	setSampleTradeValues(td.TradeInfo)
 
 	tdBytes, err := json.Marshal(td)
 	if err != nil {
@@ -211,6 +262,19 @@ func GetMsgCreateTradeWithTypeAndAmount(tradeType TradeType, amount int64) *MsgC
 	}
 }
 
+// setSampleTradeValues derives consistent values of a buy or sell trade from its quantity,
+// the trade fee is set to 5% of the trade net value
+func setSampleTradeValues(ti *TradeInfo) {
+	ti.TradeNetValue = math.LegacyNewDecFromInt(ti.Quantity.Amount).Mul(ti.CoinMintingPrice).Quo(ti.ExchangeRate)
+	ti.TradeFee = ti.TradeNetValue.Mul(math.LegacyNewDecWithPrec(5, 2))
+	ti.TradeValue = ti.TradeNetValue.Sub(ti.TradeFee)
+	if ti.TradeType == TradeTypeFiatWithdrawal {
+		ti.TradeValue = ti.TradeNetValue.Add(ti.TradeFee)
+	}
+	ti.SharePrice = ti.TradeValue.Quo(ti.NumberOfShares)
+	ti.ShareNetPrice = ti.TradeNetValue.Quo(ti.NumberOfShares)
+}
+
 // GetSampleStoredTrade return sample stored trade according to GetSampleMsgCreateTrade function
 // used after create trade
 func GetSampleStoredTrade(tradeIndex uint64) StoredTrade {
@@ -228,7 +292,9 @@ func GetSampleStoredTradeConfirmed(tradeIndex uint64) StoredTrade {
 	sampleStoredTrade.TradeIndex = tradeIndex
 	sampleStoredTrade.Status = StatusProcessed
 	sampleStoredTrade.Checker = testutil.Bob
+	sampleStoredTrade.Approvals = []string{testutil.Bob}
 	sampleStoredTrade.Result = TradeProcessedSuccessfully
+	sampleStoredTrade.Attempts = 1
 
 	return sampleStoredTrade
 }
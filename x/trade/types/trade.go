@@ -4,18 +4,23 @@ package types
 func (tt TradeType) IsTypeValid() bool {
 	switch tt {
 	case TradeTypeFiatDeposit,
-		TradeTypeFiatWithdrawal:
-		// TradeTypeSplit,
-		// TradeTypeReverseSplit,
-		// TradeTypeReinvestment,
-		// TradeTypeDividends,
-		// TradeTypeDividendsDeduction:
+		TradeTypeFiatWithdrawal,
+		TradeTypeSplit,
+		TradeTypeReverseSplit,
+		TradeTypeReinvestment,
+		TradeTypeDividends,
+		TradeTypeDividendsDeduction:
 		return true
 	default:
 		return false
 	}
 }
 
+// IsMintOrBurn check if a trade type mints or burns coins when it is processed
+func (tt TradeType) IsMintOrBurn() bool {
+	return tt == TradeTypeFiatDeposit || tt == TradeTypeFiatWithdrawal
+}
+
 // IsStatusValid check if a trade status is valid
 func (ts TradeStatus) IsStatusValid() bool {
 	switch ts {
@@ -29,3 +34,14 @@ func (ts TradeStatus) IsStatusValid() bool {
 		return false
 	}
 }
+
+// IsTransitionValid check if a trade transition is valid
+func (tt TradeTransition) IsTransitionValid() bool {
+	_, ok := TradeTransition_name[int32(tt)]
+	return ok && tt != TransitionNil
+}
+
+// IsModeValid check if a batch mode is valid
+func (bm BatchMode) IsModeValid() bool {
+	return bm == BatchModeAllOrNothing || bm == BatchModeBestEffort
+}
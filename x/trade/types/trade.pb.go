@@ -4,8 +4,10 @@
 package types
 
 import (
-	encoding_binary "encoding/binary"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -102,30 +104,41 @@ func (ProcessType) EnumDescriptor() ([]byte, []int) {
 type TradeType int32
 
 const (
-	TradeType_TRADE_TYPE_UNSPECIFIED TradeType = 0
-	// TRADE_TYPE_BUY = 1;
-	// TRADE_TYPE_SELL = 2;
-	// TRADE_TYPE_SPLIT = 3;
-	// TRADE_TYPE_REVERSE_SPLIT = 4;
-	// // TRADE_TYPE_REINVESTMENT buy new shares using dividends
-	// TRADE_TYPE_REINVESTMENT = 5;
-	// TRADE_TYPE_DIVIDENDS = 6;
-	// // TRADE_TYPE_DIVIDEND_DEDUCTION deduct dividends when reinvested
-	// TRADE_TYPE_DIVIDEND_DEDUCTION = 1;
+	TradeType_TRADE_TYPE_UNSPECIFIED     TradeType = 0
 	TradeType_TRADE_TYPE_FIAT_DEPOSIT    TradeType = 1
 	TradeType_TRADE_TYPE_FIAT_WITHDRAWAL TradeType = 2
+	// TRADE_TYPE_SPLIT records a share split, no coins are minted or burned
+	TradeType_TRADE_TYPE_SPLIT TradeType = 3
+	// TRADE_TYPE_REVERSE_SPLIT records a reverse share split, no coins are minted or burned
+	TradeType_TRADE_TYPE_REVERSE_SPLIT TradeType = 4
+	// TRADE_TYPE_REINVESTMENT buy new shares using dividends
+	TradeType_TRADE_TYPE_REINVESTMENT TradeType = 5
+	// TRADE_TYPE_DIVIDENDS records dividends paid to the fund
+	TradeType_TRADE_TYPE_DIVIDENDS TradeType = 6
+	// TRADE_TYPE_DIVIDEND_DEDUCTION deduct dividends when reinvested
+	TradeType_TRADE_TYPE_DIVIDEND_DEDUCTION TradeType = 7
 )
 
 var TradeType_name = map[int32]string{
 	0: "TRADE_TYPE_UNSPECIFIED",
 	1: "TRADE_TYPE_FIAT_DEPOSIT",
 	2: "TRADE_TYPE_FIAT_WITHDRAWAL",
+	3: "TRADE_TYPE_SPLIT",
+	4: "TRADE_TYPE_REVERSE_SPLIT",
+	5: "TRADE_TYPE_REINVESTMENT",
+	6: "TRADE_TYPE_DIVIDENDS",
+	7: "TRADE_TYPE_DIVIDEND_DEDUCTION",
 }
 
 var TradeType_value = map[string]int32{
-	"TRADE_TYPE_UNSPECIFIED":     0,
-	"TRADE_TYPE_FIAT_DEPOSIT":    1,
-	"TRADE_TYPE_FIAT_WITHDRAWAL": 2,
+	"TRADE_TYPE_UNSPECIFIED":        0,
+	"TRADE_TYPE_FIAT_DEPOSIT":       1,
+	"TRADE_TYPE_FIAT_WITHDRAWAL":    2,
+	"TRADE_TYPE_SPLIT":              3,
+	"TRADE_TYPE_REVERSE_SPLIT":      4,
+	"TRADE_TYPE_REINVESTMENT":       5,
+	"TRADE_TYPE_DIVIDENDS":          6,
+	"TRADE_TYPE_DIVIDEND_DEDUCTION": 7,
 }
 
 func (x TradeType) String() string {
@@ -136,13 +149,81 @@ func (TradeType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_457ca30f30f03c4e, []int{2}
 }
 
+// TradeDateField selects the date of a trade used by date range queries.
+type TradeDateField int32
+
+const (
+	// TRADE_DATE_FIELD_UNSPECIFIED defines an unspecified date field.
+	TradeDateField_TRADE_DATE_FIELD_UNSPECIFIED TradeDateField = 0
+	// TRADE_DATE_FIELD_CREATE_DATE selects the create date of a trade.
+	TradeDateField_TRADE_DATE_FIELD_CREATE_DATE TradeDateField = 1
+	// TRADE_DATE_FIELD_TX_DATE selects the transaction date of a trade.
+	TradeDateField_TRADE_DATE_FIELD_TX_DATE TradeDateField = 2
+	// TRADE_DATE_FIELD_PROCESS_DATE selects the process date of a trade.
+	TradeDateField_TRADE_DATE_FIELD_PROCESS_DATE TradeDateField = 3
+)
+
+var TradeDateField_name = map[int32]string{
+	0: "TRADE_DATE_FIELD_UNSPECIFIED",
+	1: "TRADE_DATE_FIELD_CREATE_DATE",
+	2: "TRADE_DATE_FIELD_TX_DATE",
+	3: "TRADE_DATE_FIELD_PROCESS_DATE",
+}
+
+var TradeDateField_value = map[string]int32{
+	"TRADE_DATE_FIELD_UNSPECIFIED":  0,
+	"TRADE_DATE_FIELD_CREATE_DATE":  1,
+	"TRADE_DATE_FIELD_TX_DATE":      2,
+	"TRADE_DATE_FIELD_PROCESS_DATE": 3,
+}
+
+func (x TradeDateField) String() string {
+	return proto.EnumName(TradeDateField_name, int32(x))
+}
+
+func (TradeDateField) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_457ca30f30f03c4e, []int{3}
+}
+
+// BatchMode selects how the entries of a batch message are handled when one of them fails.
+type BatchMode int32
+
+const (
+	// BATCH_MODE_UNSPECIFIED defines an unspecified batch mode.
+	BatchMode_BATCH_MODE_UNSPECIFIED BatchMode = 0
+	// BATCH_MODE_ALL_OR_NOTHING fails the whole message when an entry fails.
+	BatchMode_BATCH_MODE_ALL_OR_NOTHING BatchMode = 1
+	// BATCH_MODE_BEST_EFFORT skips the failed entries and reports their error in the results.
+	BatchMode_BATCH_MODE_BEST_EFFORT BatchMode = 2
+)
+
+var BatchMode_name = map[int32]string{
+	0: "BATCH_MODE_UNSPECIFIED",
+	1: "BATCH_MODE_ALL_OR_NOTHING",
+	2: "BATCH_MODE_BEST_EFFORT",
+}
+
+var BatchMode_value = map[string]int32{
+	"BATCH_MODE_UNSPECIFIED":    0,
+	"BATCH_MODE_ALL_OR_NOTHING": 1,
+	"BATCH_MODE_BEST_EFFORT":    2,
+}
+
+func (x BatchMode) String() string {
+	return proto.EnumName(BatchMode_name, int32(x))
+}
+
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_457ca30f30f03c4e, []int{4}
+}
+
 type ExchangeRateJson struct {
-	FromCurrency    string  `protobuf:"bytes,1,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
-	ToCurrency      string  `protobuf:"bytes,2,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
-	OriginalAmount  float64 `protobuf:"fixed64,3,opt,name=original_amount,json=originalAmount,proto3" json:"original_amount,omitempty"`
-	ConvertedAmount float64 `protobuf:"fixed64,4,opt,name=converted_amount,json=convertedAmount,proto3" json:"converted_amount,omitempty"`
-	CurrencyRate    float64 `protobuf:"fixed64,5,opt,name=currency_rate,json=currencyRate,proto3" json:"currency_rate,omitempty"`
-	Timestamp       string  `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FromCurrency    string                      `protobuf:"bytes,1,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
+	ToCurrency      string                      `protobuf:"bytes,2,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+	OriginalAmount  cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=original_amount,json=originalAmount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"original_amount"`
+	ConvertedAmount cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=converted_amount,json=convertedAmount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"converted_amount"`
+	CurrencyRate    cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=currency_rate,json=currencyRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"currency_rate"`
+	Timestamp       string                      `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
 func (m *ExchangeRateJson) Reset()         { *m = ExchangeRateJson{} }
@@ -192,27 +273,6 @@ func (m *ExchangeRateJson) GetToCurrency() string {
 	return ""
 }
 
-func (m *ExchangeRateJson) GetOriginalAmount() float64 {
-	if m != nil {
-		return m.OriginalAmount
-	}
-	return 0
-}
-
-func (m *ExchangeRateJson) GetConvertedAmount() float64 {
-	if m != nil {
-		return m.ConvertedAmount
-	}
-	return 0
-}
-
-func (m *ExchangeRateJson) GetCurrencyRate() float64 {
-	if m != nil {
-		return m.CurrencyRate
-	}
-	return 0
-}
-
 func (m *ExchangeRateJson) GetTimestamp() string {
 	if m != nil {
 		return m.Timestamp
@@ -221,8 +281,8 @@ func (m *ExchangeRateJson) GetTimestamp() string {
 }
 
 type CoinMintingPriceJson struct {
-	CurrencyCode string  `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
-	MintingPrice float64 `protobuf:"fixed64,2,opt,name=minting_price,json=mintingPrice,proto3" json:"minting_price,omitempty"`
+	CurrencyCode string                      `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	MintingPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=minting_price,json=mintingPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"minting_price"`
 }
 
 func (m *CoinMintingPriceJson) Reset()         { *m = CoinMintingPriceJson{} }
@@ -265,17 +325,12 @@ func (m *CoinMintingPriceJson) GetCurrencyCode() string {
 	return ""
 }
 
-func (m *CoinMintingPriceJson) GetMintingPrice() float64 {
-	if m != nil {
-		return m.MintingPrice
-	}
-	return 0
-}
-
 func init() {
 	proto.RegisterEnum("vvtxchain.trade.TradeStatus", TradeStatus_name, TradeStatus_value)
 	proto.RegisterEnum("vvtxchain.trade.ProcessType", ProcessType_name, ProcessType_value)
 	proto.RegisterEnum("vvtxchain.trade.TradeType", TradeType_name, TradeType_value)
+	proto.RegisterEnum("vvtxchain.trade.TradeDateField", TradeDateField_name, TradeDateField_value)
+	proto.RegisterEnum("vvtxchain.trade.BatchMode", BatchMode_name, BatchMode_value)
 	proto.RegisterType((*ExchangeRateJson)(nil), "vvtxchain.trade.ExchangeRateJson")
 	proto.RegisterType((*CoinMintingPriceJson)(nil), "vvtxchain.trade.CoinMintingPriceJson")
 }
@@ -283,39 +338,54 @@ func init() {
 func init() { proto.RegisterFile("vvtxchain/trade/trade.proto", fileDescriptor_457ca30f30f03c4e) }
 
 var fileDescriptor_457ca30f30f03c4e = []byte{
-	// 512 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x4f, 0x6e, 0xd3, 0x40,
-	0x14, 0xc6, 0x33, 0xe9, 0x1f, 0xa9, 0x2f, 0x29, 0x19, 0x0d, 0x85, 0x9a, 0xb6, 0x32, 0x55, 0xbb,
-	0xa0, 0x04, 0x29, 0x59, 0x70, 0x02, 0x63, 0x4f, 0x82, 0xab, 0x34, 0xb1, 0x6c, 0x57, 0x15, 0x5d,
-	0x60, 0x1c, 0x67, 0x48, 0x2d, 0x61, 0x4f, 0x64, 0x4f, 0xa2, 0xe6, 0x16, 0x1c, 0x85, 0x63, 0xb0,
-	0xec, 0x92, 0x25, 0x4a, 0x0e, 0xc0, 0x15, 0x90, 0x27, 0xb1, 0x53, 0x53, 0x36, 0xb3, 0xf8, 0x7d,
-	0xdf, 0xfb, 0xe6, 0xbd, 0x27, 0x3d, 0x38, 0x9e, 0xcd, 0xc4, 0x7d, 0x70, 0xe7, 0x87, 0x71, 0x5b,
-	0x24, 0xfe, 0x88, 0xad, 0xde, 0xd6, 0x24, 0xe1, 0x82, 0x93, 0x46, 0x21, 0xb6, 0x24, 0x3e, 0xfb,
-	0x83, 0x00, 0xd3, 0x8c, 0xc4, 0x63, 0x66, 0xfb, 0x82, 0x5d, 0xa6, 0x3c, 0x26, 0xe7, 0xb0, 0xff,
-	0x35, 0xe1, 0x91, 0x17, 0x4c, 0x93, 0x84, 0xc5, 0xc1, 0x5c, 0x41, 0xa7, 0xe8, 0x62, 0xcf, 0xae,
-	0x67, 0x50, 0x5f, 0x33, 0xf2, 0x1a, 0x6a, 0x82, 0x6f, 0x2c, 0x55, 0x69, 0x01, 0xc1, 0x0b, 0xc3,
-	0x1b, 0x68, 0xf0, 0x24, 0x1c, 0x87, 0xb1, 0xff, 0xcd, 0xf3, 0x23, 0x3e, 0x8d, 0x85, 0xb2, 0x75,
-	0x8a, 0x2e, 0x90, 0xfd, 0x2c, 0xc7, 0x9a, 0xa4, 0xe4, 0x2d, 0xe0, 0x80, 0xc7, 0x33, 0x96, 0x08,
-	0x36, 0xca, 0x9d, 0xdb, 0xd2, 0xd9, 0x28, 0xf8, 0xda, 0x7a, 0x0e, 0xfb, 0xf9, 0x8f, 0x5e, 0xe2,
-	0x0b, 0xa6, 0xec, 0x48, 0x5f, 0x3d, 0x87, 0xd9, 0x08, 0xe4, 0x04, 0xf6, 0x44, 0x18, 0xb1, 0x54,
-	0xf8, 0xd1, 0x44, 0xd9, 0x95, 0x7d, 0x6d, 0xc0, 0xd9, 0x17, 0x38, 0xd0, 0x79, 0x18, 0x5f, 0x85,
-	0xb1, 0x08, 0xe3, 0xb1, 0x95, 0x84, 0x41, 0x31, 0x74, 0x11, 0x1d, 0xf0, 0x11, 0xcb, 0x87, 0xce,
-	0xa1, 0xce, 0x47, 0x2c, 0x33, 0x45, 0xab, 0x42, 0x6f, 0x92, 0x55, 0xca, 0xb1, 0x91, 0x5d, 0x8f,
-	0x1e, 0xa5, 0x35, 0x7f, 0x20, 0xa8, 0xb9, 0xd9, 0x76, 0x1d, 0xe1, 0x8b, 0x69, 0x4a, 0x4e, 0x40,
-	0x71, 0x6d, 0xcd, 0xa0, 0x9e, 0xe3, 0x6a, 0xee, 0xb5, 0xe3, 0x5d, 0xf7, 0x1d, 0x8b, 0xea, 0x66,
-	0xc7, 0xa4, 0x06, 0xae, 0x10, 0x05, 0x0e, 0x4a, 0xaa, 0x45, 0xfb, 0x86, 0xd9, 0xef, 0x62, 0x44,
-	0x5e, 0xc1, 0x8b, 0x92, 0xa2, 0x6b, 0x7d, 0x9d, 0xf6, 0xa8, 0x81, 0xab, 0xe4, 0x08, 0x5e, 0x96,
-	0x8b, 0xec, 0x81, 0x4e, 0x1d, 0x87, 0x1a, 0x78, 0xeb, 0x49, 0x99, 0x4d, 0x2f, 0xa9, 0xee, 0x52,
-	0x03, 0x6f, 0x93, 0x43, 0x78, 0x5e, 0x92, 0x3a, 0x9a, 0x99, 0xe5, 0xed, 0x34, 0x3f, 0x43, 0xcd,
-	0x4a, 0x78, 0xc0, 0xd2, 0xd4, 0x9d, 0x4f, 0xb2, 0x0d, 0x2a, 0xeb, 0x44, 0xcf, 0xfd, 0x64, 0xd1,
-	0xa7, 0x1d, 0x97, 0x54, 0x7d, 0xd0, 0xef, 0x98, 0xf6, 0x15, 0x46, 0x59, 0x7e, 0x49, 0x59, 0x7d,
-	0x8d, 0xab, 0xcd, 0x11, 0xec, 0xc9, 0x8d, 0xc8, 0xf4, 0xa2, 0xf9, 0xff, 0x64, 0x1f, 0xc3, 0xe1,
-	0x23, 0xad, 0x63, 0x6a, 0xae, 0x67, 0x50, 0x6b, 0xe0, 0x98, 0x2e, 0x46, 0x44, 0x85, 0xa3, 0x7f,
-	0xc5, 0x1b, 0xd3, 0xfd, 0x68, 0xd8, 0xda, 0x8d, 0xd6, 0xc3, 0xd5, 0x0f, 0xf4, 0xe7, 0x42, 0x45,
-	0x0f, 0x0b, 0x15, 0xfd, 0x5e, 0xa8, 0xe8, 0xfb, 0x52, 0xad, 0x3c, 0x2c, 0xd5, 0xca, 0xaf, 0xa5,
-	0x5a, 0xb9, 0x7d, 0x37, 0x0e, 0xc5, 0xdd, 0x74, 0xd8, 0x0a, 0x78, 0xd4, 0xee, 0x76, 0xe9, 0x6d,
-	0xcf, 0x1f, 0xa6, 0xed, 0xcd, 0xa1, 0xdc, 0xe7, 0xa7, 0x32, 0x9f, 0xb0, 0x74, 0xb8, 0x2b, 0x6f,
-	0xe5, 0xfd, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xea, 0xdb, 0x7a, 0x2f, 0x4a, 0x03, 0x00, 0x00,
+	// 750 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xdd, 0x4e, 0xe3, 0x46,
+	0x14, 0xc7, 0xe3, 0x04, 0xa8, 0x32, 0x7c, 0x8d, 0xdc, 0x14, 0x4c, 0x80, 0x40, 0xe9, 0x4d, 0x95,
+	0xaa, 0x89, 0xaa, 0x3e, 0x81, 0xe3, 0x99, 0x80, 0x91, 0x63, 0x47, 0xf6, 0x10, 0x5a, 0x54, 0xd5,
+	0x72, 0x9c, 0x69, 0x62, 0x15, 0x7b, 0x22, 0x7b, 0x82, 0xc8, 0x1b, 0xec, 0xe5, 0x4a, 0xab, 0x7d,
+	0x8f, 0xbd, 0xd8, 0x87, 0xe0, 0x12, 0xed, 0xd5, 0x6a, 0x2f, 0xd0, 0x0a, 0xde, 0x60, 0x9f, 0x60,
+	0x65, 0x3b, 0xce, 0x17, 0x5c, 0x71, 0x13, 0x25, 0xe7, 0x77, 0xce, 0x7f, 0xfe, 0xe7, 0x9c, 0xc9,
+	0x80, 0xfd, 0x9b, 0x1b, 0x7e, 0xeb, 0x0e, 0x1c, 0x2f, 0xa8, 0xf3, 0xd0, 0xe9, 0xd1, 0xf4, 0xb3,
+	0x36, 0x0c, 0x19, 0x67, 0xe2, 0xf6, 0x14, 0xd6, 0x92, 0x70, 0x79, 0xcf, 0x65, 0x91, 0xcf, 0x22,
+	0x3b, 0xc1, 0xf5, 0xf4, 0x47, 0x9a, 0x5b, 0x2e, 0xf5, 0x59, 0x9f, 0xa5, 0xf1, 0xf8, 0x5b, 0x1a,
+	0x3d, 0x79, 0x53, 0x00, 0x10, 0xc7, 0x12, 0x41, 0x9f, 0x9a, 0x0e, 0xa7, 0xe7, 0x11, 0x0b, 0xc4,
+	0x5f, 0xc0, 0xe6, 0x7f, 0x21, 0xf3, 0x6d, 0x77, 0x14, 0x86, 0x34, 0x70, 0xc7, 0x92, 0x70, 0x2c,
+	0xfc, 0x5a, 0x34, 0x37, 0xe2, 0xa0, 0x32, 0x89, 0x89, 0x47, 0x60, 0x9d, 0xb3, 0x59, 0x4a, 0x3e,
+	0x49, 0x01, 0x9c, 0x4d, 0x13, 0xae, 0xc0, 0x36, 0x0b, 0xbd, 0xbe, 0x17, 0x38, 0xd7, 0xb6, 0xe3,
+	0xb3, 0x51, 0xc0, 0xa5, 0x42, 0x9c, 0xd4, 0xf8, 0xe3, 0xee, 0xe1, 0x28, 0xf7, 0xe5, 0xe1, 0x68,
+	0x3f, 0xf5, 0x17, 0xf5, 0xfe, 0xaf, 0x79, 0xac, 0xee, 0x3b, 0x7c, 0x50, 0xd3, 0x68, 0xdf, 0x71,
+	0xc7, 0x88, 0xba, 0x9f, 0x3e, 0xfe, 0x0e, 0x26, 0xf6, 0x11, 0x75, 0xcd, 0xad, 0x4c, 0x49, 0x4e,
+	0x84, 0xc4, 0x7f, 0x00, 0x74, 0x59, 0x70, 0x43, 0x43, 0x4e, 0x7b, 0x99, 0xf8, 0xca, 0x6b, 0xc5,
+	0xb7, 0xa7, 0x52, 0x13, 0xf5, 0x0e, 0xd8, 0xcc, 0xfa, 0xb2, 0x43, 0x87, 0x53, 0x69, 0xf5, 0xb5,
+	0xd2, 0x1b, 0x99, 0x4e, 0x3c, 0x5b, 0xf1, 0x00, 0x14, 0xb9, 0xe7, 0xd3, 0x88, 0x3b, 0xfe, 0x50,
+	0x5a, 0x4b, 0x06, 0x36, 0x0b, 0x9c, 0xbc, 0x13, 0x40, 0x49, 0x61, 0x5e, 0xd0, 0xf2, 0x02, 0xee,
+	0x05, 0xfd, 0x76, 0xe8, 0xb9, 0xd3, 0x75, 0x4c, 0xed, 0xb8, 0xac, 0x47, 0xb3, 0x75, 0x64, 0x41,
+	0x85, 0xf5, 0x68, 0xec, 0xd9, 0x4f, 0x0b, 0xed, 0x61, 0x5c, 0x29, 0xe5, 0x5f, 0xed, 0xd9, 0x9f,
+	0x33, 0x50, 0xfd, 0x20, 0x80, 0x75, 0x12, 0xdf, 0x2d, 0x8b, 0x3b, 0x7c, 0x14, 0x89, 0x07, 0x40,
+	0x22, 0xa6, 0x8c, 0xb0, 0x6d, 0x11, 0x99, 0x5c, 0x58, 0xf6, 0x85, 0x6e, 0xb5, 0xb1, 0xa2, 0x36,
+	0x55, 0x8c, 0x60, 0x4e, 0x94, 0x40, 0x69, 0x81, 0xb6, 0xb1, 0x8e, 0x54, 0xfd, 0x14, 0x0a, 0xe2,
+	0x1e, 0xf8, 0x69, 0x81, 0x28, 0xb2, 0xae, 0x60, 0x0d, 0x23, 0x98, 0x17, 0xcb, 0x60, 0x67, 0xb1,
+	0xc8, 0x34, 0x14, 0x6c, 0x59, 0x18, 0xc1, 0xc2, 0xb3, 0x32, 0x13, 0x9f, 0x63, 0x85, 0x60, 0x04,
+	0x57, 0xc4, 0x5d, 0xf0, 0xe3, 0x02, 0x6a, 0xca, 0x6a, 0xac, 0xb7, 0x5a, 0xfd, 0x17, 0xac, 0xb7,
+	0x43, 0xe6, 0xd2, 0x28, 0x22, 0xe3, 0x61, 0x3c, 0x75, 0x69, 0xa2, 0x68, 0x93, 0xbf, 0xdb, 0xf8,
+	0xb9, 0xe3, 0x05, 0xaa, 0x18, 0x7a, 0x53, 0x35, 0x5b, 0x50, 0x88, 0xf5, 0x17, 0x48, 0x7a, 0x34,
+	0xcc, 0x57, 0xbf, 0x09, 0xa0, 0x98, 0x8c, 0x24, 0x91, 0x9f, 0xba, 0x7f, 0x41, 0x7c, 0x1f, 0xec,
+	0xce, 0xb1, 0xa6, 0x2a, 0x13, 0x1b, 0xe1, 0xb6, 0x61, 0xa9, 0x04, 0x0a, 0x62, 0x05, 0x94, 0x97,
+	0xe1, 0xa5, 0x4a, 0xce, 0x90, 0x29, 0x5f, 0xca, 0x1a, 0xcc, 0x8b, 0x25, 0x00, 0xe7, 0xb8, 0xd5,
+	0xd6, 0x54, 0x02, 0x0b, 0xb3, 0xf9, 0x4f, 0x3c, 0x75, 0xb0, 0x69, 0x65, 0x74, 0x65, 0xe9, 0x40,
+	0x13, 0xab, 0x7a, 0x07, 0x5b, 0xa4, 0x85, 0x75, 0x02, 0x57, 0x67, 0xcb, 0x49, 0x20, 0x52, 0x3b,
+	0x2a, 0xc2, 0x3a, 0xb2, 0xe0, 0x9a, 0xf8, 0x33, 0x38, 0x7c, 0x81, 0xd8, 0x08, 0xa3, 0x0b, 0x85,
+	0xa8, 0x86, 0x0e, 0x7f, 0xa8, 0xbe, 0x17, 0xc0, 0x56, 0xd2, 0x34, 0x72, 0x38, 0x6d, 0x7a, 0xf4,
+	0xba, 0x27, 0x1e, 0x83, 0x83, 0xb4, 0x0a, 0xc9, 0x24, 0x6e, 0x00, 0x6b, 0x68, 0xa9, 0xff, 0x97,
+	0x32, 0x14, 0x13, 0xcb, 0x24, 0x8d, 0x40, 0x61, 0xd6, 0xce, 0x5c, 0x06, 0xf9, 0x2b, 0xa5, 0xf9,
+	0x99, 0xaf, 0x39, 0x9a, 0xed, 0x24, 0x49, 0x29, 0x54, 0xbb, 0xa0, 0xd8, 0x70, 0xb8, 0x3b, 0x68,
+	0xc5, 0x7f, 0x82, 0x32, 0xd8, 0x69, 0xc8, 0x44, 0x39, 0xb3, 0x5b, 0x06, 0x5a, 0xde, 0xc5, 0x21,
+	0xd8, 0x9b, 0x63, 0xb2, 0xa6, 0xd9, 0x86, 0x69, 0xeb, 0x06, 0x39, 0x4b, 0xef, 0xe7, 0x62, 0x69,
+	0x03, 0x5b, 0xc4, 0xc6, 0xcd, 0xa6, 0x61, 0x12, 0x98, 0x6f, 0xe0, 0xbb, 0xc7, 0x8a, 0x70, 0xff,
+	0x58, 0x11, 0xbe, 0x3e, 0x56, 0x84, 0xb7, 0x4f, 0x95, 0xdc, 0xfd, 0x53, 0x25, 0xf7, 0xf9, 0xa9,
+	0x92, 0xbb, 0xfa, 0xad, 0xef, 0xf1, 0xc1, 0xa8, 0x5b, 0x73, 0x99, 0x5f, 0x3f, 0x3d, 0xc5, 0x57,
+	0x9a, 0xd3, 0x8d, 0xea, 0xb3, 0x17, 0xfb, 0x36, 0x7b, 0xb3, 0xc7, 0x43, 0x1a, 0x75, 0xd7, 0x92,
+	0x27, 0xf7, 0xcf, 0xef, 0x03, 0x00, 0xe8, 0x70, 0x9b, 0xa2, 0xd3, 0x05, 0x00, 0x00,
 }
 
 func (m *ExchangeRateJson) Marshal() (dAtA []byte, err error) {
@@ -345,24 +415,36 @@ func (m *ExchangeRateJson) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x32
 	}
-	if m.CurrencyRate != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CurrencyRate))))
-		i--
-		dAtA[i] = 0x29
-	}
-	if m.ConvertedAmount != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ConvertedAmount))))
-		i--
-		dAtA[i] = 0x21
-	}
-	if m.OriginalAmount != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OriginalAmount))))
-		i--
-		dAtA[i] = 0x19
+	{
+		size := m.CurrencyRate.Size()
+		i -= size
+		if _, err := m.CurrencyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ConvertedAmount.Size()
+		i -= size
+		if _, err := m.ConvertedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.OriginalAmount.Size()
+		i -= size
+		if _, err := m.OriginalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.ToCurrency) > 0 {
 		i -= len(m.ToCurrency)
 		copy(dAtA[i:], m.ToCurrency)
@@ -400,12 +482,16 @@ func (m *CoinMintingPriceJson) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MintingPrice != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MintingPrice))))
-		i--
-		dAtA[i] = 0x11
+	{
+		size := m.MintingPrice.Size()
+		i -= size
+		if _, err := m.MintingPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.CurrencyCode) > 0 {
 		i -= len(m.CurrencyCode)
 		copy(dAtA[i:], m.CurrencyCode)
@@ -441,15 +527,12 @@ func (m *ExchangeRateJson) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTrade(uint64(l))
 	}
-	if m.OriginalAmount != 0 {
-		n += 9
-	}
-	if m.ConvertedAmount != 0 {
-		n += 9
-	}
-	if m.CurrencyRate != 0 {
-		n += 9
-	}
+	l = m.OriginalAmount.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	l = m.ConvertedAmount.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	l = m.CurrencyRate.Size()
+	n += 1 + l + sovTrade(uint64(l))
 	l = len(m.Timestamp)
 	if l > 0 {
 		n += 1 + l + sovTrade(uint64(l))
@@ -467,9 +550,8 @@ func (m *CoinMintingPriceJson) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTrade(uint64(l))
 	}
-	if m.MintingPrice != 0 {
-		n += 9
-	}
+	l = m.MintingPrice.Size()
+	n += 1 + l + sovTrade(uint64(l))
 	return n
 }
 
@@ -573,38 +655,107 @@ func (m *ExchangeRateJson) Unmarshal(dAtA []byte) error {
 			m.ToCurrency = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 1 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAmount", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.OriginalAmount = float64(math.Float64frombits(v))
+			if err := m.OriginalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 1 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedAmount", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.ConvertedAmount = float64(math.Float64frombits(v))
+			if err := m.ConvertedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 1 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyRate", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.CurrencyRate = float64(math.Float64frombits(v))
+			if err := m.CurrencyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
@@ -720,16 +871,39 @@ func (m *CoinMintingPriceJson) Unmarshal(dAtA []byte) error {
 			m.CurrencyCode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 1 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MintingPrice", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.MintingPrice = float64(math.Float64frombits(v))
+			if err := m.MintingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrade(dAtA[iNdEx:])
@@ -1,14 +1,35 @@
 package types
 
 import (
-	"encoding/json"
 	"strings"
+
+	"cosmossdk.io/math"
 )
 
-func ValidateTradeData(tradeData string) (TradeData, error) {
-	var td TradeData
-	if err := json.Unmarshal([]byte(tradeData), &td); err != nil {
-		return td, ErrInvalidTradeData.Wrap(err.Error())
+// ValidateTradeData unmarshals and validates the trade data against the module params, the
+// quantity denom of a buy or sell must be a registered mintable denom and its values must
+// agree within the trade data tolerances
+func ValidateTradeData(tradeData string, params Params) (TradeData, error) {
+	td, err := ValidateTradeDataStructure(tradeData)
+	if err != nil {
+		return td, err
+	}
+
+	if td.TradeInfo.TradeType == TradeTypeFiatDeposit || td.TradeInfo.TradeType == TradeTypeFiatWithdrawal {
+		if err := ValidateQuantityDenom(td.TradeInfo, params); err != nil {
+			return td, err
+		}
+		return td, ValidateTradeDataConsistency(td.TradeInfo, params.TradeDataTolerances)
+	}
+	return td, nil
+}
+
+// ValidateTradeDataStructure unmarshals and validates the fields of the trade data without the
+// module params. It is used for stored trades, which must stay valid when the params change.
+func ValidateTradeDataStructure(tradeData string) (TradeData, error) {
+	td, err := ParseTradeData(tradeData)
+	if err != nil {
+		return td, err
 	}
 
 	if td.TradeInfo == nil || td.Brokerage == nil {
@@ -21,18 +42,30 @@ func ValidateTradeData(tradeData string) (TradeData, error) {
 
 	switch td.TradeInfo.TradeType {
 	case TradeTypeFiatDeposit, TradeTypeFiatWithdrawal:
-		return td, ValidateBuyOrSell(td.TradeInfo)
-	// case TradeTypeReinvestment:
-	// 	return td, ValidateReinvestment(td.TradeInfo)
-	// case TradeTypeDividends, TradeTypeDividendsDeduction:
-	// 	return td, ValidateDividends(td.TradeInfo)
-	// case TradeTypeSplit, TradeTypeReverseSplit:
-	// 	return td, ValidateSplit(td.TradeInfo)
+		return td, ValidateBuyOrSellValues(td.TradeInfo)
+	case TradeTypeReinvestment:
+		return td, ValidateReinvestment(td.TradeInfo)
+	case TradeTypeDividends, TradeTypeDividendsDeduction:
+		return td, ValidateDividends(td.TradeInfo)
+	case TradeTypeSplit, TradeTypeReverseSplit:
+		return td, ValidateSplit(td.TradeInfo)
 	default:
 		return td, ErrInvalidTradeInfo.Wrapf("invalid trade_type, %s", td.TradeInfo.TradeType.String())
 	}
 }
 
+// ParseTradeData unmarshals the trade data, missing decimals are set to zero
+func ParseTradeData(tradeData string) (TradeData, error) {
+	var td TradeData
+	if err := unmarshalDecimalJSON(tradeData, &td); err != nil {
+		return td, ErrInvalidTradeData.Wrap(err.Error())
+	}
+	if td.TradeInfo != nil {
+		td.TradeInfo.zeroNilDecimals()
+	}
+	return td, nil
+}
+
 // ValidateCommonTradeData validates fields common to all trade types
 func ValidateCommonTradeData(td TradeData) error {
 	if td.TradeInfo.AssetHolderId <= 0 {
@@ -59,8 +92,8 @@ func ValidateCommonTradeData(td TradeData) error {
 	if strings.TrimSpace(td.TradeInfo.Issuer) == "" {
 		return ErrInvalidTradeInfo.Wrap("issuer must not be empty or whitespace")
 	}
-	if td.TradeInfo.CoinMintingPrice <= 0 {
-		return ErrInvalidTradeInfo.Wrapf("coin_minting_price must be greater than 0, got: %f", td.TradeInfo.CoinMintingPrice)
+	if !td.TradeInfo.CoinMintingPrice.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("coin_minting_price must be greater than 0, got: %s", td.TradeInfo.CoinMintingPrice)
 	}
 	if strings.TrimSpace(td.TradeInfo.Segment) == "" {
 		return ErrInvalidTradeInfo.Wrap("segment must not be empty or whitespace")
@@ -68,11 +101,11 @@ func ValidateCommonTradeData(td TradeData) error {
 	if strings.TrimSpace(td.TradeInfo.Ticker) == "" {
 		return ErrInvalidTradeInfo.Wrap("ticker must not be empty or whitespace")
 	}
-	if td.TradeInfo.TradeFee < 0 {
-		return ErrInvalidTradeInfo.Wrapf("trade_fee must be a non-negative number, got: %f", td.TradeInfo.TradeFee)
+	if td.TradeInfo.TradeFee.IsNegative() {
+		return ErrInvalidTradeInfo.Wrapf("trade_fee must be a non-negative number, got: %s", td.TradeInfo.TradeFee)
 	}
-	if td.TradeInfo.ExchangeRate <= 0 {
-		return ErrInvalidTradeInfo.Wrapf("exchange_rate must be greater than 0, got: %f", td.TradeInfo.ExchangeRate)
+	if !td.TradeInfo.ExchangeRate.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("exchange_rate must be greater than 0, got: %s", td.TradeInfo.ExchangeRate)
 	}
 	if strings.TrimSpace(td.Brokerage.Country) == "" {
 		return ErrInvalidTradeBrokerage.Wrap("brokerage country must not be empty or whitespace")
@@ -86,22 +119,31 @@ func ValidateCommonTradeData(td TradeData) error {
 	return nil
 }
 
-// ValidateBuyOrSell validates buy and sell trade types
-func ValidateBuyOrSell(tradeInfo *TradeInfo) error {
-	if tradeInfo.SharePrice <= 0 {
-		return ErrInvalidTradeInfo.Wrapf("share_price must be greater than 0, got: %f", tradeInfo.SharePrice)
+// ValidateBuyOrSell validates buy and sell trade types, the quantity denom must be
+// the registered mintable denom of the trade base and settlement currencies
+func ValidateBuyOrSell(tradeInfo *TradeInfo, params Params) error {
+	if err := ValidateBuyOrSellValues(tradeInfo); err != nil {
+		return err
+	}
+	return ValidateQuantityDenom(tradeInfo, params)
+}
+
+// ValidateBuyOrSellValues validates the values and the quantity of buy and sell trade types
+func ValidateBuyOrSellValues(tradeInfo *TradeInfo) error {
+	if !tradeInfo.SharePrice.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("share_price must be greater than 0, got: %s", tradeInfo.SharePrice)
 	}
-	if tradeInfo.ShareNetPrice <= 0 {
-		return ErrInvalidTradeInfo.Wrapf("share_net_price must be greater than 0, got: %f", tradeInfo.ShareNetPrice)
+	if !tradeInfo.ShareNetPrice.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("share_net_price must be greater than 0, got: %s", tradeInfo.ShareNetPrice)
 	}
-	if tradeInfo.NumberOfShares <= 0 {
-		return ErrInvalidTradeInfo.Wrapf("number_of_shares must be greater than 0, got: %f", tradeInfo.NumberOfShares)
+	if !tradeInfo.NumberOfShares.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("number_of_shares must be greater than 0, got: %s", tradeInfo.NumberOfShares)
 	}
-	if tradeInfo.TradeValue <= 0 {
-		return ErrInvalidTradeInfo.Wrapf("trade_value must be greater than 0, got: %f", tradeInfo.TradeValue)
+	if !tradeInfo.TradeValue.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("trade_value must be greater than 0, got: %s", tradeInfo.TradeValue)
 	}
-	if tradeInfo.TradeNetValue <= 0 {
-		return ErrInvalidTradeInfo.Wrapf("trade_net_value must be greater than 0, got: %f", tradeInfo.TradeNetValue)
+	if !tradeInfo.TradeNetValue.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("trade_net_value must be greater than 0, got: %s", tradeInfo.TradeNetValue)
 	}
 	if tradeInfo.Quantity == nil {
 		return ErrInvalidTradeInfo.Wrap("invalid quantity")
@@ -112,17 +154,144 @@ func ValidateBuyOrSell(tradeInfo *TradeInfo) error {
 	if tradeInfo.Quantity.IsZero() {
 		return ErrInvalidTradeInfo.Wrapf("zero quantity not allowed: %s", tradeInfo.Quantity.String())
 	}
-	if tradeInfo.Quantity.Denom != DefaultDenom {
-		return ErrInvalidTradeInfo.Wrapf("invalid denom expected: %s, got: %s", DefaultDenom, tradeInfo.Quantity.Denom)
+	return nil
+}
+
+// ValidateQuantityDenom ensures the quantity denom is the registered mintable denom of the trade
+// base and settlement currencies
+func ValidateQuantityDenom(tradeInfo *TradeInfo, params Params) error {
+	md, found := params.FindMintableDenom(tradeInfo.BaseCurrency, tradeInfo.SettlementCurrency)
+	if !found {
+		return ErrInvalidTradeInfo.Wrapf("no mintable denom registered for base_currency: %s, settlement_currency: %s", tradeInfo.BaseCurrency, tradeInfo.SettlementCurrency)
+	}
+	if tradeInfo.Quantity.Denom != md.Denom {
+		return ErrInvalidTradeInfo.Wrapf("invalid denom expected: %s, got: %s", md.Denom, tradeInfo.Quantity.Denom)
 	}
 	return nil
 }
 
-// ValidateNoQuantity ensures no quantity is set for certain trade types
+// ValidateTradeDataConsistency checks that the values of a buy or sell trade agree with each other
+// within the given tolerances, the trade value must match the number of shares times the share price,
+// the trade net value must match the trade value with the fee added for a deposit or deducted for
+// a withdrawal, and the quantity must match the trade net value converted by the exchange rate
+// and divided by the coin minting price, the quantity may additionally differ by one unit for rounding
+func ValidateTradeDataConsistency(tradeInfo *TradeInfo, tolerances TradeDataTolerances) error {
+	if !tolerances.Enabled {
+		return nil
+	}
+
+	expectedTradeValue := tradeInfo.NumberOfShares.Mul(tradeInfo.SharePrice)
+	if !withinTolerance(tradeInfo.TradeValue, expectedTradeValue, tolerances.TradeValue, math.LegacyZeroDec()) {
+		return ErrInconsistentTradeData.Wrapf("trade_value %s does not match number_of_shares * share_price %s",
+			tradeInfo.TradeValue, expectedTradeValue)
+	}
+
+	expectedTradeNetValue := tradeInfo.TradeValue.Add(tradeInfo.TradeFee)
+	if tradeInfo.TradeType == TradeTypeFiatWithdrawal {
+		expectedTradeNetValue = tradeInfo.TradeValue.Sub(tradeInfo.TradeFee)
+	}
+	if !withinTolerance(tradeInfo.TradeNetValue, expectedTradeNetValue, tolerances.TradeNetValue, math.LegacyZeroDec()) {
+		return ErrInconsistentTradeData.Wrapf("trade_net_value %s does not match trade_value and trade_fee %s for trade type %s",
+			tradeInfo.TradeNetValue, expectedTradeNetValue, tradeInfo.TradeType.String())
+	}
+
+	expectedQuantity := tradeInfo.TradeNetValue.Mul(tradeInfo.ExchangeRate).Quo(tradeInfo.CoinMintingPrice)
+	if !withinTolerance(math.LegacyNewDecFromInt(tradeInfo.Quantity.Amount), expectedQuantity, tolerances.Quantity, math.LegacyOneDec()) {
+		return ErrInconsistentTradeData.Wrapf("quantity %s does not match trade_net_value * exchange_rate / coin_minting_price %s",
+			tradeInfo.Quantity.Amount, expectedQuantity)
+	}
+	return nil
+}
+
+// withinTolerance reports whether actual differs from expected by at most the relative tolerance
+// of expected plus the absolute slack
+func withinTolerance(actual, expected, tolerance, slack math.LegacyDec) bool {
+	return actual.Sub(expected).Abs().LTE(expected.Abs().Mul(tolerance).Add(slack))
+}
+
+// ValidateReinvestment validates reinvestment trade types, the shares are bought with
+// dividends so no coins are minted and the quantity must not be set
+func ValidateReinvestment(tradeInfo *TradeInfo) error {
+	if !tradeInfo.SharePrice.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("share_price must be greater than 0, got: %s", tradeInfo.SharePrice)
+	}
+	if !tradeInfo.ShareNetPrice.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("share_net_price must be greater than 0, got: %s", tradeInfo.ShareNetPrice)
+	}
+	if !tradeInfo.NumberOfShares.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("number_of_shares must be greater than 0, got: %s", tradeInfo.NumberOfShares)
+	}
+	if !tradeInfo.TradeValue.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("trade_value must be greater than 0, got: %s", tradeInfo.TradeValue)
+	}
+	if !tradeInfo.TradeNetValue.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("trade_net_value must be greater than 0, got: %s", tradeInfo.TradeNetValue)
+	}
+	return ValidateNoQuantity(tradeInfo)
+}
+
+// ValidateDividends validates dividends and dividend deduction trade types, they carry a value
+// but no shares, and the quantity must not be set
+func ValidateDividends(tradeInfo *TradeInfo) error {
+	if !tradeInfo.TradeValue.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("trade_value must be greater than 0, got: %s", tradeInfo.TradeValue)
+	}
+	if !tradeInfo.TradeNetValue.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("trade_net_value must be greater than 0, got: %s", tradeInfo.TradeNetValue)
+	}
+	if !tradeInfo.NumberOfShares.IsZero() {
+		return ErrInvalidTradeInfo.Wrapf("number_of_shares must be 0 for trade type %s, got: %s", tradeInfo.TradeType.String(), tradeInfo.NumberOfShares)
+	}
+	if !tradeInfo.SharePrice.IsZero() {
+		return ErrInvalidTradeInfo.Wrapf("share_price must be 0 for trade type %s, got: %s", tradeInfo.TradeType.String(), tradeInfo.SharePrice)
+	}
+	if !tradeInfo.ShareNetPrice.IsZero() {
+		return ErrInvalidTradeInfo.Wrapf("share_net_price must be 0 for trade type %s, got: %s", tradeInfo.TradeType.String(), tradeInfo.ShareNetPrice)
+	}
+	return ValidateNoQuantity(tradeInfo)
+}
+
+// ValidateSplit validates split and reverse split trade types, they change the number of shares
+// without any value, and the quantity must not be set
+func ValidateSplit(tradeInfo *TradeInfo) error {
+	if !tradeInfo.NumberOfShares.IsPositive() {
+		return ErrInvalidTradeInfo.Wrapf("number_of_shares must be greater than 0, got: %s", tradeInfo.NumberOfShares)
+	}
+	if !tradeInfo.TradeValue.IsZero() {
+		return ErrInvalidTradeInfo.Wrapf("trade_value must be 0 for trade type %s, got: %s", tradeInfo.TradeType.String(), tradeInfo.TradeValue)
+	}
+	if !tradeInfo.TradeNetValue.IsZero() {
+		return ErrInvalidTradeInfo.Wrapf("trade_net_value must be 0 for trade type %s, got: %s", tradeInfo.TradeType.String(), tradeInfo.TradeNetValue)
+	}
+	if !tradeInfo.SharePrice.IsZero() {
+		return ErrInvalidTradeInfo.Wrapf("share_price must be 0 for trade type %s, got: %s", tradeInfo.TradeType.String(), tradeInfo.SharePrice)
+	}
+	if !tradeInfo.ShareNetPrice.IsZero() {
+		return ErrInvalidTradeInfo.Wrapf("share_net_price must be 0 for trade type %s, got: %s", tradeInfo.TradeType.String(), tradeInfo.ShareNetPrice)
+	}
+	return ValidateNoQuantity(tradeInfo)
+}
+
+// ValidateNoQuantity ensures no quantity is set for certain trade types, a quantity without
+// denom and with a missing or zero amount is accepted
 func ValidateNoQuantity(tradeInfo *TradeInfo) error {
 	if tradeInfo.Quantity != nil &&
-		(tradeInfo.Quantity.IsValid() || tradeInfo.Quantity.Denom != "" || !tradeInfo.Quantity.IsZero()) {
+		(tradeInfo.Quantity.Denom != "" || (!tradeInfo.Quantity.Amount.IsNil() && !tradeInfo.Quantity.IsZero())) {
 		return ErrInvalidTradeInfo.Wrapf("quantity must not be set for trade type %s, got: %s", tradeInfo.TradeType.String(), tradeInfo.Quantity.String())
 	}
 	return nil
 }
+
+// zeroNilDecimals sets the decimal fields missing from the trade data to zero
+func (ti *TradeInfo) zeroNilDecimals() {
+	zeroNilDecimals(
+		&ti.TradeValue,
+		&ti.ExchangeRate,
+		&ti.NumberOfShares,
+		&ti.CoinMintingPrice,
+		&ti.SharePrice,
+		&ti.TradeFee,
+		&ti.ShareNetPrice,
+		&ti.TradeNetValue,
+	)
+}
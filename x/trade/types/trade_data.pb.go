@@ -4,9 +4,11 @@
 package types
 
 import (
-	encoding_binary "encoding/binary"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -76,26 +78,28 @@ func (m *TradeData) GetBrokerage() *Brokerage {
 	return nil
 }
 
+// TradeInfo is the trade information of the trade data. The prices, values, rates
+// and number of shares are decimal strings, legacy JSON numbers are accepted on input.
 type TradeInfo struct {
-	AssetHolderId      uint64      `protobuf:"varint,1,opt,name=asset_holder_id,json=assetHolderId,proto3" json:"asset_holder_id,omitempty"`
-	AssetId            uint64      `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
-	TradeType          TradeType   `protobuf:"varint,3,opt,name=trade_type,json=tradeType,proto3,enum=vvtxchain.trade.TradeType" json:"trade_type,omitempty"`
-	TradeValue         float64     `protobuf:"fixed64,4,opt,name=trade_value,json=tradeValue,proto3" json:"trade_value,omitempty"`
-	BaseCurrency       string      `protobuf:"bytes,5,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
-	SettlementCurrency string      `protobuf:"bytes,6,opt,name=settlement_currency,json=settlementCurrency,proto3" json:"settlement_currency,omitempty"`
-	ExchangeRate       float64     `protobuf:"fixed64,7,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
-	Exchange           string      `protobuf:"bytes,8,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	FundName           string      `protobuf:"bytes,9,opt,name=fund_name,json=fundName,proto3" json:"fund_name,omitempty"`
-	Issuer             string      `protobuf:"bytes,10,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	NumberOfShares     float64     `protobuf:"fixed64,11,opt,name=number_of_shares,json=numberOfShares,proto3" json:"number_of_shares,omitempty"`
-	CoinMintingPrice   float64     `protobuf:"fixed64,12,opt,name=coin_minting_price,json=coinMintingPrice,proto3" json:"coin_minting_price,omitempty"`
-	Quantity           *types.Coin `protobuf:"bytes,13,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Segment            string      `protobuf:"bytes,14,opt,name=segment,proto3" json:"segment,omitempty"`
-	SharePrice         float64     `protobuf:"fixed64,15,opt,name=share_price,json=sharePrice,proto3" json:"share_price,omitempty"`
-	Ticker             string      `protobuf:"bytes,16,opt,name=ticker,proto3" json:"ticker,omitempty"`
-	TradeFee           float64     `protobuf:"fixed64,17,opt,name=trade_fee,json=tradeFee,proto3" json:"trade_fee,omitempty"`
-	ShareNetPrice      float64     `protobuf:"fixed64,18,opt,name=share_net_price,json=shareNetPrice,proto3" json:"share_net_price,omitempty"`
-	TradeNetValue      float64     `protobuf:"fixed64,19,opt,name=trade_net_value,json=tradeNetValue,proto3" json:"trade_net_value,omitempty"`
+	AssetHolderId      uint64                      `protobuf:"varint,1,opt,name=asset_holder_id,json=assetHolderId,proto3" json:"asset_holder_id,omitempty"`
+	AssetId            uint64                      `protobuf:"varint,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	TradeType          TradeType                   `protobuf:"varint,3,opt,name=trade_type,json=tradeType,proto3,enum=vvtxchain.trade.TradeType" json:"trade_type,omitempty"`
+	TradeValue         cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=trade_value,json=tradeValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trade_value"`
+	BaseCurrency       string                      `protobuf:"bytes,5,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	SettlementCurrency string                      `protobuf:"bytes,6,opt,name=settlement_currency,json=settlementCurrency,proto3" json:"settlement_currency,omitempty"`
+	ExchangeRate       cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"exchange_rate"`
+	Exchange           string                      `protobuf:"bytes,8,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	FundName           string                      `protobuf:"bytes,9,opt,name=fund_name,json=fundName,proto3" json:"fund_name,omitempty"`
+	Issuer             string                      `protobuf:"bytes,10,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	NumberOfShares     cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=number_of_shares,json=numberOfShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"number_of_shares"`
+	CoinMintingPrice   cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=coin_minting_price,json=coinMintingPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"coin_minting_price"`
+	Quantity           *types.Coin                 `protobuf:"bytes,13,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Segment            string                      `protobuf:"bytes,14,opt,name=segment,proto3" json:"segment,omitempty"`
+	SharePrice         cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=share_price,json=sharePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share_price"`
+	Ticker             string                      `protobuf:"bytes,16,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	TradeFee           cosmossdk_io_math.LegacyDec `protobuf:"bytes,17,opt,name=trade_fee,json=tradeFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trade_fee"`
+	ShareNetPrice      cosmossdk_io_math.LegacyDec `protobuf:"bytes,18,opt,name=share_net_price,json=shareNetPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share_net_price"`
+	TradeNetValue      cosmossdk_io_math.LegacyDec `protobuf:"bytes,19,opt,name=trade_net_value,json=tradeNetValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trade_net_value"`
 }
 
 func (m *TradeInfo) Reset()         { *m = TradeInfo{} }
@@ -152,13 +156,6 @@ func (m *TradeInfo) GetTradeType() TradeType {
 	return TradeType_TRADE_TYPE_UNSPECIFIED
 }
 
-func (m *TradeInfo) GetTradeValue() float64 {
-	if m != nil {
-		return m.TradeValue
-	}
-	return 0
-}
-
 func (m *TradeInfo) GetBaseCurrency() string {
 	if m != nil {
 		return m.BaseCurrency
@@ -173,13 +170,6 @@ func (m *TradeInfo) GetSettlementCurrency() string {
 	return ""
 }
 
-func (m *TradeInfo) GetExchangeRate() float64 {
-	if m != nil {
-		return m.ExchangeRate
-	}
-	return 0
-}
-
 func (m *TradeInfo) GetExchange() string {
 	if m != nil {
 		return m.Exchange
@@ -201,20 +191,6 @@ func (m *TradeInfo) GetIssuer() string {
 	return ""
 }
 
-func (m *TradeInfo) GetNumberOfShares() float64 {
-	if m != nil {
-		return m.NumberOfShares
-	}
-	return 0
-}
-
-func (m *TradeInfo) GetCoinMintingPrice() float64 {
-	if m != nil {
-		return m.CoinMintingPrice
-	}
-	return 0
-}
-
 func (m *TradeInfo) GetQuantity() *types.Coin {
 	if m != nil {
 		return m.Quantity
@@ -229,13 +205,6 @@ func (m *TradeInfo) GetSegment() string {
 	return ""
 }
 
-func (m *TradeInfo) GetSharePrice() float64 {
-	if m != nil {
-		return m.SharePrice
-	}
-	return 0
-}
-
 func (m *TradeInfo) GetTicker() string {
 	if m != nil {
 		return m.Ticker
@@ -243,27 +212,6 @@ func (m *TradeInfo) GetTicker() string {
 	return ""
 }
 
-func (m *TradeInfo) GetTradeFee() float64 {
-	if m != nil {
-		return m.TradeFee
-	}
-	return 0
-}
-
-func (m *TradeInfo) GetShareNetPrice() float64 {
-	if m != nil {
-		return m.ShareNetPrice
-	}
-	return 0
-}
-
-func (m *TradeInfo) GetTradeNetValue() float64 {
-	if m != nil {
-		return m.TradeNetValue
-	}
-	return 0
-}
-
 type Brokerage struct {
 	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
@@ -333,47 +281,51 @@ func init() {
 func init() { proto.RegisterFile("vvtxchain/trade/trade_data.proto", fileDescriptor_14074a44e3a2d20a) }
 
 var fileDescriptor_14074a44e3a2d20a = []byte{
-	// 627 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4f, 0x4b, 0x1b, 0x41,
-	0x14, 0x77, 0x6d, 0x8c, 0xd9, 0xd1, 0x18, 0x3b, 0x82, 0x8c, 0x11, 0xd2, 0xa0, 0x20, 0x81, 0x96,
-	0x5d, 0x6c, 0x29, 0xb4, 0x57, 0xad, 0xb5, 0x42, 0xb5, 0x65, 0x2b, 0x3d, 0x78, 0x59, 0x66, 0x77,
-	0x5f, 0x92, 0x45, 0x77, 0x26, 0x9d, 0x99, 0x0d, 0xe6, 0xd6, 0x8f, 0xd0, 0x2f, 0x55, 0xe8, 0xd1,
-	0x63, 0x8f, 0x45, 0xbf, 0x48, 0x99, 0x37, 0xbb, 0x09, 0x94, 0x7a, 0x09, 0xf3, 0x7e, 0xef, 0xf7,
-	0xfe, 0xff, 0xb2, 0xa4, 0x3f, 0x9d, 0x9a, 0xdb, 0x74, 0xcc, 0x73, 0x11, 0x1a, 0xc5, 0x33, 0x70,
-	0xbf, 0x71, 0xc6, 0x0d, 0x0f, 0x26, 0x4a, 0x1a, 0x49, 0x3b, 0x73, 0x46, 0x80, 0xbe, 0xee, 0xee,
-	0x7f, 0x43, 0x1c, 0xbb, 0xdb, 0x4b, 0xa5, 0x2e, 0xa4, 0x0e, 0x13, 0xae, 0x21, 0x9c, 0x1e, 0x26,
-	0x60, 0xf8, 0x61, 0x98, 0xca, 0x5c, 0x38, 0xff, 0xde, 0x77, 0x8f, 0xf8, 0x97, 0x96, 0xff, 0x8e,
-	0x1b, 0x4e, 0xdf, 0x12, 0xe2, 0xea, 0xe5, 0x62, 0x28, 0x99, 0xd7, 0xf7, 0x06, 0x6b, 0x2f, 0xbb,
-	0xc1, 0x3f, 0x05, 0x03, 0xe4, 0x9f, 0x89, 0xa1, 0x8c, 0x7c, 0x53, 0x3f, 0xe9, 0x1b, 0xe2, 0x27,
-	0x4a, 0x5e, 0x83, 0xe2, 0x23, 0x60, 0xcb, 0x8f, 0x44, 0x1e, 0xd5, 0x8c, 0x68, 0x41, 0xde, 0xfb,
-	0xb9, 0x52, 0xb5, 0x80, 0x79, 0x0e, 0x48, 0x87, 0x6b, 0x0d, 0x26, 0x1e, 0xcb, 0x9b, 0x0c, 0x54,
-	0x9c, 0x67, 0xd8, 0x47, 0x23, 0x6a, 0x23, 0xfc, 0x01, 0xd1, 0xb3, 0x8c, 0xee, 0x90, 0x96, 0xe3,
-	0xe5, 0x19, 0x96, 0x6b, 0x44, 0xab, 0x68, 0x9f, 0x65, 0x8b, 0x29, 0xcc, 0x6c, 0x02, 0xec, 0x49,
-	0xdf, 0x1b, 0x6c, 0x3c, 0x36, 0xc5, 0xe5, 0x6c, 0x02, 0xd5, 0x14, 0xf6, 0x49, 0x9f, 0x91, 0x35,
-	0x17, 0x3a, 0xe5, 0x37, 0x25, 0xb0, 0x46, 0xdf, 0x1b, 0x78, 0x91, 0xcb, 0xf6, 0xd5, 0x22, 0x74,
-	0x9f, 0xb4, 0xed, 0x2a, 0xe3, 0xb4, 0x54, 0x0a, 0x44, 0x3a, 0x63, 0x2b, 0x7d, 0x6f, 0xe0, 0x47,
-	0xeb, 0x16, 0x3c, 0xae, 0x30, 0x1a, 0x92, 0x2d, 0x0d, 0xc6, 0xdc, 0x40, 0x01, 0xc2, 0x2c, 0xa8,
-	0x4d, 0xa4, 0xd2, 0x85, 0x6b, 0x1e, 0xb0, 0x4f, 0xda, 0x60, 0x9b, 0x13, 0x23, 0x88, 0x15, 0x37,
-	0xc0, 0x56, 0xb1, 0xf0, 0x7a, 0x0d, 0x46, 0xdc, 0x00, 0xed, 0x92, 0x56, 0x6d, 0xb3, 0x16, 0xa6,
-	0x9a, 0xdb, 0x74, 0x97, 0xf8, 0xc3, 0x52, 0x64, 0xb1, 0xe0, 0x05, 0x30, 0xdf, 0x39, 0x2d, 0x70,
-	0xc1, 0x0b, 0xa0, 0xdb, 0xa4, 0x99, 0x6b, 0x5d, 0x82, 0x62, 0x04, 0x3d, 0x95, 0x45, 0x07, 0x64,
-	0x53, 0x94, 0x45, 0x02, 0x2a, 0x96, 0xc3, 0x58, 0x8f, 0xb9, 0x02, 0xcd, 0xd6, 0xb0, 0xf0, 0x86,
-	0xc3, 0x3f, 0x0d, 0xbf, 0x20, 0x4a, 0x5f, 0x10, 0x6a, 0x35, 0x13, 0x17, 0xb9, 0x30, 0xb9, 0x18,
-	0xc5, 0x13, 0x95, 0xa7, 0xc0, 0xd6, 0x91, 0xbb, 0x69, 0x3d, 0xe7, 0xce, 0xf1, 0xd9, 0xe2, 0xf4,
-	0x35, 0x69, 0x7d, 0x2b, 0xb9, 0x30, 0xb9, 0x99, 0xb1, 0x36, 0x2a, 0x61, 0x27, 0x70, 0x32, 0x0c,
-	0xec, 0x9a, 0x82, 0x4a, 0x86, 0xc1, 0xb1, 0xcc, 0x45, 0x34, 0xa7, 0x52, 0x46, 0x56, 0x35, 0x8c,
-	0xec, 0x5e, 0xd8, 0x06, 0xf6, 0x59, 0x9b, 0xf6, 0x2a, 0xd8, 0x5e, 0x55, 0xb7, 0xe3, 0xae, 0x82,
-	0x90, 0xab, 0xb8, 0x4d, 0x9a, 0x26, 0x4f, 0xaf, 0x41, 0xb1, 0x4d, 0x37, 0xa1, 0xb3, 0xec, 0x5a,
-	0xdc, 0x39, 0x87, 0x00, 0xec, 0x29, 0x86, 0xb5, 0x10, 0x78, 0x0f, 0x60, 0x95, 0xe6, 0xb2, 0x0a,
-	0x30, 0x55, 0x66, 0x8a, 0x94, 0x36, 0xc2, 0x17, 0x60, 0x5c, 0xf2, 0x03, 0xd2, 0x71, 0x49, 0x2c,
-	0xcf, 0xe9, 0x62, 0xcb, 0xf1, 0x10, 0xbe, 0x00, 0x83, 0xd2, 0xd8, 0x3b, 0x27, 0xfe, 0x5c, 0xdf,
-	0x94, 0x92, 0x06, 0xde, 0xc2, 0xc3, 0x7e, 0xf0, 0x6d, 0x31, 0x54, 0xe4, 0xb2, 0xc3, 0xec, 0xdb,
-	0x0e, 0x9d, 0xca, 0x52, 0x18, 0x35, 0x43, 0xa1, 0xfa, 0x51, 0x6d, 0x1e, 0x9d, 0xfc, 0xba, 0xef,
-	0x79, 0x77, 0xf7, 0x3d, 0xef, 0xcf, 0x7d, 0xcf, 0xfb, 0xf1, 0xd0, 0x5b, 0xba, 0x7b, 0xe8, 0x2d,
-	0xfd, 0x7e, 0xe8, 0x2d, 0x5d, 0x3d, 0x1f, 0xe5, 0x66, 0x5c, 0x26, 0x41, 0x2a, 0x8b, 0xf0, 0xf4,
-	0xf4, 0xe4, 0xea, 0x23, 0x4f, 0x74, 0xb8, 0xf8, 0x08, 0xdc, 0xd6, 0x9f, 0x81, 0xd9, 0x04, 0x74,
-	0xd2, 0xc4, 0xff, 0xf9, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x47, 0xe1, 0xbe, 0x25, 0x59,
-	0x04, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xdf, 0x4a, 0x1b, 0x4f,
+	0x14, 0xce, 0xfa, 0xcb, 0x2f, 0x66, 0x47, 0x63, 0xec, 0x58, 0x64, 0x8c, 0x10, 0x83, 0x85, 0x22,
+	0x94, 0xee, 0x62, 0x4b, 0xa1, 0xbd, 0xf5, 0x4f, 0xad, 0xa0, 0x69, 0xd9, 0x8a, 0xa0, 0xbd, 0x58,
+	0x26, 0xbb, 0x27, 0x9b, 0x45, 0x77, 0x26, 0x9d, 0x99, 0x0d, 0xe6, 0xae, 0x8f, 0xd0, 0x87, 0xe9,
+	0x43, 0x78, 0x29, 0xbd, 0x2a, 0x85, 0x4a, 0xd1, 0x17, 0x29, 0x33, 0xb3, 0x49, 0xa0, 0xd4, 0x9b,
+	0xbd, 0x59, 0xe6, 0x3b, 0xe7, 0xcc, 0xb7, 0xdf, 0xf9, 0x33, 0x07, 0x75, 0x46, 0x23, 0x75, 0x15,
+	0x0d, 0x68, 0xca, 0x7c, 0x25, 0x68, 0x0c, 0xf6, 0x1b, 0xc6, 0x54, 0x51, 0x6f, 0x28, 0xb8, 0xe2,
+	0xb8, 0x39, 0x8d, 0xf0, 0x8c, 0xaf, 0xd5, 0x8e, 0xb8, 0xcc, 0xb8, 0xf4, 0x7b, 0x54, 0x82, 0x3f,
+	0xda, 0xee, 0x81, 0xa2, 0xdb, 0x7e, 0xc4, 0x53, 0x66, 0x2f, 0xb4, 0xd6, 0xac, 0x3f, 0x34, 0xc8,
+	0xb7, 0xa0, 0x70, 0x3d, 0x4e, 0x78, 0xc2, 0xad, 0x5d, 0x9f, 0x0a, 0xeb, 0xfa, 0x3f, 0x35, 0x58,
+	0xe7, 0xe6, 0x17, 0x07, 0xb9, 0x27, 0x1a, 0xef, 0x51, 0x45, 0xf1, 0x1b, 0x84, 0xac, 0xc0, 0x94,
+	0xf5, 0x39, 0x71, 0x3a, 0xce, 0xd6, 0xc2, 0x8b, 0x96, 0xf7, 0x97, 0x42, 0xcf, 0xc4, 0x1f, 0xb2,
+	0x3e, 0x0f, 0x5c, 0x35, 0x39, 0xe2, 0xd7, 0xc8, 0xed, 0x09, 0x7e, 0x01, 0x82, 0x26, 0x40, 0xe6,
+	0x1e, 0xb8, 0xb9, 0x33, 0x89, 0x08, 0x66, 0xc1, 0x9b, 0xbf, 0xea, 0x85, 0x04, 0xc3, 0xf3, 0x14,
+	0x35, 0xa9, 0x94, 0xa0, 0xc2, 0x01, 0xbf, 0x8c, 0x41, 0x84, 0x69, 0x6c, 0x74, 0x54, 0x83, 0x86,
+	0x31, 0xbf, 0x33, 0xd6, 0xc3, 0x18, 0xaf, 0xa1, 0xba, 0x8d, 0x4b, 0x63, 0xf3, 0xbb, 0x6a, 0x30,
+	0x6f, 0xf0, 0x61, 0x3c, 0xcb, 0x42, 0x8d, 0x87, 0x40, 0xfe, 0xeb, 0x38, 0x5b, 0x4b, 0x0f, 0x65,
+	0x71, 0x32, 0x1e, 0x42, 0x91, 0x85, 0x3e, 0xe2, 0x00, 0x2d, 0xd8, 0xab, 0x23, 0x7a, 0x99, 0x03,
+	0xa9, 0x76, 0x9c, 0x2d, 0x77, 0x67, 0xfb, 0xfa, 0x76, 0xa3, 0xf2, 0xf3, 0x76, 0x63, 0xdd, 0x16,
+	0x5b, 0xc6, 0x17, 0x5e, 0xca, 0xfd, 0x8c, 0xaa, 0x81, 0x77, 0x04, 0x09, 0x8d, 0xc6, 0x7b, 0x10,
+	0x7d, 0xff, 0xf6, 0x1c, 0x15, 0xbd, 0xd8, 0x83, 0x28, 0xb0, 0x02, 0x4e, 0x35, 0x09, 0x7e, 0x82,
+	0x1a, 0xba, 0x97, 0x61, 0x94, 0x0b, 0x01, 0x2c, 0x1a, 0x93, 0xff, 0x35, 0x6b, 0xb0, 0xa8, 0x8d,
+	0xbb, 0x85, 0x0d, 0xfb, 0x68, 0x45, 0x82, 0x52, 0x97, 0x90, 0x01, 0x53, 0xb3, 0xd0, 0x9a, 0x09,
+	0xc5, 0x33, 0xd7, 0xf4, 0xc2, 0x29, 0x6a, 0x80, 0xce, 0x87, 0x25, 0x10, 0x0a, 0xaa, 0x80, 0xcc,
+	0x97, 0xd5, 0xba, 0x38, 0xe1, 0x09, 0xa8, 0x02, 0xdc, 0x42, 0xf5, 0x09, 0x26, 0x75, 0xf3, 0xf7,
+	0x29, 0xc6, 0xeb, 0xc8, 0xed, 0xe7, 0x2c, 0x0e, 0x19, 0xcd, 0x80, 0xb8, 0xd6, 0xa9, 0x0d, 0x5d,
+	0x9a, 0x01, 0x5e, 0x45, 0xb5, 0x54, 0xca, 0x1c, 0x04, 0x41, 0xc6, 0x53, 0x20, 0xfc, 0x09, 0x2d,
+	0xb3, 0x3c, 0xeb, 0x81, 0x08, 0x79, 0x3f, 0x94, 0x03, 0x2a, 0x40, 0x92, 0x85, 0xb2, 0x5a, 0x97,
+	0x2c, 0xd5, 0xfb, 0xfe, 0x47, 0x43, 0x84, 0x43, 0x84, 0xf5, 0xd3, 0x08, 0xb3, 0x94, 0xa9, 0x94,
+	0x25, 0xe1, 0x50, 0xa4, 0x11, 0x90, 0xc5, 0xb2, 0xf4, 0xcb, 0x9a, 0xec, 0xd8, 0x72, 0x7d, 0xd0,
+	0x54, 0xf8, 0x15, 0xaa, 0x7f, 0xce, 0x29, 0x53, 0xa9, 0x1a, 0x93, 0x86, 0x99, 0xea, 0x35, 0xaf,
+	0xb8, 0xa0, 0xfb, 0xe7, 0x15, 0x0f, 0xd4, 0xdb, 0xe5, 0x29, 0x0b, 0xa6, 0xa1, 0x98, 0xa0, 0x79,
+	0x09, 0x89, 0x6e, 0x18, 0x59, 0x32, 0xd5, 0x98, 0x40, 0x3d, 0x61, 0xa6, 0x08, 0x85, 0xd4, 0x66,
+	0xe9, 0x09, 0x33, 0x2c, 0x56, 0xe4, 0x2a, 0xaa, 0xa9, 0x34, 0xba, 0x00, 0x41, 0x96, 0x6d, 0xe9,
+	0x2d, 0xc2, 0x5d, 0x64, 0x47, 0x3b, 0xec, 0x03, 0x90, 0x47, 0x65, 0xff, 0x54, 0x37, 0x1c, 0x6f,
+	0x01, 0xf0, 0x19, 0x6a, 0x5a, 0xed, 0x0c, 0x54, 0xa1, 0x1f, 0x97, 0x65, 0x6d, 0x18, 0xa6, 0x2e,
+	0x28, 0x9b, 0xc2, 0x19, 0x6a, 0x5a, 0xa9, 0x9a, 0xda, 0x3e, 0xbe, 0x95, 0xd2, 0xd4, 0x86, 0xa9,
+	0x0b, 0xca, 0xbc, 0xbf, 0xcd, 0x63, 0xe4, 0x4e, 0xf7, 0x0e, 0xc6, 0xa8, 0x6a, 0xa6, 0xd7, 0x31,
+	0x85, 0x32, 0x67, 0x6d, 0x33, 0x9b, 0x62, 0xce, 0xda, 0xf4, 0x59, 0x37, 0x30, 0xe2, 0x39, 0x53,
+	0x62, 0x6c, 0x16, 0x88, 0x1b, 0x4c, 0xe0, 0xce, 0xfe, 0xf5, 0x5d, 0xdb, 0xb9, 0xb9, 0x6b, 0x3b,
+	0xbf, 0xef, 0xda, 0xce, 0xd7, 0xfb, 0x76, 0xe5, 0xe6, 0xbe, 0x5d, 0xf9, 0x71, 0xdf, 0xae, 0x9c,
+	0x3f, 0x4b, 0x52, 0x35, 0xc8, 0x7b, 0x5e, 0xc4, 0x33, 0xff, 0xe0, 0x60, 0xff, 0xfc, 0x88, 0xf6,
+	0xa4, 0x3f, 0x5b, 0xbe, 0x57, 0x93, 0xf5, 0x3b, 0x1e, 0x82, 0xec, 0xd5, 0xcc, 0xfe, 0x7d, 0xf9,
+	0x67, 0x00, 0x01, 0x70, 0x1d, 0x98, 0x22, 0x06, 0x00, 0x00,
 }
 
 func (m *TradeData) Marshal() (dAtA []byte, err error) {
@@ -443,30 +395,42 @@ func (m *TradeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.TradeNetValue != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TradeNetValue))))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x99
-	}
-	if m.ShareNetPrice != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ShareNetPrice))))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x91
-	}
-	if m.TradeFee != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TradeFee))))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x89
+	{
+		size := m.TradeNetValue.Size()
+		i -= size
+		if _, err := m.TradeNetValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTradeData(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.ShareNetPrice.Size()
+		i -= size
+		if _, err := m.ShareNetPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTradeData(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.TradeFee.Size()
+		i -= size
+		if _, err := m.TradeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTradeData(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
 	if len(m.Ticker) > 0 {
 		i -= len(m.Ticker)
 		copy(dAtA[i:], m.Ticker)
@@ -476,12 +440,16 @@ func (m *TradeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x82
 	}
-	if m.SharePrice != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SharePrice))))
-		i--
-		dAtA[i] = 0x79
+	{
+		size := m.SharePrice.Size()
+		i -= size
+		if _, err := m.SharePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTradeData(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x7a
 	if len(m.Segment) > 0 {
 		i -= len(m.Segment)
 		copy(dAtA[i:], m.Segment)
@@ -501,18 +469,26 @@ func (m *TradeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x6a
 	}
-	if m.CoinMintingPrice != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CoinMintingPrice))))
-		i--
-		dAtA[i] = 0x61
-	}
-	if m.NumberOfShares != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NumberOfShares))))
-		i--
-		dAtA[i] = 0x59
+	{
+		size := m.CoinMintingPrice.Size()
+		i -= size
+		if _, err := m.CoinMintingPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTradeData(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.NumberOfShares.Size()
+		i -= size
+		if _, err := m.NumberOfShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTradeData(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x5a
 	if len(m.Issuer) > 0 {
 		i -= len(m.Issuer)
 		copy(dAtA[i:], m.Issuer)
@@ -534,12 +510,16 @@ func (m *TradeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x42
 	}
-	if m.ExchangeRate != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ExchangeRate))))
-		i--
-		dAtA[i] = 0x39
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTradeData(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x3a
 	if len(m.SettlementCurrency) > 0 {
 		i -= len(m.SettlementCurrency)
 		copy(dAtA[i:], m.SettlementCurrency)
@@ -554,12 +534,16 @@ func (m *TradeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.TradeValue != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TradeValue))))
-		i--
-		dAtA[i] = 0x21
+	{
+		size := m.TradeValue.Size()
+		i -= size
+		if _, err := m.TradeValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTradeData(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if m.TradeType != 0 {
 		i = encodeVarintTradeData(dAtA, i, uint64(m.TradeType))
 		i--
@@ -665,9 +649,8 @@ func (m *TradeInfo) Size() (n int) {
 	if m.TradeType != 0 {
 		n += 1 + sovTradeData(uint64(m.TradeType))
 	}
-	if m.TradeValue != 0 {
-		n += 9
-	}
+	l = m.TradeValue.Size()
+	n += 1 + l + sovTradeData(uint64(l))
 	l = len(m.BaseCurrency)
 	if l > 0 {
 		n += 1 + l + sovTradeData(uint64(l))
@@ -676,9 +659,8 @@ func (m *TradeInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTradeData(uint64(l))
 	}
-	if m.ExchangeRate != 0 {
-		n += 9
-	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovTradeData(uint64(l))
 	l = len(m.Exchange)
 	if l > 0 {
 		n += 1 + l + sovTradeData(uint64(l))
@@ -691,12 +673,10 @@ func (m *TradeInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTradeData(uint64(l))
 	}
-	if m.NumberOfShares != 0 {
-		n += 9
-	}
-	if m.CoinMintingPrice != 0 {
-		n += 9
-	}
+	l = m.NumberOfShares.Size()
+	n += 1 + l + sovTradeData(uint64(l))
+	l = m.CoinMintingPrice.Size()
+	n += 1 + l + sovTradeData(uint64(l))
 	if m.Quantity != nil {
 		l = m.Quantity.Size()
 		n += 1 + l + sovTradeData(uint64(l))
@@ -705,22 +685,18 @@ func (m *TradeInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTradeData(uint64(l))
 	}
-	if m.SharePrice != 0 {
-		n += 9
-	}
+	l = m.SharePrice.Size()
+	n += 1 + l + sovTradeData(uint64(l))
 	l = len(m.Ticker)
 	if l > 0 {
 		n += 2 + l + sovTradeData(uint64(l))
 	}
-	if m.TradeFee != 0 {
-		n += 10
-	}
-	if m.ShareNetPrice != 0 {
-		n += 10
-	}
-	if m.TradeNetValue != 0 {
-		n += 10
-	}
+	l = m.TradeFee.Size()
+	n += 2 + l + sovTradeData(uint64(l))
+	l = m.ShareNetPrice.Size()
+	n += 2 + l + sovTradeData(uint64(l))
+	l = m.TradeNetValue.Size()
+	n += 2 + l + sovTradeData(uint64(l))
 	return n
 }
 
@@ -960,16 +936,39 @@ func (m *TradeInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType != 1 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TradeValue", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.TradeValue = float64(math.Float64frombits(v))
+			if err := m.TradeValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BaseCurrency", wireType)
@@ -1035,16 +1034,39 @@ func (m *TradeInfo) Unmarshal(dAtA []byte) error {
 			m.SettlementCurrency = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
-			if wireType != 1 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.ExchangeRate = float64(math.Float64frombits(v))
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Exchange", wireType)
@@ -1142,27 +1164,73 @@ func (m *TradeInfo) Unmarshal(dAtA []byte) error {
 			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 11:
-			if wireType != 1 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfShares", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NumberOfShares = float64(math.Float64frombits(v))
+			if err := m.NumberOfShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 12:
-			if wireType != 1 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CoinMintingPrice", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.CoinMintingPrice = float64(math.Float64frombits(v))
+			if err := m.CoinMintingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 13:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
@@ -1232,16 +1300,39 @@ func (m *TradeInfo) Unmarshal(dAtA []byte) error {
 			m.Segment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 15:
-			if wireType != 1 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SharePrice", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.SharePrice = float64(math.Float64frombits(v))
+			if err := m.SharePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 16:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
@@ -1275,38 +1366,107 @@ func (m *TradeInfo) Unmarshal(dAtA []byte) error {
 			m.Ticker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 17:
-			if wireType != 1 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TradeFee", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.TradeFee = float64(math.Float64frombits(v))
+			if err := m.TradeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 18:
-			if wireType != 1 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ShareNetPrice", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.ShareNetPrice = float64(math.Float64frombits(v))
+			if err := m.ShareNetPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 19:
-			if wireType != 1 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TradeNetValue", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeData
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.TradeNetValue = float64(math.Float64frombits(v))
+			if err := m.TradeNetValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTradeData(dAtA[iNdEx:])
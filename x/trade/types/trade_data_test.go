@@ -20,6 +20,46 @@ func TestValidateTradeData(t *testing.T) {
 			name:      "valid trade data object",
 			tradeData: types.GetSampleTradeDataJson(types.TradeTypeFiatDeposit),
 		},
+		{
+			name:      "valid split trade data",
+			tradeData: types.GetSampleTradeDataJson(types.TradeTypeSplit),
+		},
+		{
+			name:      "valid reverse split trade data",
+			tradeData: types.GetSampleTradeDataJson(types.TradeTypeReverseSplit),
+		},
+		{
+			name:      "valid reinvestment trade data",
+			tradeData: types.GetSampleTradeDataJson(types.TradeTypeReinvestment),
+		},
+		{
+			name:      "valid dividends trade data",
+			tradeData: types.GetSampleTradeDataJson(types.TradeTypeDividends),
+		},
+		{
+			name:      "valid dividend deduction trade data",
+			tradeData: types.GetSampleTradeDataJson(types.TradeTypeDividendsDeduction),
+		},
+		{
+			name:      "valid trade data with legacy json numbers",
+			tradeData: `{"trade_info":{"asset_holder_id":1,"asset_id":1,"trade_type":1,"trade_value":1944.9,"base_currency":"GBP","settlement_currency":"GBP","exchange_rate":1,"exchange":"US","fund_name":"Low Carbon Target ETF","issuer":"Blackrock","number_of_shares":10,"coin_minting_price":1.2e-11,"quantity":{"amount":"162075000000000","denom":"ugbpv"},"segment":"Equity: Global Low Carbon","share_price":194.49,"ticker":"CRBN","trade_fee":0,"share_net_price":194.49,"trade_net_value":1944.9},"brokerage":{"name":"Interactive Brokers LLC","type":"Brokerage Firm","country":"US"}}`,
+		},
+		{
+			name:      "valid trade data with decimal strings",
+			tradeData: `{"trade_info":{"asset_holder_id":1,"asset_id":1,"trade_type":1,"trade_value":"1944.9","base_currency":"GBP","settlement_currency":"GBP","exchange_rate":"1","exchange":"US","fund_name":"Low Carbon Target ETF","issuer":"Blackrock","number_of_shares":"10","coin_minting_price":"0.000000000012","quantity":{"amount":"162075000000000","denom":"ugbpv"},"segment":"Equity: Global Low Carbon","share_price":"194.49","ticker":"CRBN","trade_fee":"0","share_net_price":"194.49","trade_net_value":"1944.9"},"brokerage":{"name":"Interactive Brokers LLC","type":"Brokerage Firm","country":"US"}}`,
+		},
+		{
+			name:      "trade value with too much precision",
+			tradeData: `{"trade_info":{"asset_holder_id":1,"asset_id":1,"trade_type":1,"trade_value":"1944.0000000000000000001","base_currency":"GBP","settlement_currency":"GBP","exchange_rate":"1","exchange":"US","fund_name":"Low Carbon Target ETF","issuer":"Blackrock","number_of_shares":"10","coin_minting_price":"0.000000000012","quantity":{"amount":"162075000000000","denom":"ugbpv"},"segment":"Equity: Global Low Carbon","share_price":"194.49","ticker":"CRBN","trade_fee":"0","share_net_price":"194.49","trade_net_value":"1944.9"},"brokerage":{"name":"Interactive Brokers LLC","type":"Brokerage Firm","country":"US"}}`,
+			expErr:    true,
+			expErrMsg: "invalid decimal trade_value",
+		},
+		{
+			name:      "split with quantity",
+			tradeData: `{"trade_info":{"asset_holder_id":1,"asset_id":1,"trade_type":3,"base_currency":"GBP","settlement_currency":"GBP","exchange_rate":1,"exchange":"US","fund_name":"Low Carbon Target ETF","issuer":"Blackrock","number_of_shares":10,"coin_minting_price":0.000000000012,"quantity":{"amount":"1000","denom":"ugbpv"},"segment":"Equity: Global Low Carbon","ticker":"CRBN","trade_fee":0},"brokerage":{"name":"Interactive Brokers LLC","type":"Brokerage Firm","country":"US"}}`,
+			expErr:    true,
+			expErrMsg: "quantity must not be set for trade type TRADE_TYPE_SPLIT",
+		},
 		{
 			name:      "nil trade info",
 			tradeData: `{"brokerage":{"name":"Interactive Brokers LLC","type":"Brokerage Firm","country":"US"}}`,
@@ -190,10 +230,16 @@ func TestValidateTradeData(t *testing.T) {
 			expErr:    true,
 			expErrMsg: "invalid denom expected: ugbpv, got: uvvtx",
 		},
+		{
+			name:      "inconsistent quantity - trade type buy",
+			tradeData: `{"trade_info":{"asset_holder_id":1,"asset_id":1,"trade_type":1,"trade_value":1944.9,"base_currency":"GBP","settlement_currency":"GBP","exchange_rate":1,"exchange":"US","fund_name":"Low Carbon Target ETF","issuer":"Blackrock","number_of_shares":10,"coin_minting_price":0.000000000012,"quantity":{"amount":"1620750000000000","denom":"ugbpv"},"segment":"Equity: Global Low Carbon","share_price":194.49,"ticker":"CRBN","trade_fee":0,"share_net_price":194.49,"trade_net_value":1944.9},"brokerage":{"name":"Interactive Brokers LLC","type":"Brokerage Firm","country":"US"}}`,
+			expErr:    true,
+			expErrMsg: "inconsistent trade data",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := types.ValidateTradeData(tt.tradeData)
+			_, err := types.ValidateTradeData(tt.tradeData, types.DefaultParams())
 			if tt.expErr {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), tt.expErrMsg)
@@ -335,7 +381,7 @@ func TestValidateCommonTradeData(t *testing.T) {
 					Exchange:           "US",
 					FundName:           "TechFund",
 					Issuer:             "Blackrock",
-					CoinMintingPrice:   0,
+					CoinMintingPrice:   math.LegacyZeroDec(),
 				},
 			},
 			expErr:    true,
@@ -353,7 +399,7 @@ func TestValidateCommonTradeData(t *testing.T) {
 					Exchange:           "US",
 					FundName:           "TechFund",
 					Issuer:             "Blackrock",
-					CoinMintingPrice:   1,
+					CoinMintingPrice:   math.LegacyNewDec(1),
 					Segment:            "",
 				},
 			},
@@ -372,7 +418,7 @@ func TestValidateCommonTradeData(t *testing.T) {
 					Exchange:           "US",
 					FundName:           "TechFund",
 					Issuer:             "Blackrock",
-					CoinMintingPrice:   1,
+					CoinMintingPrice:   math.LegacyNewDec(1),
 					Segment:            "Global Low Carbon",
 					Ticker:             "",
 				},
@@ -392,10 +438,10 @@ func TestValidateCommonTradeData(t *testing.T) {
 					Exchange:           "US",
 					FundName:           "TechFund",
 					Issuer:             "Blackrock",
-					CoinMintingPrice:   1,
+					CoinMintingPrice:   math.LegacyNewDec(1),
 					Segment:            "Global Low Carbon",
 					Ticker:             "CEN",
-					TradeFee:           -1,
+					TradeFee:           math.LegacyNewDec(-1),
 				},
 			},
 			expErr:    true,
@@ -413,11 +459,11 @@ func TestValidateCommonTradeData(t *testing.T) {
 					Exchange:           "US",
 					FundName:           "TechFund",
 					Issuer:             "Blackrock",
-					CoinMintingPrice:   1,
+					CoinMintingPrice:   math.LegacyNewDec(1),
 					Segment:            "Global Low Carbon",
 					Ticker:             "CEN",
-					TradeFee:           0,
-					ExchangeRate:       0,
+					TradeFee:           math.LegacyZeroDec(),
+					ExchangeRate:       math.LegacyZeroDec(),
 				},
 			},
 			expErr:    true,
@@ -435,11 +481,11 @@ func TestValidateCommonTradeData(t *testing.T) {
 					Exchange:           "US",
 					FundName:           "TechFund",
 					Issuer:             "Blackrock",
-					CoinMintingPrice:   1,
+					CoinMintingPrice:   math.LegacyNewDec(1),
 					Segment:            "Global Low Carbon",
 					Ticker:             "CEN",
-					TradeFee:           0,
-					ExchangeRate:       1,
+					TradeFee:           math.LegacyZeroDec(),
+					ExchangeRate:       math.LegacyNewDec(1),
 				},
 				Brokerage: &types.Brokerage{
 					Country: "",
@@ -460,11 +506,11 @@ func TestValidateCommonTradeData(t *testing.T) {
 					Exchange:           "US",
 					FundName:           "TechFund",
 					Issuer:             "Blackrock",
-					CoinMintingPrice:   1,
+					CoinMintingPrice:   math.LegacyNewDec(1),
 					Segment:            "Global Low Carbon",
 					Ticker:             "CEN",
-					TradeFee:           0,
-					ExchangeRate:       1,
+					TradeFee:           math.LegacyZeroDec(),
+					ExchangeRate:       math.LegacyNewDec(1),
 				},
 				Brokerage: &types.Brokerage{
 					Country: "USA",
@@ -486,11 +532,11 @@ func TestValidateCommonTradeData(t *testing.T) {
 					Exchange:           "US",
 					FundName:           "TechFund",
 					Issuer:             "Blackrock",
-					CoinMintingPrice:   1,
+					CoinMintingPrice:   math.LegacyNewDec(1),
 					Segment:            "Global Low Carbon",
 					Ticker:             "CEN",
-					TradeFee:           0,
-					ExchangeRate:       1,
+					TradeFee:           math.LegacyZeroDec(),
+					ExchangeRate:       math.LegacyNewDec(1),
 				},
 				Brokerage: &types.Brokerage{
 					Country: "USA",
@@ -529,7 +575,7 @@ func TestValidateBuyOrSell(t *testing.T) {
 		{
 			name: "invalid share_price",
 			tradeInfo: &types.TradeInfo{
-				SharePrice: 0,
+				SharePrice: math.LegacyZeroDec(),
 			},
 			expErr:    true,
 			expErrMsg: "share_price must be greater than 0",
@@ -537,8 +583,8 @@ func TestValidateBuyOrSell(t *testing.T) {
 		{
 			name: "invalid share_net_price",
 			tradeInfo: &types.TradeInfo{
-				SharePrice:    100,
-				ShareNetPrice: 0,
+				SharePrice:    math.LegacyNewDec(100),
+				ShareNetPrice: math.LegacyZeroDec(),
 			},
 			expErr:    true,
 			expErrMsg: "share_net_price must be greater than 0",
@@ -546,9 +592,9 @@ func TestValidateBuyOrSell(t *testing.T) {
 		{
 			name: "invalid number_of_shares",
 			tradeInfo: &types.TradeInfo{
-				SharePrice:     100,
-				ShareNetPrice:  100,
-				NumberOfShares: 0,
+				SharePrice:     math.LegacyNewDec(100),
+				ShareNetPrice:  math.LegacyNewDec(100),
+				NumberOfShares: math.LegacyZeroDec(),
 			},
 			expErr:    true,
 			expErrMsg: "number_of_shares must be greater than 0",
@@ -556,10 +602,10 @@ func TestValidateBuyOrSell(t *testing.T) {
 		{
 			name: "invalid trade value",
 			tradeInfo: &types.TradeInfo{
-				SharePrice:     100,
-				ShareNetPrice:  100,
-				NumberOfShares: 500,
-				TradeValue:     -5,
+				SharePrice:     math.LegacyNewDec(100),
+				ShareNetPrice:  math.LegacyNewDec(100),
+				NumberOfShares: math.LegacyNewDec(500),
+				TradeValue:     math.LegacyNewDec(-5),
 			},
 			expErr:    true,
 			expErrMsg: "trade_value must be greater than 0",
@@ -567,11 +613,11 @@ func TestValidateBuyOrSell(t *testing.T) {
 		{
 			name: "invalid trade net value",
 			tradeInfo: &types.TradeInfo{
-				SharePrice:     100,
-				ShareNetPrice:  100,
-				NumberOfShares: 500,
-				TradeValue:     5000,
-				TradeNetValue:  0,
+				SharePrice:     math.LegacyNewDec(100),
+				ShareNetPrice:  math.LegacyNewDec(100),
+				NumberOfShares: math.LegacyNewDec(500),
+				TradeValue:     math.LegacyNewDec(5000),
+				TradeNetValue:  math.LegacyZeroDec(),
 			},
 			expErr:    true,
 			expErrMsg: "trade_net_value must be greater than 0",
@@ -579,11 +625,11 @@ func TestValidateBuyOrSell(t *testing.T) {
 		{
 			name: "invalid quantity",
 			tradeInfo: &types.TradeInfo{
-				SharePrice:     100,
-				ShareNetPrice:  100,
-				NumberOfShares: 500,
-				TradeValue:     5000,
-				TradeNetValue:  5000,
+				SharePrice:     math.LegacyNewDec(100),
+				ShareNetPrice:  math.LegacyNewDec(100),
+				NumberOfShares: math.LegacyNewDec(500),
+				TradeValue:     math.LegacyNewDec(5000),
+				TradeNetValue:  math.LegacyNewDec(5000),
 				Quantity:       nil,
 			},
 			expErr:    true,
@@ -592,11 +638,11 @@ func TestValidateBuyOrSell(t *testing.T) {
 		{
 			name: "invalid quantity",
 			tradeInfo: &types.TradeInfo{
-				SharePrice:     100,
-				ShareNetPrice:  100,
-				NumberOfShares: 500,
-				TradeValue:     5000,
-				TradeNetValue:  5000,
+				SharePrice:     math.LegacyNewDec(100),
+				ShareNetPrice:  math.LegacyNewDec(100),
+				NumberOfShares: math.LegacyNewDec(500),
+				TradeValue:     math.LegacyNewDec(5000),
+				TradeNetValue:  math.LegacyNewDec(5000),
 				Quantity: &sdk.Coin{
 					Amount: math.NewInt(100),
 				},
@@ -607,11 +653,11 @@ func TestValidateBuyOrSell(t *testing.T) {
 		{
 			name: "zero quantity",
 			tradeInfo: &types.TradeInfo{
-				SharePrice:     100,
-				ShareNetPrice:  100,
-				NumberOfShares: 500,
-				TradeValue:     5000,
-				TradeNetValue:  5000,
+				SharePrice:     math.LegacyNewDec(100),
+				ShareNetPrice:  math.LegacyNewDec(100),
+				NumberOfShares: math.LegacyNewDec(500),
+				TradeValue:     math.LegacyNewDec(5000),
+				TradeNetValue:  math.LegacyNewDec(5000),
 				Quantity: &sdk.Coin{
 					Amount: math.NewInt(0),
 					Denom:  types.DefaultDenom,
@@ -623,11 +669,13 @@ func TestValidateBuyOrSell(t *testing.T) {
 		{
 			name: "invalid denom",
 			tradeInfo: &types.TradeInfo{
-				SharePrice:     100,
-				ShareNetPrice:  100,
-				NumberOfShares: 500,
-				TradeValue:     5000,
-				TradeNetValue:  5000,
+				SharePrice:         math.LegacyNewDec(100),
+				ShareNetPrice:      math.LegacyNewDec(100),
+				NumberOfShares:     math.LegacyNewDec(500),
+				TradeValue:         math.LegacyNewDec(5000),
+				TradeNetValue:      math.LegacyNewDec(5000),
+				BaseCurrency:       "GBP",
+				SettlementCurrency: "GBP",
 				Quantity: &sdk.Coin{
 					Amount: math.NewInt(1000000),
 					Denom:  "uvvtx",
@@ -636,10 +684,28 @@ func TestValidateBuyOrSell(t *testing.T) {
 			expErr:    true,
 			expErrMsg: "invalid denom expected: ugbpv, got: uvvtx",
 		},
+		{
+			name: "no mintable denom for currencies",
+			tradeInfo: &types.TradeInfo{
+				SharePrice:         math.LegacyNewDec(100),
+				ShareNetPrice:      math.LegacyNewDec(100),
+				NumberOfShares:     math.LegacyNewDec(500),
+				TradeValue:         math.LegacyNewDec(5000),
+				TradeNetValue:      math.LegacyNewDec(5000),
+				BaseCurrency:       "EUR",
+				SettlementCurrency: "EUR",
+				Quantity: &sdk.Coin{
+					Amount: math.NewInt(1000000),
+					Denom:  types.DefaultDenom,
+				},
+			},
+			expErr:    true,
+			expErrMsg: "no mintable denom registered for base_currency: EUR, settlement_currency: EUR",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := types.ValidateBuyOrSell(tt.tradeInfo)
+			err := types.ValidateBuyOrSell(tt.tradeInfo, types.DefaultParams())
 			if tt.expErr {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), tt.expErrMsg)
@@ -650,6 +716,133 @@ func TestValidateBuyOrSell(t *testing.T) {
 	}
 }
 
+func TestValidateTradeDataConsistency(t *testing.T) {
+	tolerances := types.DefaultTradeDataTolerances
+	tests := []struct {
+		name       string
+		tradeType  types.TradeType
+		malleate   func(ti *types.TradeInfo)
+		tolerances types.TradeDataTolerances
+		expErr     bool
+		expErrMsg  string
+	}{
+		{
+			name:       "consistent deposit",
+			tradeType:  types.TradeTypeFiatDeposit,
+			tolerances: tolerances,
+		},
+		{
+			name:       "consistent withdrawal",
+			tradeType:  types.TradeTypeFiatWithdrawal,
+			tolerances: tolerances,
+		},
+		{
+			name:      "consistent trade with exchange rate",
+			tradeType: types.TradeTypeFiatDeposit,
+			malleate: func(ti *types.TradeInfo) {
+				ti.ExchangeRate = math.LegacyMustNewDecFromStr("1.25")
+				ti.Quantity.Amount = math.NewInt(125000)
+			},
+			tolerances: tolerances,
+		},
+		{
+			name:      "trade value within tolerance",
+			tradeType: types.TradeTypeFiatDeposit,
+			malleate: func(ti *types.TradeInfo) {
+				ti.SharePrice = math.LegacyMustNewDecFromStr("0.095095")
+			},
+			tolerances: tolerances,
+		},
+		{
+			name:      "quantity off by one unit",
+			tradeType: types.TradeTypeFiatDeposit,
+			malleate: func(ti *types.TradeInfo) {
+				ti.Quantity.Amount = math.NewInt(100101)
+			},
+			tolerances: tolerances,
+		},
+		{
+			name:      "trade value does not match shares",
+			tradeType: types.TradeTypeFiatDeposit,
+			malleate: func(ti *types.TradeInfo) {
+				ti.SharePrice = math.LegacyMustNewDecFromStr("0.0951")
+			},
+			tolerances: tolerances,
+			expErr:     true,
+			expErrMsg:  "trade_value 95.000000000000000000 does not match number_of_shares * share_price 95.100000000000000000",
+		},
+		{
+			name:       "deposit fee deducted from net value",
+			tradeType:  types.TradeTypeFiatDeposit,
+			malleate:   func(ti *types.TradeInfo) { ti.TradeNetValue = math.LegacyNewDec(90) },
+			tolerances: tolerances,
+			expErr:     true,
+			expErrMsg:  "trade_net_value 90.000000000000000000 does not match trade_value and trade_fee 100.000000000000000000",
+		},
+		{
+			name:       "withdrawal fee added to net value",
+			tradeType:  types.TradeTypeFiatWithdrawal,
+			malleate:   func(ti *types.TradeInfo) { ti.TradeNetValue = math.LegacyNewDec(110) },
+			tolerances: tolerances,
+			expErr:     true,
+			expErrMsg:  "trade_net_value 110.000000000000000000 does not match trade_value and trade_fee 100.000000000000000000",
+		},
+		{
+			name:      "quantity does not match net value",
+			tradeType: types.TradeTypeFiatDeposit,
+			malleate: func(ti *types.TradeInfo) {
+				ti.Quantity.Amount = math.NewInt(1000000)
+			},
+			tolerances: tolerances,
+			expErr:     true,
+			expErrMsg:  "quantity 1000000 does not match trade_net_value * exchange_rate / coin_minting_price 100000.000000000000000000",
+		},
+		{
+			name:      "quantity just above tolerance",
+			tradeType: types.TradeTypeFiatDeposit,
+			malleate: func(ti *types.TradeInfo) {
+				ti.Quantity.Amount = math.NewInt(100102)
+			},
+			tolerances: tolerances,
+			expErr:     true,
+			expErrMsg:  "inconsistent trade data",
+		},
+		{
+			name:      "zero tolerance",
+			tradeType: types.TradeTypeFiatDeposit,
+			malleate: func(ti *types.TradeInfo) {
+				ti.SharePrice = math.LegacyMustNewDecFromStr("0.095095")
+			},
+			tolerances: types.NewTradeDataTolerances(true, math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
+			expErr:     true,
+			expErrMsg:  "does not match number_of_shares * share_price",
+		},
+		{
+			name:      "disabled checks",
+			tradeType: types.TradeTypeFiatDeposit,
+			malleate: func(ti *types.TradeInfo) {
+				ti.Quantity.Amount = math.NewInt(1000000)
+			},
+			tolerances: types.NewTradeDataTolerances(false, math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tradeInfo := types.GetSampleTradeData(tt.tradeType).TradeInfo
+			if tt.malleate != nil {
+				tt.malleate(tradeInfo)
+			}
+			err := types.ValidateTradeDataConsistency(tradeInfo, tt.tolerances)
+			if tt.expErr {
+				require.ErrorIs(t, err, types.ErrInconsistentTradeData)
+				require.Contains(t, err.Error(), tt.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateNoQuantity(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -685,6 +878,33 @@ func TestValidateNoQuantity(t *testing.T) {
 			},
 			expErr: false,
 		},
+		{
+			name: "empty quantity",
+			tradeInfo: &types.TradeInfo{
+				Quantity: &sdk.Coin{},
+			},
+			expErr: false,
+		},
+		{
+			name: "quantity with denom and without amount",
+			tradeInfo: &types.TradeInfo{
+				Quantity: &sdk.Coin{
+					Denom: types.DefaultDenom,
+				},
+			},
+			expErr:    true,
+			expErrMsg: "quantity must not be set",
+		},
+		{
+			name: "quantity with amount and without denom",
+			tradeInfo: &types.TradeInfo{
+				Quantity: &sdk.Coin{
+					Amount: math.NewInt(1000000),
+				},
+			},
+			expErr:    true,
+			expErrMsg: "quantity must not be set",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -698,3 +918,157 @@ func TestValidateNoQuantity(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSplit(t *testing.T) {
+	tests := []struct {
+		name      string
+		update    func(*types.TradeInfo)
+		expErrMsg string
+	}{
+		{
+			name:   "valid split",
+			update: func(*types.TradeInfo) {},
+		},
+		{
+			name:      "zero number of shares",
+			update:    func(ti *types.TradeInfo) { ti.NumberOfShares = math.LegacyZeroDec() },
+			expErrMsg: "number_of_shares must be greater than 0",
+		},
+		{
+			name:      "trade value set",
+			update:    func(ti *types.TradeInfo) { ti.TradeValue = math.LegacyNewDec(10) },
+			expErrMsg: "trade_value must be 0 for trade type TRADE_TYPE_SPLIT",
+		},
+		{
+			name:      "trade net value set",
+			update:    func(ti *types.TradeInfo) { ti.TradeNetValue = math.LegacyNewDec(10) },
+			expErrMsg: "trade_net_value must be 0 for trade type TRADE_TYPE_SPLIT",
+		},
+		{
+			name:      "share price set",
+			update:    func(ti *types.TradeInfo) { ti.SharePrice = math.LegacyNewDec(10) },
+			expErrMsg: "share_price must be 0 for trade type TRADE_TYPE_SPLIT",
+		},
+		{
+			name:      "share net price set",
+			update:    func(ti *types.TradeInfo) { ti.ShareNetPrice = math.LegacyNewDec(10) },
+			expErrMsg: "share_net_price must be 0 for trade type TRADE_TYPE_SPLIT",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tradeInfo := types.GetSampleTradeData(types.TradeTypeSplit).TradeInfo
+			tt.update(tradeInfo)
+			err := types.ValidateSplit(tradeInfo)
+			if tt.expErrMsg != "" {
+				require.ErrorContains(t, err, tt.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDividends(t *testing.T) {
+	tests := []struct {
+		name      string
+		update    func(*types.TradeInfo)
+		expErrMsg string
+	}{
+		{
+			name:   "valid dividends",
+			update: func(*types.TradeInfo) {},
+		},
+		{
+			name:      "zero trade value",
+			update:    func(ti *types.TradeInfo) { ti.TradeValue = math.LegacyZeroDec() },
+			expErrMsg: "trade_value must be greater than 0",
+		},
+		{
+			name:      "zero trade net value",
+			update:    func(ti *types.TradeInfo) { ti.TradeNetValue = math.LegacyZeroDec() },
+			expErrMsg: "trade_net_value must be greater than 0",
+		},
+		{
+			name:      "number of shares set",
+			update:    func(ti *types.TradeInfo) { ti.NumberOfShares = math.LegacyNewDec(10) },
+			expErrMsg: "number_of_shares must be 0 for trade type TRADE_TYPE_DIVIDENDS",
+		},
+		{
+			name:      "share price set",
+			update:    func(ti *types.TradeInfo) { ti.SharePrice = math.LegacyNewDec(10) },
+			expErrMsg: "share_price must be 0 for trade type TRADE_TYPE_DIVIDENDS",
+		},
+		{
+			name:      "share net price set",
+			update:    func(ti *types.TradeInfo) { ti.ShareNetPrice = math.LegacyNewDec(10) },
+			expErrMsg: "share_net_price must be 0 for trade type TRADE_TYPE_DIVIDENDS",
+		},
+		{
+			name: "quantity set",
+			update: func(ti *types.TradeInfo) {
+				ti.Quantity = &sdk.Coin{Denom: types.DefaultDenom, Amount: math.NewInt(1000)}
+			},
+			expErrMsg: "quantity must not be set for trade type TRADE_TYPE_DIVIDENDS",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tradeInfo := types.GetSampleTradeData(types.TradeTypeDividends).TradeInfo
+			tt.update(tradeInfo)
+			err := types.ValidateDividends(tradeInfo)
+			if tt.expErrMsg != "" {
+				require.ErrorContains(t, err, tt.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateReinvestment(t *testing.T) {
+	tests := []struct {
+		name      string
+		update    func(*types.TradeInfo)
+		expErrMsg string
+	}{
+		{
+			name:   "valid reinvestment",
+			update: func(*types.TradeInfo) {},
+		},
+		{
+			name:      "zero share price",
+			update:    func(ti *types.TradeInfo) { ti.SharePrice = math.LegacyZeroDec() },
+			expErrMsg: "share_price must be greater than 0",
+		},
+		{
+			name:      "zero number of shares",
+			update:    func(ti *types.TradeInfo) { ti.NumberOfShares = math.LegacyZeroDec() },
+			expErrMsg: "number_of_shares must be greater than 0",
+		},
+		{
+			name:      "zero trade value",
+			update:    func(ti *types.TradeInfo) { ti.TradeValue = math.LegacyZeroDec() },
+			expErrMsg: "trade_value must be greater than 0",
+		},
+		{
+			name: "quantity set",
+			update: func(ti *types.TradeInfo) {
+				ti.Quantity = &sdk.Coin{Denom: types.DefaultDenom, Amount: math.NewInt(1000)}
+			},
+			expErrMsg: "quantity must not be set for trade type TRADE_TYPE_REINVESTMENT",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tradeInfo := types.GetSampleTradeData(types.TradeTypeReinvestment).TradeInfo
+			tt.update(tradeInfo)
+			err := types.ValidateReinvestment(tradeInfo)
+			if tt.expErrMsg != "" {
+				require.ErrorContains(t, err, tt.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
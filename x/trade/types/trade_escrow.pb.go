@@ -0,0 +1,420 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: vvtxchain/trade/trade_escrow.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TradeEscrow is the amount of a pending withdrawal held by the module account
+// from the creation of the trade until it is burned or refunded to its holder.
+type TradeEscrow struct {
+	TradeIndex uint64     `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Holder     string     `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+	Amount     types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *TradeEscrow) Reset()         { *m = TradeEscrow{} }
+func (m *TradeEscrow) String() string { return proto.CompactTextString(m) }
+func (*TradeEscrow) ProtoMessage()    {}
+func (*TradeEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ac299960fb8e2fc, []int{0}
+}
+func (m *TradeEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradeEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradeEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradeEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeEscrow.Merge(m, src)
+}
+func (m *TradeEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradeEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeEscrow proto.InternalMessageInfo
+
+func (m *TradeEscrow) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
+	}
+	return 0
+}
+
+func (m *TradeEscrow) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *TradeEscrow) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*TradeEscrow)(nil), "vvtxchain.trade.TradeEscrow")
+}
+
+func init() {
+	proto.RegisterFile("vvtxchain/trade/trade_escrow.proto", fileDescriptor_9ac299960fb8e2fc)
+}
+
+var fileDescriptor_9ac299960fb8e2fc = []byte{
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xb1, 0x4e, 0xfb, 0x30,
+	0x10, 0xc6, 0xe3, 0xff, 0x1f, 0x55, 0x6a, 0x3a, 0x20, 0xa2, 0x0e, 0x69, 0x07, 0xb7, 0xea, 0x54,
+	0x81, 0xb0, 0x29, 0xac, 0x2c, 0x14, 0x55, 0x15, 0x12, 0x53, 0x61, 0xea, 0x52, 0x39, 0x89, 0x95,
+	0x5a, 0x22, 0xbe, 0xca, 0x76, 0x4b, 0x79, 0x0b, 0x9e, 0x80, 0x99, 0x91, 0x81, 0x87, 0xe8, 0x58,
+	0x31, 0x31, 0x21, 0xd4, 0x0c, 0xbc, 0x06, 0x8a, 0x6d, 0x60, 0x39, 0xf9, 0xbe, 0xef, 0x67, 0xdd,
+	0x7d, 0x17, 0xf6, 0x56, 0x2b, 0xb3, 0x4e, 0xe7, 0x4c, 0x48, 0x6a, 0x14, 0xcb, 0xb8, 0xab, 0x33,
+	0xae, 0x53, 0x05, 0xf7, 0x64, 0xa1, 0xc0, 0x40, 0xb4, 0xff, 0xcb, 0x10, 0xeb, 0xb6, 0x0f, 0x58,
+	0x21, 0x24, 0x50, 0x5b, 0x1d, 0xd3, 0xc6, 0x29, 0xe8, 0x02, 0x34, 0x4d, 0x98, 0xe6, 0x74, 0x35,
+	0x48, 0xb8, 0x61, 0x03, 0x9a, 0x82, 0x90, 0xde, 0x6f, 0x39, 0x7f, 0x66, 0x3b, 0xea, 0x1a, 0x6f,
+	0x35, 0x73, 0xc8, 0xc1, 0xe9, 0xd5, 0xcb, 0xa9, 0xbd, 0x27, 0x14, 0x36, 0x6e, 0xab, 0x69, 0x23,
+	0xbb, 0x4a, 0xd4, 0x09, 0x1b, 0x6e, 0x35, 0x21, 0x33, 0xbe, 0x8e, 0x51, 0x17, 0xf5, 0xf7, 0x26,
+	0xa1, 0x95, 0xae, 0x2a, 0x25, 0x3a, 0x09, 0x6b, 0x73, 0xb8, 0xcb, 0xb8, 0x8a, 0xff, 0x75, 0x51,
+	0xbf, 0x3e, 0x8c, 0xdf, 0x5e, 0x8f, 0x9b, 0x7e, 0xd0, 0x45, 0x96, 0x29, 0xae, 0xf5, 0x8d, 0x51,
+	0x42, 0xe6, 0x13, 0xcf, 0x45, 0xe7, 0x61, 0x8d, 0x15, 0xb0, 0x94, 0x26, 0xfe, 0xdf, 0x45, 0xfd,
+	0xc6, 0x69, 0x8b, 0x78, 0xbc, 0x0a, 0x41, 0x7c, 0x08, 0x72, 0x09, 0x42, 0x0e, 0xeb, 0x9b, 0x8f,
+	0x4e, 0xf0, 0xfc, 0xf5, 0x72, 0x88, 0x26, 0xfe, 0xcf, 0x70, 0xb4, 0xd9, 0x61, 0xb4, 0xdd, 0x61,
+	0xf4, 0xb9, 0xc3, 0xe8, 0xb1, 0xc4, 0xc1, 0xb6, 0xc4, 0xc1, 0x7b, 0x89, 0x83, 0xe9, 0x51, 0x2e,
+	0xcc, 0x7c, 0x99, 0x90, 0x14, 0x0a, 0x3a, 0x1e, 0x8f, 0xa6, 0xd7, 0x2c, 0xd1, 0xf4, 0xef, 0xce,
+	0xeb, 0x9f, 0x4b, 0x3f, 0x2c, 0xb8, 0x4e, 0x6a, 0x36, 0xee, 0xd9, 0xf7, 0x00, 0xac, 0x86, 0x04,
+	0xbd, 0x89, 0x01, 0x00, 0x00,
+}
+
+func (m *TradeEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradeEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradeEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTradeEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTradeEscrow(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintTradeEscrow(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTradeEscrow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTradeEscrow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TradeEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovTradeEscrow(uint64(m.TradeIndex))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTradeEscrow(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTradeEscrow(uint64(l))
+	return n
+}
+
+func sovTradeEscrow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTradeEscrow(x uint64) (n int) {
+	return sovTradeEscrow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TradeEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTradeEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradeEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradeEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTradeEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTradeEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTradeEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTradeEscrow(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTradeEscrow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTradeEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTradeEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTradeEscrow
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTradeEscrow
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTradeEscrow
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTradeEscrow        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTradeEscrow          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTradeEscrow = fmt.Errorf("proto: unexpected end of group")
+)
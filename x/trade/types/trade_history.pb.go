@@ -0,0 +1,674 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: vvtxchain/trade/trade_history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TradeTransition is a change of the state of a trade.
+type TradeTransition int32
+
+const (
+	TradeTransition_TRADE_TRANSITION_UNSPECIFIED TradeTransition = 0
+	TradeTransition_TRADE_TRANSITION_CREATED     TradeTransition = 1
+	TradeTransition_TRADE_TRANSITION_AMENDED     TradeTransition = 2
+	// approved is a confirmation of a checker, the trade stays pending while it is
+	// awaiting approvals.
+	TradeTransition_TRADE_TRANSITION_APPROVED TradeTransition = 3
+	TradeTransition_TRADE_TRANSITION_REJECTED TradeTransition = 4
+	TradeTransition_TRADE_TRANSITION_CANCELED TradeTransition = 5
+	TradeTransition_TRADE_TRANSITION_EXPIRED  TradeTransition = 6
+	TradeTransition_TRADE_TRANSITION_FAILED   TradeTransition = 7
+	TradeTransition_TRADE_TRANSITION_RETRIED  TradeTransition = 8
+)
+
+var TradeTransition_name = map[int32]string{
+	0: "TRADE_TRANSITION_UNSPECIFIED",
+	1: "TRADE_TRANSITION_CREATED",
+	2: "TRADE_TRANSITION_AMENDED",
+	3: "TRADE_TRANSITION_APPROVED",
+	4: "TRADE_TRANSITION_REJECTED",
+	5: "TRADE_TRANSITION_CANCELED",
+	6: "TRADE_TRANSITION_EXPIRED",
+	7: "TRADE_TRANSITION_FAILED",
+	8: "TRADE_TRANSITION_RETRIED",
+}
+
+var TradeTransition_value = map[string]int32{
+	"TRADE_TRANSITION_UNSPECIFIED": 0,
+	"TRADE_TRANSITION_CREATED":     1,
+	"TRADE_TRANSITION_AMENDED":     2,
+	"TRADE_TRANSITION_APPROVED":    3,
+	"TRADE_TRANSITION_REJECTED":    4,
+	"TRADE_TRANSITION_CANCELED":    5,
+	"TRADE_TRANSITION_EXPIRED":     6,
+	"TRADE_TRANSITION_FAILED":      7,
+	"TRADE_TRANSITION_RETRIED":     8,
+}
+
+func (x TradeTransition) String() string {
+	return proto.EnumName(TradeTransition_name, int32(x))
+}
+
+func (TradeTransition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_92de28f7dd04bbf4, []int{0}
+}
+
+// TradeHistoryEntry is a transition of a trade. The entries of a trade are
+// append only and ordered by sequence.
+type TradeHistoryEntry struct {
+	TradeIndex uint64          `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Sequence   uint64          `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Transition TradeTransition `protobuf:"varint,3,opt,name=transition,proto3,enum=vvtxchain.trade.TradeTransition" json:"transition,omitempty"`
+	// status is the status of the trade after the transition.
+	Status TradeStatus `protobuf:"varint,4,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
+	// actor is the signer of the transition, empty for the transitions made by the module.
+	Actor       string    `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	BlockHeight int64     `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Time        time.Time `protobuf:"bytes,7,opt,name=time,proto3,stdtime" json:"time"`
+	Reason      string    `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *TradeHistoryEntry) Reset()         { *m = TradeHistoryEntry{} }
+func (m *TradeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*TradeHistoryEntry) ProtoMessage()    {}
+func (*TradeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_92de28f7dd04bbf4, []int{0}
+}
+func (m *TradeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeHistoryEntry.Merge(m, src)
+}
+func (m *TradeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeHistoryEntry proto.InternalMessageInfo
+
+func (m *TradeHistoryEntry) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
+	}
+	return 0
+}
+
+func (m *TradeHistoryEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *TradeHistoryEntry) GetTransition() TradeTransition {
+	if m != nil {
+		return m.Transition
+	}
+	return TradeTransition_TRADE_TRANSITION_UNSPECIFIED
+}
+
+func (m *TradeHistoryEntry) GetStatus() TradeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
+}
+
+func (m *TradeHistoryEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *TradeHistoryEntry) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TradeHistoryEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *TradeHistoryEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("vvtxchain.trade.TradeTransition", TradeTransition_name, TradeTransition_value)
+	proto.RegisterType((*TradeHistoryEntry)(nil), "vvtxchain.trade.TradeHistoryEntry")
+}
+
+func init() {
+	proto.RegisterFile("vvtxchain/trade/trade_history.proto", fileDescriptor_92de28f7dd04bbf4)
+}
+
+var fileDescriptor_92de28f7dd04bbf4 = []byte{
+	// 499 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0x33, 0xf9, 0x6b, 0x98, 0x20, 0x6a, 0x46, 0x15, 0x98, 0x34, 0x38, 0x06, 0x36, 0x11,
+	0x48, 0xb6, 0x54, 0x58, 0xb0, 0xc4, 0xb5, 0xa7, 0xad, 0x51, 0x71, 0xa3, 0x89, 0x41, 0xa8, 0x9b,
+	0xc8, 0x76, 0x07, 0xdb, 0xa2, 0xf1, 0x04, 0x7b, 0x52, 0x25, 0x6f, 0xd1, 0x97, 0xe0, 0x5d, 0xba,
+	0xac, 0xc4, 0x86, 0x15, 0xa0, 0xe4, 0x45, 0x90, 0xc7, 0x49, 0x40, 0x8d, 0x37, 0x96, 0xef, 0xfd,
+	0xce, 0xf1, 0xf1, 0xbd, 0x33, 0xf0, 0xc5, 0xd5, 0x15, 0x9f, 0x05, 0x91, 0x17, 0x27, 0x3a, 0x4f,
+	0xbd, 0x0b, 0x5a, 0x3c, 0x47, 0x51, 0x9c, 0x71, 0x96, 0xce, 0xb5, 0x49, 0xca, 0x38, 0x43, 0xbb,
+	0x1b, 0x91, 0x26, 0x70, 0x67, 0x2f, 0x64, 0x21, 0x13, 0x4c, 0xcf, 0xdf, 0x0a, 0x59, 0xa7, 0x17,
+	0x32, 0x16, 0x5e, 0x52, 0x5d, 0x54, 0xfe, 0xf4, 0x8b, 0xce, 0xe3, 0x31, 0xcd, 0xb8, 0x37, 0x9e,
+	0xac, 0x04, 0xfb, 0xa5, 0x61, 0x05, 0x7c, 0xfe, 0xa3, 0x0a, 0x1f, 0xba, 0x79, 0x7d, 0x52, 0x64,
+	0xe3, 0x84, 0xa7, 0x73, 0xd4, 0x83, 0xed, 0xe2, 0x8f, 0xe2, 0xe4, 0x82, 0xce, 0x64, 0xa0, 0x82,
+	0x7e, 0x9d, 0x40, 0xd1, 0xb2, 0xf3, 0x0e, 0xea, 0xc0, 0x56, 0x46, 0xbf, 0x4d, 0x69, 0x12, 0x50,
+	0xb9, 0x2a, 0xe8, 0xa6, 0x46, 0xef, 0x60, 0xae, 0x4c, 0xb2, 0x98, 0xc7, 0x2c, 0x91, 0x6b, 0x2a,
+	0xe8, 0x3f, 0x38, 0x50, 0xb5, 0x3b, 0xc3, 0x68, 0x22, 0xd4, 0xdd, 0xe8, 0xc8, 0x7f, 0x1e, 0xf4,
+	0x06, 0x36, 0x33, 0xee, 0xf1, 0x69, 0x26, 0xd7, 0x85, 0xbb, 0x5b, 0xee, 0x1e, 0x0a, 0x0d, 0x59,
+	0x69, 0xd1, 0x1e, 0x6c, 0x78, 0x01, 0x67, 0xa9, 0xdc, 0x50, 0x41, 0xff, 0x1e, 0x29, 0x0a, 0xf4,
+	0x0c, 0xde, 0xf7, 0x2f, 0x59, 0xf0, 0x75, 0x14, 0xd1, 0x38, 0x8c, 0xb8, 0xdc, 0x54, 0x41, 0xbf,
+	0x46, 0xda, 0xa2, 0x77, 0x22, 0x5a, 0xe8, 0x2d, 0xac, 0xe7, 0x3b, 0x93, 0x77, 0x54, 0xd0, 0x6f,
+	0x1f, 0x74, 0xb4, 0x62, 0xa1, 0xda, 0x7a, 0xa1, 0x9a, 0xbb, 0x5e, 0xe8, 0x61, 0xeb, 0xe6, 0x57,
+	0xaf, 0x72, 0xfd, 0xbb, 0x07, 0x88, 0x70, 0xa0, 0x47, 0xb0, 0x99, 0x52, 0x2f, 0x63, 0x89, 0xdc,
+	0x12, 0x99, 0xab, 0xea, 0xe5, 0xf7, 0x2a, 0xdc, 0xbd, 0x33, 0x20, 0x52, 0x61, 0xd7, 0x25, 0x86,
+	0x85, 0x47, 0x2e, 0x31, 0x9c, 0xa1, 0xed, 0xda, 0x67, 0xce, 0xe8, 0xa3, 0x33, 0x1c, 0x60, 0xd3,
+	0x3e, 0xb2, 0xb1, 0x25, 0x55, 0x50, 0x17, 0xca, 0x5b, 0x0a, 0x93, 0x60, 0xc3, 0xc5, 0x96, 0x04,
+	0x4a, 0xa9, 0xf1, 0x01, 0x3b, 0x16, 0xb6, 0xa4, 0x2a, 0x7a, 0x0a, 0x9f, 0x6c, 0xd3, 0xc1, 0x80,
+	0x9c, 0x7d, 0xc2, 0x96, 0x54, 0x2b, 0xc5, 0x04, 0xbf, 0xc7, 0x66, 0xfe, 0xed, 0x7a, 0x29, 0x36,
+	0x0d, 0xc7, 0xc4, 0xa7, 0xd8, 0x92, 0x1a, 0xa5, 0xd1, 0xf8, 0xf3, 0xc0, 0x26, 0xd8, 0x92, 0x9a,
+	0x68, 0x1f, 0x3e, 0xde, 0xa2, 0x47, 0x86, 0x9d, 0x5b, 0x77, 0x4a, 0xad, 0x04, 0xbb, 0x24, 0x9f,
+	0xb8, 0x75, 0x88, 0x6f, 0x16, 0x0a, 0xb8, 0x5d, 0x28, 0xe0, 0xcf, 0x42, 0x01, 0xd7, 0x4b, 0xa5,
+	0x72, 0xbb, 0x54, 0x2a, 0x3f, 0x97, 0x4a, 0xe5, 0xfc, 0x55, 0x18, 0xf3, 0x68, 0xea, 0x6b, 0x01,
+	0x1b, 0xeb, 0xc7, 0xc7, 0xf8, 0xfc, 0xd4, 0xf3, 0x33, 0xfd, 0xdf, 0x45, 0x9e, 0xad, 0xaf, 0xf2,
+	0x7c, 0x42, 0x33, 0xbf, 0x29, 0x8e, 0xea, 0xf5, 0xdf, 0x01, 0x00, 0xcb, 0x1f, 0x7f, 0x9a, 0x57,
+	0x03, 0x00, 0x00,
+}
+
+func (m *TradeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTradeHistory(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTradeHistory(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.BlockHeight != 0 {
+		i = encodeVarintTradeHistory(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintTradeHistory(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintTradeHistory(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Transition != 0 {
+		i = encodeVarintTradeHistory(dAtA, i, uint64(m.Transition))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTradeHistory(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintTradeHistory(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTradeHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTradeHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TradeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovTradeHistory(uint64(m.TradeIndex))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTradeHistory(uint64(m.Sequence))
+	}
+	if m.Transition != 0 {
+		n += 1 + sovTradeHistory(uint64(m.Transition))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTradeHistory(uint64(m.Status))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovTradeHistory(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTradeHistory(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTradeHistory(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTradeHistory(uint64(l))
+	}
+	return n
+}
+
+func sovTradeHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTradeHistory(x uint64) (n int) {
+	return sovTradeHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TradeHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTradeHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradeHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transition", wireType)
+			}
+			m.Transition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Transition |= TradeTransition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTradeHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTradeHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTradeHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTradeHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTradeHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTradeHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTradeHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTradeHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTradeHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTradeHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTradeHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTradeHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTradeHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTradeHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTradeHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTradeHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -23,31 +23,31 @@ func TestIsTypeValid(t *testing.T) {
 			tradeType: types.TradeTypeFiatWithdrawal,
 			valid:     true,
 		},
-		// {
-		// 	name:      "trade type split",
-		// 	tradeType: types.TradeTypeSplit,
-		// 	valid:     true,
-		// },
-		// {
-		// 	name:      "trade type reverse split",
-		// 	tradeType: types.TradeTypeReverseSplit,
-		// 	valid:     true,
-		// },
-		// {
-		// 	name:      "trade type reinvestment",
-		// 	tradeType: types.TradeTypeReinvestment,
-		// 	valid:     true,
-		// },
-		// {
-		// 	name:      "trade type dividends",
-		// 	tradeType: types.TradeTypeDividends,
-		// 	valid:     true,
-		// },
-		// {
-		// 	name:      "trade type dividends deduction",
-		// 	tradeType: types.TradeTypeDividendsDeduction,
-		// 	valid:     true,
-		// },
+		{
+			name:      "trade type split",
+			tradeType: types.TradeTypeSplit,
+			valid:     true,
+		},
+		{
+			name:      "trade type reverse split",
+			tradeType: types.TradeTypeReverseSplit,
+			valid:     true,
+		},
+		{
+			name:      "trade type reinvestment",
+			tradeType: types.TradeTypeReinvestment,
+			valid:     true,
+		},
+		{
+			name:      "trade type dividends",
+			tradeType: types.TradeTypeDividends,
+			valid:     true,
+		},
+		{
+			name:      "trade type dividends deduction",
+			tradeType: types.TradeTypeDividendsDeduction,
+			valid:     true,
+		},
 		{
 			name:      "trade type unspecified",
 			tradeType: types.TradeTypeNil,
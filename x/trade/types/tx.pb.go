@@ -132,6 +132,9 @@ type MsgCreateTrade struct {
 	CoinMintingPriceJson string `protobuf:"bytes,5,opt,name=coin_minting_price_json,json=coinMintingPriceJson,proto3" json:"coin_minting_price_json,omitempty"`
 	ExchangeRateJson     string `protobuf:"bytes,6,opt,name=exchange_rate_json,json=exchangeRateJson,proto3" json:"exchange_rate_json,omitempty"`
 	CreateDate           string `protobuf:"bytes,7,opt,name=create_date,json=createDate,proto3" json:"create_date,omitempty"`
+	// external_reference is an optional reference of the trade in the banking system, a trade
+	// is rejected while another pending or processed trade has the same reference.
+	ExternalReference string `protobuf:"bytes,8,opt,name=external_reference,json=externalReference,proto3" json:"external_reference,omitempty"`
 }
 
 func (m *MsgCreateTrade) Reset()         { *m = MsgCreateTrade{} }
@@ -216,6 +219,13 @@ func (m *MsgCreateTrade) GetCreateDate() string {
 	return ""
 }
 
+func (m *MsgCreateTrade) GetExternalReference() string {
+	if m != nil {
+		return m.ExternalReference
+	}
+	return ""
+}
+
 type MsgCreateTradeResponse struct {
 	TradeIndex uint64      `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
 	Status     TradeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
@@ -272,6 +282,9 @@ type MsgProcessTrade struct {
 	Creator     string      `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	ProcessType ProcessType `protobuf:"varint,2,opt,name=process_type,json=processType,proto3,enum=vvtxchain.trade.ProcessType" json:"process_type,omitempty"`
 	TradeIndex  uint64      `protobuf:"varint,3,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	// expected_revision is the revision of the trade being processed, the message
+	// fails if the trade has been amended since.
+	ExpectedRevision uint64 `protobuf:"varint,4,opt,name=expected_revision,json=expectedRevision,proto3" json:"expected_revision,omitempty"`
 }
 
 func (m *MsgProcessTrade) Reset()         { *m = MsgProcessTrade{} }
@@ -328,6 +341,13 @@ func (m *MsgProcessTrade) GetTradeIndex() uint64 {
 	return 0
 }
 
+func (m *MsgProcessTrade) GetExpectedRevision() uint64 {
+	if m != nil {
+		return m.ExpectedRevision
+	}
+	return 0
+}
+
 type MsgProcessTradeResponse struct {
 	TradeIndex uint64      `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
 	Status     TradeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
@@ -380,589 +400,4728 @@ func (m *MsgProcessTradeResponse) GetStatus() TradeStatus {
 	return TradeStatus_TRADE_STATUS_UNSPECIFIED
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "vvtxchain.trade.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "vvtxchain.trade.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateTrade)(nil), "vvtxchain.trade.MsgCreateTrade")
-	proto.RegisterType((*MsgCreateTradeResponse)(nil), "vvtxchain.trade.MsgCreateTradeResponse")
-	proto.RegisterType((*MsgProcessTrade)(nil), "vvtxchain.trade.MsgProcessTrade")
-	proto.RegisterType((*MsgProcessTradeResponse)(nil), "vvtxchain.trade.MsgProcessTradeResponse")
+type MsgCancelTrade struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TradeIndex uint64 `protobuf:"varint,2,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func init() { proto.RegisterFile("vvtxchain/trade/tx.proto", fileDescriptor_adc27de6a3fce195) }
-
-var fileDescriptor_adc27de6a3fce195 = []byte{
-	// 659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbd, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0xfb, 0x91, 0x2a, 0x97, 0xa8, 0x1f, 0xa6, 0x22, 0x6e, 0x28, 0x6e, 0xc9, 0x42, 0x29,
-	0x60, 0x8b, 0x52, 0x18, 0xba, 0x20, 0x4a, 0xab, 0x0a, 0x44, 0xa4, 0xca, 0x05, 0x21, 0x65, 0xb1,
-	0x2e, 0xf6, 0xc9, 0x35, 0xc8, 0x77, 0xd6, 0xdd, 0x35, 0x4a, 0x36, 0xc4, 0xc8, 0xc4, 0x8e, 0xd8,
-	0x19, 0x33, 0x30, 0x20, 0xf1, 0x0f, 0x74, 0xac, 0x98, 0x98, 0x10, 0x4a, 0x86, 0xfc, 0x1b, 0xe8,
-	0xee, 0xec, 0x7c, 0x38, 0x41, 0x99, 0x58, 0x9c, 0xdc, 0xef, 0xbd, 0x77, 0xf7, 0x7e, 0xcf, 0x3f,
-	0x1f, 0x30, 0x9a, 0x4d, 0xde, 0xf2, 0xce, 0x61, 0x88, 0x6d, 0x4e, 0xa1, 0x8f, 0x6c, 0xde, 0xb2,
-	0x62, 0x4a, 0x38, 0xd1, 0x57, 0x06, 0x88, 0x25, 0x91, 0xca, 0x1a, 0x8c, 0x42, 0x4c, 0x6c, 0xf9,
-	0x54, 0x9c, 0x4a, 0xd9, 0x23, 0x2c, 0x22, 0xcc, 0x8e, 0x58, 0x60, 0x37, 0x1f, 0x88, 0x9f, 0x04,
-	0xd8, 0x50, 0x80, 0x2b, 0x57, 0xb6, 0x5a, 0x24, 0xd0, 0x7a, 0x40, 0x02, 0xa2, 0xea, 0xe2, 0x5f,
-	0x52, 0xdd, 0xcc, 0xfa, 0x88, 0x21, 0x85, 0x51, 0xaa, 0xb9, 0x31, 0xe1, 0x52, 0x3c, 0x15, 0x58,
-	0xfd, 0xa1, 0x81, 0x95, 0x1a, 0x0b, 0x5e, 0xc7, 0x3e, 0xe4, 0xe8, 0x54, 0xca, 0xf4, 0xc7, 0xa0,
-	0x00, 0x2f, 0xf8, 0x39, 0xa1, 0x21, 0x6f, 0x1b, 0xda, 0xb6, 0xb6, 0x53, 0x38, 0x34, 0x7e, 0x7e,
-	0xbb, 0xbf, 0x9e, 0x38, 0x79, 0xea, 0xfb, 0x14, 0x31, 0x76, 0xc6, 0x69, 0x88, 0x03, 0x67, 0x48,
-	0xd5, 0x0f, 0x40, 0x5e, 0x1d, 0x6c, 0xcc, 0x6d, 0x6b, 0x3b, 0xc5, 0xbd, 0xb2, 0x95, 0x49, 0xc1,
-	0x52, 0x07, 0x1c, 0x16, 0x2e, 0x7f, 0x6f, 0xe5, 0xbe, 0xf6, 0x3b, 0xbb, 0x9a, 0x93, 0x28, 0x0e,
-	0xf6, 0x3f, 0xf4, 0x3b, 0xbb, 0xc3, 0xbd, 0x3e, 0xf6, 0x3b, 0xbb, 0xb7, 0x86, 0xbe, 0x5b, 0x89,
-	0xf3, 0x8c, 0xd3, 0xea, 0x06, 0x28, 0x67, 0x4a, 0x0e, 0x62, 0x31, 0xc1, 0x0c, 0x55, 0xbf, 0xcf,
-	0x81, 0xe5, 0x1a, 0x0b, 0x9e, 0x51, 0x04, 0x39, 0x7a, 0x25, 0xd4, 0xba, 0x01, 0x96, 0x3c, 0xb1,
-	0x24, 0x54, 0x75, 0xe5, 0xa4, 0x4b, 0xfd, 0x0e, 0x58, 0xa5, 0xc8, 0x43, 0x61, 0x13, 0x51, 0x17,
-	0xaa, 0xf6, 0x64, 0x0f, 0x05, 0x67, 0x25, 0xad, 0x27, 0x5d, 0xeb, 0x37, 0x01, 0x90, 0x5e, 0x5c,
-	0x1f, 0x72, 0x68, 0xcc, 0x4b, 0x52, 0x41, 0x56, 0x8e, 0x20, 0x87, 0xba, 0x05, 0xae, 0x35, 0x20,
-	0x7e, 0x17, 0xe2, 0xc0, 0x65, 0x6d, 0xc6, 0x51, 0xa4, 0x78, 0x0b, 0x92, 0xb7, 0x96, 0x40, 0x67,
-	0x12, 0x91, 0xfc, 0x47, 0xa0, 0xec, 0x91, 0x10, 0xbb, 0x51, 0x88, 0xb9, 0x10, 0xc5, 0x34, 0xf4,
-	0x90, 0xfb, 0x96, 0x11, 0x6c, 0x2c, 0x4a, 0xcd, 0xba, 0x80, 0x6b, 0x0a, 0x3d, 0x15, 0xe0, 0x0b,
-	0x46, 0xb0, 0x7e, 0x0f, 0xe8, 0x48, 0x64, 0x83, 0x03, 0xe4, 0x52, 0xc8, 0x13, 0x45, 0x5e, 0x2a,
-	0x56, 0x53, 0xc4, 0x81, 0x5c, 0xb1, 0xb7, 0x40, 0x51, 0x76, 0x2a, 0x4d, 0x23, 0x63, 0x49, 0xd2,
-	0x80, 0x2a, 0x1d, 0x41, 0x8e, 0x0e, 0x4a, 0x22, 0xfd, 0x34, 0x8d, 0x2a, 0x01, 0xd7, 0xc7, 0x93,
-	0x4b, 0x43, 0x15, 0x1b, 0xa9, 0xe6, 0x43, 0xec, 0xa3, 0x96, 0x4c, 0x71, 0xc1, 0x51, 0x79, 0x3c,
-	0x17, 0x15, 0x7d, 0x1f, 0xe4, 0x19, 0x87, 0xfc, 0x42, 0xc5, 0xb7, 0xbc, 0xb7, 0x39, 0x31, 0x02,
-	0x72, 0xc3, 0x33, 0xc9, 0x71, 0x12, 0x6e, 0xf5, 0xb3, 0x1a, 0xc2, 0x53, 0x4a, 0x3c, 0xc4, 0xd8,
-	0xac, 0x97, 0xf5, 0x04, 0x94, 0x62, 0xc5, 0x74, 0x79, 0x3b, 0x46, 0xff, 0x3c, 0x29, 0xdd, 0xae,
-	0x1d, 0x23, 0xa7, 0x18, 0x0f, 0x17, 0xd9, 0x2e, 0xe6, 0xb3, 0x5d, 0x64, 0xe2, 0x88, 0xe5, 0x90,
-	0x8d, 0x9a, 0xfb, 0xcf, 0x79, 0xec, 0x7d, 0x99, 0x03, 0xf3, 0x35, 0x16, 0xe8, 0x75, 0x50, 0x1a,
-	0xfb, 0x30, 0xb7, 0x27, 0xd4, 0x99, 0xe9, 0xaf, 0xec, 0xcc, 0x62, 0x0c, 0xac, 0xbf, 0x01, 0xc5,
-	0xd1, 0x6f, 0x63, 0x6b, 0x9a, 0x70, 0x84, 0x50, 0xb9, 0x3d, 0x83, 0x30, 0xd8, 0xb8, 0x0e, 0x4a,
-	0x63, 0x2f, 0x72, 0xaa, 0xe9, 0x51, 0xc6, 0x74, 0xd3, 0xd3, 0xf2, 0xae, 0x2c, 0xbe, 0x17, 0x97,
-	0xc6, 0xe1, 0xf1, 0x65, 0xd7, 0xd4, 0xae, 0xba, 0xa6, 0xf6, 0xa7, 0x6b, 0x6a, 0x9f, 0x7a, 0x66,
-	0xee, 0xaa, 0x67, 0xe6, 0x7e, 0xf5, 0xcc, 0x5c, 0xfd, 0x6e, 0x10, 0xf2, 0xf3, 0x8b, 0x86, 0xe5,
-	0x91, 0xc8, 0x3e, 0x39, 0x39, 0xae, 0xbf, 0x84, 0x0d, 0x66, 0x4f, 0xde, 0x23, 0x62, 0x6e, 0x58,
-	0x23, 0x2f, 0xaf, 0xc0, 0x87, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x33, 0xf0, 0xdd, 0x2b, 0xc7,
-	0x05, 0x00, 0x00,
+func (m *MsgCancelTrade) Reset()         { *m = MsgCancelTrade{} }
+func (m *MsgCancelTrade) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTrade) ProtoMessage()    {}
+func (*MsgCancelTrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc27de6a3fce195, []int{6}
+}
+func (m *MsgCancelTrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTrade.Merge(m, src)
+}
+func (m *MsgCancelTrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTrade.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgCancelTrade proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgCancelTrade) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	CreateTrade(ctx context.Context, in *MsgCreateTrade, opts ...grpc.CallOption) (*MsgCreateTradeResponse, error)
-	ProcessTrade(ctx context.Context, in *MsgProcessTrade, opts ...grpc.CallOption) (*MsgProcessTradeResponse, error)
+func (m *MsgCancelTrade) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
+	}
+	return 0
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCancelTrade) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgCancelTradeResponse struct {
+	TradeIndex uint64      `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Status     TradeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelTradeResponse) Reset()         { *m = MsgCancelTradeResponse{} }
+func (m *MsgCancelTradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTradeResponse) ProtoMessage()    {}
+func (*MsgCancelTradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc27de6a3fce195, []int{7}
+}
+func (m *MsgCancelTradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCancelTradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTradeResponse.Merge(m, src)
+}
+func (m *MsgCancelTradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTradeResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateTrade(ctx context.Context, in *MsgCreateTrade, opts ...grpc.CallOption) (*MsgCreateTradeResponse, error) {
-	out := new(MsgCreateTradeResponse)
-	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Msg/CreateTrade", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelTradeResponse proto.InternalMessageInfo
+
+func (m *MsgCancelTradeResponse) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ProcessTrade(ctx context.Context, in *MsgProcessTrade, opts ...grpc.CallOption) (*MsgProcessTradeResponse, error) {
-	out := new(MsgProcessTradeResponse)
-	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Msg/ProcessTrade", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelTradeResponse) GetStatus() TradeStatus {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	CreateTrade(context.Context, *MsgCreateTrade) (*MsgCreateTradeResponse, error)
-	ProcessTrade(context.Context, *MsgProcessTrade) (*MsgProcessTradeResponse, error)
+// MsgAmendTrade replaces the payload of a pending trade. Empty fields are left unchanged.
+type MsgAmendTrade struct {
+	Creator              string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TradeIndex           uint64 `protobuf:"varint,2,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	ReceiverAddress      string `protobuf:"bytes,3,opt,name=receiver_address,json=receiverAddress,proto3" json:"receiver_address,omitempty"`
+	TradeData            string `protobuf:"bytes,4,opt,name=trade_data,json=tradeData,proto3" json:"trade_data,omitempty"`
+	BankingSystemData    string `protobuf:"bytes,5,opt,name=banking_system_data,json=bankingSystemData,proto3" json:"banking_system_data,omitempty"`
+	CoinMintingPriceJson string `protobuf:"bytes,6,opt,name=coin_minting_price_json,json=coinMintingPriceJson,proto3" json:"coin_minting_price_json,omitempty"`
+	ExchangeRateJson     string `protobuf:"bytes,7,opt,name=exchange_rate_json,json=exchangeRateJson,proto3" json:"exchange_rate_json,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgAmendTrade) Reset()         { *m = MsgAmendTrade{} }
+func (m *MsgAmendTrade) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendTrade) ProtoMessage()    {}
+func (*MsgAmendTrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc27de6a3fce195, []int{8}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgAmendTrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateTrade(ctx context.Context, req *MsgCreateTrade) (*MsgCreateTradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTrade not implemented")
+func (m *MsgAmendTrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendTrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ProcessTrade(ctx context.Context, req *MsgProcessTrade) (*MsgProcessTradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProcessTrade not implemented")
+func (m *MsgAmendTrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendTrade.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgAmendTrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendTrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendTrade.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vvtxchain.trade.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgAmendTrade proto.InternalMessageInfo
+
+func (m *MsgAmendTrade) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateTrade)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateTrade(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vvtxchain.trade.Msg/CreateTrade",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateTrade(ctx, req.(*MsgCreateTrade))
+func (m *MsgAmendTrade) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_ProcessTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProcessTrade)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProcessTrade(ctx, in)
+func (m *MsgAmendTrade) GetReceiverAddress() string {
+	if m != nil {
+		return m.ReceiverAddress
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/vvtxchain.trade.Msg/ProcessTrade",
+	return ""
+}
+
+func (m *MsgAmendTrade) GetTradeData() string {
+	if m != nil {
+		return m.TradeData
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProcessTrade(ctx, req.(*MsgProcessTrade))
+	return ""
+}
+
+func (m *MsgAmendTrade) GetBankingSystemData() string {
+	if m != nil {
+		return m.BankingSystemData
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "vvtxchain.trade.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateTrade",
-			Handler:    _Msg_CreateTrade_Handler,
-		},
-		{
-			MethodName: "ProcessTrade",
-			Handler:    _Msg_ProcessTrade_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "vvtxchain/trade/tx.proto",
+func (m *MsgAmendTrade) GetCoinMintingPriceJson() string {
+	if m != nil {
+		return m.CoinMintingPriceJson
+	}
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAmendTrade) GetExchangeRateJson() string {
+	if m != nil {
+		return m.ExchangeRateJson
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgAmendTradeResponse struct {
+	TradeIndex uint64 `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Revision   uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgAmendTradeResponse) Reset()         { *m = MsgAmendTradeResponse{} }
+func (m *MsgAmendTradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendTradeResponse) ProtoMessage()    {}
+func (*MsgAmendTradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc27de6a3fce195, []int{9}
+}
+func (m *MsgAmendTradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendTradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendTradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAmendTradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendTradeResponse.Merge(m, src)
+}
+func (m *MsgAmendTradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendTradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendTradeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAmendTradeResponse proto.InternalMessageInfo
+
+func (m *MsgAmendTradeResponse) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAmendTradeResponse) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgCreateTradeV2 creates a trade like MsgCreateTrade, with the trade data, coin minting
+// prices and exchange rates given as typed messages instead of JSON strings.
+type MsgCreateTradeV2 struct {
+	Creator         string    `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ReceiverAddress string    `protobuf:"bytes,2,opt,name=receiver_address,json=receiverAddress,proto3" json:"receiver_address,omitempty"`
+	TradeData       TradeData `protobuf:"bytes,3,opt,name=trade_data,json=tradeData,proto3" json:"trade_data"`
+	// banking_system_data is a JSON object defined by the banking system.
+	BankingSystemData string                 `protobuf:"bytes,4,opt,name=banking_system_data,json=bankingSystemData,proto3" json:"banking_system_data,omitempty"`
+	CoinMintingPrices []CoinMintingPriceJson `protobuf:"bytes,5,rep,name=coin_minting_prices,json=coinMintingPrices,proto3" json:"coin_minting_prices"`
+	ExchangeRates     []ExchangeRateJson     `protobuf:"bytes,6,rep,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates"`
+	CreateDate        string                 `protobuf:"bytes,7,opt,name=create_date,json=createDate,proto3" json:"create_date,omitempty"`
+	// external_reference is an optional reference of the trade in the banking system.
+	ExternalReference string `protobuf:"bytes,8,opt,name=external_reference,json=externalReference,proto3" json:"external_reference,omitempty"`
 }
 
-func (m *MsgCreateTrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeV2) Reset()         { *m = MsgCreateTradeV2{} }
+func (m *MsgCreateTradeV2) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTradeV2) ProtoMessage()    {}
+func (*MsgCreateTradeV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc27de6a3fce195, []int{10}
+}
+func (m *MsgCreateTradeV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTradeV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTradeV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateTrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateTradeV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTradeV2.Merge(m, src)
+}
+func (m *MsgCreateTradeV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTradeV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTradeV2.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CreateDate) > 0 {
-		i -= len(m.CreateDate)
-		copy(dAtA[i:], m.CreateDate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CreateDate)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ExchangeRateJson) > 0 {
-		i -= len(m.ExchangeRateJson)
-		copy(dAtA[i:], m.ExchangeRateJson)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExchangeRateJson)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.CoinMintingPriceJson) > 0 {
-		i -= len(m.CoinMintingPriceJson)
-		copy(dAtA[i:], m.CoinMintingPriceJson)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CoinMintingPriceJson)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.BankingSystemData) > 0 {
-		i -= len(m.BankingSystemData)
-		copy(dAtA[i:], m.BankingSystemData)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BankingSystemData)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TradeData) > 0 {
-		i -= len(m.TradeData)
-		copy(dAtA[i:], m.TradeData)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TradeData)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ReceiverAddress) > 0 {
-		i -= len(m.ReceiverAddress)
-		copy(dAtA[i:], m.ReceiverAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiverAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgCreateTradeV2 proto.InternalMessageInfo
+
+func (m *MsgCreateTradeV2) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateTradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeV2) GetReceiverAddress() string {
+	if m != nil {
+		return m.ReceiverAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateTradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateTradeV2) GetTradeData() TradeData {
+	if m != nil {
+		return m.TradeData
+	}
+	return TradeData{}
 }
 
-func (m *MsgCreateTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TradeIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TradeIndex))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgCreateTradeV2) GetBankingSystemData() string {
+	if m != nil {
+		return m.BankingSystemData
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgProcessTrade) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeV2) GetCoinMintingPrices() []CoinMintingPriceJson {
+	if m != nil {
+		return m.CoinMintingPrices
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgProcessTrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateTradeV2) GetExchangeRates() []ExchangeRateJson {
+	if m != nil {
+		return m.ExchangeRates
+	}
+	return nil
 }
 
-func (m *MsgProcessTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TradeIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TradeIndex))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ProcessType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ProcessType))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgCreateTradeV2) GetCreateDate() string {
+	if m != nil {
+		return m.CreateDate
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgProcessTradeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTradeV2) GetExternalReference() string {
+	if m != nil {
+		return m.ExternalReference
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgProcessTradeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCreateTradeV2Response struct {
+	TradeIndex uint64      `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Status     TradeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
 }
 
-func (m *MsgProcessTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TradeIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TradeIndex))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgCreateTradeV2Response) Reset()         { *m = MsgCreateTradeV2Response{} }
+func (m *MsgCreateTradeV2Response) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTradeV2Response) ProtoMessage()    {}
+func (*MsgCreateTradeV2Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc27de6a3fce195, []int{11}
+}
+func (m *MsgCreateTradeV2Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTradeV2Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTradeV2Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCreateTradeV2Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTradeV2Response.Merge(m, src)
+}
+func (m *MsgCreateTradeV2Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTradeV2Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTradeV2Response.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgCreateTradeV2Response proto.InternalMessageInfo
+
+func (m *MsgCreateTradeV2Response) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgCreateTradeV2Response) GetStatus() TradeStatus {
+	if m != nil {
+		return m.Status
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
+}
+
+// MsgCreateTrades creates a trade for each entry, like a MsgCreateTrade signed by the creator.
+type MsgCreateTrades struct {
+	Creator string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Entries []CreateTradeEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+	Mode    BatchMode          `protobuf:"varint,3,opt,name=mode,proto3,enum=vvtxchain.trade.BatchMode" json:"mode,omitempty"`
+}
+
+func (m *MsgCreateTrades) Reset()         { *m = MsgCreateTrades{} }
+func (m *MsgCreateTrades) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTrades) ProtoMessage()    {}
+func (*MsgCreateTrades) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc27de6a3fce195, []int{12}
+}
+func (m *MsgCreateTrades) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTrades) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTrades.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgCreateTrades) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTrades.Merge(m, src)
+}
+func (m *MsgCreateTrades) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTrades) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTrades.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgCreateTrades proto.InternalMessageInfo
+
+func (m *MsgCreateTrades) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgCreateTrade) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateTrades) GetEntries() []CreateTradeEntry {
+	if m != nil {
+		return m.Entries
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *MsgCreateTrades) GetMode() BatchMode {
+	if m != nil {
+		return m.Mode
 	}
-	l = len(m.ReceiverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+// CreateTradeEntry is a trade of a MsgCreateTrades, with the fields of a MsgCreateTrade.
+type CreateTradeEntry struct {
+	ReceiverAddress      string `protobuf:"bytes,1,opt,name=receiver_address,json=receiverAddress,proto3" json:"receiver_address,omitempty"`
+	TradeData            string `protobuf:"bytes,2,opt,name=trade_data,json=tradeData,proto3" json:"trade_data,omitempty"`
+	BankingSystemData    string `protobuf:"bytes,3,opt,name=banking_system_data,json=bankingSystemData,proto3" json:"banking_system_data,omitempty"`
+	CoinMintingPriceJson string `protobuf:"bytes,4,opt,name=coin_minting_price_json,json=coinMintingPriceJson,proto3" json:"coin_minting_price_json,omitempty"`
+	ExchangeRateJson     string `protobuf:"bytes,5,opt,name=exchange_rate_json,json=exchangeRateJson,proto3" json:"exchange_rate_json,omitempty"`
+	CreateDate           string `protobuf:"bytes,6,opt,name=create_date,json=createDate,proto3" json:"create_date,omitempty"`
+	ExternalReference    string `protobuf:"bytes,7,opt,name=external_reference,json=externalReference,proto3" json:"external_reference,omitempty"`
+}
+
+func (m *CreateTradeEntry) Reset()         { *m = CreateTradeEntry{} }
+func (m *CreateTradeEntry) String() string { return proto.CompactTextString(m) }
+func (*CreateTradeEntry) ProtoMessage()    {}
+func (*CreateTradeEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc27de6a3fce195, []int{13}
+}
+func (m *CreateTradeEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateTradeEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateTradeEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.TradeData)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *CreateTradeEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTradeEntry.Merge(m, src)
+}
+func (m *CreateTradeEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateTradeEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTradeEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTradeEntry proto.InternalMessageInfo
+
+func (m *CreateTradeEntry) GetReceiverAddress() string {
+	if m != nil {
+		return m.ReceiverAddress
 	}
-	l = len(m.BankingSystemData)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *CreateTradeEntry) GetTradeData() string {
+	if m != nil {
+		return m.TradeData
 	}
-	l = len(m.CoinMintingPriceJson)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *CreateTradeEntry) GetBankingSystemData() string {
+	if m != nil {
+		return m.BankingSystemData
 	}
-	l = len(m.ExchangeRateJson)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *CreateTradeEntry) GetCoinMintingPriceJson() string {
+	if m != nil {
+		return m.CoinMintingPriceJson
 	}
-	l = len(m.CreateDate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *CreateTradeEntry) GetExchangeRateJson() string {
+	if m != nil {
+		return m.ExchangeRateJson
 	}
-	return n
+	return ""
 }
 
-func (m *MsgCreateTradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateTradeEntry) GetCreateDate() string {
+	if m != nil {
+		return m.CreateDate
 	}
-	var l int
-	_ = l
-	if m.TradeIndex != 0 {
-		n += 1 + sovTx(uint64(m.TradeIndex))
+	return ""
+}
+
+func (m *CreateTradeEntry) GetExternalReference() string {
+	if m != nil {
+		return m.ExternalReference
 	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+	return ""
+}
+
+type MsgCreateTradesResponse struct {
+	// results are the results of the entries, in the order of the entries.
+	Results []BatchTradeResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *MsgCreateTradesResponse) Reset()         { *m = MsgCreateTradesResponse{} }
+func (m *MsgCreateTradesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTradesResponse) ProtoMessage()    {}
+func (*MsgCreateTradesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc27de6a3fce195, []int{14}
+}
+func (m *MsgCreateTradesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTradesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgCreateTradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTradesResponse.Merge(m, src)
+}
+func (m *MsgCreateTradesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTradesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgProcessTrade) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgCreateTradesResponse proto.InternalMessageInfo
+
+func (m *MsgCreateTradesResponse) GetResults() []BatchTradeResult {
+	if m != nil {
+		return m.Results
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// MsgProcessTrades confirms or rejects each trade, like a MsgProcessTrade signed by the creator.
+type MsgProcessTrades struct {
+	Creator      string      `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ProcessType  ProcessType `protobuf:"varint,2,opt,name=process_type,json=processType,proto3,enum=vvtxchain.trade.ProcessType" json:"process_type,omitempty"`
+	TradeIndexes []uint64    `protobuf:"varint,3,rep,packed,name=trade_indexes,json=tradeIndexes,proto3" json:"trade_indexes,omitempty"`
+	// expected_revisions are the revisions of the trades being processed, in the order of the
+	// trade indexes. When empty, the trades are expected to have never been amended.
+	ExpectedRevisions []uint64  `protobuf:"varint,4,rep,packed,name=expected_revisions,json=expectedRevisions,proto3" json:"expected_revisions,omitempty"`
+	Mode              BatchMode `protobuf:"varint,5,opt,name=mode,proto3,enum=vvtxchain.trade.BatchMode" json:"mode,omitempty"`
+}
+
+func (m *MsgProcessTrades) Reset()         { *m = MsgProcessTrades{} }
+func (m *MsgProcessTrades) String() string { return proto.CompactTextString(m) }
+func (*MsgProcessTrades) ProtoMessage()    {}
+func (*MsgProcessTrades) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc27de6a3fce195, []int{15}
+}
+func (m *MsgProcessTrades) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProcessTrades) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProcessTrades.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ProcessType != 0 {
-		n += 1 + sovTx(uint64(m.ProcessType))
+}
+func (m *MsgProcessTrades) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProcessTrades.Merge(m, src)
+}
+func (m *MsgProcessTrades) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProcessTrades) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProcessTrades.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProcessTrades proto.InternalMessageInfo
+
+func (m *MsgProcessTrades) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if m.TradeIndex != 0 {
-		n += 1 + sovTx(uint64(m.TradeIndex))
+	return ""
+}
+
+func (m *MsgProcessTrades) GetProcessType() ProcessType {
+	if m != nil {
+		return m.ProcessType
 	}
-	return n
+	return ProcessType_PROCESS_TYPE_UNSPECIFIED
 }
 
-func (m *MsgProcessTradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProcessTrades) GetTradeIndexes() []uint64 {
+	if m != nil {
+		return m.TradeIndexes
 	}
-	var l int
-	_ = l
-	if m.TradeIndex != 0 {
-		n += 1 + sovTx(uint64(m.TradeIndex))
+	return nil
+}
+
+func (m *MsgProcessTrades) GetExpectedRevisions() []uint64 {
+	if m != nil {
+		return m.ExpectedRevisions
 	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+	return nil
+}
+
+func (m *MsgProcessTrades) GetMode() BatchMode {
+	if m != nil {
+		return m.Mode
 	}
-	return n
+	return BatchMode_BATCH_MODE_UNSPECIFIED
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+type MsgProcessTradesResponse struct {
+	// results are the results of the trades, in the order of the trade indexes.
+	Results []BatchTradeResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgProcessTradesResponse) Reset()         { *m = MsgProcessTradesResponse{} }
+func (m *MsgProcessTradesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProcessTradesResponse) ProtoMessage()    {}
+func (*MsgProcessTradesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc27de6a3fce195, []int{16}
+}
+func (m *MsgProcessTradesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProcessTradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProcessTradesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProcessTradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProcessTradesResponse.Merge(m, src)
+}
+func (m *MsgProcessTradesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProcessTradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProcessTradesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProcessTradesResponse proto.InternalMessageInfo
+
+func (m *MsgProcessTradesResponse) GetResults() []BatchTradeResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// BatchTradeResult is the result of an entry of a batch message.
+type BatchTradeResult struct {
+	// trade_index is zero when a trade could not be created.
+	TradeIndex uint64      `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Status     TradeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
+	// error is the error of a failed entry in the best effort mode.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *BatchTradeResult) Reset()         { *m = BatchTradeResult{} }
+func (m *BatchTradeResult) String() string { return proto.CompactTextString(m) }
+func (*BatchTradeResult) ProtoMessage()    {}
+func (*BatchTradeResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc27de6a3fce195, []int{17}
+}
+func (m *BatchTradeResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTradeResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTradeResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchTradeResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTradeResult.Merge(m, src)
+}
+func (m *BatchTradeResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTradeResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTradeResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchTradeResult proto.InternalMessageInfo
+
+func (m *BatchTradeResult) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
+	}
+	return 0
+}
+
+func (m *BatchTradeResult) GetStatus() TradeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
+}
+
+func (m *BatchTradeResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// MsgRetryTrade re-attempts the mint or burn of a trade with status TRADE_STATUS_FAILED,
+// keeping its trade index.
+type MsgRetryTrade struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TradeIndex uint64 `protobuf:"varint,2,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	// receiver_address is an optional corrected receiver address of the trade.
+	ReceiverAddress string `protobuf:"bytes,3,opt,name=receiver_address,json=receiverAddress,proto3" json:"receiver_address,omitempty"`
+}
+
+func (m *MsgRetryTrade) Reset()         { *m = MsgRetryTrade{} }
+func (m *MsgRetryTrade) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryTrade) ProtoMessage()    {}
+func (*MsgRetryTrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc27de6a3fce195, []int{18}
+}
+func (m *MsgRetryTrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryTrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryTrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryTrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryTrade.Merge(m, src)
+}
+func (m *MsgRetryTrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryTrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryTrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryTrade proto.InternalMessageInfo
+
+func (m *MsgRetryTrade) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRetryTrade) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
+	}
+	return 0
+}
+
+func (m *MsgRetryTrade) GetReceiverAddress() string {
+	if m != nil {
+		return m.ReceiverAddress
+	}
+	return ""
+}
+
+type MsgRetryTradeResponse struct {
+	TradeIndex uint64      `protobuf:"varint,1,opt,name=trade_index,json=tradeIndex,proto3" json:"trade_index,omitempty"`
+	Status     TradeStatus `protobuf:"varint,2,opt,name=status,proto3,enum=vvtxchain.trade.TradeStatus" json:"status,omitempty"`
+	// attempts is the number of times the mint or burn of the trade has been executed.
+	Attempts uint32 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (m *MsgRetryTradeResponse) Reset()         { *m = MsgRetryTradeResponse{} }
+func (m *MsgRetryTradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryTradeResponse) ProtoMessage()    {}
+func (*MsgRetryTradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_adc27de6a3fce195, []int{19}
+}
+func (m *MsgRetryTradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryTradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryTradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryTradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryTradeResponse.Merge(m, src)
+}
+func (m *MsgRetryTradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryTradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryTradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryTradeResponse proto.InternalMessageInfo
+
+func (m *MsgRetryTradeResponse) GetTradeIndex() uint64 {
+	if m != nil {
+		return m.TradeIndex
+	}
+	return 0
+}
+
+func (m *MsgRetryTradeResponse) GetStatus() TradeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TradeStatus_TRADE_STATUS_UNSPECIFIED
+}
+
+func (m *MsgRetryTradeResponse) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "vvtxchain.trade.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "vvtxchain.trade.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateTrade)(nil), "vvtxchain.trade.MsgCreateTrade")
+	proto.RegisterType((*MsgCreateTradeResponse)(nil), "vvtxchain.trade.MsgCreateTradeResponse")
+	proto.RegisterType((*MsgProcessTrade)(nil), "vvtxchain.trade.MsgProcessTrade")
+	proto.RegisterType((*MsgProcessTradeResponse)(nil), "vvtxchain.trade.MsgProcessTradeResponse")
+	proto.RegisterType((*MsgCancelTrade)(nil), "vvtxchain.trade.MsgCancelTrade")
+	proto.RegisterType((*MsgCancelTradeResponse)(nil), "vvtxchain.trade.MsgCancelTradeResponse")
+	proto.RegisterType((*MsgAmendTrade)(nil), "vvtxchain.trade.MsgAmendTrade")
+	proto.RegisterType((*MsgAmendTradeResponse)(nil), "vvtxchain.trade.MsgAmendTradeResponse")
+	proto.RegisterType((*MsgCreateTradeV2)(nil), "vvtxchain.trade.MsgCreateTradeV2")
+	proto.RegisterType((*MsgCreateTradeV2Response)(nil), "vvtxchain.trade.MsgCreateTradeV2Response")
+	proto.RegisterType((*MsgCreateTrades)(nil), "vvtxchain.trade.MsgCreateTrades")
+	proto.RegisterType((*CreateTradeEntry)(nil), "vvtxchain.trade.CreateTradeEntry")
+	proto.RegisterType((*MsgCreateTradesResponse)(nil), "vvtxchain.trade.MsgCreateTradesResponse")
+	proto.RegisterType((*MsgProcessTrades)(nil), "vvtxchain.trade.MsgProcessTrades")
+	proto.RegisterType((*MsgProcessTradesResponse)(nil), "vvtxchain.trade.MsgProcessTradesResponse")
+	proto.RegisterType((*BatchTradeResult)(nil), "vvtxchain.trade.BatchTradeResult")
+	proto.RegisterType((*MsgRetryTrade)(nil), "vvtxchain.trade.MsgRetryTrade")
+	proto.RegisterType((*MsgRetryTradeResponse)(nil), "vvtxchain.trade.MsgRetryTradeResponse")
+}
+
+func init() { proto.RegisterFile("vvtxchain/trade/tx.proto", fileDescriptor_adc27de6a3fce195) }
+
+var fileDescriptor_adc27de6a3fce195 = []byte{
+	// 1265 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0x8f, 0xff, 0x37, 0xcf, 0x71, 0xea, 0x6c, 0xd3, 0x66, 0xbb, 0x14, 0xc7, 0x59, 0x44, 0xeb,
+	0xb6, 0xd4, 0x06, 0xb7, 0x70, 0xc8, 0x05, 0x25, 0x4d, 0xa8, 0x40, 0x58, 0x8a, 0x36, 0xa1, 0x48,
+	0x11, 0x91, 0x99, 0xac, 0x07, 0x67, 0x21, 0xde, 0xdd, 0xce, 0x4c, 0xa2, 0xf8, 0x04, 0xe2, 0xd8,
+	0x13, 0xdf, 0x81, 0x0b, 0xc7, 0x1c, 0x90, 0x38, 0xc0, 0x81, 0xde, 0x72, 0xac, 0x38, 0x71, 0x42,
+	0x28, 0x39, 0xe4, 0xcc, 0x37, 0x40, 0x3b, 0xb3, 0xeb, 0xfd, 0xe3, 0x4d, 0xd6, 0x75, 0x92, 0x8b,
+	0xdb, 0x99, 0xf7, 0x7b, 0x6f, 0xde, 0xfb, 0xbd, 0x37, 0x6f, 0xde, 0x06, 0xe4, 0xfd, 0x7d, 0x76,
+	0xa0, 0xef, 0x20, 0xc3, 0x6c, 0x30, 0x82, 0x3a, 0xb8, 0xc1, 0x0e, 0xea, 0x36, 0xb1, 0x98, 0x25,
+	0x5d, 0x1f, 0x48, 0xea, 0x5c, 0xa2, 0xcc, 0xa0, 0x9e, 0x61, 0x5a, 0x0d, 0xfe, 0x2b, 0x30, 0xca,
+	0x9c, 0x6e, 0xd1, 0x9e, 0x45, 0x1b, 0x3d, 0xda, 0x6d, 0xec, 0x7f, 0xe0, 0xfc, 0xe3, 0x0a, 0x6e,
+	0x0b, 0x41, 0x9b, 0xaf, 0x1a, 0x62, 0xe1, 0x8a, 0x66, 0xbb, 0x56, 0xd7, 0x12, 0xfb, 0xce, 0xff,
+	0xdc, 0xdd, 0x3b, 0x51, 0x3f, 0x6c, 0x44, 0x50, 0xcf, 0xd3, 0x79, 0x6b, 0xc8, 0x4b, 0xe7, 0xd7,
+	0x15, 0x56, 0x63, 0x85, 0xed, 0x0e, 0x62, 0x48, 0x20, 0xd4, 0xdf, 0x53, 0x70, 0xbd, 0x45, 0xbb,
+	0x5f, 0xd8, 0x1d, 0xc4, 0xf0, 0x1a, 0x37, 0x2c, 0x7d, 0x04, 0x93, 0x68, 0x8f, 0xed, 0x58, 0xc4,
+	0x60, 0x7d, 0x39, 0x55, 0x4d, 0xd5, 0x26, 0x97, 0xe5, 0xbf, 0x7e, 0x7d, 0x34, 0xeb, 0xfa, 0xba,
+	0xd4, 0xe9, 0x10, 0x4c, 0xe9, 0x3a, 0x23, 0x86, 0xd9, 0xd5, 0x7c, 0xa8, 0xb4, 0x08, 0x79, 0xe1,
+	0x9a, 0x9c, 0xae, 0xa6, 0x6a, 0xc5, 0xe6, 0x5c, 0x3d, 0xc2, 0x53, 0x5d, 0x1c, 0xb0, 0x3c, 0x79,
+	0xf4, 0xcf, 0xfc, 0xc4, 0x2f, 0xa7, 0x87, 0x0f, 0x52, 0x9a, 0xab, 0xb1, 0xf8, 0xe4, 0xc7, 0xd3,
+	0xc3, 0x07, 0xbe, 0xad, 0x97, 0xa7, 0x87, 0x0f, 0x16, 0x7c, 0xe7, 0x0f, 0x5c, 0xf7, 0x23, 0x9e,
+	0xaa, 0xb7, 0x61, 0x2e, 0xb2, 0xa5, 0x61, 0x6a, 0x5b, 0x26, 0xc5, 0xea, 0x69, 0x1a, 0xa6, 0x5b,
+	0xb4, 0xfb, 0x94, 0x60, 0xc4, 0xf0, 0x86, 0xa3, 0x2d, 0xc9, 0x50, 0xd0, 0x9d, 0xa5, 0x45, 0x44,
+	0x54, 0x9a, 0xb7, 0x94, 0xee, 0x43, 0x99, 0x60, 0x1d, 0x1b, 0xfb, 0x98, 0xb4, 0x91, 0x08, 0x8f,
+	0xc7, 0x30, 0xa9, 0x5d, 0xf7, 0xf6, 0xdd, 0xa8, 0xa5, 0xb7, 0x01, 0x7c, 0x12, 0xe5, 0x0c, 0x07,
+	0x4d, 0xf2, 0x9d, 0x15, 0xc4, 0x90, 0x54, 0x87, 0x1b, 0xdb, 0xc8, 0xfc, 0xce, 0x30, 0xbb, 0x6d,
+	0xda, 0xa7, 0x0c, 0xf7, 0x04, 0x2e, 0xcb, 0x71, 0x33, 0xae, 0x68, 0x9d, 0x4b, 0x38, 0xfe, 0x43,
+	0x98, 0xd3, 0x2d, 0xc3, 0x6c, 0xf7, 0x0c, 0x93, 0x39, 0x4a, 0x36, 0x31, 0x74, 0xdc, 0xfe, 0x96,
+	0x5a, 0xa6, 0x9c, 0xe3, 0x3a, 0xb3, 0x8e, 0xb8, 0x25, 0xa4, 0x6b, 0x8e, 0xf0, 0x33, 0x6a, 0x99,
+	0xd2, 0x7b, 0x20, 0x61, 0x87, 0x1b, 0xb3, 0x8b, 0xdb, 0x04, 0x31, 0x57, 0x23, 0xcf, 0x35, 0xca,
+	0x9e, 0x44, 0x43, 0x4c, 0xa0, 0xe7, 0xa1, 0xc8, 0x23, 0xe5, 0x4e, 0x63, 0xb9, 0xc0, 0x61, 0x20,
+	0xb6, 0x56, 0x10, 0xc3, 0xd2, 0x23, 0xc7, 0x1c, 0xc3, 0xc4, 0x44, 0xbb, 0x6d, 0x82, 0xbf, 0xc1,
+	0x04, 0x9b, 0x3a, 0x96, 0xaf, 0x09, 0xa7, 0x3d, 0x89, 0xe6, 0x09, 0x16, 0xa7, 0x9c, 0x64, 0x79,
+	0xe4, 0xa9, 0x16, 0xdc, 0x0a, 0x13, 0xed, 0xe5, 0xc0, 0x39, 0x57, 0x70, 0x65, 0x98, 0x1d, 0x7c,
+	0xc0, 0x49, 0xcf, 0x6a, 0x82, 0xbe, 0x4f, 0x9d, 0x1d, 0xe9, 0x09, 0xe4, 0x29, 0x43, 0x6c, 0x4f,
+	0xb0, 0x3d, 0xdd, 0xbc, 0x33, 0x54, 0x31, 0xdc, 0xe0, 0x3a, 0xc7, 0x68, 0x2e, 0x56, 0x3d, 0x12,
+	0x35, 0xbb, 0x46, 0x2c, 0x1d, 0x53, 0x9a, 0x94, 0xdb, 0x8f, 0x61, 0xca, 0x16, 0xc8, 0x36, 0xeb,
+	0xdb, 0xf8, 0xcc, 0x93, 0x3c, 0x73, 0x7d, 0x1b, 0x6b, 0x45, 0xdb, 0x5f, 0x44, 0xa3, 0xc8, 0x0c,
+	0x45, 0xf1, 0x10, 0x66, 0xf0, 0x81, 0x8d, 0x75, 0x86, 0x3b, 0x6d, 0x82, 0xf7, 0x0d, 0x6a, 0x58,
+	0x26, 0xcf, 0x78, 0x56, 0x2b, 0x7b, 0x02, 0xcd, 0xdd, 0x8f, 0x70, 0x67, 0xf3, 0x02, 0x0e, 0x46,
+	0x72, 0xd5, 0xe4, 0xbd, 0x10, 0xd7, 0x02, 0x99, 0x3a, 0xde, 0x4d, 0xa2, 0x2e, 0xe2, 0x42, 0x7a,
+	0xc8, 0x85, 0x5b, 0x90, 0x27, 0x18, 0x39, 0xa5, 0x27, 0x2e, 0x82, 0xbb, 0x8a, 0x2f, 0x10, 0xff,
+	0xc8, 0xab, 0x8e, 0xf1, 0xb7, 0x34, 0x94, 0x5a, 0xb4, 0xbb, 0xd4, 0xc3, 0x66, 0xe7, 0xc2, 0x31,
+	0xc6, 0xf5, 0x86, 0xcc, 0x28, 0xbd, 0x21, 0x3b, 0x62, 0x6f, 0xc8, 0x8d, 0xd1, 0x1b, 0xf2, 0x6f,
+	0xdc, 0x1b, 0x0a, 0xf1, 0xbd, 0x21, 0x92, 0xaa, 0x0d, 0xb8, 0x19, 0x22, 0x6e, 0xf4, 0x4c, 0x29,
+	0x70, 0x6d, 0x50, 0xfb, 0x82, 0xc4, 0xc1, 0x5a, 0xfd, 0x39, 0x0b, 0xe5, 0x70, 0x8b, 0x78, 0xde,
+	0x94, 0x9a, 0x91, 0x94, 0x9c, 0xf3, 0xc6, 0x8c, 0xd3, 0xa7, 0x57, 0x86, 0xfa, 0x74, 0xb1, 0xa9,
+	0xc4, 0x57, 0x8f, 0x43, 0x76, 0xf0, 0x4d, 0xba, 0x40, 0x3b, 0xff, 0x1a, 0x6e, 0x0c, 0xa7, 0x8c,
+	0xca, 0xb9, 0x6a, 0xa6, 0x56, 0x6c, 0xbe, 0x3b, 0x74, 0xfc, 0xd3, 0x98, 0xfc, 0x05, 0x3d, 0x99,
+	0x89, 0x26, 0x98, 0x4a, 0xeb, 0x30, 0x1d, 0xca, 0x2e, 0x95, 0xf3, 0xdc, 0xf8, 0xc2, 0x90, 0xf1,
+	0xd5, 0x48, 0xaa, 0x83, 0x86, 0x4b, 0xc1, 0x3a, 0xa0, 0x97, 0xfe, 0x40, 0x3c, 0x0e, 0x16, 0x95,
+	0xf3, 0x96, 0xab, 0xb1, 0x6f, 0x79, 0xa8, 0x20, 0xd4, 0x17, 0x20, 0x47, 0xf7, 0xae, 0xba, 0x51,
+	0xfc, 0x27, 0x5e, 0x92, 0xc0, 0x99, 0x74, 0xac, 0xba, 0xfc, 0x04, 0x0a, 0xd8, 0x64, 0xc4, 0xc0,
+	0xce, 0xf1, 0xf1, 0xd9, 0x08, 0x9c, 0xb1, 0x6a, 0x32, 0xd2, 0x0f, 0x66, 0xc3, 0x53, 0x96, 0xea,
+	0x90, 0xed, 0x59, 0x1d, 0xcc, 0xcb, 0x75, 0x3a, 0xa6, 0x5c, 0x97, 0x11, 0xd3, 0x77, 0x5a, 0x56,
+	0x07, 0x6b, 0x1c, 0xb7, 0xd8, 0x8c, 0xf2, 0xbc, 0x90, 0xc4, 0x33, 0x55, 0x5f, 0xa5, 0xa1, 0x1c,
+	0x75, 0x26, 0xf6, 0x62, 0xa5, 0x46, 0x69, 0x72, 0xe9, 0x11, 0x9b, 0x5c, 0x66, 0x8c, 0x26, 0x97,
+	0x7d, 0xe3, 0x26, 0x97, 0x1b, 0x6d, 0x00, 0xca, 0x8f, 0x58, 0xdf, 0x85, 0x33, 0xea, 0x5b, 0xfd,
+	0x8a, 0x3f, 0xdb, 0x41, 0x5a, 0x07, 0x95, 0xba, 0x04, 0x05, 0x82, 0xe9, 0xde, 0x2e, 0x73, 0x08,
+	0x8c, 0x2f, 0x05, 0x9e, 0x45, 0xaf, 0xbd, 0xee, 0xed, 0xb2, 0xe5, 0xac, 0x53, 0x0a, 0x9a, 0xa7,
+	0xa7, 0xfe, 0x99, 0x86, 0x72, 0x64, 0x2a, 0x18, 0xaf, 0x2c, 0x2f, 0x3c, 0xfa, 0xbc, 0x03, 0xa5,
+	0xc0, 0xb5, 0xc3, 0xce, 0xc3, 0x97, 0xa9, 0x65, 0xb5, 0x29, 0xff, 0xe2, 0x61, 0x2a, 0xb8, 0x8b,
+	0x8c, 0x3f, 0x54, 0xce, 0x72, 0xe4, 0x4c, 0x74, 0xfe, 0xf1, 0x6b, 0x3c, 0x37, 0x62, 0x8d, 0x8f,
+	0xd8, 0x4b, 0x42, 0x6c, 0xa9, 0x5b, 0x20, 0x47, 0xf7, 0x2e, 0x33, 0x43, 0xdf, 0x43, 0x39, 0x0a,
+	0xb9, 0xa2, 0x16, 0x25, 0xcd, 0x42, 0x0e, 0x13, 0x62, 0x11, 0xf7, 0x06, 0x89, 0x85, 0xfa, 0x2a,
+	0xc5, 0x27, 0x1c, 0x0d, 0x33, 0xd2, 0xe7, 0x5a, 0x63, 0xd5, 0xc7, 0x25, 0xce, 0x3e, 0x8b, 0xef,
+	0x47, 0xd3, 0x34, 0x1f, 0x9b, 0x26, 0xdf, 0x63, 0xf5, 0x65, 0x0a, 0x6e, 0x86, 0x76, 0xae, 0xb8,
+	0xdb, 0x3b, 0x23, 0x0a, 0x62, 0x0c, 0xf7, 0x6c, 0x26, 0xa2, 0x28, 0x69, 0x83, 0x75, 0xf3, 0x8f,
+	0x3c, 0x64, 0x5a, 0xb4, 0x2b, 0x6d, 0xc2, 0x54, 0xe8, 0x5b, 0xb8, 0x3a, 0x64, 0x39, 0xf2, 0xc1,
+	0xa9, 0xd4, 0x92, 0x10, 0x83, 0xb0, 0xbe, 0x84, 0x62, 0xf0, 0x73, 0x74, 0x3e, 0x4e, 0x31, 0x00,
+	0x50, 0xee, 0x25, 0x00, 0x06, 0x86, 0x37, 0x61, 0x2a, 0xf4, 0x31, 0x14, 0xeb, 0x74, 0x10, 0xa1,
+	0xd4, 0x92, 0x10, 0x21, 0xa7, 0x03, 0x1f, 0x0b, 0xf1, 0x4e, 0xfb, 0x00, 0xe5, 0x5e, 0x02, 0x60,
+	0x60, 0x78, 0x03, 0x20, 0x30, 0xa0, 0x57, 0xe2, 0xd4, 0x7c, 0xb9, 0x72, 0xf7, 0x7c, 0xf9, 0xc0,
+	0xea, 0x16, 0x94, 0xc2, 0x63, 0xe6, 0x42, 0x02, 0x89, 0xcf, 0x9b, 0xca, 0xfd, 0x44, 0x48, 0x90,
+	0xe9, 0xd0, 0xb0, 0x50, 0x4d, 0x50, 0x3d, 0xa3, 0x3c, 0x62, 0x5f, 0x8e, 0x2d, 0x28, 0x85, 0x5b,
+	0xfe, 0x42, 0x52, 0x92, 0xa8, 0x72, 0x3f, 0x11, 0x12, 0xe4, 0x3b, 0xd0, 0x2e, 0x62, 0xf9, 0xf6,
+	0xe5, 0xca, 0xdd, 0xf3, 0xe5, 0x9e, 0x55, 0x25, 0xf7, 0x83, 0x33, 0xc1, 0x2c, 0xaf, 0x1e, 0x1d,
+	0x57, 0x52, 0xaf, 0x8f, 0x2b, 0xa9, 0x7f, 0x8f, 0x2b, 0xa9, 0x9f, 0x4e, 0x2a, 0x13, 0xaf, 0x4f,
+	0x2a, 0x13, 0x7f, 0x9f, 0x54, 0x26, 0x36, 0x1f, 0x76, 0x0d, 0xb6, 0xb3, 0xb7, 0x5d, 0xd7, 0xad,
+	0x5e, 0xe3, 0xd9, 0xb3, 0xd5, 0xcd, 0xcf, 0xd1, 0x36, 0x6d, 0x0c, 0xb7, 0x06, 0xe7, 0x7d, 0xa2,
+	0xdb, 0x79, 0xfe, 0x47, 0xa9, 0xc7, 0xff, 0x0f, 0x00, 0x50, 0x8c, 0x75, 0xea, 0x7b, 0x13, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	CreateTrade(ctx context.Context, in *MsgCreateTrade, opts ...grpc.CallOption) (*MsgCreateTradeResponse, error)
+	ProcessTrade(ctx context.Context, in *MsgProcessTrade, opts ...grpc.CallOption) (*MsgProcessTradeResponse, error)
+	// CancelTrade cancels a pending trade. Only the maker of the trade or an
+	// acl admin can cancel it.
+	CancelTrade(ctx context.Context, in *MsgCancelTrade, opts ...grpc.CallOption) (*MsgCancelTradeResponse, error)
+	// AmendTrade corrects the payload of a pending trade. Only the maker of the
+	// trade can amend it.
+	AmendTrade(ctx context.Context, in *MsgAmendTrade, opts ...grpc.CallOption) (*MsgAmendTradeResponse, error)
+	// CreateTradeV2 creates a trade from typed payloads instead of JSON strings.
+	CreateTradeV2(ctx context.Context, in *MsgCreateTradeV2, opts ...grpc.CallOption) (*MsgCreateTradeV2Response, error)
+	// CreateTrades creates a batch of trades.
+	CreateTrades(ctx context.Context, in *MsgCreateTrades, opts ...grpc.CallOption) (*MsgCreateTradesResponse, error)
+	// ProcessTrades confirms or rejects a batch of trades.
+	ProcessTrades(ctx context.Context, in *MsgProcessTrades, opts ...grpc.CallOption) (*MsgProcessTradesResponse, error)
+	// RetryTrade re-attempts the mint or burn of a failed trade. Only a checker
+	// other than the maker of the trade can retry it.
+	RetryTrade(ctx context.Context, in *MsgRetryTrade, opts ...grpc.CallOption) (*MsgRetryTradeResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateTrade(ctx context.Context, in *MsgCreateTrade, opts ...grpc.CallOption) (*MsgCreateTradeResponse, error) {
+	out := new(MsgCreateTradeResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Msg/CreateTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProcessTrade(ctx context.Context, in *MsgProcessTrade, opts ...grpc.CallOption) (*MsgProcessTradeResponse, error) {
+	out := new(MsgProcessTradeResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Msg/ProcessTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelTrade(ctx context.Context, in *MsgCancelTrade, opts ...grpc.CallOption) (*MsgCancelTradeResponse, error) {
+	out := new(MsgCancelTradeResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Msg/CancelTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AmendTrade(ctx context.Context, in *MsgAmendTrade, opts ...grpc.CallOption) (*MsgAmendTradeResponse, error) {
+	out := new(MsgAmendTradeResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Msg/AmendTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateTradeV2(ctx context.Context, in *MsgCreateTradeV2, opts ...grpc.CallOption) (*MsgCreateTradeV2Response, error) {
+	out := new(MsgCreateTradeV2Response)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Msg/CreateTradeV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateTrades(ctx context.Context, in *MsgCreateTrades, opts ...grpc.CallOption) (*MsgCreateTradesResponse, error) {
+	out := new(MsgCreateTradesResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Msg/CreateTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProcessTrades(ctx context.Context, in *MsgProcessTrades, opts ...grpc.CallOption) (*MsgProcessTradesResponse, error) {
+	out := new(MsgProcessTradesResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Msg/ProcessTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetryTrade(ctx context.Context, in *MsgRetryTrade, opts ...grpc.CallOption) (*MsgRetryTradeResponse, error) {
+	out := new(MsgRetryTradeResponse)
+	err := c.cc.Invoke(ctx, "/vvtxchain.trade.Msg/RetryTrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	CreateTrade(context.Context, *MsgCreateTrade) (*MsgCreateTradeResponse, error)
+	ProcessTrade(context.Context, *MsgProcessTrade) (*MsgProcessTradeResponse, error)
+	// CancelTrade cancels a pending trade. Only the maker of the trade or an
+	// acl admin can cancel it.
+	CancelTrade(context.Context, *MsgCancelTrade) (*MsgCancelTradeResponse, error)
+	// AmendTrade corrects the payload of a pending trade. Only the maker of the
+	// trade can amend it.
+	AmendTrade(context.Context, *MsgAmendTrade) (*MsgAmendTradeResponse, error)
+	// CreateTradeV2 creates a trade from typed payloads instead of JSON strings.
+	CreateTradeV2(context.Context, *MsgCreateTradeV2) (*MsgCreateTradeV2Response, error)
+	// CreateTrades creates a batch of trades.
+	CreateTrades(context.Context, *MsgCreateTrades) (*MsgCreateTradesResponse, error)
+	// ProcessTrades confirms or rejects a batch of trades.
+	ProcessTrades(context.Context, *MsgProcessTrades) (*MsgProcessTradesResponse, error)
+	// RetryTrade re-attempts the mint or burn of a failed trade. Only a checker
+	// other than the maker of the trade can retry it.
+	RetryTrade(context.Context, *MsgRetryTrade) (*MsgRetryTradeResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateTrade(ctx context.Context, req *MsgCreateTrade) (*MsgCreateTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrade not implemented")
+}
+func (*UnimplementedMsgServer) ProcessTrade(ctx context.Context, req *MsgProcessTrade) (*MsgProcessTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessTrade not implemented")
+}
+func (*UnimplementedMsgServer) CancelTrade(ctx context.Context, req *MsgCancelTrade) (*MsgCancelTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTrade not implemented")
+}
+func (*UnimplementedMsgServer) AmendTrade(ctx context.Context, req *MsgAmendTrade) (*MsgAmendTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendTrade not implemented")
+}
+func (*UnimplementedMsgServer) CreateTradeV2(ctx context.Context, req *MsgCreateTradeV2) (*MsgCreateTradeV2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTradeV2 not implemented")
+}
+func (*UnimplementedMsgServer) CreateTrades(ctx context.Context, req *MsgCreateTrades) (*MsgCreateTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrades not implemented")
+}
+func (*UnimplementedMsgServer) ProcessTrades(ctx context.Context, req *MsgProcessTrades) (*MsgProcessTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessTrades not implemented")
+}
+func (*UnimplementedMsgServer) RetryTrade(ctx context.Context, req *MsgRetryTrade) (*MsgRetryTradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryTrade not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Msg/CreateTrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTrade(ctx, req.(*MsgCreateTrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProcessTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProcessTrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProcessTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Msg/ProcessTrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProcessTrade(ctx, req.(*MsgProcessTrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Msg/CancelTrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTrade(ctx, req.(*MsgCancelTrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendTrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Msg/AmendTrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendTrade(ctx, req.(*MsgAmendTrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTradeV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTradeV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTradeV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Msg/CreateTradeV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTradeV2(ctx, req.(*MsgCreateTradeV2))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTrades)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Msg/CreateTrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTrades(ctx, req.(*MsgCreateTrades))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProcessTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProcessTrades)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProcessTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Msg/ProcessTrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProcessTrades(ctx, req.(*MsgProcessTrades))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetryTrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryTrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryTrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vvtxchain.trade.Msg/RetryTrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryTrade(ctx, req.(*MsgRetryTrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "vvtxchain.trade.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateTrade",
+			Handler:    _Msg_CreateTrade_Handler,
+		},
+		{
+			MethodName: "ProcessTrade",
+			Handler:    _Msg_ProcessTrade_Handler,
+		},
+		{
+			MethodName: "CancelTrade",
+			Handler:    _Msg_CancelTrade_Handler,
+		},
+		{
+			MethodName: "AmendTrade",
+			Handler:    _Msg_AmendTrade_Handler,
+		},
+		{
+			MethodName: "CreateTradeV2",
+			Handler:    _Msg_CreateTradeV2_Handler,
+		},
+		{
+			MethodName: "CreateTrades",
+			Handler:    _Msg_CreateTrades_Handler,
+		},
+		{
+			MethodName: "ProcessTrades",
+			Handler:    _Msg_ProcessTrades_Handler,
+		},
+		{
+			MethodName: "RetryTrade",
+			Handler:    _Msg_RetryTrade_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "vvtxchain/trade/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalReference) > 0 {
+		i -= len(m.ExternalReference)
+		copy(dAtA[i:], m.ExternalReference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalReference)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CreateDate) > 0 {
+		i -= len(m.CreateDate)
+		copy(dAtA[i:], m.CreateDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CreateDate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ExchangeRateJson) > 0 {
+		i -= len(m.ExchangeRateJson)
+		copy(dAtA[i:], m.ExchangeRateJson)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExchangeRateJson)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CoinMintingPriceJson) > 0 {
+		i -= len(m.CoinMintingPriceJson)
+		copy(dAtA[i:], m.CoinMintingPriceJson)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CoinMintingPriceJson)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BankingSystemData) > 0 {
+		i -= len(m.BankingSystemData)
+		copy(dAtA[i:], m.BankingSystemData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BankingSystemData)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TradeData) > 0 {
+		i -= len(m.TradeData)
+		copy(dAtA[i:], m.TradeData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TradeData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReceiverAddress) > 0 {
+		i -= len(m.ReceiverAddress)
+		copy(dAtA[i:], m.ReceiverAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiverAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProcessTrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProcessTrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProcessTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpectedRevision != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpectedRevision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ProcessType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProcessType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProcessTradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProcessTradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProcessTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendTrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendTrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExchangeRateJson) > 0 {
+		i -= len(m.ExchangeRateJson)
+		copy(dAtA[i:], m.ExchangeRateJson)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExchangeRateJson)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CoinMintingPriceJson) > 0 {
+		i -= len(m.CoinMintingPriceJson)
+		copy(dAtA[i:], m.CoinMintingPriceJson)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CoinMintingPriceJson)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BankingSystemData) > 0 {
+		i -= len(m.BankingSystemData)
+		copy(dAtA[i:], m.BankingSystemData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BankingSystemData)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TradeData) > 0 {
+		i -= len(m.TradeData)
+		copy(dAtA[i:], m.TradeData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TradeData)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReceiverAddress) > 0 {
+		i -= len(m.ReceiverAddress)
+		copy(dAtA[i:], m.ReceiverAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiverAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendTradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendTradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTradeV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTradeV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTradeV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalReference) > 0 {
+		i -= len(m.ExternalReference)
+		copy(dAtA[i:], m.ExternalReference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalReference)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CreateDate) > 0 {
+		i -= len(m.CreateDate)
+		copy(dAtA[i:], m.CreateDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CreateDate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CoinMintingPrices) > 0 {
+		for iNdEx := len(m.CoinMintingPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoinMintingPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BankingSystemData) > 0 {
+		i -= len(m.BankingSystemData)
+		copy(dAtA[i:], m.BankingSystemData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BankingSystemData)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.TradeData.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ReceiverAddress) > 0 {
+		i -= len(m.ReceiverAddress)
+		copy(dAtA[i:], m.ReceiverAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiverAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTradeV2Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTradeV2Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTradeV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTrades) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTrades) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTrades) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateTradeEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTradeEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateTradeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExternalReference) > 0 {
+		i -= len(m.ExternalReference)
+		copy(dAtA[i:], m.ExternalReference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExternalReference)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CreateDate) > 0 {
+		i -= len(m.CreateDate)
+		copy(dAtA[i:], m.CreateDate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CreateDate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ExchangeRateJson) > 0 {
+		i -= len(m.ExchangeRateJson)
+		copy(dAtA[i:], m.ExchangeRateJson)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExchangeRateJson)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CoinMintingPriceJson) > 0 {
+		i -= len(m.CoinMintingPriceJson)
+		copy(dAtA[i:], m.CoinMintingPriceJson)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CoinMintingPriceJson)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BankingSystemData) > 0 {
+		i -= len(m.BankingSystemData)
+		copy(dAtA[i:], m.BankingSystemData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BankingSystemData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TradeData) > 0 {
+		i -= len(m.TradeData)
+		copy(dAtA[i:], m.TradeData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TradeData)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReceiverAddress) > 0 {
+		i -= len(m.ReceiverAddress)
+		copy(dAtA[i:], m.ReceiverAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTradesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTradesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProcessTrades) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProcessTrades) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProcessTrades) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ExpectedRevisions) > 0 {
+		dAtA4 := make([]byte, len(m.ExpectedRevisions)*10)
+		var j3 int
+		for _, num := range m.ExpectedRevisions {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TradeIndexes) > 0 {
+		dAtA6 := make([]byte, len(m.TradeIndexes)*10)
+		var j5 int
+		for _, num := range m.TradeIndexes {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProcessType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProcessType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProcessTradesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProcessTradesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProcessTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchTradeResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTradeResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchTradeResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryTrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryTrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryTrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiverAddress) > 0 {
+		i -= len(m.ReceiverAddress)
+		copy(dAtA[i:], m.ReceiverAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiverAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryTradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryTradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryTradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradeIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TradeIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateTrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReceiverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TradeData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BankingSystemData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CoinMintingPriceJson)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExchangeRateJson)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CreateDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExternalReference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateTradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovTx(uint64(m.TradeIndex))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgProcessTrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProcessType != 0 {
+		n += 1 + sovTx(uint64(m.ProcessType))
+	}
+	if m.TradeIndex != 0 {
+		n += 1 + sovTx(uint64(m.TradeIndex))
+	}
+	if m.ExpectedRevision != 0 {
+		n += 1 + sovTx(uint64(m.ExpectedRevision))
+	}
+	return n
+}
+
+func (m *MsgProcessTradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovTx(uint64(m.TradeIndex))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgCancelTrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TradeIndex != 0 {
+		n += 1 + sovTx(uint64(m.TradeIndex))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelTradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovTx(uint64(m.TradeIndex))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgAmendTrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TradeIndex != 0 {
+		n += 1 + sovTx(uint64(m.TradeIndex))
+	}
+	l = len(m.ReceiverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TradeData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BankingSystemData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CoinMintingPriceJson)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExchangeRateJson)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAmendTradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovTx(uint64(m.TradeIndex))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovTx(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *MsgCreateTradeV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReceiverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TradeData.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.BankingSystemData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CoinMintingPrices) > 0 {
+		for _, e := range m.CoinMintingPrices {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.CreateDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExternalReference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateTradeV2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovTx(uint64(m.TradeIndex))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgCreateTrades) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *CreateTradeEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReceiverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TradeData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BankingSystemData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CoinMintingPriceJson)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExchangeRateJson)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CreateDate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExternalReference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateTradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProcessTrades) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProcessType != 0 {
+		n += 1 + sovTx(uint64(m.ProcessType))
+	}
+	if len(m.TradeIndexes) > 0 {
+		l = 0
+		for _, e := range m.TradeIndexes {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.ExpectedRevisions) > 0 {
+		l = 0
+		for _, e := range m.ExpectedRevisions {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *MsgProcessTradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchTradeResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovTx(uint64(m.TradeIndex))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetryTrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TradeIndex != 0 {
+		n += 1 + sovTx(uint64(m.TradeIndex))
+	}
+	l = len(m.ReceiverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetryTradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeIndex != 0 {
+		n += 1 + sovTx(uint64(m.TradeIndex))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovTx(uint64(m.Attempts))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankingSystemData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankingSystemData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinMintingPriceJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinMintingPriceJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProcessTrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProcessTrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProcessTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessType", wireType)
+			}
+			m.ProcessType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessType |= ProcessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedRevision", wireType)
+			}
+			m.ExpectedRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedRevision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProcessTradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProcessTradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProcessTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendTrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendTrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradeData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankingSystemData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankingSystemData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinMintingPriceJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinMintingPriceJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateJson", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendTradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendTradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTradeV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTradeV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTradeV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TradeData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankingSystemData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankingSystemData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinMintingPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinMintingPrices = append(m.CoinMintingPrices, CoinMintingPriceJson{})
+			if err := m.CoinMintingPrices[len(m.CoinMintingPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, ExchangeRateJson{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTradeV2Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTradeV2Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTradeV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			}
+			m.TradeIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TradeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTrades) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTrades: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTrades: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, CreateTradeEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= BatchMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *CreateTradeEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -985,15 +5144,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateTradeEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateTradeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1021,13 +5180,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.ReceiverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeData", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1037,128 +5196,91 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TradeData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankingSystemData", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BankingSystemData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinMintingPriceJson", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTrade: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTrade: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CoinMintingPriceJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateJson", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1186,11 +5308,11 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ExchangeRateJson = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateDate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1218,11 +5340,11 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiverAddress = string(dAtA[iNdEx:postIndex])
+			m.CreateDate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradeData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalReference", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1239,22 +5361,156 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTradesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTradesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TradeData = string(dAtA[iNdEx:postIndex])
+			m.Results = append(m.Results, BatchTradeResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProcessTrades) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProcessTrades: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProcessTrades: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankingSystemData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1282,13 +5538,13 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BankingSystemData = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinMintingPriceJson", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessType", wireType)
 			}
-			var stringLen uint64
+			m.ProcessType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1298,29 +5554,168 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProcessType |= ProcessType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TradeIndexes = append(m.TradeIndexes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TradeIndexes) == 0 {
+					m.TradeIndexes = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TradeIndexes = append(m.TradeIndexes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndexes", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExpectedRevisions = append(m.ExpectedRevisions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExpectedRevisions) == 0 {
+					m.ExpectedRevisions = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExpectedRevisions = append(m.ExpectedRevisions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedRevisions", wireType)
 			}
-			m.CoinMintingPriceJson = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateJson", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var stringLen uint64
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1330,29 +5725,66 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Mode |= BatchMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRateJson = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProcessTradesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProcessTradesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProcessTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1362,23 +5794,25 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreateDate = string(dAtA[iNdEx:postIndex])
+			m.Results = append(m.Results, BatchTradeResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1401,7 +5835,7 @@ func (m *MsgCreateTrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTradeResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchTradeResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1424,10 +5858,10 @@ func (m *MsgCreateTradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchTradeResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchTradeResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1468,6 +5902,38 @@ func (m *MsgCreateTradeResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1489,7 +5955,7 @@ func (m *MsgCreateTradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProcessTrade) Unmarshal(dAtA []byte) error {
+func (m *MsgRetryTrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1512,10 +5978,10 @@ func (m *MsgProcessTrade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProcessTrade: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetryTrade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProcessTrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetryTrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1552,9 +6018,9 @@ func (m *MsgProcessTrade) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
 			}
-			m.ProcessType = 0
+			m.TradeIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1564,16 +6030,16 @@ func (m *MsgProcessTrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProcessType |= ProcessType(b&0x7F) << shift
+				m.TradeIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradeIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddress", wireType)
 			}
-			m.TradeIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1583,11 +6049,24 @@ func (m *MsgProcessTrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TradeIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1609,7 +6088,7 @@ func (m *MsgProcessTrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProcessTradeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRetryTradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1632,10 +6111,10 @@ func (m *MsgProcessTradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProcessTradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRetryTradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProcessTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRetryTradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1676,6 +6155,25 @@ func (m *MsgProcessTradeResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])